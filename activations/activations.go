@@ -97,6 +97,28 @@ func (a *Activation[T]) FunctionValues() map[string]T {
 	return values
 }
 
+// ForEach calls the given function for each name-value pair in the activation,
+// and then for each pair in its parents.
+// Entries of a parent may be shadowed by entries of a nested activation.
+func (a *Activation[T]) ForEach(cb func(string, T) error) error {
+
+	current := a
+
+	for current != nil {
+
+		for name, value := range current.entries { //nolint:maprange
+			err := cb(name, value)
+			if err != nil {
+				return err
+			}
+		}
+
+		current = current.Parent
+	}
+
+	return nil
+}
+
 // Set sets the given name-value pair in the activation.
 func (a *Activation[T]) Set(name string, value T) {
 	if a.entries == nil {
@@ -71,3 +71,40 @@ func TestActivations(t *testing.T) {
 	assert.Zero(t, activations.Find("b"))
 	assert.Zero(t, activations.Find("c"))
 }
+
+func TestActivationForEach(t *testing.T) {
+
+	t.Parallel()
+
+	parent := NewActivation[int](nil, nil)
+	parent.Set("a", 1)
+	parent.Set("b", 2)
+
+	child := NewActivation(nil, parent)
+	child.Set("a", 3)
+
+	type entry struct {
+		name  string
+		value int
+	}
+
+	var entries []entry
+
+	err := child.ForEach(func(name string, value int) error {
+		entries = append(entries, entry{name: name, value: value})
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Entries of the child come first, the parent's entries are in any order
+
+	assert.Len(t, entries, 3)
+	assert.Equal(t, entry{name: "a", value: 3}, entries[0])
+	assert.ElementsMatch(t,
+		[]entry{
+			{name: "a", value: 1},
+			{name: "b", value: 2},
+		},
+		entries[1:],
+	)
+}
@@ -36,6 +36,7 @@ type AttachmentDeclaration struct {
 	Members      *Members
 	DocString    string
 	Range
+	ElementComments
 }
 
 var _ Element = &AttachmentDeclaration{}
@@ -258,7 +259,7 @@ func (e *AttachExpression) Doc() prettier.Doc {
 	return prettier.Concat{
 		attachExpressionDoc,
 		prettier.Space,
-		e.Attachment.Doc(),
+		e.Attachment.nominalDoc(),
 		prettier.Space,
 		attachExpressionToDoc,
 		prettier.Space,
@@ -296,6 +297,7 @@ type RemoveStatement struct {
 	Attachment *NominalType
 	Value      Expression
 	StartPos   Position `json:"-"`
+	ElementComments
 }
 
 var _ Element = &RemoveStatement{}
@@ -29,6 +29,7 @@ import (
 type Block struct {
 	Statements []Statement
 	Range
+	ElementComments
 }
 
 var _ Element = &Block{}
@@ -59,14 +60,14 @@ var blockEndDoc prettier.Doc = prettier.Text("}")
 var blockEmptyDoc prettier.Doc = prettier.Text("{}")
 
 func (b *Block) Doc() prettier.Doc {
-	if b.IsEmpty() {
+	if b.IsEmpty() && len(innerCommentsDoc(b)) == 0 {
 		return blockEmptyDoc
 	}
 
 	return prettier.Concat{
 		blockStartDoc,
 		prettier.Indent{
-			Doc: StatementsDoc(b.Statements),
+			Doc: withInnerCommentsDoc(b, StatementsDoc(b.Statements)),
 		},
 		prettier.HardLine{},
 		blockEndDoc,
@@ -76,11 +77,16 @@ func (b *Block) Doc() prettier.Doc {
 func StatementsDoc(statements []Statement) prettier.Doc {
 	var doc prettier.Concat
 
-	for _, statement := range statements {
+	for i, statement := range statements {
+		// Preserve blank lines between statements, if recorded
+		if i > 0 && hasBlankLineBefore(statement) {
+			doc = append(doc, prettier.HardLine{})
+		}
+
 		doc = append(
 			doc,
 			prettier.HardLine{},
-			statement.Doc(),
+			commentedDoc(statement, statement.Doc()),
 		)
 	}
 
@@ -168,7 +174,7 @@ var preConditionsKeywordDoc = prettier.Text("pre")
 var postConditionsKeywordDoc = prettier.Text("post")
 
 func (b *FunctionBlock) Doc() prettier.Doc {
-	if b.IsEmpty() {
+	if b.IsEmpty() && (b == nil || len(innerCommentsDoc(b.Block)) == 0) {
 		return blockEmptyDoc
 	}
 
@@ -192,7 +198,7 @@ func (b *FunctionBlock) Doc() prettier.Doc {
 
 	var bodyDoc prettier.Doc
 
-	statementsDoc := StatementsDoc(b.Block.Statements)
+	statementsDoc := withInnerCommentsDoc(b.Block, StatementsDoc(b.Block.Statements))
 
 	if len(conditionDocs) > 0 {
 		bodyConcatDoc := prettier.Concat(conditionDocs)
@@ -243,6 +249,7 @@ type Condition interface {
 type TestCondition struct {
 	Test    Expression
 	Message Expression
+	ElementComments
 }
 
 func (c TestCondition) ElementType() ElementType {
@@ -358,6 +365,7 @@ func (c *EmitCondition) Walk(walkChild func(Element)) {
 type Conditions struct {
 	Conditions []Condition
 	Range
+	ElementComments
 }
 
 func (c *Conditions) IsEmpty() bool {
@@ -365,7 +373,13 @@ func (c *Conditions) IsEmpty() bool {
 }
 
 func (c *Conditions) Doc(keywordDoc prettier.Doc) prettier.Doc {
-	if c.IsEmpty() {
+	if c == nil {
+		return nil
+	}
+
+	innerCommentsDoc := innerCommentsDoc(c)
+
+	if c.IsEmpty() && len(innerCommentsDoc) == 0 {
 		return nil
 	}
 
@@ -375,22 +389,27 @@ func (c *Conditions) Doc(keywordDoc prettier.Doc) prettier.Doc {
 		doc = append(
 			doc,
 			prettier.HardLine{},
-			condition.Doc(),
+			commentedDoc(condition, condition.Doc()),
 		)
 	}
 
-	return prettier.Group{
-		Doc: prettier.Concat{
-			keywordDoc,
-			prettier.Space,
-			blockStartDoc,
-			prettier.Indent{
-				Doc: doc,
+	doc = append(doc, innerCommentsDoc...)
+
+	return commentedDoc(
+		c,
+		prettier.Group{
+			Doc: prettier.Concat{
+				keywordDoc,
+				prettier.Space,
+				blockStartDoc,
+				prettier.Indent{
+					Doc: doc,
+				},
+				prettier.HardLine{},
+				blockEndDoc,
 			},
-			prettier.HardLine{},
-			blockEndDoc,
 		},
-	}
+	)
 }
 
 func (c *Conditions) Walk(walkChild func(Element)) {
@@ -0,0 +1,220 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"strings"
+
+	"github.com/turbolent/prettier"
+)
+
+// Comment is a line comment (`// ...`) or a block comment (`/* ... */`)
+type Comment struct {
+	// Text is the source code of the comment, including the delimiters
+	Text string
+	Range
+	// BlankLineBefore is true if the comment is preceded by a blank line
+	BlankLineBefore bool `json:",omitempty"`
+	// BlankLineAfter is true if the comment is followed by a blank line
+	BlankLineAfter bool `json:",omitempty"`
+}
+
+const blockCommentPrefix = "/*"
+
+// IsBlock returns true if the comment is a block comment,
+// and false if it is a line comment
+func (c *Comment) IsBlock() bool {
+	return strings.HasPrefix(c.Text, blockCommentPrefix)
+}
+
+// Doc returns the document for the comment.
+//
+// The continuation lines of a multi-line block comment are re-indented:
+// Whitespace up to the column at which the comment started is removed,
+// so that the comment can be printed at a different indentation
+func (c *Comment) Doc() prettier.Doc {
+	lines := strings.Split(c.Text, "\n")
+	if len(lines) == 1 {
+		return prettier.Text(c.Text)
+	}
+
+	docs := make([]prettier.Doc, 0, len(lines))
+
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+		if i > 0 {
+			line = trimIndentation(line, c.StartPos.Column)
+		}
+		docs = append(docs, prettier.Text(line))
+	}
+
+	return prettier.Join(prettier.HardLine{}, docs...)
+}
+
+// trimIndentation removes at most the given number of leading whitespace characters
+func trimIndentation(line string, indentation int) string {
+	i := 0
+	for i < len(line) && i < indentation && (line[i] == ' ' || line[i] == '\t') {
+		i++
+	}
+	return line[i:]
+}
+
+// Comments are the comments and blank-line trivia attached to an element.
+//
+// Comments are only recorded if the parser is configured to do so,
+// see parser.Config.CommentsEnabled
+type Comments struct {
+	// Leading are the comments before the element.
+	// Comments inside the element which cannot be attached
+	// to any nested element are also leading comments
+	Leading []*Comment `json:",omitempty"`
+	// Trailing are the comments after the element,
+	// which start on the line the element ends
+	Trailing []*Comment `json:",omitempty"`
+	// Inner are the comments at the end of an element's body,
+	// after the last nested element, e.g. before the closing brace of a block
+	Inner []*Comment `json:",omitempty"`
+	// BlankLineBefore is true if the element (including its leading comments)
+	// is preceded by a blank line
+	BlankLineBefore bool `json:",omitempty"`
+}
+
+func (c *Comments) IsEmpty() bool {
+	return c == nil ||
+		(len(c.Leading) == 0 &&
+			len(c.Trailing) == 0 &&
+			len(c.Inner) == 0 &&
+			!c.BlankLineBefore)
+}
+
+// ElementComments is embedded in elements to which comments can be attached
+type ElementComments struct {
+	Comments *Comments `json:",omitempty"`
+}
+
+// AttachedComments returns the comments attached to the element, if any
+func (c ElementComments) AttachedComments() *Comments {
+	return c.Comments
+}
+
+// AttachComments attaches the given comments to the element
+func (c *ElementComments) AttachComments(comments *Comments) {
+	c.Comments = comments
+}
+
+// CommentedElement is an element to which comments can be attached
+type CommentedElement interface {
+	AttachedComments() *Comments
+	AttachComments(comments *Comments)
+}
+
+// commentedDoc returns the given document of the given element,
+// surrounded by the leading and trailing comments attached to the element, if any
+func commentedDoc(element any, doc prettier.Doc) prettier.Doc {
+	comments := attachedComments(element)
+	if comments == nil ||
+		(len(comments.Leading) == 0 && len(comments.Trailing) == 0) {
+
+		return doc
+	}
+
+	result := make(prettier.Concat, 0, 3*len(comments.Leading)+1+2*len(comments.Trailing))
+
+	for _, comment := range comments.Leading {
+		result = append(
+			result,
+			comment.Doc(),
+			prettier.HardLine{},
+		)
+		if comment.BlankLineAfter {
+			result = append(result, prettier.HardLine{})
+		}
+	}
+
+	result = append(result, doc)
+
+	for _, comment := range comments.Trailing {
+		result = append(
+			result,
+			prettier.Space,
+			comment.Doc(),
+		)
+	}
+
+	return result
+}
+
+// innerCommentsDoc returns the document for the inner comments of the given element,
+// each on a separate line, or an empty document if there are none
+func innerCommentsDoc(element any) prettier.Concat {
+	comments := attachedComments(element)
+	if comments == nil || len(comments.Inner) == 0 {
+		return nil
+	}
+
+	result := make(prettier.Concat, 0, 3*len(comments.Inner))
+
+	for _, comment := range comments.Inner {
+		if comment.BlankLineBefore {
+			result = append(result, prettier.HardLine{})
+		}
+		result = append(
+			result,
+			prettier.HardLine{},
+			comment.Doc(),
+		)
+	}
+
+	return result
+}
+
+// withInnerCommentsDoc returns the given document of the body of the given element,
+// followed by the inner comments attached to the element, if any
+func withInnerCommentsDoc(element any, doc prettier.Doc) prettier.Doc {
+	innerCommentsDoc := innerCommentsDoc(element)
+	if len(innerCommentsDoc) == 0 {
+		return doc
+	}
+
+	if doc == nil {
+		return innerCommentsDoc
+	}
+
+	return prettier.Concat{
+		doc,
+		innerCommentsDoc,
+	}
+}
+
+// hasBlankLineBefore returns true if the given element is preceded by a blank line
+func hasBlankLineBefore(element any) bool {
+	comments := attachedComments(element)
+	return comments != nil && comments.BlankLineBefore
+}
+
+func attachedComments(element any) *Comments {
+	// NOTE: not CommentedElement, as some elements with attached comments
+	// are values (e.g. TestCondition), which cannot be modified through the interface
+	commentedElement, ok := element.(interface{ AttachedComments() *Comments })
+	if !ok {
+		return nil
+	}
+	return commentedElement.AttachedComments()
+}
@@ -0,0 +1,162 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turbolent/prettier"
+)
+
+func TestComment_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("line", func(t *testing.T) {
+
+		t.Parallel()
+
+		comment := &Comment{
+			Text: "// test",
+		}
+
+		assert.False(t, comment.IsBlock())
+		assert.Equal(t,
+			prettier.Text("// test"),
+			comment.Doc(),
+		)
+	})
+
+	t.Run("multi-line block", func(t *testing.T) {
+
+		t.Parallel()
+
+		comment := &Comment{
+			Text: "/* a\n       b\n   */",
+			Range: Range{
+				StartPos: Position{Line: 1, Column: 4},
+			},
+		}
+
+		assert.True(t, comment.IsBlock())
+		assert.Equal(t,
+			prettier.Join(
+				prettier.HardLine{},
+				prettier.Text("/* a"),
+				prettier.Text("   b"),
+				prettier.Text("*/"),
+			),
+			comment.Doc(),
+		)
+	})
+}
+
+func TestBlock_String_Comments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("statements", func(t *testing.T) {
+
+		t.Parallel()
+
+		block := &Block{
+			Statements: []Statement{
+				&ExpressionStatement{
+					Expression: &BoolExpression{
+						Value: false,
+					},
+					ElementComments: ElementComments{
+						Comments: &Comments{
+							Leading: []*Comment{
+								{Text: "// leading"},
+							},
+							Trailing: []*Comment{
+								{Text: "/* trailing */"},
+							},
+						},
+					},
+				},
+				&ExpressionStatement{
+					Expression: &BoolExpression{
+						Value: true,
+					},
+					ElementComments: ElementComments{
+						Comments: &Comments{
+							BlankLineBefore: true,
+						},
+					},
+				},
+			},
+			ElementComments: ElementComments{
+				Comments: &Comments{
+					Inner: []*Comment{
+						{Text: "// inner"},
+					},
+				},
+			},
+		}
+
+		assert.Equal(t,
+			"{\n"+
+				"    // leading\n"+
+				"    false /* trailing */\n"+
+				"    \n"+
+				"    true\n"+
+				"    // inner\n"+
+				"}",
+			block.String(),
+		)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		block := &Block{}
+		block.AttachComments(&Comments{
+			Inner: []*Comment{
+				{Text: "// TODO"},
+			},
+		})
+
+		assert.Equal(t,
+			"{\n    // TODO\n}",
+			block.String(),
+		)
+	})
+}
+
+func TestProgram_Doc_Comments(t *testing.T) {
+
+	t.Parallel()
+
+	program := NewProgram(nil, nil)
+	program.AttachComments(&Comments{
+		Inner: []*Comment{
+			{Text: "// a", BlankLineBefore: true},
+			{Text: "// b", BlankLineBefore: true},
+		},
+	})
+
+	assert.Equal(t,
+		"// a\n\n// b",
+		Prettier(program),
+	)
+}
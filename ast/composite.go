@@ -50,13 +50,15 @@ func IsResourceDestructionDefaultEvent(identifier string) bool {
 }
 
 type CompositeDeclaration struct {
-	Members      *Members
-	DocString    string
-	Conformances []*NominalType
-	Identifier   Identifier
+	Members           *Members
+	TypeParameterList *TypeParameterList `json:",omitempty"`
+	DocString         string
+	Conformances      []*NominalType
+	Identifier        Identifier
 	Range
 	Access        Access
 	CompositeKind common.CompositeKind
+	ElementComments
 }
 
 var _ Element = &CompositeDeclaration{}
@@ -69,6 +71,7 @@ func NewCompositeDeclaration(
 	access Access,
 	compositeKind common.CompositeKind,
 	identifier Identifier,
+	typeParameterList *TypeParameterList,
 	conformances []*NominalType,
 	members *Members,
 	docString string,
@@ -77,13 +80,14 @@ func NewCompositeDeclaration(
 	common.UseMemory(memoryGauge, common.CompositeDeclarationMemoryUsage)
 
 	return &CompositeDeclaration{
-		Access:        access,
-		CompositeKind: compositeKind,
-		Identifier:    identifier,
-		Conformances:  conformances,
-		Members:       members,
-		DocString:     docString,
-		Range:         declarationRange,
+		Access:            access,
+		CompositeKind:     compositeKind,
+		Identifier:        identifier,
+		TypeParameterList: typeParameterList,
+		Conformances:      conformances,
+		Members:           members,
+		DocString:         docString,
+		Range:             declarationRange,
 	}
 }
 
@@ -145,6 +149,7 @@ func (d *CompositeDeclaration) Doc() prettier.Doc {
 		d.CompositeKind,
 		false,
 		d.Identifier.Identifier,
+		d.TypeParameterList,
 		d.Conformances,
 		d.Members,
 	)
@@ -195,6 +200,7 @@ func CompositeDocument(
 	kind common.CompositeKind,
 	isInterface bool,
 	identifier string,
+	typeParameterList *TypeParameterList,
 	conformances []*NominalType,
 	members *Members,
 ) prettier.Doc {
@@ -227,6 +233,16 @@ func CompositeDocument(
 		prettier.Text(identifier),
 	)
 
+	if typeParameterList != nil {
+		typeParameterListDoc := typeParameterList.Doc()
+		if typeParameterListDoc != nil {
+			doc = append(
+				doc,
+				typeParameterListDoc,
+			)
+		}
+	}
+
 	if len(conformances) > 0 {
 
 		conformancesDoc := prettier.Concat{
@@ -304,12 +320,15 @@ const (
 
 type FieldDeclaration struct {
 	TypeAnnotation *TypeAnnotation
-	DocString      string
-	Identifier     Identifier
+	// Getter is the body of a computed field, if any
+	Getter     *FunctionBlock `json:",omitempty"`
+	DocString  string
+	Identifier Identifier
 	Range
 	Access       Access
 	VariableKind VariableKind
 	Flags        FieldDeclarationFlags
+	ElementComments
 }
 
 var _ Element = &FieldDeclaration{}
@@ -351,9 +370,11 @@ func (*FieldDeclaration) ElementType() ElementType {
 	return ElementTypeFieldDeclaration
 }
 
-func (d *FieldDeclaration) Walk(_ func(Element)) {
-	// NO-OP
+func (d *FieldDeclaration) Walk(walkChild func(Element)) {
 	// TODO: walk type
+	if d.Getter != nil {
+		walkChild(d.Getter)
+	}
 }
 
 func (*FieldDeclaration) isDeclaration() {}
@@ -472,9 +493,19 @@ func (d *FieldDeclaration) Doc() prettier.Doc {
 		}
 	}
 
-	return prettier.Group{
+	doc = prettier.Group{
 		Doc: doc,
 	}
+
+	if d.Getter != nil {
+		doc = prettier.Concat{
+			doc,
+			prettier.Space,
+			d.Getter.Doc(),
+		}
+	}
+
+	return doc
 }
 
 func (d *FieldDeclaration) String() string {
@@ -489,6 +520,12 @@ func (d *FieldDeclaration) IsNative() bool {
 	return d.Flags&FieldDeclarationFlagsIsNative != 0
 }
 
+// IsComputed returns true if the field is not stored,
+// but its value is computed by its getter on each access
+func (d *FieldDeclaration) IsComputed() bool {
+	return d.Getter != nil
+}
+
 // EnumCaseDeclaration
 
 type EnumCaseDeclaration struct {
@@ -496,6 +533,7 @@ type EnumCaseDeclaration struct {
 	Identifier Identifier
 	StartPos   Position `json:"-"`
 	Access     Access
+	ElementComments
 }
 
 var _ Element = &EnumCaseDeclaration{}
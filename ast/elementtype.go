@@ -43,6 +43,7 @@ const (
 	ElementTypePragmaDeclaration
 	ElementTypeImportDeclaration
 	ElementTypeTransactionDeclaration
+	ElementTypeTypeAliasDeclaration
 
 	// Statements
 
@@ -24,48 +24,49 @@ func _() {
 	_ = x[ElementTypePragmaDeclaration-13]
 	_ = x[ElementTypeImportDeclaration-14]
 	_ = x[ElementTypeTransactionDeclaration-15]
-	_ = x[ElementTypeReturnStatement-16]
-	_ = x[ElementTypeBreakStatement-17]
-	_ = x[ElementTypeContinueStatement-18]
-	_ = x[ElementTypeIfStatement-19]
-	_ = x[ElementTypeSwitchStatement-20]
-	_ = x[ElementTypeWhileStatement-21]
-	_ = x[ElementTypeForStatement-22]
-	_ = x[ElementTypeEmitStatement-23]
-	_ = x[ElementTypeVariableDeclaration-24]
-	_ = x[ElementTypeAssignmentStatement-25]
-	_ = x[ElementTypeSwapStatement-26]
-	_ = x[ElementTypeExpressionStatement-27]
-	_ = x[ElementTypeRemoveStatement-28]
-	_ = x[ElementTypeVoidExpression-29]
-	_ = x[ElementTypeBoolExpression-30]
-	_ = x[ElementTypeNilExpression-31]
-	_ = x[ElementTypeIntegerExpression-32]
-	_ = x[ElementTypeFixedPointExpression-33]
-	_ = x[ElementTypeArrayExpression-34]
-	_ = x[ElementTypeDictionaryExpression-35]
-	_ = x[ElementTypeIdentifierExpression-36]
-	_ = x[ElementTypeInvocationExpression-37]
-	_ = x[ElementTypeMemberExpression-38]
-	_ = x[ElementTypeIndexExpression-39]
-	_ = x[ElementTypeConditionalExpression-40]
-	_ = x[ElementTypeUnaryExpression-41]
-	_ = x[ElementTypeBinaryExpression-42]
-	_ = x[ElementTypeFunctionExpression-43]
-	_ = x[ElementTypeStringExpression-44]
-	_ = x[ElementTypeCastingExpression-45]
-	_ = x[ElementTypeCreateExpression-46]
-	_ = x[ElementTypeDestroyExpression-47]
-	_ = x[ElementTypeReferenceExpression-48]
-	_ = x[ElementTypeForceExpression-49]
-	_ = x[ElementTypePathExpression-50]
-	_ = x[ElementTypeAttachExpression-51]
-	_ = x[ElementTypeStringTemplateExpression-52]
+	_ = x[ElementTypeTypeAliasDeclaration-16]
+	_ = x[ElementTypeReturnStatement-17]
+	_ = x[ElementTypeBreakStatement-18]
+	_ = x[ElementTypeContinueStatement-19]
+	_ = x[ElementTypeIfStatement-20]
+	_ = x[ElementTypeSwitchStatement-21]
+	_ = x[ElementTypeWhileStatement-22]
+	_ = x[ElementTypeForStatement-23]
+	_ = x[ElementTypeEmitStatement-24]
+	_ = x[ElementTypeVariableDeclaration-25]
+	_ = x[ElementTypeAssignmentStatement-26]
+	_ = x[ElementTypeSwapStatement-27]
+	_ = x[ElementTypeExpressionStatement-28]
+	_ = x[ElementTypeRemoveStatement-29]
+	_ = x[ElementTypeVoidExpression-30]
+	_ = x[ElementTypeBoolExpression-31]
+	_ = x[ElementTypeNilExpression-32]
+	_ = x[ElementTypeIntegerExpression-33]
+	_ = x[ElementTypeFixedPointExpression-34]
+	_ = x[ElementTypeArrayExpression-35]
+	_ = x[ElementTypeDictionaryExpression-36]
+	_ = x[ElementTypeIdentifierExpression-37]
+	_ = x[ElementTypeInvocationExpression-38]
+	_ = x[ElementTypeMemberExpression-39]
+	_ = x[ElementTypeIndexExpression-40]
+	_ = x[ElementTypeConditionalExpression-41]
+	_ = x[ElementTypeUnaryExpression-42]
+	_ = x[ElementTypeBinaryExpression-43]
+	_ = x[ElementTypeFunctionExpression-44]
+	_ = x[ElementTypeStringExpression-45]
+	_ = x[ElementTypeCastingExpression-46]
+	_ = x[ElementTypeCreateExpression-47]
+	_ = x[ElementTypeDestroyExpression-48]
+	_ = x[ElementTypeReferenceExpression-49]
+	_ = x[ElementTypeForceExpression-50]
+	_ = x[ElementTypePathExpression-51]
+	_ = x[ElementTypeAttachExpression-52]
+	_ = x[ElementTypeStringTemplateExpression-53]
 }
 
-const _ElementType_name = "ElementTypeUnknownElementTypeProgramElementTypeBlockElementTypeFunctionBlockElementTypeFunctionDeclarationElementTypeSpecialFunctionDeclarationElementTypeCompositeDeclarationElementTypeInterfaceDeclarationElementTypeEntitlementDeclarationElementTypeEntitlementMappingDeclarationElementTypeAttachmentDeclarationElementTypeFieldDeclarationElementTypeEnumCaseDeclarationElementTypePragmaDeclarationElementTypeImportDeclarationElementTypeTransactionDeclarationElementTypeReturnStatementElementTypeBreakStatementElementTypeContinueStatementElementTypeIfStatementElementTypeSwitchStatementElementTypeWhileStatementElementTypeForStatementElementTypeEmitStatementElementTypeVariableDeclarationElementTypeAssignmentStatementElementTypeSwapStatementElementTypeExpressionStatementElementTypeRemoveStatementElementTypeVoidExpressionElementTypeBoolExpressionElementTypeNilExpressionElementTypeIntegerExpressionElementTypeFixedPointExpressionElementTypeArrayExpressionElementTypeDictionaryExpressionElementTypeIdentifierExpressionElementTypeInvocationExpressionElementTypeMemberExpressionElementTypeIndexExpressionElementTypeConditionalExpressionElementTypeUnaryExpressionElementTypeBinaryExpressionElementTypeFunctionExpressionElementTypeStringExpressionElementTypeCastingExpressionElementTypeCreateExpressionElementTypeDestroyExpressionElementTypeReferenceExpressionElementTypeForceExpressionElementTypePathExpressionElementTypeAttachExpressionElementTypeStringTemplateExpression"
+const _ElementType_name = "ElementTypeUnknownElementTypeProgramElementTypeBlockElementTypeFunctionBlockElementTypeFunctionDeclarationElementTypeSpecialFunctionDeclarationElementTypeCompositeDeclarationElementTypeInterfaceDeclarationElementTypeEntitlementDeclarationElementTypeEntitlementMappingDeclarationElementTypeAttachmentDeclarationElementTypeFieldDeclarationElementTypeEnumCaseDeclarationElementTypePragmaDeclarationElementTypeImportDeclarationElementTypeTransactionDeclarationElementTypeTypeAliasDeclarationElementTypeReturnStatementElementTypeBreakStatementElementTypeContinueStatementElementTypeIfStatementElementTypeSwitchStatementElementTypeWhileStatementElementTypeForStatementElementTypeEmitStatementElementTypeVariableDeclarationElementTypeAssignmentStatementElementTypeSwapStatementElementTypeExpressionStatementElementTypeRemoveStatementElementTypeVoidExpressionElementTypeBoolExpressionElementTypeNilExpressionElementTypeIntegerExpressionElementTypeFixedPointExpressionElementTypeArrayExpressionElementTypeDictionaryExpressionElementTypeIdentifierExpressionElementTypeInvocationExpressionElementTypeMemberExpressionElementTypeIndexExpressionElementTypeConditionalExpressionElementTypeUnaryExpressionElementTypeBinaryExpressionElementTypeFunctionExpressionElementTypeStringExpressionElementTypeCastingExpressionElementTypeCreateExpressionElementTypeDestroyExpressionElementTypeReferenceExpressionElementTypeForceExpressionElementTypePathExpressionElementTypeAttachExpressionElementTypeStringTemplateExpression"
 
-var _ElementType_index = [...]uint16{0, 18, 36, 52, 76, 106, 143, 174, 205, 238, 278, 310, 337, 367, 395, 423, 456, 482, 507, 535, 557, 583, 608, 631, 655, 685, 715, 739, 769, 795, 820, 845, 869, 897, 928, 954, 985, 1016, 1047, 1074, 1100, 1132, 1158, 1185, 1214, 1241, 1269, 1296, 1324, 1354, 1380, 1405, 1432, 1467}
+var _ElementType_index = [...]uint16{0, 18, 36, 52, 76, 106, 143, 174, 205, 238, 278, 310, 337, 367, 395, 423, 456, 487, 513, 538, 566, 588, 614, 639, 662, 686, 716, 746, 770, 800, 826, 851, 876, 900, 928, 959, 985, 1016, 1047, 1078, 1105, 1131, 1163, 1189, 1216, 1245, 1272, 1300, 1327, 1355, 1385, 1411, 1436, 1463, 1498}
 
 func (i ElementType) String() string {
 	if i >= ElementType(len(_ElementType_index)-1) {
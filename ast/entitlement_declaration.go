@@ -33,6 +33,7 @@ type EntitlementDeclaration struct {
 	DocString  string
 	Identifier Identifier
 	Range
+	ElementComments
 }
 
 var _ Element = &EntitlementDeclaration{}
@@ -172,6 +173,7 @@ type EntitlementMappingDeclaration struct {
 	Identifier Identifier
 	Elements   []EntitlementMapElement
 	Range
+	ElementComments
 }
 
 var _ Element = &EntitlementMappingDeclaration{}
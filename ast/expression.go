@@ -271,13 +271,36 @@ func (e *StringTemplateExpression) String() string {
 	return Prettier(e)
 }
 
+const stringTemplateQuoteDoc = prettier.Text(`"`)
+const stringTemplateExpressionStartDoc = prettier.Text(`\(`)
+const stringTemplateExpressionEndDoc = prettier.Text(`)`)
+
 func (e *StringTemplateExpression) Doc() prettier.Doc {
 	if len(e.Expressions) == 0 {
 		return prettier.Text(QuoteString(e.Values[0]))
 	}
 
-	// TODO: must reproduce expressions as literals
-	panic("not implemented")
+	doc := prettier.Concat{
+		stringTemplateQuoteDoc,
+	}
+
+	for i, value := range e.Values {
+		quoted := QuoteString(value)
+		// Strip the quotes
+		doc = append(doc, prettier.Text(quoted[1:len(quoted)-1]))
+
+		if i < len(e.Expressions) {
+			doc = append(
+				doc,
+				stringTemplateExpressionStartDoc,
+				// The expression must not be broken into multiple lines
+				prettier.Text(flatString(e.Expressions[i])),
+				stringTemplateExpressionEndDoc,
+			)
+		}
+	}
+
+	return append(doc, stringTemplateQuoteDoc)
 }
 
 func (e *StringTemplateExpression) MarshalJSON() ([]byte, error) {
@@ -812,11 +835,36 @@ func (e *InvocationExpression) String() string {
 
 func (e *InvocationExpression) Doc() prettier.Doc {
 
-	result := prettier.Concat{
-		parenthesizedExpressionDoc(
+	var invokedExpressionDoc prettier.Doc
+	if _, ok := e.InvokedExpression.(*FunctionExpression); ok {
+		// The invocation of a function expression must be parenthesized,
+		// as the arguments would otherwise be parsed as a separate expression
+		invokedExpressionDoc = prettier.WrapParentheses(
+			e.InvokedExpression.Doc(),
+			prettier.SoftLine{},
+		)
+	} else {
+		invokedExpressionDoc = parenthesizedExpressionDoc(
 			e.InvokedExpression,
 			e.precedence(),
-		),
+		)
+	}
+
+	return e.docWithInvokedExpressionDoc(invokedExpressionDoc)
+}
+
+// nominalDoc returns the document for an invocation of a nominal type,
+// as in create and attach expressions, and emit statements.
+// The invoked type is not broken across lines, as it is parsed as a nominal type
+func (e *InvocationExpression) nominalDoc() prettier.Doc {
+	return e.docWithInvokedExpressionDoc(
+		prettier.Text(flatString(e.InvokedExpression)),
+	)
+}
+
+func (e *InvocationExpression) docWithInvokedExpressionDoc(invokedExpressionDoc prettier.Doc) prettier.Doc {
+	result := prettier.Concat{
+		invokedExpressionDoc,
 	}
 
 	if len(e.TypeArguments) > 0 {
@@ -937,11 +985,21 @@ func (e *MemberExpression) Doc() prettier.Doc {
 		separatorDoc = memberExpressionSeparatorDoc
 	}
 
-	return prettier.Concat{
-		parenthesizedExpressionDoc(
+	var expressionDoc prettier.Doc
+	if isNumericLiteralMemberAccessAmbiguous(e.Expression) {
+		expressionDoc = prettier.WrapParentheses(
+			e.Expression.Doc(),
+			prettier.SoftLine{},
+		)
+	} else {
+		expressionDoc = parenthesizedExpressionDoc(
 			e.Expression,
 			e.precedence(),
-		),
+		)
+	}
+
+	return prettier.Concat{
+		expressionDoc,
 		prettier.Group{
 			Doc: prettier.Indent{
 				Doc: prettier.Concat{
@@ -954,6 +1012,21 @@ func (e *MemberExpression) Doc() prettier.Doc {
 	}
 }
 
+// isNumericLiteralMemberAccessAmbiguous returns true if the given expression
+// must be parenthesized when it is the receiver of a member access:
+// A decimal integer literal followed by a dot would be parsed as a fixed-point literal,
+// and the sign of a negative literal would apply to the whole member access
+func isNumericLiteralMemberAccessAmbiguous(expression Expression) bool {
+	switch expression := expression.(type) {
+	case *IntegerExpression:
+		return expression.Base == 10 ||
+			expression.Value.Sign() < 0
+	case *FixedPointExpression:
+		return expression.Negative
+	}
+	return false
+}
+
 func (e *MemberExpression) StartPosition() Position {
 	return e.Expression.StartPosition()
 }
@@ -1233,6 +1306,14 @@ func parenthesizedExpressionDoc(e Expression, parentPrecedence precedence) prett
 	if parentPrecedence <= subPrecedence {
 		return doc
 	}
+	// Postfix expressions are applied left to right,
+	// so a postfix sub-expression of an access expression does not need to be parenthesized,
+	// e.g. `x!.y` is `(x!).y`
+	if parentPrecedence >= precedenceUnaryPostfix &&
+		subPrecedence >= precedenceUnaryPostfix {
+
+		return doc
+	}
 	return prettier.WrapParentheses(
 		doc,
 		prettier.SoftLine{},
@@ -1270,7 +1351,10 @@ func (e *UnaryExpression) MarshalJSON() ([]byte, error) {
 	})
 }
 
-func (*UnaryExpression) precedence() precedence {
+func (e *UnaryExpression) precedence() precedence {
+	if e.Operation == OperationMove {
+		return precedenceMove
+	}
 	return precedenceUnaryPrefix
 }
 
@@ -1754,7 +1838,7 @@ var createKeywordSpaceDoc = prettier.Text("create ")
 func (e *CreateExpression) Doc() prettier.Doc {
 	return prettier.Concat{
 		createKeywordSpaceDoc,
-		e.InvocationExpression.Doc(),
+		e.InvocationExpression.nominalDoc(),
 	}
 }
 
@@ -1287,6 +1287,71 @@ func TestMemberExpression_String(t *testing.T) {
 			expr.String(),
 		)
 	})
+
+	t.Run("decimal integer", func(t *testing.T) {
+
+		t.Parallel()
+
+		expr := &MemberExpression{
+			Expression: &IntegerExpression{
+				PositiveLiteral: []byte("1"),
+				Value:           big.NewInt(1),
+				Base:            10,
+			},
+			Identifier: Identifier{
+				Identifier: "foo",
+			},
+		}
+
+		assert.Equal(t,
+			"(1).foo",
+			expr.String(),
+		)
+	})
+
+	t.Run("hexadecimal integer", func(t *testing.T) {
+
+		t.Parallel()
+
+		expr := &MemberExpression{
+			Expression: &IntegerExpression{
+				PositiveLiteral: []byte("0x1"),
+				Value:           big.NewInt(1),
+				Base:            16,
+			},
+			Identifier: Identifier{
+				Identifier: "foo",
+			},
+		}
+
+		assert.Equal(t,
+			"0x1.foo",
+			expr.String(),
+		)
+	})
+
+	t.Run("force", func(t *testing.T) {
+
+		t.Parallel()
+
+		expr := &MemberExpression{
+			Expression: &ForceExpression{
+				Expression: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "foo",
+					},
+				},
+			},
+			Identifier: Identifier{
+				Identifier: "bar",
+			},
+		}
+
+		assert.Equal(t,
+			"foo!.bar",
+			expr.String(),
+		)
+	})
 }
 
 func TestIndexExpression_MarshalJSON(t *testing.T) {
@@ -3612,6 +3677,25 @@ func TestInvocationExpression_String(t *testing.T) {
 			expr.String(),
 		)
 	})
+
+	t.Run("function expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		expr := &InvocationExpression{
+			InvokedExpression: &FunctionExpression{
+				ParameterList: &ParameterList{},
+				FunctionBlock: &FunctionBlock{
+					Block: &Block{},
+				},
+			},
+		}
+
+		assert.Equal(t,
+			"(fun () {})()",
+			expr.String(),
+		)
+	})
 }
 
 func TestCastingExpression_MarshalJSON(t *testing.T) {
@@ -3943,6 +4027,36 @@ func TestCastingExpression_String(t *testing.T) {
 			expr.String(),
 		)
 	})
+
+	t.Run("move", func(t *testing.T) {
+
+		t.Parallel()
+
+		expr := &CastingExpression{
+			Expression: &UnaryExpression{
+				Operation: OperationMove,
+				Expression: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "r",
+					},
+				},
+			},
+			Operation: OperationForceCast,
+			TypeAnnotation: &TypeAnnotation{
+				IsResource: true,
+				Type: &NominalType{
+					Identifier: Identifier{
+						Identifier: "R",
+					},
+				},
+			},
+		}
+
+		assert.Equal(t,
+			"(<-r) as! @R",
+			expr.String(),
+		)
+	})
 }
 
 func TestCreateExpression_MarshalJSON(t *testing.T) {
@@ -74,6 +74,7 @@ type FunctionDeclaration struct {
 	StartPos             Position `json:"-"`
 	Access               Access
 	Flags                FunctionDeclarationFlags
+	ElementComments
 }
 
 var _ Element = &FunctionDeclaration{}
@@ -230,6 +231,7 @@ func (d *FunctionDeclaration) IsNative() bool {
 type SpecialFunctionDeclaration struct {
 	FunctionDeclaration *FunctionDeclaration
 	Kind                common.DeclarationKind
+	ElementComments
 }
 
 var _ Element = &SpecialFunctionDeclaration{}
@@ -290,13 +292,20 @@ func (d *SpecialFunctionDeclaration) DeclarationDocString() string {
 }
 
 func (d *SpecialFunctionDeclaration) Doc() prettier.Doc {
+	keywords := d.Kind.Keywords()
+	if keywords == "" {
+		// Unknown special functions are rejected by the checker,
+		// but are kept in the program
+		keywords = d.FunctionDeclaration.Identifier.Identifier
+	}
+
 	return FunctionDocument(
 		d.FunctionDeclaration.Access,
 		d.FunctionDeclaration.Purity,
 		d.FunctionDeclaration.IsStatic(),
 		d.FunctionDeclaration.IsNative(),
 		false,
-		d.Kind.Keywords(),
+		keywords,
 		d.FunctionDeclaration.TypeParameterList,
 		d.FunctionDeclaration.ParameterList,
 		d.FunctionDeclaration.ReturnTypeAnnotation,
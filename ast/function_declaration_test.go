@@ -894,3 +894,24 @@ func TestSpecialFunctionDeclaration_String(t *testing.T) {
 		decl.String(),
 	)
 }
+
+func TestSpecialFunctionDeclaration_String_Unknown(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &SpecialFunctionDeclaration{
+		Kind: common.DeclarationKindUnknown,
+		FunctionDeclaration: &FunctionDeclaration{
+			Access: AccessNotSpecified,
+			Identifier: Identifier{
+				Identifier: "xyz",
+			},
+			ParameterList: &ParameterList{},
+		},
+	}
+
+	require.Equal(t,
+		"xyz()",
+		decl.String(),
+	)
+}
@@ -19,6 +19,7 @@
 package ast
 
 import (
+	"encoding/hex"
 	"encoding/json"
 
 	"github.com/turbolent/prettier"
@@ -26,13 +27,20 @@ import (
 	"github.com/onflow/cadence/common"
 )
 
+// ImportCodeHashLength is the length of the code hash an import may be pinned to,
+// the SHA3-256 hash of the imported program's code
+const ImportCodeHashLength = 32
+
 // ImportDeclaration
 
 type ImportDeclaration struct {
 	Location    common.Location
 	Identifiers []Identifier
+	// CodeHash is the optional expected hash of the imported program's code
+	CodeHash []byte `json:",omitempty"`
 	Range
 	LocationPos Position
+	ElementComments
 }
 
 var _ Element = &ImportDeclaration{}
@@ -42,6 +50,7 @@ func NewImportDeclaration(
 	gauge common.MemoryGauge,
 	identifiers []Identifier,
 	location common.Location,
+	codeHash []byte,
 	declRange Range,
 	locationPos Position,
 ) *ImportDeclaration {
@@ -50,6 +59,7 @@ func NewImportDeclaration(
 	return &ImportDeclaration{
 		Identifiers: identifiers,
 		Location:    location,
+		CodeHash:    codeHash,
 		Range:       declRange,
 		LocationPos: locationPos,
 	}
@@ -89,17 +99,24 @@ func (d *ImportDeclaration) DeclarationDocString() string {
 
 func (d *ImportDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias ImportDeclaration
+	var codeHash string
+	if d.CodeHash != nil {
+		codeHash = hex.EncodeToString(d.CodeHash)
+	}
 	return json.Marshal(&struct {
 		*Alias
-		Type string
+		Type     string
+		CodeHash string `json:",omitempty"`
 	}{
-		Type:  "ImportDeclaration",
-		Alias: (*Alias)(d),
+		Type:     "ImportDeclaration",
+		Alias:    (*Alias)(d),
+		CodeHash: codeHash,
 	})
 }
 
 const importDeclarationImportKeywordDoc = prettier.Text("import")
 const importDeclarationFromKeywordDoc = prettier.Text("from ")
+const importDeclarationHashKeywordDoc = prettier.Text(" hash ")
 
 var importDeclarationSeparatorDoc prettier.Doc = prettier.Concat{
 	prettier.Text(","),
@@ -152,10 +169,20 @@ func (d *ImportDeclaration) Doc() prettier.Doc {
 		)
 	}
 
-	return append(
+	doc = append(
 		doc,
 		LocationDoc(d.Location),
 	)
+
+	if d.CodeHash != nil {
+		doc = append(
+			doc,
+			importDeclarationHashKeywordDoc,
+			prettier.Text(QuoteString(hex.EncodeToString(d.CodeHash))),
+		)
+	}
+
+	return doc
 }
 
 func (d *ImportDeclaration) String() string {
@@ -165,7 +192,12 @@ func (d *ImportDeclaration) String() string {
 func LocationDoc(location common.Location) prettier.Doc {
 	switch location := location.(type) {
 	case common.AddressLocation:
-		return prettier.Text(location.Address.ShortHexWithPrefix())
+		address := location.Address.ShortHexWithPrefix()
+		if address == "0x" {
+			// The zero address has no significant digits
+			address = "0x0"
+		}
+		return prettier.Text(address)
 	case common.IdentifierLocation:
 		return prettier.Text(location)
 	case common.StringLocation:
@@ -19,7 +19,9 @@
 package ast
 
 import (
+	"bytes"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -239,4 +241,80 @@ func TestImportDeclaration_String(t *testing.T) {
 			decl.String(),
 		)
 	})
+	t.Run("code hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &ImportDeclaration{
+			Identifiers: []Identifier{
+				{
+					Identifier: "foo",
+				},
+			},
+			Location: common.AddressLocation{
+				Address: common.MustBytesToAddress([]byte{0x1}),
+			},
+			CodeHash: bytes.Repeat([]byte{0xab}, ImportCodeHashLength),
+		}
+
+		require.Equal(
+			t,
+			`import foo from 0x1 hash "`+strings.Repeat("ab", ImportCodeHashLength)+`"`,
+			decl.String(),
+		)
+	})
+
+	t.Run("zero address", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &ImportDeclaration{
+			Identifiers: []Identifier{
+				{
+					Identifier: "foo",
+				},
+			},
+			Location: common.AddressLocation{
+				Address: common.ZeroAddress,
+			},
+		}
+
+		require.Equal(
+			t,
+			`import foo from 0x0`,
+			decl.String(),
+		)
+	})
+}
+
+func TestImportDeclaration_MarshalJSON_CodeHash(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &ImportDeclaration{
+		Location: common.StringLocation("test"),
+		CodeHash: []byte{0x01, 0x02},
+	}
+
+	actual, err := json.Marshal(decl)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		// language=json
+		`
+        {
+            "Type": "ImportDeclaration",
+            "Identifiers": null,
+            "Location": {
+                "Type": "StringLocation",
+                "String": "test"
+            },
+            "CodeHash": "0102",
+            "LocationPos": {"Offset": 0, "Line": 0, "Column": 0},
+            "StartPos": {"Offset": 0, "Line": 0, "Column": 0},
+            "EndPos": {"Offset": 0, "Line": 0, "Column": 0}
+        }
+        `,
+		string(actual),
+	)
 }
@@ -36,6 +36,7 @@ type InterfaceDeclaration struct {
 	Range
 	Access        Access
 	CompositeKind common.CompositeKind
+	ElementComments
 }
 
 var _ Element = &InterfaceDeclaration{}
@@ -116,6 +117,7 @@ func (d *InterfaceDeclaration) Doc() prettier.Doc {
 		d.CompositeKind,
 		true,
 		d.Identifier.Identifier,
+		nil,
 		d.Conformances,
 		d.Members,
 	)
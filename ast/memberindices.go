@@ -31,6 +31,10 @@ type memberIndices struct {
 	_fields []*FieldDeclaration
 	// Use `FieldsByIdentifier()` instead
 	_fieldsByIdentifier map[string]*FieldDeclaration
+	// Use `StoredFields()` instead
+	_storedFields []*FieldDeclaration
+	// Use `ComputedFields()` instead
+	_computedFields []*FieldDeclaration
 	// All special functions, such as initializers and destructors.
 	// Use `SpecialFunctions()` to get all special functions instead,
 	// or `Initializers()` and `Destructors()` to get subsets
@@ -65,6 +69,8 @@ type memberIndices struct {
 	_enumCases []*EnumCaseDeclaration
 	// Use `Pragmas()` instead
 	_pragmas []*PragmaDeclaration
+	// Use `TypeAliases()` instead
+	_typeAliases []*TypeAliasDeclaration
 }
 
 func (i *memberIndices) FieldsByIdentifier(declarations []Declaration) map[string]*FieldDeclaration {
@@ -112,6 +118,16 @@ func (i *memberIndices) Fields(declarations []Declaration) []*FieldDeclaration {
 	return i._fields
 }
 
+func (i *memberIndices) StoredFields(declarations []Declaration) []*FieldDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._storedFields
+}
+
+func (i *memberIndices) ComputedFields(declarations []Declaration) []*FieldDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._computedFields
+}
+
 func (i *memberIndices) Functions(declarations []Declaration) []*FunctionDeclaration {
 	i.once.Do(i.initializer(declarations))
 	return i._functions
@@ -157,6 +173,11 @@ func (i *memberIndices) Pragmas(declarations []Declaration) []*PragmaDeclaration
 	return i._pragmas
 }
 
+func (i *memberIndices) TypeAliases(declarations []Declaration) []*TypeAliasDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._typeAliases
+}
+
 func (i *memberIndices) initializer(declarations []Declaration) func() {
 	return func() {
 		i.init(declarations)
@@ -168,6 +189,8 @@ func (i *memberIndices) init(declarations []Declaration) {
 
 	i._fields = make([]*FieldDeclaration, 0)
 	i._fieldsByIdentifier = make(map[string]*FieldDeclaration)
+	i._storedFields = make([]*FieldDeclaration, 0)
+	i._computedFields = make([]*FieldDeclaration, 0)
 
 	i._functions = make([]*FunctionDeclaration, 0)
 	i._functionsByIdentifier = make(map[string]*FunctionDeclaration)
@@ -192,12 +215,18 @@ func (i *memberIndices) init(declarations []Declaration) {
 
 	i._enumCases = make([]*EnumCaseDeclaration, 0)
 	i._pragmas = make([]*PragmaDeclaration, 0)
+	i._typeAliases = make([]*TypeAliasDeclaration, 0)
 
 	for _, declaration := range declarations {
 		switch declaration := declaration.(type) {
 		case *FieldDeclaration:
 			i._fields = append(i._fields, declaration)
 			i._fieldsByIdentifier[declaration.Identifier.Identifier] = declaration
+			if declaration.IsComputed() {
+				i._computedFields = append(i._computedFields, declaration)
+			} else {
+				i._storedFields = append(i._storedFields, declaration)
+			}
 
 		case *FunctionDeclaration:
 			i._functions = append(i._functions, declaration)
@@ -236,6 +265,9 @@ func (i *memberIndices) init(declarations []Declaration) {
 
 		case *PragmaDeclaration:
 			i._pragmas = append(i._pragmas, declaration)
+
+		case *TypeAliasDeclaration:
+			i._typeAliases = append(i._typeAliases, declaration)
 		}
 	}
 }
@@ -31,6 +31,7 @@ import (
 type Members struct {
 	declarations []Declaration
 	indices      memberIndices
+	ElementComments
 }
 
 func NewMembers(memoryGauge common.MemoryGauge, declarations []Declaration) *Members {
@@ -52,6 +53,16 @@ func (m *Members) Fields() []*FieldDeclaration {
 	return m.indices.Fields(m.declarations)
 }
 
+// StoredFields returns the fields which are stored, i.e. not computed
+func (m *Members) StoredFields() []*FieldDeclaration {
+	return m.indices.StoredFields(m.declarations)
+}
+
+// ComputedFields returns the fields which have a getter
+func (m *Members) ComputedFields() []*FieldDeclaration {
+	return m.indices.ComputedFields(m.declarations)
+}
+
 func (m *Members) Functions() []*FunctionDeclaration {
 	return m.indices.Functions(m.declarations)
 }
@@ -88,6 +99,10 @@ func (m *Members) Pragmas() []*PragmaDeclaration {
 	return m.indices.Pragmas(m.declarations)
 }
 
+func (m *Members) TypeAliases() []*TypeAliasDeclaration {
+	return m.indices.TypeAliases(m.declarations)
+}
+
 func (m *Members) FieldsByIdentifier() map[string]*FieldDeclaration {
 	return m.indices.FieldsByIdentifier(m.declarations)
 }
@@ -148,31 +163,31 @@ var membersEndDoc prettier.Doc = prettier.Text("}")
 var membersEmptyDoc prettier.Doc = prettier.Text("{}")
 
 func (m *Members) docWithNoBraces() prettier.Concat {
-	var docs []prettier.Doc
-
-	for _, decl := range m.declarations {
-		docs = append(
-			docs,
-			prettier.Concat{
-				prettier.HardLine{},
-				decl.Doc(),
-			},
+	var doc prettier.Concat
+
+	for i, decl := range m.declarations {
+		if i > 0 {
+			doc = append(doc, prettier.HardLine{})
+		}
+		doc = append(
+			doc,
+			prettier.HardLine{},
+			commentedDoc(decl, decl.Doc()),
 		)
 	}
 
+	doc = append(doc, innerCommentsDoc(m)...)
+
 	return prettier.Concat{
 		prettier.Indent{
-			Doc: prettier.Join(
-				prettier.HardLine{},
-				docs...,
-			),
+			Doc: doc,
 		},
 		prettier.HardLine{},
 	}
 }
 
 func (m *Members) Doc() prettier.Doc {
-	if len(m.declarations) == 0 {
+	if len(m.declarations) == 0 && len(innerCommentsDoc(m)) == 0 {
 		return membersEmptyDoc
 	}
 
@@ -31,6 +31,7 @@ import (
 type PragmaDeclaration struct {
 	Expression Expression
 	Range
+	ElementComments
 }
 
 var _ Element = &PragmaDeclaration{}
@@ -62,11 +62,14 @@ const (
 	// precedenceMultiplication is the precedence of
 	// - BinaryExpression, with OperationMul, OperationMod, or OperationDiv
 	precedenceMultiplication
+	// precedenceMove is the precedence of
+	// - UnaryExpression, with OperationMove
+	precedenceMove
 	// precedenceCasting is the precedence of
 	// - CastingExpression
 	precedenceCasting
 	// precedenceUnaryPrefix is the precedence of
-	// - UnaryExpression
+	// - UnaryExpression, with OperationMinus, OperationNegate, or OperationMul
 	// - CreateExpression
 	// - DestroyExpression
 	// - ReferenceExpression
@@ -20,16 +20,17 @@ func _() {
 	_ = x[precedenceBitwiseShift-9]
 	_ = x[precedenceAddition-10]
 	_ = x[precedenceMultiplication-11]
-	_ = x[precedenceCasting-12]
-	_ = x[precedenceUnaryPrefix-13]
-	_ = x[precedenceUnaryPostfix-14]
-	_ = x[precedenceAccess-15]
-	_ = x[precedenceLiteral-16]
+	_ = x[precedenceMove-12]
+	_ = x[precedenceCasting-13]
+	_ = x[precedenceUnaryPrefix-14]
+	_ = x[precedenceUnaryPostfix-15]
+	_ = x[precedenceAccess-16]
+	_ = x[precedenceLiteral-17]
 }
 
-const _precedence_name = "precedenceUnknownprecedenceTernaryprecedenceLogicalOrprecedenceLogicalAndprecedenceComparisonprecedenceNilCoalescingprecedenceBitwiseOrprecedenceBitwiseXorprecedenceBitwiseAndprecedenceBitwiseShiftprecedenceAdditionprecedenceMultiplicationprecedenceCastingprecedenceUnaryPrefixprecedenceUnaryPostfixprecedenceAccessprecedenceLiteral"
+const _precedence_name = "precedenceUnknownprecedenceTernaryprecedenceLogicalOrprecedenceLogicalAndprecedenceComparisonprecedenceNilCoalescingprecedenceBitwiseOrprecedenceBitwiseXorprecedenceBitwiseAndprecedenceBitwiseShiftprecedenceAdditionprecedenceMultiplicationprecedenceMoveprecedenceCastingprecedenceUnaryPrefixprecedenceUnaryPostfixprecedenceAccessprecedenceLiteral"
 
-var _precedence_index = [...]uint16{0, 17, 34, 53, 73, 93, 116, 135, 155, 175, 197, 215, 239, 256, 277, 299, 315, 332}
+var _precedence_index = [...]uint16{0, 17, 34, 53, 73, 93, 116, 135, 155, 175, 197, 215, 239, 253, 270, 291, 313, 329, 346}
 
 func (i precedence) String() string {
 	if i >= precedence(len(_precedence_index)-1) {
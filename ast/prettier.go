@@ -19,6 +19,7 @@
 package ast
 
 import (
+	"math"
 	"strings"
 
 	"github.com/turbolent/prettier"
@@ -30,3 +31,14 @@ func Prettier(element interface{ Doc() prettier.Doc }) string {
 	prettier.Prettier(&builder, doc, 80, "    ")
 	return builder.String()
 }
+
+// flatString returns the source code of the given element on a single line,
+// i.e. without line breaks, unless they are required
+func flatString(element interface{ Doc() prettier.Doc }) string {
+	var builder strings.Builder
+	doc := prettier.Group{
+		Doc: element.Doc(),
+	}
+	prettier.Prettier(&builder, doc, math.MaxInt32, "")
+	return builder.String()
+}
@@ -30,6 +30,7 @@ type Program struct {
 	// all declarations, in the order they are defined
 	declarations []Declaration
 	indices      programIndices
+	ElementComments
 }
 
 var _ Element = &Program{}
@@ -110,6 +111,10 @@ func (p *Program) VariableDeclarations() []*VariableDeclaration {
 	return p.indices.variableDeclarations(p.declarations)
 }
 
+func (p *Program) TypeAliasDeclarations() []*TypeAliasDeclaration {
+	return p.indices.typeAliasDeclarations(p.declarations)
+}
+
 // SoleContractDeclaration returns the sole contract declaration, if any,
 // and if there are no other actionable declarations.
 func (p *Program) SoleContractDeclaration() *CompositeDeclaration {
@@ -199,8 +204,29 @@ func (p *Program) Doc() prettier.Doc {
 	docs := make([]prettier.Doc, 0, len(declarations))
 
 	for _, declaration := range declarations {
-		docs = append(docs, declaration.Doc())
+		docs = append(
+			docs,
+			commentedDoc(declaration, declaration.Doc()),
+		)
+	}
+
+	if len(docs) == 0 {
+		innerCommentsDoc := innerCommentsDoc(p)
+		if len(innerCommentsDoc) == 0 {
+			return nil
+		}
+		// Drop the line breaks before the first comment
+		for len(innerCommentsDoc) > 0 {
+			if _, ok := innerCommentsDoc[0].(prettier.HardLine); !ok {
+				break
+			}
+			innerCommentsDoc = innerCommentsDoc[1:]
+		}
+		return innerCommentsDoc
 	}
 
-	return prettier.Join(programSeparatorDoc, docs...)
+	return withInnerCommentsDoc(
+		p,
+		prettier.Join(programSeparatorDoc, docs...),
+	)
 }
@@ -45,6 +45,8 @@ type programIndices struct {
 	_transactionDeclarations []*TransactionDeclaration
 	// Use `variableDeclarations()` instead
 	_variableDeclarations []*VariableDeclaration
+	// Use `typeAliasDeclarations()` instead
+	_typeAliasDeclarations []*TypeAliasDeclaration
 }
 
 func (i *programIndices) pragmaDeclarations(declarations []Declaration) []*PragmaDeclaration {
@@ -97,6 +99,11 @@ func (i *programIndices) variableDeclarations(declarations []Declaration) []*Var
 	return i._variableDeclarations
 }
 
+func (i *programIndices) typeAliasDeclarations(declarations []Declaration) []*TypeAliasDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._typeAliasDeclarations
+}
+
 func (i *programIndices) initializer(declarations []Declaration) func() {
 	return func() {
 		i.init(declarations)
@@ -116,6 +123,7 @@ func (i *programIndices) init(declarations []Declaration) {
 	i._entitlementMappingDeclarations = make([]*EntitlementMappingDeclaration, 0)
 	i._functionDeclarations = make([]*FunctionDeclaration, 0)
 	i._transactionDeclarations = make([]*TransactionDeclaration, 0)
+	i._typeAliasDeclarations = make([]*TypeAliasDeclaration, 0)
 
 	for _, declaration := range declarations {
 
@@ -149,6 +157,9 @@ func (i *programIndices) init(declarations []Declaration) {
 
 		case *VariableDeclaration:
 			i._variableDeclarations = append(i._variableDeclarations, declaration)
+
+		case *TypeAliasDeclaration:
+			i._typeAliasDeclarations = append(i._typeAliasDeclarations, declaration)
 		}
 	}
 }
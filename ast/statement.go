@@ -39,6 +39,7 @@ type Statement interface {
 type ReturnStatement struct {
 	Expression Expression
 	Range
+	ElementComments
 }
 
 var _ Element = &ReturnStatement{}
@@ -96,15 +97,20 @@ func (s *ReturnStatement) MarshalJSON() ([]byte, error) {
 // BreakStatement
 
 type BreakStatement struct {
+	// Label is the label of the loop targeted by the statement, if any,
+	// e.g. `outer` in `break outer`
+	Label *Identifier `json:",omitempty"`
 	Range
+	ElementComments
 }
 
 var _ Element = &BreakStatement{}
 var _ Statement = &BreakStatement{}
 
-func NewBreakStatement(gauge common.MemoryGauge, tokenRange Range) *BreakStatement {
+func NewBreakStatement(gauge common.MemoryGauge, label *Identifier, tokenRange Range) *BreakStatement {
 	common.UseMemory(gauge, common.BreakStatementMemoryUsage)
 	return &BreakStatement{
+		Label: label,
 		Range: tokenRange,
 	}
 }
@@ -121,8 +127,16 @@ func (*BreakStatement) Walk(_ func(Element)) {
 
 const breakStatementKeywordDoc = prettier.Text("break")
 
-func (*BreakStatement) Doc() prettier.Doc {
-	return breakStatementKeywordDoc
+func (s *BreakStatement) Doc() prettier.Doc {
+	if s.Label == nil {
+		return breakStatementKeywordDoc
+	}
+
+	return prettier.Concat{
+		breakStatementKeywordDoc,
+		prettier.Space,
+		prettier.Text(s.Label.Identifier),
+	}
 }
 
 func (s *BreakStatement) String() string {
@@ -143,15 +157,20 @@ func (s *BreakStatement) MarshalJSON() ([]byte, error) {
 // ContinueStatement
 
 type ContinueStatement struct {
+	// Label is the label of the loop targeted by the statement, if any,
+	// e.g. `outer` in `continue outer`
+	Label *Identifier `json:",omitempty"`
 	Range
+	ElementComments
 }
 
 var _ Element = &ContinueStatement{}
 var _ Statement = &ContinueStatement{}
 
-func NewContinueStatement(gauge common.MemoryGauge, tokenRange Range) *ContinueStatement {
+func NewContinueStatement(gauge common.MemoryGauge, label *Identifier, tokenRange Range) *ContinueStatement {
 	common.UseMemory(gauge, common.ContinueStatementMemoryUsage)
 	return &ContinueStatement{
+		Label: label,
 		Range: tokenRange,
 	}
 }
@@ -168,8 +187,16 @@ func (*ContinueStatement) Walk(_ func(Element)) {
 
 const continueStatementKeywordDoc = prettier.Text("continue")
 
-func (*ContinueStatement) Doc() prettier.Doc {
-	return continueStatementKeywordDoc
+func (s *ContinueStatement) Doc() prettier.Doc {
+	if s.Label == nil {
+		return continueStatementKeywordDoc
+	}
+
+	return prettier.Concat{
+		continueStatementKeywordDoc,
+		prettier.Space,
+		prettier.Text(s.Label.Identifier),
+	}
 }
 
 func (s *ContinueStatement) String() string {
@@ -202,6 +229,7 @@ type IfStatement struct {
 	Then     *Block
 	Else     *Block
 	StartPos Position `json:"-"`
+	ElementComments
 }
 
 var _ Element = &IfStatement{}
@@ -261,7 +289,9 @@ func (s *IfStatement) Doc() prettier.Doc {
 		s.Then.Doc(),
 	}
 
-	if s.Else != nil && len(s.Else.Statements) > 0 {
+	if s.Else != nil &&
+		(len(s.Else.Statements) > 0 || len(innerCommentsDoc(s.Else)) > 0) {
+
 		var elseDoc prettier.Doc
 		if len(s.Else.Statements) == 1 {
 			if elseIfStatement, ok := s.Else.Statements[0].(*IfStatement); ok {
@@ -306,9 +336,13 @@ func (s *IfStatement) MarshalJSON() ([]byte, error) {
 // WhileStatement
 
 type WhileStatement struct {
-	Test     Expression
-	Block    *Block
-	StartPos Position `json:"-"`
+	Test  Expression
+	Block *Block
+	// Label is the label of the loop, if any,
+	// e.g. `outer` in `outer: while ...`
+	Label    *Identifier `json:",omitempty"`
+	StartPos Position    `json:"-"`
+	ElementComments
 }
 
 var _ Element = &WhileStatement{}
@@ -316,12 +350,14 @@ var _ Statement = &WhileStatement{}
 
 func NewWhileStatement(
 	gauge common.MemoryGauge,
+	label *Identifier,
 	expression Expression,
 	block *Block,
 	startPos Position,
 ) *WhileStatement {
 	common.UseMemory(gauge, common.WhileStatementMemoryUsage)
 	return &WhileStatement{
+		Label:    label,
 		Test:     expression,
 		Block:    block,
 		StartPos: startPos,
@@ -351,13 +387,33 @@ const whileStatementKeywordSpaceDoc = prettier.Text("while ")
 
 func (s *WhileStatement) Doc() prettier.Doc {
 	return prettier.Group{
-		Doc: prettier.Concat{
-			whileStatementKeywordSpaceDoc,
-			s.Test.Doc(),
-			prettier.Space,
-			s.Block.Doc(),
-		},
+		Doc: withLoopLabelDoc(
+			s.Label,
+			prettier.Concat{
+				whileStatementKeywordSpaceDoc,
+				s.Test.Doc(),
+				prettier.Space,
+				s.Block.Doc(),
+			},
+		),
+	}
+}
+
+const loopLabelSeparatorDoc = prettier.Text(": ")
+
+// withLoopLabelDoc prefixes the given loop document with the label of the loop, if any
+func withLoopLabelDoc(label *Identifier, doc prettier.Concat) prettier.Concat {
+	if label == nil {
+		return doc
 	}
+
+	return append(
+		prettier.Concat{
+			prettier.Text(label.Identifier),
+			loopLabelSeparatorDoc,
+		},
+		doc...,
+	)
 }
 
 func (s *WhileStatement) String() string {
@@ -380,11 +436,19 @@ func (s *WhileStatement) MarshalJSON() ([]byte, error) {
 // ForStatement
 
 type ForStatement struct {
-	Value      Expression
-	Index      *Identifier
+	Value Expression
+	Index *Identifier
+	// Key is the key variable of a loop over the key-value pairs of a dictionary,
+	// i.e. `for (key, value) in dictionary`.
+	// The value variable is Identifier
+	Key *Identifier `json:",omitempty"`
+	// Label is the label of the loop, if any,
+	// e.g. `outer` in `outer: for ...`
+	Label      *Identifier `json:",omitempty"`
 	Block      *Block
 	Identifier Identifier
 	StartPos   Position `json:"-"`
+	ElementComments
 }
 
 var _ Element = &ForStatement{}
@@ -392,8 +456,10 @@ var _ Statement = &ForStatement{}
 
 func NewForStatement(
 	gauge common.MemoryGauge,
+	label *Identifier,
 	identifier Identifier,
 	index *Identifier,
+	key *Identifier,
 	block *Block,
 	expression Expression,
 	startPos Position,
@@ -401,8 +467,10 @@ func NewForStatement(
 	common.UseMemory(gauge, common.ForStatementMemoryUsage)
 
 	return &ForStatement{
+		Label:      label,
 		Identifier: identifier,
 		Index:      index,
+		Key:        key,
 		Block:      block,
 		Value:      expression,
 		StartPos:   startPos,
@@ -444,9 +512,24 @@ func (s *ForStatement) Doc() prettier.Doc {
 		)
 	}
 
+	if s.Key != nil {
+		doc = append(
+			doc,
+			prettier.Text("("),
+			prettier.Text(s.Key.Identifier),
+			prettier.Text(", "),
+			prettier.Text(s.Identifier.Identifier),
+			prettier.Text(")"),
+		)
+	} else {
+		doc = append(
+			doc,
+			prettier.Text(s.Identifier.Identifier),
+		)
+	}
+
 	doc = append(
 		doc,
-		prettier.Text(s.Identifier.Identifier),
 		forStatementSpaceInKeywordSpaceDoc,
 		s.Value.Doc(),
 		prettier.Space,
@@ -454,7 +537,7 @@ func (s *ForStatement) Doc() prettier.Doc {
 	)
 
 	return prettier.Group{
-		Doc: doc,
+		Doc: withLoopLabelDoc(s.Label, doc),
 	}
 }
 
@@ -480,6 +563,7 @@ func (s *ForStatement) MarshalJSON() ([]byte, error) {
 type EmitStatement struct {
 	InvocationExpression *InvocationExpression
 	StartPos             Position `json:"-"`
+	ElementComments
 }
 
 var _ Element = &EmitStatement{}
@@ -520,7 +604,7 @@ const emitStatementKeywordSpaceDoc = prettier.Text("emit ")
 func (s *EmitStatement) Doc() prettier.Doc {
 	return prettier.Concat{
 		emitStatementKeywordSpaceDoc,
-		s.InvocationExpression.Doc(),
+		s.InvocationExpression.nominalDoc(),
 	}
 }
 
@@ -547,6 +631,7 @@ type AssignmentStatement struct {
 	Target   Expression
 	Transfer *Transfer
 	Value    Expression
+	ElementComments
 }
 
 var _ Element = &AssignmentStatement{}
@@ -624,6 +709,7 @@ func (s *AssignmentStatement) MarshalJSON() ([]byte, error) {
 type SwapStatement struct {
 	Left  Expression
 	Right Expression
+	ElementComments
 }
 
 var _ Element = &SwapStatement{}
@@ -689,6 +775,7 @@ func (s *SwapStatement) MarshalJSON() ([]byte, error) {
 
 type ExpressionStatement struct {
 	Expression Expression
+	ElementComments
 }
 
 var _ Element = &ExpressionStatement{}
@@ -746,6 +833,7 @@ type SwitchStatement struct {
 	Expression Expression
 	Cases      []*SwitchCase
 	Range
+	ElementComments
 }
 
 var _ Element = &SwitchStatement{}
@@ -793,10 +881,12 @@ func (s *SwitchStatement) Doc() prettier.Doc {
 		bodyDoc = append(
 			bodyDoc,
 			prettier.HardLine{},
-			switchCase.Doc(),
+			commentedDoc(switchCase, switchCase.Doc()),
 		)
 	}
 
+	bodyDoc = append(bodyDoc, innerCommentsDoc(s)...)
+
 	return prettier.Concat{
 		prettier.Group{
 			Doc: prettier.Concat{
@@ -840,6 +930,7 @@ type SwitchCase struct {
 	Expression Expression
 	Statements []Statement
 	Range
+	ElementComments
 }
 
 func (s *SwitchCase) MarshalJSON() ([]byte, error) {
@@ -859,7 +950,7 @@ const switchCaseDefaultKeywordSpaceDoc = prettier.Text("default:")
 
 func (s *SwitchCase) Doc() prettier.Doc {
 	statementsDoc := prettier.Indent{
-		Doc: StatementsDoc(s.Statements),
+		Doc: withInnerCommentsDoc(s, StatementsDoc(s.Statements)),
 	}
 
 	if s.Expression == nil {
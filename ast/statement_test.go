@@ -597,6 +597,39 @@ func TestWhileStatement_String(t *testing.T) {
 	)
 }
 
+func TestWhileStatement_String_Labeled(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &WhileStatement{
+		Label: &Identifier{
+			Identifier: "outer",
+		},
+		Test: &BoolExpression{
+			Value: true,
+		},
+		Block: &Block{
+			Statements: []Statement{
+				&BreakStatement{
+					Label: &Identifier{
+						Identifier: "outer",
+					},
+				},
+				&ContinueStatement{
+					Label: &Identifier{
+						Identifier: "outer",
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		"outer: while true {\n    break outer\n    continue outer\n}",
+		stmt.String(),
+	)
+}
+
 func TestForStatement_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
@@ -801,6 +834,44 @@ func TestForStatement_Doc(t *testing.T) {
 			stmt.Doc(),
 		)
 	})
+
+	t.Run("with key", func(t *testing.T) {
+
+		t.Parallel()
+
+		stmt := &ForStatement{
+			Key: &Identifier{
+				Identifier: "k",
+			},
+			Identifier: Identifier{
+				Identifier: "foobar",
+			},
+			Value: &BoolExpression{
+				Value: false,
+			},
+			Block: &Block{
+				Statements: []Statement{},
+			},
+		}
+
+		assert.Equal(t,
+			prettier.Group{
+				Doc: prettier.Concat{
+					prettier.Text("for "),
+					prettier.Text("("),
+					prettier.Text("k"),
+					prettier.Text(", "),
+					prettier.Text("foobar"),
+					prettier.Text(")"),
+					prettier.Text(" in "),
+					prettier.Text("false"),
+					prettier.Text(" "),
+					prettier.Text("{}"),
+				},
+			},
+			stmt.Doc(),
+		)
+	})
 }
 
 func TestForStatement_String(t *testing.T) {
@@ -853,6 +924,31 @@ func TestForStatement_String(t *testing.T) {
 			stmt.String(),
 		)
 	})
+
+	t.Run("with key", func(t *testing.T) {
+
+		t.Parallel()
+
+		stmt := &ForStatement{
+			Key: &Identifier{
+				Identifier: "k",
+			},
+			Identifier: Identifier{
+				Identifier: "foobar",
+			},
+			Value: &BoolExpression{
+				Value: false,
+			},
+			Block: &Block{
+				Statements: []Statement{},
+			},
+		}
+
+		assert.Equal(t,
+			"for (k, foobar) in false {}",
+			stmt.String(),
+		)
+	})
 }
 
 func TestAssignmentStatement_MarshalJSON(t *testing.T) {
@@ -19,6 +19,7 @@
 package ast
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -46,3 +47,35 @@ func TestStringTemplate_Doc(t *testing.T) {
 		stmt.Doc(),
 	)
 }
+
+func TestStringTemplate_String(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &StringTemplateExpression{
+		Values: []string{
+			"a\n",
+			"c",
+		},
+		Expressions: []Expression{
+			&BinaryExpression{
+				Operation: OperationPlus,
+				Left: &IdentifierExpression{
+					Identifier: Identifier{
+						Identifier: "b",
+					},
+				},
+				Right: &IntegerExpression{
+					PositiveLiteral: []byte("1"),
+					Value:           big.NewInt(1),
+					Base:            10,
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		`"a\n\(b + 1)c"`,
+		stmt.String(),
+	)
+}
@@ -35,6 +35,7 @@ type TransactionDeclaration struct {
 	DocString      string
 	Fields         []*FieldDeclaration
 	Range
+	ElementComments
 }
 
 var _ Element = &TransactionDeclaration{}
@@ -135,11 +136,11 @@ func (d *TransactionDeclaration) Doc() prettier.Doc {
 	}
 
 	for _, field := range d.Fields {
-		addContent(field.Doc())
+		addContent(commentedDoc(field, field.Doc()))
 	}
 
 	if d.Prepare != nil {
-		addContent(d.Prepare.Doc())
+		addContent(commentedDoc(d.Prepare, d.Prepare.Doc()))
 	}
 
 	if conditionsDoc := d.PreConditions.Doc(preConditionsKeywordDoc); conditionsDoc != nil {
@@ -147,7 +148,7 @@ func (d *TransactionDeclaration) Doc() prettier.Doc {
 	}
 
 	if d.Execute != nil {
-		addContent(d.Execute.Doc())
+		addContent(commentedDoc(d.Execute, d.Execute.Doc()))
 	}
 
 	if conditionsDoc := d.PostConditions.Doc(postConditionsKeywordDoc); conditionsDoc != nil {
@@ -158,21 +159,32 @@ func (d *TransactionDeclaration) Doc() prettier.Doc {
 		transactionKeywordDoc,
 	}
 
-	if !d.ParameterList.IsEmpty() {
+	if d.ParameterList != nil {
 		doc = append(
 			doc,
 			d.ParameterList.Doc(),
 		)
 	}
 
+	if len(contents) == 0 && len(innerCommentsDoc(d)) == 0 {
+		return append(
+			doc,
+			prettier.Space,
+			blockEmptyDoc,
+		)
+	}
+
 	return append(
 		doc,
 		prettier.Space,
 		blockStartDoc,
 		prettier.Indent{
-			Doc: prettier.Join(
-				prettier.HardLine{},
-				contents...,
+			Doc: withInnerCommentsDoc(
+				d,
+				prettier.Join(
+					prettier.HardLine{},
+					contents...,
+				),
 			),
 		},
 		prettier.HardLine{},
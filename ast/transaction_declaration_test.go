@@ -507,3 +507,34 @@ func TestTransactionDeclaration_String(t *testing.T) {
 		decl.String(),
 	)
 }
+
+func TestTransactionDeclaration_String_Empty(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("empty parameter list", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &TransactionDeclaration{
+			ParameterList: &ParameterList{},
+		}
+
+		require.Equal(t,
+			"transaction() {}",
+			decl.String(),
+		)
+	})
+
+	t.Run("no parameter list", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &TransactionDeclaration{}
+
+		require.Equal(t,
+			"transaction {}",
+			decl.String(),
+		)
+	})
+}
@@ -223,8 +223,20 @@ func (t *OptionalType) EndPosition(memoryGauge common.MemoryGauge) Position {
 const optionalTypeSymbolDoc = prettier.Text("?")
 
 func (t *OptionalType) Doc() prettier.Doc {
+	typeDoc := t.Type.Doc()
+
+	// Function types must be parenthesized,
+	// as the return type would otherwise be optional, e.g. `(fun(): Int)?` vs `fun(): Int?`
+	if _, ok := t.Type.(*FunctionType); ok {
+		typeDoc = prettier.Concat{
+			openParenthesisDoc,
+			typeDoc,
+			closeParenthesisDoc,
+		}
+	}
+
 	return prettier.Concat{
-		t.Type.Doc(),
+		typeDoc,
 		optionalTypeSymbolDoc,
 	}
 }
@@ -277,16 +289,18 @@ const arrayTypeStartDoc = prettier.Text("[")
 const arrayTypeEndDoc = prettier.Text("]")
 
 func (t *VariableSizedType) Doc() prettier.Doc {
-	return prettier.Concat{
-		arrayTypeStartDoc,
-		prettier.Indent{
-			Doc: prettier.Concat{
-				prettier.SoftLine{},
-				t.Type.Doc(),
+	return prettier.Group{
+		Doc: prettier.Concat{
+			arrayTypeStartDoc,
+			prettier.Indent{
+				Doc: prettier.Concat{
+					prettier.SoftLine{},
+					t.Type.Doc(),
+				},
 			},
+			prettier.SoftLine{},
+			arrayTypeEndDoc,
 		},
-		prettier.SoftLine{},
-		arrayTypeEndDoc,
 	}
 }
 
@@ -338,18 +352,20 @@ func (t *ConstantSizedType) String() string {
 const constantSizedTypeSeparatorSpaceDoc = prettier.Text("; ")
 
 func (t *ConstantSizedType) Doc() prettier.Doc {
-	return prettier.Concat{
-		arrayTypeStartDoc,
-		prettier.Indent{
-			Doc: prettier.Concat{
-				prettier.SoftLine{},
-				t.Type.Doc(),
-				constantSizedTypeSeparatorSpaceDoc,
-				t.Size.Doc(),
+	return prettier.Group{
+		Doc: prettier.Concat{
+			arrayTypeStartDoc,
+			prettier.Indent{
+				Doc: prettier.Concat{
+					prettier.SoftLine{},
+					t.Type.Doc(),
+					constantSizedTypeSeparatorSpaceDoc,
+					t.Size.Doc(),
+				},
 			},
+			prettier.SoftLine{},
+			arrayTypeEndDoc,
 		},
-		prettier.SoftLine{},
-		arrayTypeEndDoc,
 	}
 }
 
@@ -402,18 +418,20 @@ const dictionaryTypeStartDoc = prettier.Text("{")
 const dictionaryTypeEndDoc = prettier.Text("}")
 
 func (t *DictionaryType) Doc() prettier.Doc {
-	return prettier.Concat{
-		dictionaryTypeStartDoc,
-		prettier.Indent{
-			Doc: prettier.Concat{
-				prettier.SoftLine{},
-				t.KeyType.Doc(),
-				typeSeparatorSpaceDoc,
-				t.ValueType.Doc(),
+	return prettier.Group{
+		Doc: prettier.Concat{
+			dictionaryTypeStartDoc,
+			prettier.Indent{
+				Doc: prettier.Concat{
+					prettier.SoftLine{},
+					t.KeyType.Doc(),
+					typeSeparatorSpaceDoc,
+					t.ValueType.Doc(),
+				},
 			},
+			prettier.SoftLine{},
+			dictionaryTypeEndDoc,
 		},
-		prettier.SoftLine{},
-		dictionaryTypeEndDoc,
 	}
 }
 
@@ -513,10 +531,18 @@ func (t *FunctionType) Doc() prettier.Doc {
 				closeParenthesisDoc,
 			},
 		},
-		typeSeparatorSpaceDoc,
-		t.ReturnTypeAnnotation.Doc(),
 	)
 
+	if t.ReturnTypeAnnotation != nil &&
+		!IsEmptyType(t.ReturnTypeAnnotation.Type) {
+
+		result = append(
+			result,
+			typeSeparatorSpaceDoc,
+			t.ReturnTypeAnnotation.Doc(),
+		)
+	}
+
 	return result
 }
 
@@ -608,10 +634,26 @@ func (t *ReferenceType) Doc() prettier.Doc {
 		)
 	}
 
+	typeDoc := t.Type.Doc()
+
+	// Optional, reference, and function referenced types must be parenthesized:
+	// The reference would otherwise be optional, e.g. `&(Int?)` vs `&Int?`,
+	// `&&` is the logical and operator, e.g. `&(&Int)` vs `&&Int`,
+	// and an optional reference to a function would otherwise be a reference to a function
+	// with an optional return type, e.g. `&(fun(): Int)?` vs `&fun(): Int?`
+	switch t.Type.(type) {
+	case *OptionalType, *ReferenceType, *FunctionType:
+		typeDoc = prettier.Concat{
+			openParenthesisDoc,
+			typeDoc,
+			closeParenthesisDoc,
+		}
+	}
+
 	return append(
 		doc,
 		referenceTypeSymbolDoc,
-		t.Type.Doc(),
+		typeDoc,
 	)
 }
 
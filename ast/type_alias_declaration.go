@@ -0,0 +1,131 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+
+	"github.com/turbolent/prettier"
+
+	"github.com/onflow/cadence/common"
+)
+
+// TypeAliasDeclaration
+
+type TypeAliasDeclaration struct {
+	Access      Access
+	DocString   string
+	Identifier  Identifier
+	AliasedType Type
+	Range
+	ElementComments
+}
+
+var _ Element = &TypeAliasDeclaration{}
+var _ Declaration = &TypeAliasDeclaration{}
+var _ Statement = &TypeAliasDeclaration{}
+
+func NewTypeAliasDeclaration(
+	gauge common.MemoryGauge,
+	access Access,
+	identifier Identifier,
+	aliasedType Type,
+	docString string,
+	declRange Range,
+) *TypeAliasDeclaration {
+	common.UseMemory(gauge, common.TypeAliasDeclarationMemoryUsage)
+
+	return &TypeAliasDeclaration{
+		Access:      access,
+		Identifier:  identifier,
+		AliasedType: aliasedType,
+		DocString:   docString,
+		Range:       declRange,
+	}
+}
+
+func (*TypeAliasDeclaration) ElementType() ElementType {
+	return ElementTypeTypeAliasDeclaration
+}
+
+func (*TypeAliasDeclaration) Walk(_ func(Element)) {}
+
+func (*TypeAliasDeclaration) isDeclaration() {}
+
+// NOTE: statement, so it can be represented in the AST,
+// but will be rejected in semantic analysis
+func (*TypeAliasDeclaration) isStatement() {}
+
+func (d *TypeAliasDeclaration) DeclarationIdentifier() *Identifier {
+	return &d.Identifier
+}
+
+func (d *TypeAliasDeclaration) DeclarationAccess() Access {
+	return d.Access
+}
+
+func (d *TypeAliasDeclaration) DeclarationKind() common.DeclarationKind {
+	return common.DeclarationKindTypeAlias
+}
+
+func (d *TypeAliasDeclaration) DeclarationMembers() *Members {
+	return nil
+}
+
+func (d *TypeAliasDeclaration) DeclarationDocString() string {
+	return d.DocString
+}
+
+func (d *TypeAliasDeclaration) MarshalJSON() ([]byte, error) {
+	type Alias TypeAliasDeclaration
+	return json.Marshal(&struct {
+		*Alias
+		Type string
+	}{
+		Type:  "TypeAliasDeclaration",
+		Alias: (*Alias)(d),
+	})
+}
+
+var typeAliasKeywordSpaceDoc = prettier.Text("typealias ")
+var typeAliasEqualDoc prettier.Doc = prettier.Text(" = ")
+
+func (d *TypeAliasDeclaration) Doc() prettier.Doc {
+	var doc prettier.Concat
+
+	if d.Access != AccessNotSpecified {
+		doc = append(
+			doc,
+			prettier.Text(d.Access.Keyword()),
+			prettier.Space,
+		)
+	}
+
+	return append(
+		doc,
+		typeAliasKeywordSpaceDoc,
+		prettier.Text(d.Identifier.Identifier),
+		typeAliasEqualDoc,
+		d.AliasedType.Doc(),
+	)
+}
+
+func (d *TypeAliasDeclaration) String() string {
+	return Prettier(d)
+}
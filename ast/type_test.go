@@ -282,18 +282,45 @@ func TestOptionalType_String(t *testing.T) {
 
 	t.Parallel()
 
-	ty := &OptionalType{
-		Type: &NominalType{
-			Identifier: Identifier{
-				Identifier: "R",
+	t.Run("nominal", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := &OptionalType{
+			Type: &NominalType{
+				Identifier: Identifier{
+					Identifier: "R",
+				},
 			},
-		},
-	}
+		}
 
-	assert.Equal(t,
-		"R?",
-		ty.String(),
-	)
+		assert.Equal(t,
+			"R?",
+			ty.String(),
+		)
+	})
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := &OptionalType{
+			Type: &FunctionType{
+				ReturnTypeAnnotation: &TypeAnnotation{
+					Type: &NominalType{
+						Identifier: Identifier{
+							Identifier: "R",
+						},
+					},
+				},
+			},
+		}
+
+		assert.Equal(t,
+			"(fun (): R)?",
+			ty.String(),
+		)
+	})
 }
 
 func TestOptionalType_MarshalJSON(t *testing.T) {
@@ -349,16 +376,18 @@ func TestVariableSizedType_Doc(t *testing.T) {
 	}
 
 	assert.Equal(t,
-		prettier.Concat{
-			prettier.Text("["),
-			prettier.Indent{
-				Doc: prettier.Concat{
-					prettier.SoftLine{},
-					prettier.Text("T"),
+		prettier.Group{
+			Doc: prettier.Concat{
+				prettier.Text("["),
+				prettier.Indent{
+					Doc: prettier.Concat{
+						prettier.SoftLine{},
+						prettier.Text("T"),
+					},
 				},
+				prettier.SoftLine{},
+				prettier.Text("]"),
 			},
-			prettier.SoftLine{},
-			prettier.Text("]"),
 		},
 		ty.Doc(),
 	)
@@ -443,18 +472,20 @@ func TestConstantSizedType_Doc(t *testing.T) {
 	}
 
 	assert.Equal(t,
-		prettier.Concat{
-			prettier.Text("["),
-			prettier.Indent{
-				Doc: prettier.Concat{
-					prettier.SoftLine{},
-					prettier.Text("T"),
-					prettier.Text("; "),
-					prettier.Text("42"),
+		prettier.Group{
+			Doc: prettier.Concat{
+				prettier.Text("["),
+				prettier.Indent{
+					Doc: prettier.Concat{
+						prettier.SoftLine{},
+						prettier.Text("T"),
+						prettier.Text("; "),
+						prettier.Text("42"),
+					},
 				},
+				prettier.SoftLine{},
+				prettier.Text("]"),
 			},
-			prettier.SoftLine{},
-			prettier.Text("]"),
 		},
 		ty.Doc(),
 	)
@@ -561,18 +592,20 @@ func TestDictionaryType_Doc(t *testing.T) {
 	}
 
 	assert.Equal(t,
-		prettier.Concat{
-			prettier.Text("{"),
-			prettier.Indent{
-				Doc: prettier.Concat{
-					prettier.SoftLine{},
-					prettier.Text("AB"),
-					prettier.Text(": "),
-					prettier.Text("CD"),
+		prettier.Group{
+			Doc: prettier.Concat{
+				prettier.Text("{"),
+				prettier.Indent{
+					Doc: prettier.Concat{
+						prettier.SoftLine{},
+						prettier.Text("AB"),
+						prettier.Text(": "),
+						prettier.Text("CD"),
+					},
 				},
+				prettier.SoftLine{},
+				prettier.Text("}"),
 			},
-			prettier.SoftLine{},
-			prettier.Text("}"),
 		},
 		ty.Doc(),
 	)
@@ -1167,6 +1200,69 @@ func TestReferenceType_String(t *testing.T) {
 		)
 	})
 
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := &ReferenceType{
+			Type: &OptionalType{
+				Type: &NominalType{
+					Identifier: Identifier{
+						Identifier: "T",
+					},
+				},
+			},
+		}
+
+		assert.Equal(t,
+			"&(T?)",
+			ty.String(),
+		)
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := &ReferenceType{
+			Type: &ReferenceType{
+				Type: &NominalType{
+					Identifier: Identifier{
+						Identifier: "T",
+					},
+				},
+			},
+		}
+
+		assert.Equal(t,
+			"&(&T)",
+			ty.String(),
+		)
+	})
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := &OptionalType{
+			Type: &ReferenceType{
+				Type: &FunctionType{
+					ReturnTypeAnnotation: &TypeAnnotation{
+						Type: &NominalType{
+							Identifier: Identifier{
+								Identifier: "T",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		assert.Equal(t,
+			"&(fun (): T)?",
+			ty.String(),
+		)
+	})
 }
 
 func TestReferenceType_MarshalJSON(t *testing.T) {
@@ -38,6 +38,7 @@ type VariableDeclaration struct {
 	StartPos          Position `json:"-"`
 	Access            Access
 	IsConstant        bool
+	ElementComments
 }
 
 var _ Element = &VariableDeclaration{}
@@ -38,6 +38,7 @@ type StatementDeclarationVisitor[T any] interface {
 	VisitEntitlementDeclaration(*EntitlementDeclaration) T
 	VisitEntitlementMappingDeclaration(*EntitlementMappingDeclaration) T
 	VisitTransactionDeclaration(*TransactionDeclaration) T
+	VisitTypeAliasDeclaration(*TypeAliasDeclaration) T
 }
 
 type DeclarationVisitor[T any] interface {
@@ -90,6 +91,9 @@ func AcceptDeclaration[T any](declaration Declaration, visitor DeclarationVisito
 
 	case ElementTypeEntitlementMappingDeclaration:
 		return visitor.VisitEntitlementMappingDeclaration(declaration.(*EntitlementMappingDeclaration))
+
+	case ElementTypeTypeAliasDeclaration:
+		return visitor.VisitTypeAliasDeclaration(declaration.(*TypeAliasDeclaration))
 	}
 
 	panic(errors.NewUnreachableError())
@@ -171,6 +175,9 @@ func AcceptStatement[T any](statement Statement, visitor StatementVisitor[T]) (_
 	case ElementTypeEntitlementDeclaration:
 		return visitor.VisitEntitlementDeclaration(statement.(*EntitlementDeclaration))
 
+	case ElementTypeTypeAliasDeclaration:
+		return visitor.VisitTypeAliasDeclaration(statement.(*TypeAliasDeclaration))
+
 	case ElementTypeRemoveStatement:
 		return visitor.VisitRemoveStatement(statement.(*RemoveStatement))
 	}
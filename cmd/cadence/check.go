@@ -0,0 +1,90 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"github.com/onflow/cadence/cmd"
+)
+
+type checkResult struct {
+	Path  string `json:"path"`
+	Error string `json:"error,omitempty"`
+}
+
+// check parses and checks the programs in the given files
+func (c *cli) check(args []string) int {
+	options := c.newOptions("check", "[files]")
+
+	var memberAccountAccessFlag stringsFlag
+	options.flags.Var(
+		&memberAccountAccessFlag,
+		"memberAccountAccess",
+		"allow account access from:to, where both are location type IDs. can be repeated",
+	)
+	exhaustiveEnumSwitchFlag := options.flags.Bool(
+		"exhaustiveEnumSwitch",
+		false,
+		"report switch statements over enums which have no default case and do not cover all enum cases",
+	)
+
+	if !options.parse(args) {
+		return 2
+	}
+
+	paths := options.flags.Args()
+	if len(paths) == 0 {
+		return c.printUsageError(options, "no input files")
+	}
+
+	memberAccountAccess, err := cmd.ParseMemberAccountAccess(memberAccountAccessFlag)
+	if err != nil {
+		return c.printUsageError(options, "%s", err)
+	}
+
+	environment, err := options.newEnvironment(c.stdout)
+	if err != nil {
+		return c.printUsageError(options, "%s", err)
+	}
+	environment.MemberAccountAccess = memberAccountAccess
+	environment.EnumSwitchExhaustivenessCheckEnabled = *exhaustiveEnumSwitchFlag
+
+	allSucceeded := true
+
+	results := make([]checkResult, 0, len(paths))
+
+	for _, path := range paths {
+		result := checkResult{
+			Path: path,
+		}
+
+		_, err := environment.ParseAndCheckFile(path)
+		if err != nil {
+			allSucceeded = false
+
+			result.Error = c.reportError(options, environment, err, cmd.ResolveFile(path))
+		}
+
+		results = append(results, result)
+	}
+
+	if options.json {
+		c.writeJSON(results)
+	}
+
+	return exitCode(allSucceeded)
+}
@@ -0,0 +1,386 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/turbolent/prettier"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/cmd"
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/cadence/parser/lexer"
+)
+
+const defaultFormatMaxLineWidth = 80
+const defaultFormatIndentWidth = 4
+
+// errFormatChangesProgram is returned if the formatted program is not equivalent to the original program.
+// This indicates a bug in the formatter, so the program is left unchanged
+var errFormatChangesProgram = errors.New("formatting would change the program")
+
+type formatConfig struct {
+	// maxLineWidth is the maximum width of a line, which is only exceeded if a line cannot be broken
+	maxLineWidth int
+	// indent is the string used for one level of indentation
+	indent string
+}
+
+type formatResult struct {
+	Path      string `json:"path"`
+	Formatted string `json:"formatted,omitempty"`
+	Changed   bool   `json:"changed"`
+	Error     string `json:"error,omitempty"`
+}
+
+// format formats the programs in the given files.
+// The formatted programs are printed, unless they are written to the files,
+// or only checked.
+//
+// Comments are attached to declarations and statements.
+// Comments inside expressions are kept, but moved before the enclosing declaration or statement
+func (c *cli) format(args []string) int {
+	options := c.newOptions("fmt", "[files]")
+
+	writeFlag := options.flags.Bool("w", false, "write the formatted programs to the files instead of printing them")
+	listFlag := options.flags.Bool("l", false, "list the files whose formatting differs instead of printing the formatted programs")
+	checkFlag := options.flags.Bool("check", false, "list the files whose formatting differs and fail if there are any, instead of printing the formatted programs")
+	widthFlag := options.flags.Int("width", defaultFormatMaxLineWidth, "the maximum line width")
+	indentFlag := options.flags.Int("indent", defaultFormatIndentWidth, "the number of spaces used for indentation")
+	tabsFlag := options.flags.Bool("tabs", false, "indent with tabs instead of spaces")
+
+	if !options.parse(args) {
+		return 2
+	}
+
+	paths := options.flags.Args()
+	if len(paths) == 0 {
+		return c.printUsageError(options, "no input files")
+	}
+
+	if *widthFlag <= 0 {
+		return c.printUsageError(options, "invalid line width: %d", *widthFlag)
+	}
+
+	if *indentFlag <= 0 {
+		return c.printUsageError(options, "invalid indentation: %d", *indentFlag)
+	}
+
+	if *checkFlag && *writeFlag {
+		return c.printUsageError(options, "-check and -w cannot be used together")
+	}
+
+	config := formatConfig{
+		maxLineWidth: *widthFlag,
+		indent:       strings.Repeat(" ", *indentFlag),
+	}
+	if *tabsFlag {
+		config.indent = "\t"
+	}
+
+	environment, err := options.newEnvironment(c.stdout)
+	if err != nil {
+		return c.printUsageError(options, "%s", err)
+	}
+
+	allSucceeded := true
+
+	results := make([]formatResult, 0, len(paths))
+
+	for _, path := range paths {
+		result := formatResult{
+			Path: path,
+		}
+
+		code, formatted, err := formatFile(environment, path, config)
+		if err == nil {
+			result.Formatted = formatted
+			result.Changed = formatted != string(code)
+
+			if *writeFlag && result.Changed {
+				err = os.WriteFile(path, []byte(formatted), 0644)
+			}
+
+			if *checkFlag && result.Changed {
+				allSucceeded = false
+			}
+		}
+
+		if err != nil {
+			allSucceeded = false
+			result.Error = c.reportError(options, environment, err, cmd.ResolveFile(path))
+		} else if !options.json {
+			switch {
+			case *listFlag, *checkFlag:
+				if result.Changed {
+					_, _ = io.WriteString(c.stdout, path+"\n")
+				}
+			case !*writeFlag:
+				_, _ = io.WriteString(c.stdout, formatted)
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	if options.json {
+		c.writeJSON(results)
+	}
+
+	return exitCode(allSucceeded)
+}
+
+// formatFile reads the program in the given file,
+// and returns its code and its formatted code
+func formatFile(environment *cmd.Environment, path string, config formatConfig) (code []byte, formatted string, err error) {
+	_, code, err = environment.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	formatted, err = formatCode(code, config)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return code, formatted, nil
+}
+
+// formatCode formats the given program, including its comments.
+// Comments inside expressions are moved before the enclosing declaration or statement.
+//
+// The formatted program is parsed again and compared with the original program.
+// If the programs differ, other than in their positions and formatting,
+// or if comments were lost, errFormatChangesProgram is returned
+func formatCode(code []byte, config formatConfig) (string, error) {
+	program, err := parseProgramWithComments(code)
+	if err != nil {
+		return "", err
+	}
+
+	formatted := printProgram(program, config)
+
+	formattedProgram, err := parseProgramWithComments([]byte(formatted))
+	if err != nil {
+		return "", fmt.Errorf("%w: formatted program is invalid: %w", errFormatChangesProgram, err)
+	}
+
+	err = checkEquivalentPrograms(program, formattedProgram)
+	if err != nil {
+		return "", err
+	}
+
+	err = checkEquivalentComments(code, []byte(formatted))
+	if err != nil {
+		return "", err
+	}
+
+	return formatted, nil
+}
+
+func parseProgramWithComments(code []byte) (*ast.Program, error) {
+	return parser.ParseProgram(
+		nil,
+		code,
+		parser.Config{
+			CommentsEnabled: true,
+		},
+	)
+}
+
+// printProgram pretty-prints the given program.
+//
+// Trailing whitespace is removed from all lines,
+// and the program is terminated by exactly one newline
+func printProgram(program *ast.Program, config formatConfig) string {
+	var builder strings.Builder
+	prettier.Prettier(&builder, program.Doc(), config.maxLineWidth, config.indent)
+
+	lines := strings.Split(builder.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	formatted := strings.TrimSpace(strings.Join(lines, "\n"))
+	if formatted == "" {
+		return ""
+	}
+
+	return formatted + "\n"
+}
+
+// checkEquivalentPrograms checks that the given programs are equal,
+// ignoring positions and comments.
+//
+// The programs are compared using their JSON representation,
+// which contains all information of the AST
+func checkEquivalentPrograms(original *ast.Program, formatted *ast.Program) error {
+	originalValue, err := programWithoutTrivia(original)
+	if err != nil {
+		return err
+	}
+
+	formattedValue, err := programWithoutTrivia(formatted)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(originalValue, formattedValue) {
+		return fmt.Errorf("%w: programs differ", errFormatChangesProgram)
+	}
+
+	return nil
+}
+
+// triviaJSONKeys are the keys of the JSON representation of AST elements
+// that do not affect the meaning of the program.
+// Positions are also ignored, see isPositionJSON.
+//
+// Docstrings are ignored, as they are compared as part of the comments
+var triviaJSONKeys = map[string]struct{}{
+	"Comments":  {},
+	"DocString": {},
+}
+
+// isPositionJSON returns true if the given value is the JSON representation of an ast.Position
+func isPositionJSON(value any) bool {
+	object, ok := value.(map[string]any)
+	if !ok || len(object) != 3 {
+		return false
+	}
+
+	for _, key := range []string{"Offset", "Line", "Column"} {
+		if _, ok := object[key]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func programWithoutTrivia(program *ast.Program) (any, error) {
+	data, err := json.Marshal(program)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	err = json.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	removeTrivia(value)
+
+	return value, nil
+}
+
+func removeTrivia(value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, element := range value { //nolint:maprange
+			if _, ok := triviaJSONKeys[key]; ok || isPositionJSON(element) {
+				delete(value, key)
+				continue
+			}
+			removeTrivia(element)
+		}
+
+	case []any:
+		for _, element := range value {
+			removeTrivia(element)
+		}
+	}
+}
+
+// checkEquivalentComments checks that the given programs contain the same comments,
+// in the same order.
+//
+// Whitespace in comments is ignored, as multi-line block comments are re-indented
+func checkEquivalentComments(original []byte, formatted []byte) error {
+	originalComments, err := commentTexts(original)
+	if err != nil {
+		return err
+	}
+
+	formattedComments, err := commentTexts(formatted)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(originalComments, formattedComments) {
+		return fmt.Errorf("%w: comments differ", errFormatChangesProgram)
+	}
+
+	return nil
+}
+
+// commentTexts returns the texts of all top-level comments in the given code,
+// with whitespace removed
+func commentTexts(code []byte) ([]string, error) {
+	tokens, err := lexer.Lex(bytes.Clone(code), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tokens.Reclaim()
+
+	var texts []string
+	var blockCommentStart int
+	var blockCommentDepth int
+
+	for {
+		token := tokens.Next()
+
+		var text []byte
+
+		switch token.Type {
+		case lexer.TokenEOF:
+			return texts, nil
+
+		case lexer.TokenLineComment:
+			text = code[token.StartPos.Offset : token.EndPos.Offset+1]
+
+		case lexer.TokenBlockCommentStart:
+			if blockCommentDepth == 0 {
+				blockCommentStart = token.StartPos.Offset
+			}
+			blockCommentDepth++
+			continue
+
+		case lexer.TokenBlockCommentEnd:
+			blockCommentDepth--
+			if blockCommentDepth > 0 {
+				continue
+			}
+			text = code[blockCommentStart : token.EndPos.Offset+1]
+
+		default:
+			continue
+		}
+
+		texts = append(texts, strings.Join(strings.Fields(string(text)), ""))
+	}
+}
@@ -0,0 +1,351 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGoldenFiles = flag.Bool("update", false, "update the golden files")
+
+// Go treats directories named "testdata" specially
+const testDataDirectory = "testdata"
+
+// repositoryDirectory is the root of the repository, relative to this package
+const repositoryDirectory = "../.."
+
+// formatCorpus are the glob patterns of the programs in the repository
+// which are formatted by TestFormatCorpus, relative to the repository root
+var formatCorpus = []string{
+	"stdlib/*.cdc",
+	"stdlib/contracts/*.cdc",
+	"sema/*.cdc",
+	"sema/gen/testdata/*/test.cdc",
+	"benchmarks/*.cdc",
+}
+
+var defaultFormatConfig = formatConfig{
+	maxLineWidth: defaultFormatMaxLineWidth,
+	indent:       "    ",
+}
+
+// TestFormatCorpus formats all programs of the corpus.
+// Each program is expected to have a "golden output" file in the `testdata/fmt` directory,
+// with the same path relative to the repository root, and an additional `.golden` extension.
+//
+// Run the test with the `-update` flag to update the golden files
+func TestFormatCorpus(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(path string) {
+
+		t.Run(path, func(t *testing.T) {
+
+			t.Parallel()
+
+			code, err := os.ReadFile(filepath.Join(repositoryDirectory, path))
+			require.NoError(t, err)
+
+			formatted, err := formatCode(code, defaultFormatConfig)
+			require.NoError(t, err)
+
+			// Formatting must be idempotent
+
+			reformatted, err := formatCode([]byte(formatted), defaultFormatConfig)
+			require.NoError(t, err)
+			require.Equal(t, formatted, reformatted)
+
+			goldenPath := filepath.Join(testDataDirectory, "fmt", path+".golden")
+
+			if *updateGoldenFiles {
+				err = os.MkdirAll(filepath.Dir(goldenPath), 0755)
+				require.NoError(t, err)
+
+				err = os.WriteFile(goldenPath, []byte(formatted), 0644)
+				require.NoError(t, err)
+				return
+			}
+
+			want, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+
+			require.Equal(t, string(want), formatted)
+		})
+	}
+
+	for _, pattern := range formatCorpus {
+		paths, err := filepath.Glob(filepath.Join(repositoryDirectory, pattern))
+		require.NoError(t, err)
+		require.NotEmpty(t, paths)
+
+		for _, path := range paths {
+			path, err := filepath.Rel(repositoryDirectory, path)
+			require.NoError(t, err)
+
+			test(filepath.ToSlash(path))
+		}
+	}
+}
+
+func TestFormatCode(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+// License
+
+import   Foo   from 0x1 // Foo
+
+/// Bar is a contract
+access(all)   contract Bar {
+
+    // x
+    access(all) let x: Int /* x */
+
+
+    init() {
+        /*
+           Initialize
+        */
+        self.x = 1
+        // end of init
+    }
+}
+`
+
+		formatted, err := formatCode([]byte(code), defaultFormatConfig)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			`// License
+
+import Foo from 0x1 // Foo
+
+/// Bar is a contract
+access(all)
+contract Bar {
+    // x
+    access(all)
+    let x: Int /* x */
+
+    init() {
+        /*
+           Initialize
+        */
+        self.x = 1
+        // end of init
+    }
+}
+`,
+			formatted,
+		)
+	})
+
+	t.Run("comments inside expressions", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Comments are attached to declarations and statements,
+		// so comments inside expressions are moved before the enclosing statement
+
+		const code = `
+fun test(): Int {
+    /* block */ let y = [1, /* inner */ 2]
+
+    let z = 1 + // trailing op
+        2
+
+    return y[0] + z // result
+}
+`
+
+		formatted, err := formatCode([]byte(code), defaultFormatConfig)
+		require.NoError(t, err)
+
+		const expected = `fun test(): Int {
+    /* block */
+    /* inner */
+    let y = [1, 2]
+
+    // trailing op
+    let z = 1 + 2
+
+    return y[0] + z // result
+}
+`
+
+		assert.Equal(t, expected, formatted)
+
+		// Formatting must be idempotent
+
+		reformatted, err := formatCode([]byte(formatted), defaultFormatConfig)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, reformatted)
+	})
+
+	t.Run("line width and indentation", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+fun test() {
+    foo(aaaaaaaa, bbbbbbbb, cccccccc)
+}
+`
+
+		formatted, err := formatCode(
+			[]byte(code),
+			formatConfig{
+				maxLineWidth: 20,
+				indent:       "\t",
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			"fun test() {\n"+
+				"\tfoo(\n"+
+				"\t\taaaaaaaa,\n"+
+				"\t\tbbbbbbbb,\n"+
+				"\t\tcccccccc\n"+
+				"\t)\n"+
+				"}\n",
+			formatted,
+		)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		formatted, err := formatCode([]byte("\n\n"), defaultFormatConfig)
+		require.NoError(t, err)
+
+		assert.Empty(t, formatted)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := formatCode([]byte("let x = "), defaultFormatConfig)
+		require.Error(t, err)
+
+		assert.False(t, errors.Is(err, errFormatChangesProgram))
+	})
+}
+
+func TestCheckEquivalentPrograms(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, original, formatted string) error {
+		originalProgram, err := parseProgramWithComments([]byte(original))
+		require.NoError(t, err)
+
+		formattedProgram, err := parseProgramWithComments([]byte(formatted))
+		require.NoError(t, err)
+
+		return checkEquivalentPrograms(originalProgram, formattedProgram)
+	}
+
+	t.Run("formatting and comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(t,
+			"let x = 1 + 2 // x",
+			"\n\nlet   x =\n  1+2",
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("precedence", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(t,
+			"let x = (1 + 2) * 3",
+			"let x = 1 + 2 * 3",
+		)
+		require.ErrorIs(t, err, errFormatChangesProgram)
+	})
+
+	t.Run("literal", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(t,
+			"let x = 0x1",
+			"let x = 1",
+		)
+		require.ErrorIs(t, err, errFormatChangesProgram)
+	})
+}
+
+func TestCheckEquivalentComments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("re-indented", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := checkEquivalentComments(
+			[]byte("/* a\n     b */\nlet x = 1 // c"),
+			[]byte("/* a\n b */\nlet x = 1 // c\n"),
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := checkEquivalentComments(
+			[]byte("/* a /* b */ */\nlet x = 1"),
+			[]byte("/* a /* b */ */\nlet x = 1"),
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("lost", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := checkEquivalentComments(
+			[]byte("let x = 1 // c"),
+			[]byte("let x = 1"),
+		)
+		require.ErrorIs(t, err, errFormatChangesProgram)
+	})
+}
@@ -0,0 +1,137 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// The cadence command checks, runs, formats, and tests Cadence programs.
+//
+// Usage:
+//
+//	cadence <command> [flags] [files]
+//
+// Run `cadence help` for a list of commands,
+// and `cadence <command> -h` for the flags of a command.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+)
+
+type command struct {
+	usage string
+	help  string
+	run   func(cli *cli, args []string) int
+}
+
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"check": {
+			usage: "[files]",
+			help:  "Check programs",
+			run:   (*cli).check,
+		},
+		"run": {
+			usage: "<file>",
+			help:  "Run a program and call its main function, if any",
+			run:   (*cli).run,
+		},
+		"repl": {
+			help: "Start the REPL",
+			run:  (*cli).repl,
+		},
+		"parse": {
+			usage: "[files]",
+			help:  "Parse programs",
+			run:   (*cli).parse,
+		},
+		"fmt": {
+			usage: "[files]",
+			help:  "Format programs",
+			run:   (*cli).format,
+		},
+		"test": {
+			usage: "[files]",
+			help:  "Run the test functions of programs",
+			run:   (*cli).test,
+		},
+	}
+}
+
+// cli runs commands. Results are written to the standard output,
+// diagnostics are written to the standard error
+type cli struct {
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func main() {
+	cli := &cli{
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+	}
+	os.Exit(cli.main(os.Args[1:]))
+}
+
+// main runs the command with the given arguments and returns the exit code
+func (c *cli) main(args []string) int {
+	if len(args) == 0 {
+		c.printAvailableCommands()
+		return 2
+	}
+
+	commandName := args[0]
+
+	switch commandName {
+	case "help", "-h", "-help", "--help":
+		c.printAvailableCommands()
+		return 0
+	}
+
+	command, ok := commands[commandName]
+	if !ok {
+		_, _ = fmt.Fprintf(c.stderr, "unknown command: %s\n\n", commandName)
+		c.printAvailableCommands()
+		return 2
+	}
+
+	return command.run(c, args[1:])
+}
+
+func (c *cli) printAvailableCommands() {
+	names := make([]string, 0, len(commands))
+
+	// Gather all command names, then sort them
+	for name := range commands { //nolint:maprange
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	_, _ = fmt.Fprintln(c.stderr, "Usage: cadence <command> [flags]")
+	_, _ = fmt.Fprintln(c.stderr)
+	_, _ = fmt.Fprintln(c.stderr, "Available commands:")
+
+	for _, name := range names {
+		command := commands[name]
+		usage := strings.TrimSpace(name + " " + command.usage)
+		_, _ = fmt.Fprintf(c.stderr, "  %-16s %s\n", usage, command.help)
+	}
+}
@@ -0,0 +1,487 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+
+	for name, code := range files { //nolint:maprange
+		path := filepath.Join(directory, name)
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		require.NoError(t, err)
+		err = os.WriteFile(path, []byte(code), 0644)
+		require.NoError(t, err)
+	}
+
+	return directory
+}
+
+func runCLI(args ...string) (exitCode int, stdout string, stderr string) {
+	var stdoutBuffer, stderrBuffer bytes.Buffer
+
+	cli := &cli{
+		stdout: &stdoutBuffer,
+		stderr: &stderrBuffer,
+	}
+
+	exitCode = cli.main(args)
+
+	return exitCode, stdoutBuffer.String(), stderrBuffer.String()
+}
+
+const testMathCode = `
+  access(all) fun double(_ n: Int): Int {
+      return n * 2
+  }
+`
+
+const testCounterCode = `
+  access(all) contract Counter {
+      access(all) var count: Int
+
+      init() {
+          self.count = 10
+      }
+
+      access(all) fun increment(): Int {
+          self.count = self.count + 1
+          return self.count
+      }
+  }
+`
+
+func TestCLICommands(t *testing.T) {
+
+	t.Parallel()
+
+	exitCode, _, stderr := runCLI()
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "Available commands:")
+
+	exitCode, _, stderr = runCLI("unknown")
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "unknown command: unknown")
+}
+
+func TestCLICheck(t *testing.T) {
+
+	t.Parallel()
+
+	directory := writeFiles(t, map[string]string{
+		"lib/Math.cdc": testMathCode,
+		"valid.cdc": `
+          import "Math"
+
+          access(all) let x = double(1)
+        `,
+		"invalid.cdc": `
+          access(all) let x: Int = true
+        `,
+	})
+
+	valid := filepath.Join(directory, "valid.cdc")
+	invalid := filepath.Join(directory, "invalid.cdc")
+	lib := filepath.Join(directory, "lib")
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		exitCode, stdout, stderr := runCLI("check", "-color=false", "-I", lib, valid)
+		assert.Equal(t, 0, exitCode)
+		assert.Empty(t, stdout)
+		assert.Empty(t, stderr)
+	})
+
+	t.Run("unresolved import", func(t *testing.T) {
+		t.Parallel()
+
+		exitCode, _, stderr := runCLI("check", "-color=false", valid)
+		assert.Equal(t, 1, exitCode)
+		assert.Contains(t, stderr, "cannot import `Math`: file not found")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+
+		exitCode, stdout, stderr := runCLI("check", "-json", "-I", lib, valid, invalid)
+		assert.Equal(t, 1, exitCode)
+		assert.Empty(t, stderr)
+
+		var results []checkResult
+		err := json.Unmarshal([]byte(stdout), &results)
+		require.NoError(t, err)
+
+		require.Len(t, results, 2)
+		assert.Equal(t, checkResult{Path: valid}, results[0])
+		assert.Equal(t, invalid, results[1].Path)
+		assert.Contains(t, results[1].Error, "mismatched types")
+		// JSON output is never colored
+		assert.NotContains(t, results[1].Error, "\x1b[")
+	})
+
+	t.Run("exhaustive enum switch", func(t *testing.T) {
+		t.Parallel()
+
+		directory := writeFiles(t, map[string]string{
+			"switch.cdc": `
+              access(all) enum E: UInt8 {
+                  access(all) case a
+                  access(all) case b
+              }
+
+              access(all) fun test(_ e: E) {
+                  switch e {
+                      case E.a:
+                          return
+                  }
+              }
+            `,
+		})
+
+		path := filepath.Join(directory, "switch.cdc")
+
+		// The check is disabled by default
+
+		exitCode, _, stderr := runCLI("check", "-color=false", path)
+		assert.Equal(t, 0, exitCode)
+		assert.Empty(t, stderr)
+
+		exitCode, _, stderr = runCLI("check", "-color=false", "-exhaustiveEnumSwitch", path)
+		assert.Equal(t, 1, exitCode)
+		assert.Contains(t, stderr, "switch over enum `E` is not exhaustive")
+	})
+}
+
+func TestCLIRun(t *testing.T) {
+
+	t.Parallel()
+
+	directory := writeFiles(t, map[string]string{
+		"lib/Math.cdc":    testMathCode,
+		"0x1/Counter.cdc": testCounterCode,
+		"main.cdc": `
+          import "Math"
+          import Counter from 0x1
+
+          access(all) fun main(): Int {
+              log("hello")
+              return double(Counter.increment())
+          }
+        `,
+	})
+
+	main := filepath.Join(directory, "main.cdc")
+	lib := filepath.Join(directory, "lib")
+	addressMapping := "0x1=" + filepath.Join(directory, "0x1")
+
+	t.Run("text", func(t *testing.T) {
+		t.Parallel()
+
+		exitCode, stdout, stderr := runCLI("run", "-I", lib, "-address", addressMapping, main)
+		assert.Equal(t, 0, exitCode)
+		assert.Empty(t, stderr)
+		assert.Contains(t, stdout, "\"hello\"\n22\n")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+
+		exitCode, stdout, stderr := runCLI("run", "-json", "-I", lib, "-address", addressMapping, main)
+		assert.Equal(t, 0, exitCode)
+		assert.Empty(t, stderr)
+
+		var result runResult
+		err := json.Unmarshal([]byte(stdout), &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, main, result.Path)
+		assert.Equal(t, "22", result.Value)
+		assert.Contains(t, result.Output, "\"hello\"")
+		assert.Empty(t, result.Error)
+	})
+
+	t.Run("unmapped address", func(t *testing.T) {
+		t.Parallel()
+
+		exitCode, _, stderr := runCLI("run", "-color=false", "-I", lib, main)
+		assert.Equal(t, 1, exitCode)
+		assert.Contains(t, stderr, "cannot import from 0x1: address is not mapped")
+	})
+}
+
+func TestCLIProject(t *testing.T) {
+
+	t.Parallel()
+
+	directory := writeFiles(t, map[string]string{
+		"cadence.json": `
+          {
+            "contracts": {
+              "Math": "./lib/Math.cdc",
+              "Counter": {
+                "source": "./contracts/Counter.cdc",
+                "address": "0xf233dcee88fe0abe"
+              }
+            }
+          }
+        `,
+		"lib/Math.cdc":          testMathCode,
+		"contracts/Counter.cdc": testCounterCode,
+		"address.cdc": `
+          import Math
+          import Counter from 0xf233dcee88fe0abe
+
+          access(all) fun main(): Int {
+              return double(Counter.increment())
+          }
+        `,
+		"alias.cdc": `
+          import "Math"
+          import "Counter"
+
+          access(all) fun main(): String {
+              return Counter.getType().identifier
+          }
+        `,
+		"unknown.cdc": `
+          import Token from 0xf233dcee88fe0abe
+        `,
+		"invalid/cadence.json": `
+          {
+            "contracts": {
+              "Counter": {
+                "address": "0x1"
+              }
+            }
+          }
+        `,
+	})
+
+	project := filepath.Join(directory, "cadence.json")
+
+	t.Run("address import", func(t *testing.T) {
+		t.Parallel()
+
+		exitCode, stdout, stderr := runCLI("run", "-project", project, filepath.Join(directory, "address.cdc"))
+		assert.Equal(t, 0, exitCode)
+		assert.Empty(t, stderr)
+		assert.Equal(t, "22\n", stdout)
+	})
+
+	t.Run("alias import", func(t *testing.T) {
+		t.Parallel()
+
+		exitCode, stdout, stderr := runCLI("run", "-project", project, filepath.Join(directory, "alias.cdc"))
+		assert.Equal(t, 0, exitCode)
+		assert.Empty(t, stderr)
+		assert.Equal(t, "\"A.f233dcee88fe0abe.Counter\"\n", stdout)
+	})
+
+	t.Run("unknown contract", func(t *testing.T) {
+		t.Parallel()
+
+		exitCode, _, stderr := runCLI("check", "-color=false", "-project", project, filepath.Join(directory, "unknown.cdc"))
+		assert.Equal(t, 1, exitCode)
+		assert.Contains(t, stderr, "cannot import `Token` from 0xf233dcee88fe0abe: contract not found")
+	})
+
+	t.Run("invalid project", func(t *testing.T) {
+		t.Parallel()
+
+		exitCode, _, stderr := runCLI(
+			"check",
+			"-color=false",
+			"-project", filepath.Join(directory, "invalid", "cadence.json"),
+			filepath.Join(directory, "address.cdc"),
+		)
+		assert.Equal(t, 2, exitCode)
+		assert.Contains(t, stderr, "missing source of contract Counter")
+	})
+}
+
+func TestCLITest(t *testing.T) {
+
+	t.Parallel()
+
+	directory := writeFiles(t, map[string]string{
+		"tests.cdc": `
+          access(all) var count = 0
+
+          access(all) fun testPasses() {
+              count = count + 1
+              assert(count == 1)
+          }
+
+          access(all) fun testFails() {
+              count = count + 1
+              log("failing")
+              assert(count == 2, message: "state is not shared")
+          }
+
+          access(all) fun testWithParameter(_ n: Int) {}
+
+          access(all) fun helper() {}
+        `,
+	})
+
+	tests := filepath.Join(directory, "tests.cdc")
+
+	exitCode, stdout, stderr := runCLI("test", "-json", tests)
+	assert.Equal(t, 1, exitCode)
+	assert.Empty(t, stderr)
+
+	var results []testFileResult
+	err := json.Unmarshal([]byte(stdout), &results)
+	require.NoError(t, err)
+
+	require.Len(t, results, 1)
+	result := results[0]
+	assert.Empty(t, result.Error)
+
+	require.Len(t, result.Tests, 2)
+
+	assert.Equal(t, testResult{Name: "testPasses", Passed: true}, result.Tests[0])
+
+	failed := result.Tests[1]
+	assert.Equal(t, "testFails", failed.Name)
+	assert.False(t, failed.Passed)
+	assert.Contains(t, failed.Output, "\"failing\"")
+	assert.Contains(t, failed.Error, "state is not shared")
+
+	exitCode, stdout, _ = runCLI("test", "-run", "Passes", tests)
+	assert.Equal(t, 0, exitCode)
+	assert.Equal(
+		t,
+		"--- PASS: testPasses\nok\t"+tests+"\t1 of 1 tests passed\n",
+		stdout,
+	)
+}
+
+func TestCLIFormat(t *testing.T) {
+
+	t.Parallel()
+
+	directory := writeFiles(t, map[string]string{
+		"unformatted.cdc": "access(all)   fun  f( ) :Int {return 1}\n",
+		"comments.cdc":    "// comment\naccess(all)  let x = 1 // x\n",
+		"invalid.cdc":     "access(all) let x = \n",
+	})
+
+	unformatted := filepath.Join(directory, "unformatted.cdc")
+	comments := filepath.Join(directory, "comments.cdc")
+	invalid := filepath.Join(directory, "invalid.cdc")
+
+	const formatted = "access(all)\nfun f(): Int {\n    return 1\n}\n"
+
+	exitCode, stdout, stderr := runCLI("fmt", unformatted)
+	assert.Equal(t, 0, exitCode)
+	assert.Empty(t, stderr)
+	assert.Equal(t, formatted, stdout)
+
+	exitCode, stdout, _ = runCLI("fmt", "-indent", "2", unformatted)
+	assert.Equal(t, 0, exitCode)
+	assert.Equal(t, "access(all)\nfun f(): Int {\n  return 1\n}\n", stdout)
+
+	exitCode, stdout, _ = runCLI("fmt", "-tabs", unformatted)
+	assert.Equal(t, 0, exitCode)
+	assert.Equal(t, "access(all)\nfun f(): Int {\n\treturn 1\n}\n", stdout)
+
+	exitCode, stdout, _ = runCLI("fmt", comments)
+	assert.Equal(t, 0, exitCode)
+	assert.Equal(t, "// comment\naccess(all)\nlet x = 1 // x\n", stdout)
+
+	exitCode, stdout, _ = runCLI("fmt", "-l", unformatted)
+	assert.Equal(t, 0, exitCode)
+	assert.Equal(t, unformatted+"\n", stdout)
+
+	exitCode, stdout, _ = runCLI("fmt", "-check", unformatted)
+	assert.Equal(t, 1, exitCode)
+	assert.Equal(t, unformatted+"\n", stdout)
+
+	exitCode, _, stderr = runCLI("fmt", "-color=false", invalid)
+	assert.Equal(t, 1, exitCode)
+	assert.Contains(t, stderr, "unexpected end of program")
+
+	exitCode, _, stderr = runCLI("fmt", "-check", "-w", unformatted)
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "-check and -w cannot be used together")
+
+	exitCode, _, stderr = runCLI("fmt", "-width", "0", unformatted)
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "invalid line width: 0")
+
+	exitCode, stdout, _ = runCLI("fmt", "-w", unformatted)
+	assert.Equal(t, 0, exitCode)
+	assert.Empty(t, stdout)
+
+	code, err := os.ReadFile(unformatted)
+	require.NoError(t, err)
+	assert.Equal(t, formatted, string(code))
+
+	exitCode, stdout, _ = runCLI("fmt", "-l", unformatted)
+	assert.Equal(t, 0, exitCode)
+	assert.Empty(t, stdout)
+
+	exitCode, stdout, _ = runCLI("fmt", "-check", unformatted)
+	assert.Equal(t, 0, exitCode)
+	assert.Empty(t, stdout)
+}
+
+func TestCLIParse(t *testing.T) {
+
+	t.Parallel()
+
+	directory := writeFiles(t, map[string]string{
+		"valid.cdc":   "access(all) let x = 1\n",
+		"invalid.cdc": "access(all) let x = \n",
+	})
+
+	valid := filepath.Join(directory, "valid.cdc")
+	invalid := filepath.Join(directory, "invalid.cdc")
+
+	exitCode, stdout, stderr := runCLI("parse", "-json", valid, invalid)
+	assert.Equal(t, 1, exitCode)
+	assert.Empty(t, stderr)
+
+	var results []struct {
+		Path    string          `json:"path"`
+		Program json.RawMessage `json:"program"`
+		Error   string          `json:"error"`
+	}
+	err := json.Unmarshal([]byte(stdout), &results)
+	require.NoError(t, err)
+
+	require.Len(t, results, 2)
+	assert.Equal(t, valid, results[0].Path)
+	assert.Contains(t, string(results[0].Program), "VariableDeclaration")
+	assert.Empty(t, results[0].Error)
+	assert.Equal(t, invalid, results[1].Path)
+	assert.Nil(t, results[1].Program)
+	assert.Contains(t, results[1].Error, "expected")
+}
@@ -0,0 +1,187 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/onflow/cadence/cmd"
+	"github.com/onflow/cadence/common"
+)
+
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// options are the flags shared by all commands
+type options struct {
+	flags              *flag.FlagSet
+	json               bool
+	color              bool
+	includeDirectories stringsFlag
+	addressMappings    stringsFlag
+	projectPath        string
+}
+
+func (c *cli) newOptions(name string, usage string) *options {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+
+	options := &options{
+		flags: flags,
+	}
+
+	flags.BoolVar(&options.json, "json", false, "print the results formatted as JSON")
+	flags.BoolVar(&options.color, "color", isTerminal(os.Stderr), "print colored diagnostics")
+	flags.Var(
+		&options.includeDirectories,
+		"I",
+		"search the directory for imported files. can be repeated. defaults to the current directory",
+	)
+	flags.Var(
+		&options.addressMappings,
+		"address",
+		"resolve imports from the address to the contract files in the directory: address=directory. can be repeated",
+	)
+	flags.StringVar(
+		&options.projectPath,
+		"project",
+		"",
+		"resolve imports using the project file. defaults to the "+cmd.ProjectFileName+" file in the current directory or its parents",
+	)
+
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: cadence %s [flags] %s\n\nFlags:\n", name, usage)
+		flags.PrintDefaults()
+	}
+
+	return options
+}
+
+// parse parses the given arguments.
+// It returns false if the arguments are invalid
+func (o *options) parse(args []string) bool {
+	return o.flags.Parse(args) == nil
+}
+
+func (o *options) useColor() bool {
+	return o.color && !o.json
+}
+
+func (o *options) newImportResolver() (*cmd.ImportResolver, error) {
+	addressMappings := map[common.Address]string{}
+
+	for _, mapping := range o.addressMappings {
+		address, directory, err := cmd.ParseAddressMapping(mapping)
+		if err != nil {
+			return nil, err
+		}
+		addressMappings[address] = directory
+	}
+
+	project, err := cmd.LoadProjectFile(o.projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cmd.ImportResolver{
+		Directories:     o.includeDirectories,
+		AddressMappings: addressMappings,
+		Project:         project,
+	}, nil
+}
+
+// newEnvironment returns a new environment,
+// which writes log messages and events to the given output
+func (o *options) newEnvironment(output io.Writer) (*cmd.Environment, error) {
+	importResolver, err := o.newImportResolver()
+	if err != nil {
+		return nil, err
+	}
+
+	return cmd.NewEnvironment(importResolver, output), nil
+}
+
+// formatError pretty-prints the given error of the program with the given location
+func (o *options) formatError(environment *cmd.Environment, err error, location common.Location) string {
+	var builder strings.Builder
+	printErr := environment.PrettyPrintError(&builder, err, location, o.useColor())
+	if printErr != nil {
+		return err.Error()
+	}
+	return builder.String()
+}
+
+// reportError formats the given error of the program with the given location.
+// Unless the results are printed as JSON, the error is also printed to the standard error
+func (c *cli) reportError(options *options, environment *cmd.Environment, err error, location common.Location) string {
+	message := options.formatError(environment, err, location)
+	if !options.json {
+		_, _ = io.WriteString(c.stderr, message)
+	}
+	return message
+}
+
+// printUsageError prints the given error about the usage of the command
+func (c *cli) printUsageError(options *options, format string, args ...any) int {
+	_, _ = fmt.Fprintf(c.stderr, format+"\n\n", args...)
+	options.flags.Usage()
+	return 2
+}
+
+// writeJSON writes the given results formatted as JSON to the standard output
+func (c *cli) writeJSON(results any) {
+	encoder := json.NewEncoder(c.stdout)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(results)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// exitCode returns the exit code for the given success
+func exitCode(succeeded bool) int {
+	if succeeded {
+		return 0
+	}
+	return 1
+}
+
+func isTerminal(file *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
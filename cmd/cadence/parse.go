@@ -0,0 +1,84 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/cmd"
+)
+
+type parseResult struct {
+	Path    string       `json:"path"`
+	Program *ast.Program `json:"program,omitempty"`
+	Error   string       `json:"error,omitempty"`
+}
+
+// parse parses the programs in the given files.
+// The programs are part of the results if they are printed as JSON
+func (c *cli) parse(args []string) int {
+	options := c.newOptions("parse", "[files]")
+
+	if !options.parse(args) {
+		return 2
+	}
+
+	paths := options.flags.Args()
+	if len(paths) == 0 {
+		return c.printUsageError(options, "no input files")
+	}
+
+	environment, err := options.newEnvironment(c.stdout)
+	if err != nil {
+		return c.printUsageError(options, "%s", err)
+	}
+
+	allSucceeded := true
+
+	results := make([]parseResult, 0, len(paths))
+
+	for _, path := range paths {
+		result := parseResult{
+			Path: path,
+		}
+
+		program, err := parseFile(environment, path)
+		if err != nil {
+			allSucceeded = false
+			result.Error = c.reportError(options, environment, err, cmd.ResolveFile(path))
+		} else {
+			result.Program = program
+		}
+
+		results = append(results, result)
+	}
+
+	if options.json {
+		c.writeJSON(results)
+	}
+
+	return exitCode(allSucceeded)
+}
+
+func parseFile(environment *cmd.Environment, path string) (*ast.Program, error) {
+	location, code, err := environment.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return environment.Parse(code, location)
+}
@@ -0,0 +1,52 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/onflow/cadence/cmd/execute"
+)
+
+// repl starts the REPL.
+// Functions invoked from the REPL can be debugged interactively.
+//
+// The REPL does not support imports, so it has none of the shared flags
+func (c *cli) repl(args []string) int {
+	flags := flag.NewFlagSet("repl", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+
+	if flags.Parse(args) != nil {
+		return 2
+	}
+
+	if len(flags.Args()) > 0 {
+		_, _ = fmt.Fprintln(c.stderr, "unexpected arguments")
+		return 2
+	}
+
+	repl, err := execute.NewConsoleREPL(execute.NewDebugger())
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return 1
+	}
+	repl.Run()
+
+	return 0
+}
@@ -0,0 +1,120 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/onflow/cadence/cmd"
+	"github.com/onflow/cadence/cmd/execute"
+	"github.com/onflow/cadence/interpreter"
+)
+
+type runResult struct {
+	Path   string `json:"path"`
+	Value  string `json:"value,omitempty"`
+	Output string `json:"output,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// run parses, checks, and interprets the program in the given file,
+// and then calls its main function, if any.
+// The result of the main function is printed, unless it is Void
+func (c *cli) run(args []string) int {
+	options := c.newOptions("run", "<file>")
+
+	debugFlag := options.flags.Bool("debug", false, "debug the program interactively. interrupt to pause")
+
+	if !options.parse(args) {
+		return 2
+	}
+
+	paths := options.flags.Args()
+	if len(paths) != 1 {
+		return c.printUsageError(options, "expected exactly one input file")
+	}
+	path := paths[0]
+
+	// Log messages and events are part of the results if they are printed as JSON
+
+	var output bytes.Buffer
+	var outputWriter io.Writer = c.stdout
+	if options.json {
+		outputWriter = &output
+	}
+
+	environment, err := options.newEnvironment(outputWriter)
+	if err != nil {
+		return c.printUsageError(options, "%s", err)
+	}
+
+	if *debugFlag {
+		environment.Debugger = execute.NewDebugger()
+	}
+
+	result := runResult{
+		Path: path,
+	}
+
+	value, err := runFile(environment, path)
+	if err != nil {
+		result.Error = c.reportError(options, environment, err, cmd.ResolveFile(path))
+	} else if value != nil {
+		result.Value = value.String()
+		if !options.json {
+			_, _ = io.WriteString(c.stdout, result.Value+"\n")
+		}
+	}
+
+	if options.json {
+		result.Output = output.String()
+		c.writeJSON(result)
+	}
+
+	return exitCode(err == nil)
+}
+
+// runFile runs the program in the given file, and returns the result of its main function.
+// The result is nil if the program has no main function, or if the result is Void
+func runFile(environment *cmd.Environment, path string) (interpreter.Value, error) {
+	checker, err := environment.ParseAndCheckFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	inter, err := environment.Interpret(checker)
+	if err != nil {
+		return nil, err
+	}
+
+	if !inter.Globals.Contains("main") {
+		return nil, nil
+	}
+
+	value, err := inter.Invoke("main")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := value.(interpreter.VoidValue); ok {
+		return nil, nil
+	}
+
+	return value, nil
+}
@@ -0,0 +1,193 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/onflow/cadence/cmd"
+	"github.com/onflow/cadence/sema"
+)
+
+const testFunctionPrefix = "test"
+
+type testFileResult struct {
+	Path  string       `json:"path"`
+	Tests []testResult `json:"tests"`
+	Error string       `json:"error,omitempty"`
+}
+
+type testResult struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Output string `json:"output,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// test runs the test functions of the programs in the given files.
+//
+// Test functions are global functions without parameters whose name starts with "test".
+// A test fails if the function aborts, e.g. if an assertion fails.
+// The program is interpreted anew for each test function,
+// so the test functions do not share state
+func (c *cli) test(args []string) int {
+	options := c.newOptions("test", "[files]")
+
+	runFlag := options.flags.String("run", "", "only run the test functions whose name contains the given string")
+
+	if !options.parse(args) {
+		return 2
+	}
+
+	paths := options.flags.Args()
+	if len(paths) == 0 {
+		return c.printUsageError(options, "no input files")
+	}
+
+	// Log messages and events of each test are part of its result if the results are printed as JSON
+
+	var output bytes.Buffer
+	var outputWriter io.Writer = c.stdout
+	if options.json {
+		outputWriter = &output
+	}
+
+	environment, err := options.newEnvironment(outputWriter)
+	if err != nil {
+		return c.printUsageError(options, "%s", err)
+	}
+
+	allSucceeded := true
+
+	results := make([]testFileResult, 0, len(paths))
+
+	for _, path := range paths {
+		location := cmd.ResolveFile(path)
+
+		result := testFileResult{
+			Path:  path,
+			Tests: []testResult{},
+		}
+
+		checker, err := environment.ParseAndCheckFile(path)
+		if err != nil {
+			allSucceeded = false
+			result.Error = c.reportError(options, environment, err, location)
+			results = append(results, result)
+			continue
+		}
+
+		for _, name := range testFunctionNames(checker) {
+			if !strings.Contains(name, *runFlag) {
+				continue
+			}
+
+			test := testResult{
+				Name: name,
+			}
+
+			err := runTestFunction(environment, checker, name)
+
+			test.Output = output.String()
+			output.Reset()
+
+			if err != nil {
+				allSucceeded = false
+
+				if !options.json {
+					_, _ = fmt.Fprintf(c.stdout, "--- FAIL: %s\n", name)
+				}
+				test.Error = c.reportError(options, environment, err, location)
+			} else {
+				test.Passed = true
+
+				if !options.json {
+					_, _ = fmt.Fprintf(c.stdout, "--- PASS: %s\n", name)
+				}
+			}
+
+			result.Tests = append(result.Tests, test)
+		}
+
+		if !options.json {
+			passed := 0
+			for _, test := range result.Tests {
+				if test.Passed {
+					passed++
+				}
+			}
+
+			status := "ok"
+			if passed < len(result.Tests) {
+				status = "FAIL"
+			}
+
+			_, _ = fmt.Fprintf(
+				c.stdout,
+				"%s\t%s\t%d of %d tests passed\n",
+				status,
+				path,
+				passed,
+				len(result.Tests),
+			)
+		}
+
+		results = append(results, result)
+	}
+
+	if options.json {
+		c.writeJSON(results)
+	}
+
+	return exitCode(allSucceeded)
+}
+
+// testFunctionNames returns the names of the test functions of the checked program,
+// in declaration order
+func testFunctionNames(checker *sema.Checker) []string {
+	var names []string
+
+	for _, declaration := range checker.Program.FunctionDeclarations() {
+		name := declaration.Identifier.Identifier
+		if !strings.HasPrefix(name, testFunctionPrefix) {
+			continue
+		}
+
+		parameterList := declaration.ParameterList
+		if parameterList != nil && len(parameterList.Parameters) > 0 {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func runTestFunction(environment *cmd.Environment, checker *sema.Checker, name string) error {
+	inter, err := environment.Interpret(checker)
+	if err != nil {
+		return err
+	}
+
+	_, err = inter.Invoke(name)
+	return err
+}
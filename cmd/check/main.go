@@ -34,9 +34,6 @@ import (
 	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/cmd"
 	"github.com/onflow/cadence/common"
-	"github.com/onflow/cadence/pretty"
-	"github.com/onflow/cadence/sema"
-	"github.com/onflow/cadence/stdlib"
 )
 
 type memberAccountAccessFlags []string
@@ -52,6 +49,16 @@ func (f *memberAccountAccessFlags) Set(value string) error {
 
 var benchFlag = flag.Bool("bench", false, "benchmark the checker")
 var jsonFlag = flag.Bool("json", false, "print the result formatted as JSON")
+var exhaustiveEnumSwitchFlag = flag.Bool(
+	"exhaustiveEnumSwitch",
+	false,
+	"report switch statements over enums which have no default case and do not cover all enum cases",
+)
+var projectFlag = flag.String(
+	"project",
+	"",
+	"resolve imports using the project file. defaults to the "+cmd.ProjectFileName+" file in the current directory or its parents",
+)
 
 var memberAccountAccessFlag memberAccountAccessFlags
 
@@ -59,36 +66,22 @@ func main() {
 	flag.Var(&memberAccountAccessFlag, "memberAccountAccess", "allow account access from:to")
 	flag.Parse()
 
-	memberAccountAccess := map[common.Location]map[common.Location]struct{}{}
-
-	for _, value := range memberAccountAccessFlag {
-		parts := strings.SplitN(value, ":", 2)
-		if len(parts) < 2 {
-			panic(fmt.Errorf("invalid member access flag: got '%s', expected 'from:to'", value))
-		}
-
-		source := parts[0]
-		sourceLocation, _, err := common.DecodeTypeID(nil, source)
-		if err != nil {
-			panic(fmt.Errorf("invalid member access source location: %s: %w", source, err))
-		}
+	memberAccountAccess, err := cmd.ParseMemberAccountAccess(memberAccountAccessFlag)
+	if err != nil {
+		panic(err)
+	}
 
-		target := parts[1]
-		targetLocation, _, err := common.DecodeTypeID(nil, target)
-		if err != nil {
-			panic(fmt.Errorf("invalid member access target location: %s: %w", target, err))
-		}
+	project, err := cmd.LoadProjectFile(*projectFlag)
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
 
-		nested := memberAccountAccess[sourceLocation]
-		if nested == nil {
-			nested = map[common.Location]struct{}{}
-			memberAccountAccess[sourceLocation] = nested
-		}
-		nested[targetLocation] = struct{}{}
+	importResolver := &cmd.ImportResolver{
+		Project: project,
 	}
 
 	args := flag.Args()
-	run(args, *benchFlag, *jsonFlag, memberAccountAccess)
+	run(args, *benchFlag, *jsonFlag, memberAccountAccess, importResolver)
 }
 
 type benchResult struct {
@@ -182,6 +175,7 @@ func run(
 	bench bool,
 	json bool,
 	memberAccountAccess map[common.Location]map[common.Location]struct{},
+	importResolver *cmd.ImportResolver,
 ) {
 	if len(paths) == 0 {
 		paths = []string{""}
@@ -199,7 +193,7 @@ func run(
 	useColor := !json
 
 	for _, path := range paths {
-		res, runSucceeded := runPath(path, bench, useColor, memberAccountAccess)
+		res, runSucceeded := runPath(path, bench, useColor, memberAccountAccess, importResolver)
 		if !runSucceeded {
 			allSucceeded = false
 		}
@@ -219,6 +213,7 @@ func runPath(
 	bench bool,
 	useColor bool,
 	memberAccountAccess map[common.Location]map[common.Location]struct{},
+	importResolver *cmd.ImportResolver,
 ) (res result, succeeded bool) {
 	res = result{
 		Path: path,
@@ -228,16 +223,14 @@ func runPath(
 	code := read(path)
 
 	var err error
-	var checker *sema.Checker
 	var program *ast.Program
-	var must func(error)
-
-	codes := map[common.Location][]byte{}
 
 	location := common.NewStringLocation(nil, path)
 
-	// standard library handler is only needed for execution, but we're only checking
-	standardLibraryValues := stdlib.DefaultScriptStandardLibraryValues(nil)
+	// log messages and events are only written during execution, but we're only checking
+	environment := cmd.NewEnvironment(importResolver, nil)
+	environment.MemberAccountAccess = memberAccountAccess
+	environment.EnumSwitchExhaustivenessCheckEnabled = *exhaustiveEnumSwitchFlag
 
 	func() {
 		defer func() {
@@ -247,22 +240,13 @@ func runPath(
 			}
 		}()
 
-		program, must = cmd.PrepareProgram(code, location, codes)
-
-		checker, _ = cmd.PrepareChecker(
-			program,
-			location,
-			codes,
-			memberAccountAccess,
-			standardLibraryValues,
-			must,
-		)
-
-		err = checker.Check()
+		program, err = environment.Parse(code, location)
+		if err == nil {
+			_, err = environment.Check(program, location)
+		}
 		if err != nil {
 			var builder strings.Builder
-			printErr := pretty.NewErrorPrettyPrinter(&builder, useColor).
-				PrettyPrintError(err, location, codes)
+			printErr := environment.PrettyPrintError(&builder, err, location, useColor)
 			if printErr != nil {
 				panic(printErr)
 			}
@@ -277,16 +261,7 @@ func runPath(
 	if bench && err == nil {
 		benchRes := testing.Benchmark(func(b *testing.B) {
 			for i := 0; i < b.N; i++ {
-
-				checker, must = cmd.PrepareChecker(
-					program,
-					location,
-					codes,
-					memberAccountAccess,
-					standardLibraryValues,
-					must,
-				)
-				must(checker.Check())
+				_, err := environment.Check(program, location)
 				if err != nil {
 					panic(err)
 				}
@@ -21,6 +21,7 @@ package cmd
 import (
 	goerrors "errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"os"
 	"strings"
@@ -220,21 +221,65 @@ func PrepareInterpreter(filename string, debugger *interpreter.Debugger) (*inter
 	return inter, checker, must
 }
 
+// ParseMemberAccountAccess parses member account access flag values of the form `from:to`,
+// where both parts are location type IDs, e.g. `S.foo.cdc:S.bar.cdc`
+func ParseMemberAccountAccess(values []string) (map[common.Location]map[common.Location]struct{}, error) {
+	memberAccountAccess := map[common.Location]map[common.Location]struct{}{}
+
+	for _, value := range values {
+		parts := strings.SplitN(value, ":", 2)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid member access flag: got '%s', expected 'from:to'", value)
+		}
+
+		source := parts[0]
+		sourceLocation, _, err := common.DecodeTypeID(nil, source)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member access source location: %s: %w", source, err)
+		}
+
+		target := parts[1]
+		targetLocation, _, err := common.DecodeTypeID(nil, target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member access target location: %s: %w", target, err)
+		}
+
+		nested := memberAccountAccess[sourceLocation]
+		if nested == nil {
+			nested = map[common.Location]struct{}{}
+			memberAccountAccess[sourceLocation] = nested
+		}
+		nested[targetLocation] = struct{}{}
+	}
+
+	return memberAccountAccess, nil
+}
+
 func ExitWithError(message string) {
 	println(pretty.FormatErrorMessage(pretty.ErrorPrefix, message, true))
 	os.Exit(1)
 }
 
 type StandardLibraryHandler struct {
+	// Output is the writer for log messages and events.
+	// If it is nil, the standard output is used
+	Output     io.Writer
 	rand       *rand.Rand
 	accountIDs map[common.Address]uint64
 }
 
 var _ stdlib.StandardLibraryHandler = &StandardLibraryHandler{}
 
-func (*StandardLibraryHandler) ProgramLog(message string, locationRange interpreter.LocationRange) error {
-	fmt.Printf("LOG @ %s: %s\n", formatLocationRange(locationRange), message)
-	return nil
+func (h *StandardLibraryHandler) output() io.Writer {
+	if h.Output == nil {
+		return os.Stdout
+	}
+	return h.Output
+}
+
+func (h *StandardLibraryHandler) ProgramLog(message string, locationRange interpreter.LocationRange) error {
+	_, err := fmt.Fprintf(h.output(), "LOG @ %s: %s\n", formatLocationRange(locationRange), message)
+	return err
 }
 
 func (h *StandardLibraryHandler) ReadRandom(p []byte) error {
@@ -410,12 +455,13 @@ func (h *StandardLibraryHandler) NewOnEventEmittedHandler() interpreter.OnEventE
 		event *interpreter.CompositeValue,
 		_ *sema.CompositeType,
 	) error {
-		fmt.Printf(
+		_, err := fmt.Fprintf(
+			h.output(),
 			"EVENT @ %s: %s\n",
 			formatLocationRange(locationRange),
 			event.String(),
 		)
-		return nil
+		return err
 	}
 }
 
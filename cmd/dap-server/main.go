@@ -0,0 +1,60 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/onflow/cadence/cmd"
+	"github.com/onflow/cadence/cmd/dap"
+)
+
+var listenFlag = flag.String("listen", "", "serve on the given TCP address (e.g. localhost:4711), instead of stdio")
+
+// A Debug Adapter Protocol (DAP) server for Cadence programs.
+//
+// By default, the server communicates over stdin/stdout.
+// If the -listen flag is given, the server accepts a single client connection on the given address.
+func main() {
+	flag.Parse()
+
+	var err error
+
+	if *listenFlag == "" {
+		err = dap.NewServer(os.Stdin, os.Stdout, dap.LaunchProgram).Run()
+	} else {
+		var listener net.Listener
+		listener, err = net.Listen("tcp", *listenFlag)
+		if err != nil {
+			cmd.ExitWithError(err.Error())
+		}
+		defer listener.Close()
+
+		_, _ = fmt.Fprintf(os.Stderr, "listening on %s\n", listener.Addr())
+
+		err = dap.Serve(listener, dap.LaunchProgram)
+	}
+
+	if err != nil {
+		cmd.ExitWithError(err.Error())
+	}
+}
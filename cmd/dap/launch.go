@@ -0,0 +1,161 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dap
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/onflow/cadence/activations"
+	"github.com/onflow/cadence/cmd"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/cadence/pretty"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/stdlib"
+)
+
+// Launcher runs the program with the given path using the given debugger.
+// Log messages and errors are written to the given output.
+type Launcher func(path string, debugger *interpreter.Debugger, output io.Writer) error
+
+// SourceLocation returns the location of the program with the given path.
+// Breakpoints and programs are identified by their absolute, cleaned path.
+func SourceLocation(path string) common.StringLocation {
+	absolutePath, err := filepath.Abs(path)
+	if err == nil {
+		path = absolutePath
+	}
+	return common.StringLocation(filepath.Clean(path))
+}
+
+// LaunchProgram is the default Launcher.
+// It parses, checks and interprets the program, and then calls the `main` function, if any.
+// Errors are pretty-printed to the output.
+func LaunchProgram(path string, debugger *interpreter.Debugger, output io.Writer) (err error) {
+	location := SourceLocation(path)
+	codes := map[common.Location][]byte{}
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		var builder strings.Builder
+		printErr := pretty.NewErrorPrettyPrinter(&builder, false).
+			PrettyPrintError(err, location, codes)
+		if printErr != nil {
+			err = printErr
+			return
+		}
+		_, _ = io.WriteString(output, builder.String())
+	}()
+
+	code, err := os.ReadFile(string(location))
+	if err != nil {
+		return err
+	}
+	codes[location] = code
+
+	program, err := parser.ParseProgram(nil, code, parser.Config{})
+	if err != nil {
+		return err
+	}
+
+	standardLibraryValues := stdlib.DefaultScriptStandardLibraryValues(
+		&standardLibraryHandler{
+			output: output,
+		},
+	)
+
+	checker, err := sema.NewChecker(
+		program,
+		location,
+		nil,
+		cmd.DefaultCheckerConfig(
+			map[common.Location]*sema.Checker{},
+			codes,
+			standardLibraryValues,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = checker.Check()
+	if err != nil {
+		return err
+	}
+
+	baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
+	for _, value := range standardLibraryValues {
+		interpreter.Declare(baseActivation, value)
+	}
+
+	var uuid uint64
+
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		&interpreter.Config{
+			BaseActivationHandler: func(_ common.Location) *interpreter.VariableActivation {
+				return baseActivation
+			},
+			Storage: interpreter.NewInMemoryStorage(nil),
+			UUIDHandler: func() (uint64, error) {
+				defer func() { uuid++ }()
+				return uuid, nil
+			},
+			Debugger: debugger,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = inter.Interpret()
+	if err != nil {
+		return err
+	}
+
+	if !inter.Globals.Contains("main") {
+		return nil
+	}
+
+	_, err = inter.Invoke("main")
+	return err
+}
+
+// standardLibraryHandler is the standard library handler used by LaunchProgram.
+// It writes log messages to the output instead of the standard output,
+// which might be used for the protocol.
+type standardLibraryHandler struct {
+	cmd.StandardLibraryHandler
+	output io.Writer
+}
+
+var _ stdlib.StandardLibraryHandler = &standardLibraryHandler{}
+
+func (h *standardLibraryHandler) ProgramLog(message string, _ interpreter.LocationRange) error {
+	_, err := fmt.Fprintln(h.output, message)
+	return err
+}
@@ -0,0 +1,312 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+// This file contains the subset of the Debug Adapter Protocol (DAP)
+// which is supported by the server.
+// See https://microsoft.github.io/debug-adapter-protocol/specification
+
+const contentLengthHeader = "Content-Length"
+
+// ProtocolMessage is the base of all messages sent between the client and the server
+type ProtocolMessage struct {
+	Seq  int    `json:"seq"`
+	Type string `json:"type"`
+}
+
+const (
+	messageTypeRequest  = "request"
+	messageTypeResponse = "response"
+	messageTypeEvent    = "event"
+)
+
+// Request is a client-initiated request
+type Request struct {
+	ProtocolMessage
+	Command   string          `json:"command"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+// Response is the server's response to a request
+type Response struct {
+	ProtocolMessage
+	RequestSeq int    `json:"request_seq"`
+	Success    bool   `json:"success"`
+	Command    string `json:"command"`
+	Message    string `json:"message,omitempty"`
+	Body       any    `json:"body,omitempty"`
+}
+
+// Event is a server-initiated event
+type Event struct {
+	ProtocolMessage
+	Event string `json:"event"`
+	Body  any    `json:"body,omitempty"`
+}
+
+// Requests
+
+const (
+	commandInitialize              = "initialize"
+	commandLaunch                  = "launch"
+	commandSetBreakpoints          = "setBreakpoints"
+	commandSetExceptionBreakpoints = "setExceptionBreakpoints"
+	commandExceptionInfo           = "exceptionInfo"
+	commandConfigurationDone       = "configurationDone"
+	commandThreads                 = "threads"
+	commandStackTrace              = "stackTrace"
+	commandScopes                  = "scopes"
+	commandVariables               = "variables"
+	commandContinue                = "continue"
+	commandNext                    = "next"
+	commandStepIn                  = "stepIn"
+	commandStepOut                 = "stepOut"
+	commandPause                   = "pause"
+	commandDisconnect              = "disconnect"
+)
+
+// Events
+
+const (
+	eventInitialized = "initialized"
+	eventStopped     = "stopped"
+	eventOutput      = "output"
+	eventExited      = "exited"
+	eventTerminated  = "terminated"
+)
+
+// Stop reasons
+
+const (
+	stoppedReasonEntry      = "entry"
+	stoppedReasonBreakpoint = "breakpoint"
+	stoppedReasonStep       = "step"
+	stoppedReasonPause      = "pause"
+	stoppedReasonException  = "exception"
+)
+
+// Exception breakpoint filters
+
+const (
+	exceptionFilterErrors = "errors"
+)
+
+type Capabilities struct {
+	SupportsConfigurationDoneRequest  bool                         `json:"supportsConfigurationDoneRequest"`
+	SupportsConditionalBreakpoints    bool                         `json:"supportsConditionalBreakpoints"`
+	SupportsHitConditionalBreakpoints bool                         `json:"supportsHitConditionalBreakpoints"`
+	SupportsLogPoints                 bool                         `json:"supportsLogPoints"`
+	SupportsExceptionInfoRequest      bool                         `json:"supportsExceptionInfoRequest"`
+	ExceptionBreakpointFilters        []ExceptionBreakpointsFilter `json:"exceptionBreakpointFilters,omitempty"`
+}
+
+type ExceptionBreakpointsFilter struct {
+	Filter  string `json:"filter"`
+	Label   string `json:"label"`
+	Default bool   `json:"default"`
+}
+
+type LaunchArguments struct {
+	// Program is the path of the Cadence program to run
+	Program string `json:"program"`
+	// StopOnEntry pauses the program at its first statement
+	StopOnEntry bool `json:"stopOnEntry,omitempty"`
+}
+
+type Source struct {
+	Name string `json:"name,omitempty"`
+	Path string `json:"path,omitempty"`
+}
+
+type SourceBreakpoint struct {
+	Line int `json:"line"`
+	// Condition is an optional boolean Cadence expression
+	Condition string `json:"condition,omitempty"`
+	// HitCondition is an optional number of hits, e.g. `3` or `>= 3`
+	HitCondition string `json:"hitCondition,omitempty"`
+	// LogMessage is an optional message, which turns the breakpoint into a logpoint
+	LogMessage string `json:"logMessage,omitempty"`
+}
+
+type SetBreakpointsArguments struct {
+	Source      Source             `json:"source"`
+	Breakpoints []SourceBreakpoint `json:"breakpoints"`
+}
+
+type Breakpoint struct {
+	Verified bool   `json:"verified"`
+	Message  string `json:"message,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Source   Source `json:"source"`
+}
+
+type SetBreakpointsResponseBody struct {
+	Breakpoints []Breakpoint `json:"breakpoints"`
+}
+
+type SetExceptionBreakpointsArguments struct {
+	Filters []string `json:"filters"`
+}
+
+type ExceptionInfoArguments struct {
+	ThreadID int `json:"threadId"`
+}
+
+type ExceptionInfoResponseBody struct {
+	ExceptionID string `json:"exceptionId"`
+	Description string `json:"description,omitempty"`
+	BreakMode   string `json:"breakMode"`
+}
+
+type Thread struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type ThreadsResponseBody struct {
+	Threads []Thread `json:"threads"`
+}
+
+type StackTraceArguments struct {
+	ThreadID   int `json:"threadId"`
+	StartFrame int `json:"startFrame,omitempty"`
+	Levels     int `json:"levels,omitempty"`
+}
+
+type StackFrame struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Source Source `json:"source"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+type StackTraceResponseBody struct {
+	StackFrames []StackFrame `json:"stackFrames"`
+	TotalFrames int          `json:"totalFrames"`
+}
+
+type ScopesArguments struct {
+	FrameID int `json:"frameId"`
+}
+
+type Scope struct {
+	Name               string `json:"name"`
+	VariablesReference int    `json:"variablesReference"`
+	Expensive          bool   `json:"expensive"`
+}
+
+type ScopesResponseBody struct {
+	Scopes []Scope `json:"scopes"`
+}
+
+type VariablesArguments struct {
+	VariablesReference int `json:"variablesReference"`
+}
+
+type Variable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+type VariablesResponseBody struct {
+	Variables []Variable `json:"variables"`
+}
+
+type ThreadArguments struct {
+	ThreadID int `json:"threadId"`
+}
+
+type ContinueResponseBody struct {
+	AllThreadsContinued bool `json:"allThreadsContinued"`
+}
+
+type StoppedEventBody struct {
+	Reason            string `json:"reason"`
+	Text              string `json:"text,omitempty"`
+	ThreadID          int    `json:"threadId"`
+	AllThreadsStopped bool   `json:"allThreadsStopped"`
+}
+
+type OutputEventBody struct {
+	Category string  `json:"category,omitempty"`
+	Output   string  `json:"output"`
+	Source   *Source `json:"source,omitempty"`
+	Line     int     `json:"line,omitempty"`
+}
+
+type ExitedEventBody struct {
+	ExitCode int `json:"exitCode"`
+}
+
+// ReadMessage reads a single base protocol message from the given reader,
+// i.e. a header section, followed by the JSON encoded content.
+func ReadMessage(reader *bufio.Reader) ([]byte, error) {
+	header, err := textproto.NewReader(reader).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	lengthHeader := strings.TrimSpace(header.Get(contentLengthHeader))
+	if lengthHeader == "" {
+		return nil, fmt.Errorf("missing %s header", contentLengthHeader)
+	}
+
+	length, err := strconv.Atoi(lengthHeader)
+	if err != nil || length < 0 {
+		return nil, fmt.Errorf("invalid %s header: %s", contentLengthHeader, lengthHeader)
+	}
+
+	content := make([]byte, length)
+	_, err = io.ReadFull(reader, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// WriteMessage writes the given message to the given writer,
+// encoded as JSON and preceded by the header section.
+func WriteMessage(writer io.Writer, message any) error {
+	content, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, "%s: %d\r\n\r\n", contentLengthHeader, len(content))
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(content)
+	return err
+}
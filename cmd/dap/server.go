@@ -0,0 +1,724 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/onflow/cadence/interpreter"
+)
+
+// threadID is the ID of the only thread, the interpreter
+const threadID = 1
+
+// Server is a Debug Adapter Protocol server, which drives an interpreter.Debugger.
+//
+// The server handles one debug session: The client configures the session
+// (launch, setBreakpoints, configurationDone), after which the program is run
+// by the server's Launcher. Whenever the interpreter stops,
+// e.g. at a breakpoint, the server sends a stopped event,
+// and the client may inspect the stack and variables, and resume the program.
+type Server struct {
+	reader   *bufio.Reader
+	writer   io.Writer
+	launcher Launcher
+	debugger *interpreter.Debugger
+
+	writeLock sync.Mutex
+	seq       int
+
+	// lock protects the fields below,
+	// which are accessed both by the request loop and the stop loop
+	lock              sync.Mutex
+	launchArguments   *LaunchArguments
+	stop              *interpreter.Stop
+	pendingStopReason string
+	variables         []variablesContainer
+	programDone       chan struct{}
+}
+
+func NewServer(reader io.Reader, writer io.Writer, launcher Launcher) *Server {
+	server := &Server{
+		reader:   bufio.NewReader(reader),
+		writer:   writer,
+		launcher: launcher,
+		debugger: interpreter.NewDebugger(),
+	}
+	server.debugger.SetLogHandler(server.logBreakpointMessage)
+	return server
+}
+
+// Serve accepts a single connection on the given listener
+// and runs a debug session on it.
+func Serve(listener net.Listener, launcher Launcher) error {
+	conn, err := listener.Accept()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return NewServer(conn, conn, launcher).Run()
+}
+
+// Run handles requests until the client disconnects or the input ends.
+func (s *Server) Run() error {
+	for {
+		content, err := ReadMessage(s.reader)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		var request Request
+		err = json.Unmarshal(content, &request)
+		if err != nil {
+			return fmt.Errorf("invalid message: %w", err)
+		}
+
+		if request.Type != messageTypeRequest {
+			continue
+		}
+
+		done, err := s.handleRequest(request)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
+func (s *Server) handleRequest(request Request) (done bool, err error) {
+	var body any
+	var handleErr error
+
+	switch request.Command {
+	case commandInitialize:
+		body = Capabilities{
+			SupportsConfigurationDoneRequest:  true,
+			SupportsConditionalBreakpoints:    true,
+			SupportsHitConditionalBreakpoints: true,
+			SupportsLogPoints:                 true,
+			SupportsExceptionInfoRequest:      true,
+			ExceptionBreakpointFilters: []ExceptionBreakpointsFilter{
+				{
+					Filter: exceptionFilterErrors,
+					Label:  "Errors",
+				},
+			},
+		}
+		err = s.respond(request, body, nil)
+		if err != nil {
+			return false, err
+		}
+		return false, s.sendEvent(eventInitialized, nil)
+
+	case commandLaunch:
+		handleErr = s.launch(request.Arguments)
+
+	case commandSetBreakpoints:
+		body, handleErr = s.setBreakpoints(request.Arguments)
+
+	case commandSetExceptionBreakpoints:
+		handleErr = s.setExceptionBreakpoints(request.Arguments)
+
+	case commandExceptionInfo:
+		body, handleErr = s.exceptionInfo()
+
+	case commandConfigurationDone:
+		err = s.respond(request, nil, nil)
+		if err != nil {
+			return false, err
+		}
+		return false, s.startProgram()
+
+	case commandThreads:
+		body = ThreadsResponseBody{
+			Threads: []Thread{
+				{
+					ID:   threadID,
+					Name: "main",
+				},
+			},
+		}
+
+	case commandStackTrace:
+		body, handleErr = s.stackTrace()
+
+	case commandScopes:
+		body, handleErr = s.scopes(request.Arguments)
+
+	case commandVariables:
+		body, handleErr = s.variableList(request.Arguments)
+
+	case commandContinue:
+		// Respond before resuming, so the response precedes any following stopped event
+		err = s.respond(request, ContinueResponseBody{AllThreadsContinued: true}, nil)
+		if err != nil {
+			return false, err
+		}
+		s.resume(nil)
+		return false, nil
+
+	case commandNext:
+		err = s.respond(request, nil, nil)
+		if err != nil {
+			return false, err
+		}
+		s.resume(s.debugger.RequestStepOver)
+		return false, nil
+
+	case commandStepIn:
+		err = s.respond(request, nil, nil)
+		if err != nil {
+			return false, err
+		}
+		s.resume(s.debugger.RequestStepInto)
+		return false, nil
+
+	case commandStepOut:
+		err = s.respond(request, nil, nil)
+		if err != nil {
+			return false, err
+		}
+		s.resume(s.debugger.RequestStepOut)
+		return false, nil
+
+	case commandPause:
+		s.setPendingStopReason(stoppedReasonPause)
+		s.debugger.RequestPause()
+
+	case commandDisconnect:
+		err = s.respond(request, nil, nil)
+		if err != nil {
+			return false, err
+		}
+		s.disconnect()
+		return true, nil
+
+	default:
+		handleErr = fmt.Errorf("unsupported command: %s", request.Command)
+	}
+
+	return false, s.respond(request, body, handleErr)
+}
+
+func (s *Server) launch(rawArguments json.RawMessage) error {
+	var arguments LaunchArguments
+	err := json.Unmarshal(rawArguments, &arguments)
+	if err != nil {
+		return err
+	}
+
+	if arguments.Program == "" {
+		return errors.New("missing program")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.launchArguments = &arguments
+
+	return nil
+}
+
+func (s *Server) setBreakpoints(rawArguments json.RawMessage) (*SetBreakpointsResponseBody, error) {
+	var arguments SetBreakpointsArguments
+	err := json.Unmarshal(rawArguments, &arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	location := SourceLocation(arguments.Source.Path)
+
+	s.debugger.ClearBreakpointsForLocation(location)
+
+	breakpoints := make([]Breakpoint, 0, len(arguments.Breakpoints))
+
+	for _, sourceBreakpoint := range arguments.Breakpoints {
+		breakpoint := Breakpoint{
+			Line:   sourceBreakpoint.Line,
+			Source: arguments.Source,
+		}
+
+		hitCount, err := parseHitCondition(sourceBreakpoint.HitCondition)
+
+		switch {
+		case sourceBreakpoint.Line <= 0:
+			breakpoint.Message = "invalid line"
+
+		case err != nil:
+			breakpoint.Message = err.Error()
+
+		default:
+			breakpoint.Verified = true
+			s.debugger.SetBreakpoint(interpreter.Breakpoint{
+				Location:   location,
+				Line:       uint(sourceBreakpoint.Line),
+				Condition:  sourceBreakpoint.Condition,
+				HitCount:   hitCount,
+				LogMessage: sourceBreakpoint.LogMessage,
+			})
+		}
+
+		breakpoints = append(breakpoints, breakpoint)
+	}
+
+	return &SetBreakpointsResponseBody{
+		Breakpoints: breakpoints,
+	}, nil
+}
+
+func (s *Server) setExceptionBreakpoints(rawArguments json.RawMessage) error {
+	var arguments SetExceptionBreakpointsArguments
+	err := json.Unmarshal(rawArguments, &arguments)
+	if err != nil {
+		return err
+	}
+
+	breakOnError := false
+	for _, filter := range arguments.Filters {
+		switch filter {
+		case exceptionFilterErrors:
+			breakOnError = true
+		default:
+			return fmt.Errorf("unsupported exception filter: %s", filter)
+		}
+	}
+
+	s.debugger.SetBreakOnError(breakOnError)
+
+	return nil
+}
+
+func (s *Server) exceptionInfo() (*ExceptionInfoResponseBody, error) {
+	stop, err := s.currentStop()
+	if err != nil {
+		return nil, err
+	}
+
+	if stop.Err == nil {
+		return nil, fmt.Errorf("not stopped because of an error")
+	}
+
+	return &ExceptionInfoResponseBody{
+		ExceptionID: errorName(stop.Err),
+		Description: stop.Err.Error(),
+		BreakMode:   "always",
+	}, nil
+}
+
+// errorName returns the name of the type of the given error, e.g. `ConditionError`
+func errorName(err error) string {
+	name := reflect.TypeOf(err).String()
+	index := strings.LastIndexByte(name, '.')
+	return name[index+1:]
+}
+
+// parseHitCondition parses the hit condition of a breakpoint.
+// Only a minimum number of hits is supported, e.g. `3` or `>= 3`
+func parseHitCondition(hitCondition string) (uint, error) {
+	hitCondition = strings.TrimSpace(hitCondition)
+	if hitCondition == "" {
+		return 0, nil
+	}
+
+	hitCondition = strings.TrimSpace(strings.TrimPrefix(hitCondition, ">="))
+
+	hitCount, err := strconv.ParseUint(hitCondition, 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hit condition: %s", hitCondition)
+	}
+
+	return uint(hitCount), nil
+}
+
+// logBreakpointMessage sends the message of a logpoint as an output event
+func (s *Server) logBreakpointMessage(breakpoint interpreter.Breakpoint, message string) {
+	source := locationSource(breakpoint.Location)
+
+	_ = s.sendEvent(
+		eventOutput,
+		OutputEventBody{
+			Category: "console",
+			Output:   message + "\n",
+			Source:   &source,
+			Line:     int(breakpoint.Line),
+		},
+	)
+}
+
+func (s *Server) startProgram() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.launchArguments == nil {
+		return s.sendEvent(eventTerminated, nil)
+	}
+
+	if s.programDone != nil {
+		return nil
+	}
+
+	arguments := *s.launchArguments
+
+	if arguments.StopOnEntry {
+		s.pendingStopReason = stoppedReasonEntry
+		s.debugger.RequestPause()
+	}
+
+	programDone := make(chan struct{})
+	s.programDone = programDone
+
+	go s.handleStops(programDone)
+
+	go func() {
+		defer close(programDone)
+
+		exitCode := 0
+		err := s.launcher(arguments.Program, s.debugger, outputWriter{server: s})
+		if err != nil {
+			exitCode = 1
+		}
+
+		_ = s.sendEvent(eventExited, ExitedEventBody{ExitCode: exitCode})
+		_ = s.sendEvent(eventTerminated, nil)
+	}()
+
+	return nil
+}
+
+// handleStops reports all stops of the debugger to the client,
+// until the program is done
+func (s *Server) handleStops(programDone <-chan struct{}) {
+	for {
+		select {
+		case stop := <-s.debugger.Stops():
+			s.lock.Lock()
+			s.stop = &stop
+			reason := s.pendingStopReason
+			if reason == "" {
+				reason = stoppedReasonBreakpoint
+			}
+			s.pendingStopReason = ""
+			s.lock.Unlock()
+
+			var text string
+			if stop.Err != nil {
+				reason = stoppedReasonException
+				text = stop.Err.Error()
+			}
+
+			_ = s.sendEvent(
+				eventStopped,
+				StoppedEventBody{
+					Reason:            reason,
+					Text:              text,
+					ThreadID:          threadID,
+					AllThreadsStopped: true,
+				},
+			)
+
+		case <-programDone:
+			return
+		}
+	}
+}
+
+// resume resumes the stopped program, if it is stopped.
+// If a step request function is given, it is called before the program is continued,
+// and the next stop is reported as a step
+func (s *Server) resume(requestStep func()) {
+	s.lock.Lock()
+	stopped := s.stop != nil
+	s.stop = nil
+	s.variables = nil
+	if stopped && requestStep != nil {
+		s.pendingStopReason = stoppedReasonStep
+	}
+	s.lock.Unlock()
+
+	if !stopped {
+		return
+	}
+
+	if requestStep != nil {
+		requestStep()
+	}
+	s.debugger.Continue()
+}
+
+func (s *Server) disconnect() {
+	// Nobody is attached anymore which could continue the program,
+	// so it must not stop anymore
+	s.debugger.ClearBreakpoints()
+	s.debugger.SetBreakOnError(false)
+	s.resume(nil)
+}
+
+func (s *Server) currentStop() (*interpreter.Stop, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.stop == nil {
+		return nil, errors.New("program is not stopped")
+	}
+	return s.stop, nil
+}
+
+func (s *Server) setPendingStopReason(reason string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pendingStopReason = reason
+}
+
+func (s *Server) stackTrace() (*StackTraceResponseBody, error) {
+	stop, err := s.currentStop()
+	if err != nil {
+		return nil, err
+	}
+
+	// The frames of the stop are ordered from the outermost to the innermost frame,
+	// but the frames of the response are ordered from the innermost to the outermost frame.
+	// The ID of a frame is its index in the stop
+
+	frameCount := len(stop.Frames)
+	frames := make([]StackFrame, 0, frameCount)
+
+	for i := frameCount - 1; i >= 0; i-- {
+		frame := stop.Frames[i]
+
+		name := frame.FunctionName
+		if name == "" {
+			name = frame.Location.String()
+		}
+
+		frames = append(
+			frames,
+			StackFrame{
+				ID:     i,
+				Name:   name,
+				Source: locationSource(frame.Location),
+				Line:   frame.Position.Line,
+				Column: frame.Position.Column + 1,
+			},
+		)
+	}
+
+	return &StackTraceResponseBody{
+		StackFrames: frames,
+		TotalFrames: frameCount,
+	}, nil
+}
+
+func (s *Server) scopes(rawArguments json.RawMessage) (*ScopesResponseBody, error) {
+	var arguments ScopesArguments
+	err := json.Unmarshal(rawArguments, &arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	frame, err := s.frame(arguments.FrameID)
+	if err != nil {
+		return nil, err
+	}
+
+	reference := s.addVariablesContainer(
+		variablesContainer{
+			interpreter: frame.Interpreter,
+			activation:  frame.Activation,
+		},
+	)
+
+	return &ScopesResponseBody{
+		Scopes: []Scope{
+			{
+				Name:               "Locals",
+				VariablesReference: reference,
+			},
+		},
+	}, nil
+}
+
+func (s *Server) frame(id int) (*interpreter.StackFrame, error) {
+	stop, err := s.currentStop()
+	if err != nil {
+		return nil, err
+	}
+
+	if id < 0 || id >= len(stop.Frames) {
+		return nil, fmt.Errorf("invalid frame ID: %d", id)
+	}
+
+	return &stop.Frames[id], nil
+}
+
+func (s *Server) variableList(rawArguments json.RawMessage) (*VariablesResponseBody, error) {
+	var arguments VariablesArguments
+	err := json.Unmarshal(rawArguments, &arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.currentStop()
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	index := arguments.VariablesReference - 1
+	if index < 0 || index >= len(s.variables) {
+		s.lock.Unlock()
+		return nil, fmt.Errorf("invalid variables reference: %d", arguments.VariablesReference)
+	}
+	container := s.variables[index]
+	s.lock.Unlock()
+
+	inter := container.interpreter
+
+	var variables []Variable
+
+	if container.activation != nil {
+		for name, variable := range container.activation.FunctionValues() { //nolint:maprange
+			variables = append(variables, s.newVariable(inter, name, variable.GetValue(inter)))
+		}
+
+		sort.Slice(variables, func(i, j int) bool {
+			return variables[i].Name < variables[j].Name
+		})
+	} else {
+		container.forEachChild(inter, func(name string, value interpreter.Value) {
+			variables = append(variables, s.newVariable(inter, name, value))
+		})
+	}
+
+	if variables == nil {
+		variables = []Variable{}
+	}
+
+	return &VariablesResponseBody{
+		Variables: variables,
+	}, nil
+}
+
+func (s *Server) newVariable(inter *interpreter.Interpreter, name string, value interpreter.Value) Variable {
+	variable := Variable{
+		Name:  name,
+		Value: value.String(),
+		Type:  value.StaticType(inter).String(),
+	}
+
+	if hasChildren(value) {
+		variable.VariablesReference = s.addVariablesContainer(
+			variablesContainer{
+				interpreter: inter,
+				value:       value,
+			},
+		)
+	}
+
+	return variable
+}
+
+func (s *Server) addVariablesContainer(container variablesContainer) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.variables = append(s.variables, container)
+	return len(s.variables)
+}
+
+func (s *Server) respond(request Request, body any, err error) error {
+	response := Response{
+		ProtocolMessage: ProtocolMessage{
+			Type: messageTypeResponse,
+		},
+		RequestSeq: request.Seq,
+		Success:    err == nil,
+		Command:    request.Command,
+		Body:       body,
+	}
+	if err != nil {
+		response.Message = err.Error()
+		response.Body = nil
+	}
+
+	return s.send(func(seq int) any {
+		response.Seq = seq
+		return response
+	})
+}
+
+func (s *Server) sendEvent(name string, body any) error {
+	event := Event{
+		ProtocolMessage: ProtocolMessage{
+			Type: messageTypeEvent,
+		},
+		Event: name,
+		Body:  body,
+	}
+
+	return s.send(func(seq int) any {
+		event.Seq = seq
+		return event
+	})
+}
+
+func (s *Server) send(message func(seq int) any) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	s.seq++
+	return WriteMessage(s.writer, message(s.seq))
+}
+
+// outputWriter is an io.Writer which sends the written data as output events
+type outputWriter struct {
+	server *Server
+}
+
+var _ io.Writer = outputWriter{}
+
+func (w outputWriter) Write(p []byte) (int, error) {
+	err := w.server.sendEvent(
+		eventOutput,
+		OutputEventBody{
+			Category: "stdout",
+			Output:   string(p),
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
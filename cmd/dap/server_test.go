@@ -0,0 +1,735 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/interpreter"
+)
+
+const testProgram = `
+access(all) fun add(_ a: Int, _ b: Int): Int {
+    let sum = a + b
+    return sum
+}
+
+access(all) fun main() {
+    let x = 1
+    let y = add(x, 2)
+    log(y)
+    let values = [x, y]
+    log(values)
+}
+`
+
+// testClient is a scripted DAP client
+type testClient struct {
+	t       *testing.T
+	reader  *bufio.Reader
+	writer  io.Writer
+	seq     int
+	pending []json.RawMessage
+	output  string
+	// exceptionFilters are the exception breakpoint filters enabled when initializing
+	exceptionFilters []string
+}
+
+type testMessage struct {
+	Type       string          `json:"type"`
+	Command    string          `json:"command"`
+	Event      string          `json:"event"`
+	RequestSeq int             `json:"request_seq"`
+	Success    bool            `json:"success"`
+	Message    string          `json:"message"`
+	Body       json.RawMessage `json:"body"`
+}
+
+func newTestSession(t *testing.T, launcher Launcher) (*testClient, <-chan error) {
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	server := NewServer(serverReader, serverWriter, launcher)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.Run()
+		_ = serverWriter.Close()
+	}()
+
+	return &testClient{
+		t:      t,
+		reader: bufio.NewReader(clientReader),
+		writer: clientWriter,
+	}, errs
+}
+
+func (c *testClient) send(command string, arguments any) int {
+	c.seq++
+
+	request := map[string]any{
+		"seq":     c.seq,
+		"type":    "request",
+		"command": command,
+	}
+	if arguments != nil {
+		request["arguments"] = arguments
+	}
+
+	require.NoError(c.t, WriteMessage(c.writer, request))
+
+	return c.seq
+}
+
+func (c *testClient) read() testMessage {
+	content, err := ReadMessage(c.reader)
+	require.NoError(c.t, err)
+
+	var message testMessage
+	require.NoError(c.t, json.Unmarshal(content, &message))
+
+	if message.Type == "event" && message.Event == eventOutput {
+		var body OutputEventBody
+		require.NoError(c.t, json.Unmarshal(message.Body, &body))
+		c.output += body.Output
+	}
+
+	return message
+}
+
+// request sends a request and waits for its response.
+// Events received in the meantime are kept for expectEvent
+func (c *testClient) request(command string, arguments any, body any) testMessage {
+	seq := c.send(command, arguments)
+
+	for {
+		message := c.read()
+		if message.Type == "response" && message.RequestSeq == seq {
+			require.Equal(c.t, command, message.Command)
+			if body != nil && message.Success {
+				require.NoError(c.t, json.Unmarshal(message.Body, body))
+			}
+			return message
+		}
+
+		content, err := json.Marshal(message)
+		require.NoError(c.t, err)
+		c.pending = append(c.pending, content)
+	}
+}
+
+// expectEvent waits for an event with the given name, skipping other events
+func (c *testClient) expectEvent(name string, body any) {
+	for len(c.pending) > 0 {
+		var message testMessage
+		require.NoError(c.t, json.Unmarshal(c.pending[0], &message))
+		c.pending = c.pending[1:]
+
+		if message.Event == name {
+			if body != nil {
+				require.NoError(c.t, json.Unmarshal(message.Body, body))
+			}
+			return
+		}
+	}
+
+	for {
+		message := c.read()
+		require.Equal(c.t, "event", message.Type)
+		if message.Event == eventTerminated && name != eventTerminated {
+			require.Failf(c.t, "unexpected termination", "expected %s event, output:\n%s", name, c.output)
+		}
+		if message.Event == name {
+			if body != nil {
+				require.NoError(c.t, json.Unmarshal(message.Body, body))
+			}
+			return
+		}
+	}
+}
+
+func (c *testClient) variables(reference int) map[string]Variable {
+	var body VariablesResponseBody
+	response := c.request(
+		commandVariables,
+		VariablesArguments{VariablesReference: reference},
+		&body,
+	)
+	require.True(c.t, response.Success, response.Message)
+
+	variables := map[string]Variable{}
+	for _, variable := range body.Variables {
+		variables[variable.Name] = variable
+	}
+	return variables
+}
+
+func (c *testClient) localVariables() map[string]Variable {
+	var scopes ScopesResponseBody
+	response := c.request(commandScopes, ScopesArguments{FrameID: 0}, &scopes)
+	require.True(c.t, response.Success, response.Message)
+	require.Len(c.t, scopes.Scopes, 1)
+
+	return c.variables(scopes.Scopes[0].VariablesReference)
+}
+
+func (c *testClient) initialize(path string, stopOnEntry bool, lines ...int) {
+	breakpoints := make([]SourceBreakpoint, 0, len(lines))
+	for _, line := range lines {
+		breakpoints = append(breakpoints, SourceBreakpoint{Line: line})
+	}
+
+	c.initializeWithBreakpoints(path, stopOnEntry, breakpoints)
+}
+
+func (c *testClient) initializeWithBreakpoints(path string, stopOnEntry bool, breakpoints []SourceBreakpoint) {
+	var capabilities Capabilities
+	response := c.request(commandInitialize, map[string]any{"adapterID": "cadence"}, &capabilities)
+	require.True(c.t, response.Success)
+	require.True(c.t, capabilities.SupportsConfigurationDoneRequest)
+	require.True(c.t, capabilities.SupportsConditionalBreakpoints)
+	require.True(c.t, capabilities.SupportsHitConditionalBreakpoints)
+	require.True(c.t, capabilities.SupportsLogPoints)
+	require.True(c.t, capabilities.SupportsExceptionInfoRequest)
+
+	c.expectEvent(eventInitialized, nil)
+
+	response = c.request(
+		commandLaunch,
+		LaunchArguments{
+			Program:     path,
+			StopOnEntry: stopOnEntry,
+		},
+		nil,
+	)
+	require.True(c.t, response.Success, response.Message)
+
+	var breakpointsBody SetBreakpointsResponseBody
+	response = c.request(
+		commandSetBreakpoints,
+		SetBreakpointsArguments{
+			Source:      Source{Path: path},
+			Breakpoints: breakpoints,
+		},
+		&breakpointsBody,
+	)
+	require.True(c.t, response.Success, response.Message)
+	require.Len(c.t, breakpointsBody.Breakpoints, len(breakpoints))
+	for _, breakpoint := range breakpointsBody.Breakpoints {
+		require.True(c.t, breakpoint.Verified)
+	}
+
+	filters := c.exceptionFilters
+	if filters == nil {
+		filters = []string{}
+	}
+
+	response = c.request(
+		commandSetExceptionBreakpoints,
+		SetExceptionBreakpointsArguments{
+			Filters: filters,
+		},
+		nil,
+	)
+	require.True(c.t, response.Success, response.Message)
+
+	response = c.request(commandConfigurationDone, nil, nil)
+	require.True(c.t, response.Success)
+}
+
+func (c *testClient) expectStopped(reason string) {
+	var stopped StoppedEventBody
+	c.expectEvent(eventStopped, &stopped)
+	require.Equal(c.t, reason, stopped.Reason)
+	require.Equal(c.t, threadID, stopped.ThreadID)
+}
+
+func (c *testClient) stackTrace() []StackFrame {
+	var body StackTraceResponseBody
+	response := c.request(commandStackTrace, StackTraceArguments{ThreadID: threadID}, &body)
+	require.True(c.t, response.Success, response.Message)
+	return body.StackFrames
+}
+
+func writeTestProgram(t *testing.T) string {
+	return writeProgram(t, testProgram)
+}
+
+func writeProgram(t *testing.T, code string) string {
+	path := filepath.Join(t.TempDir(), "test.cdc")
+	require.NoError(t, os.WriteFile(path, []byte(code), 0600))
+	return path
+}
+
+func TestServerBreakpoints(t *testing.T) {
+
+	t.Parallel()
+
+	path := writeTestProgram(t)
+
+	client, errs := newTestSession(t, LaunchProgram)
+
+	// Break at `log(y)`
+	client.initialize(path, false, 10)
+
+	client.expectStopped(stoppedReasonBreakpoint)
+
+	var threads ThreadsResponseBody
+	response := client.request(commandThreads, nil, &threads)
+	require.True(t, response.Success)
+	require.Equal(t, []Thread{{ID: threadID, Name: "main"}}, threads.Threads)
+
+	frames := client.stackTrace()
+	require.NotEmpty(t, frames)
+	assert.Equal(t, 10, frames[0].Line)
+	assert.Equal(t, "main", frames[0].Name)
+	assert.Equal(t, string(SourceLocation(path)), frames[0].Source.Path)
+
+	variables := client.localVariables()
+	require.Contains(t, variables, "x")
+	require.Contains(t, variables, "y")
+	assert.Equal(t, "1", variables["x"].Value)
+	assert.Equal(t, "Int", variables["x"].Type)
+	assert.Equal(t, "3", variables["y"].Value)
+	assert.Zero(t, variables["y"].VariablesReference)
+
+	// Step over `log(y)`
+
+	response = client.request(commandNext, ThreadArguments{ThreadID: threadID}, nil)
+	require.True(t, response.Success)
+
+	client.expectStopped(stoppedReasonStep)
+
+	assert.Equal(t, "3\n", client.output)
+
+	frames = client.stackTrace()
+	require.NotEmpty(t, frames)
+	assert.Equal(t, 11, frames[0].Line)
+
+	// Run to the end
+
+	response = client.request(commandContinue, ThreadArguments{ThreadID: threadID}, nil)
+	require.True(t, response.Success)
+
+	var exited ExitedEventBody
+	client.expectEvent(eventExited, &exited)
+	assert.Equal(t, 0, exited.ExitCode)
+
+	client.expectEvent(eventTerminated, nil)
+
+	// Inspecting the program is no longer possible
+
+	response = client.request(commandStackTrace, StackTraceArguments{ThreadID: threadID}, nil)
+	require.False(t, response.Success)
+
+	response = client.request(commandDisconnect, nil, nil)
+	require.True(t, response.Success)
+
+	require.NoError(t, <-errs)
+}
+
+func TestServerStopOnEntry(t *testing.T) {
+
+	t.Parallel()
+
+	path := writeTestProgram(t)
+
+	client, errs := newTestSession(t, LaunchProgram)
+
+	client.initialize(path, true)
+
+	client.expectStopped(stoppedReasonEntry)
+
+	frames := client.stackTrace()
+	require.NotEmpty(t, frames)
+	assert.Equal(t, 8, frames[0].Line)
+
+	// Step over all statements of the main function.
+	// Stepping over does not enter the invoked function `add`
+
+	for _, line := range []int{9, 10, 11, 12} {
+		response := client.request(commandNext, ThreadArguments{ThreadID: threadID}, nil)
+		require.True(t, response.Success)
+
+		client.expectStopped(stoppedReasonStep)
+
+		frames = client.stackTrace()
+		require.NotEmpty(t, frames)
+		assert.Equal(t, line, frames[0].Line)
+	}
+
+	response := client.request(commandNext, ThreadArguments{ThreadID: threadID}, nil)
+	require.True(t, response.Success)
+
+	client.expectEvent(eventTerminated, nil)
+
+	response = client.request(commandDisconnect, nil, nil)
+	require.True(t, response.Success)
+
+	require.NoError(t, <-errs)
+}
+
+func TestServerStepInAndOut(t *testing.T) {
+
+	t.Parallel()
+
+	path := writeTestProgram(t)
+
+	client, errs := newTestSession(t, LaunchProgram)
+
+	// Break at `let y = add(x, 2)`
+	client.initialize(path, false, 9)
+
+	client.expectStopped(stoppedReasonBreakpoint)
+
+	response := client.request(commandStepIn, ThreadArguments{ThreadID: threadID}, nil)
+	require.True(t, response.Success)
+
+	client.expectStopped(stoppedReasonStep)
+
+	frames := client.stackTrace()
+	require.Len(t, frames, 2)
+
+	assert.Equal(t, "add", frames[0].Name)
+	assert.Equal(t, 3, frames[0].Line)
+
+	assert.Equal(t, "main", frames[1].Name)
+	assert.Equal(t, 9, frames[1].Line)
+
+	// Inspect the variables of both frames
+
+	var scopes ScopesResponseBody
+	response = client.request(commandScopes, ScopesArguments{FrameID: frames[0].ID}, &scopes)
+	require.True(t, response.Success, response.Message)
+
+	variables := client.variables(scopes.Scopes[0].VariablesReference)
+	assert.Equal(t, "1", variables["a"].Value)
+	assert.Equal(t, "2", variables["b"].Value)
+	assert.NotContains(t, variables, "x")
+
+	response = client.request(commandScopes, ScopesArguments{FrameID: frames[1].ID}, &scopes)
+	require.True(t, response.Success, response.Message)
+
+	variables = client.variables(scopes.Scopes[0].VariablesReference)
+	assert.Equal(t, "1", variables["x"].Value)
+	assert.NotContains(t, variables, "a")
+
+	// Step out of `add`
+
+	response = client.request(commandStepOut, ThreadArguments{ThreadID: threadID}, nil)
+	require.True(t, response.Success)
+
+	client.expectStopped(stoppedReasonStep)
+
+	frames = client.stackTrace()
+	require.Len(t, frames, 1)
+	assert.Equal(t, "main", frames[0].Name)
+	assert.Equal(t, 10, frames[0].Line)
+
+	response = client.request(commandContinue, ThreadArguments{ThreadID: threadID}, nil)
+	require.True(t, response.Success)
+
+	client.expectEvent(eventTerminated, nil)
+
+	response = client.request(commandDisconnect, nil, nil)
+	require.True(t, response.Success)
+
+	require.NoError(t, <-errs)
+}
+
+func TestServerStructuredVariables(t *testing.T) {
+
+	t.Parallel()
+
+	path := writeTestProgram(t)
+
+	client, errs := newTestSession(t, LaunchProgram)
+
+	// Break at `log(values)`
+	client.initialize(path, false, 12)
+
+	client.expectStopped(stoppedReasonBreakpoint)
+
+	variables := client.localVariables()
+	require.Contains(t, variables, "values")
+
+	values := variables["values"]
+	assert.Equal(t, "[1, 3]", values.Value)
+	assert.Equal(t, "[Int]", values.Type)
+	require.NotZero(t, values.VariablesReference)
+
+	elements := client.variables(values.VariablesReference)
+	assert.Equal(t, "1", elements["0"].Value)
+	assert.Equal(t, "3", elements["1"].Value)
+
+	// Variables references are invalidated when the program resumes
+
+	response := client.request(commandContinue, ThreadArguments{ThreadID: threadID}, nil)
+	require.True(t, response.Success)
+
+	client.expectEvent(eventTerminated, nil)
+
+	assert.Equal(t, "3\n[1, 3]\n", client.output)
+
+	response = client.request(
+		commandVariables,
+		VariablesArguments{VariablesReference: values.VariablesReference},
+		nil,
+	)
+	require.False(t, response.Success)
+
+	response = client.request(commandDisconnect, nil, nil)
+	require.True(t, response.Success)
+
+	require.NoError(t, <-errs)
+}
+
+func TestServerConditionalBreakpointsAndLogpoints(t *testing.T) {
+
+	t.Parallel()
+
+	path := writeProgram(t, `
+access(all) fun main() {
+    var i = 0
+    while i < 5 {
+        i = i + 1
+    }
+    log(i)
+}
+`)
+
+	client, errs := newTestSession(t, LaunchProgram)
+
+	client.initializeWithBreakpoints(
+		path,
+		false,
+		[]SourceBreakpoint{
+			// Stop at `i = i + 1` the second time the condition holds
+			{
+				Line:         5,
+				Condition:    "i >= 2",
+				HitCondition: ">= 2",
+			},
+			// Log before `log(i)`
+			{
+				Line:       7,
+				LogMessage: "i is {i}, \\{i}",
+			},
+		},
+	)
+
+	client.expectStopped(stoppedReasonBreakpoint)
+
+	frames := client.stackTrace()
+	require.NotEmpty(t, frames)
+	assert.Equal(t, 5, frames[0].Line)
+
+	variables := client.localVariables()
+	assert.Equal(t, "3", variables["i"].Value)
+
+	response := client.request(commandContinue, ThreadArguments{ThreadID: threadID}, nil)
+	require.True(t, response.Success)
+
+	// The breakpoint's hit count was reached, so the program stops on every further hit
+
+	client.expectStopped(stoppedReasonBreakpoint)
+
+	variables = client.localVariables()
+	assert.Equal(t, "4", variables["i"].Value)
+
+	response = client.request(commandContinue, ThreadArguments{ThreadID: threadID}, nil)
+	require.True(t, response.Success)
+
+	var exited ExitedEventBody
+	client.expectEvent(eventExited, &exited)
+	assert.Equal(t, 0, exited.ExitCode)
+
+	assert.Equal(t, "i is 5, {i}\n5\n", client.output)
+
+	client.expectEvent(eventTerminated, nil)
+
+	response = client.request(commandDisconnect, nil, nil)
+	require.True(t, response.Success)
+
+	require.NoError(t, <-errs)
+}
+
+func TestServerInvalidBreakpoints(t *testing.T) {
+
+	t.Parallel()
+
+	path := writeTestProgram(t)
+
+	client, errs := newTestSession(t, LaunchProgram)
+
+	var body SetBreakpointsResponseBody
+	response := client.request(
+		commandSetBreakpoints,
+		SetBreakpointsArguments{
+			Source: Source{Path: path},
+			Breakpoints: []SourceBreakpoint{
+				{Line: 10, HitCondition: "% 2"},
+				{Line: 0},
+			},
+		},
+		&body,
+	)
+	require.True(t, response.Success, response.Message)
+	require.Len(t, body.Breakpoints, 2)
+
+	for _, breakpoint := range body.Breakpoints {
+		assert.False(t, breakpoint.Verified)
+		assert.NotEmpty(t, breakpoint.Message)
+	}
+
+	response = client.request(commandDisconnect, nil, nil)
+	require.True(t, response.Success)
+
+	require.NoError(t, <-errs)
+}
+
+func TestServerBreakOnError(t *testing.T) {
+
+	t.Parallel()
+
+	path := writeProgram(t, `
+access(all) fun main() {
+    let values: {String: Int} = {"present": 1}
+    let value = values["missing"]!
+    log(value)
+}
+`)
+
+	client, errs := newTestSession(t, LaunchProgram)
+	client.exceptionFilters = []string{exceptionFilterErrors}
+
+	client.initialize(path, false)
+
+	// The program stops at the statement raising the error
+
+	var stopped StoppedEventBody
+	client.expectEvent(eventStopped, &stopped)
+	assert.Equal(t, stoppedReasonException, stopped.Reason)
+	assert.Contains(t, stopped.Text, "unexpectedly found nil")
+
+	frames := client.stackTrace()
+	require.NotEmpty(t, frames)
+	assert.Equal(t, 4, frames[0].Line)
+
+	variables := client.localVariables()
+	require.Contains(t, variables, "values")
+
+	var exceptionInfo ExceptionInfoResponseBody
+	response := client.request(commandExceptionInfo, ExceptionInfoArguments{ThreadID: threadID}, &exceptionInfo)
+	require.True(t, response.Success, response.Message)
+	assert.Equal(t, "ForceNilError", exceptionInfo.ExceptionID)
+	assert.Contains(t, exceptionInfo.Description, "unexpectedly found nil")
+
+	// Continuing aborts the program
+
+	response = client.request(commandContinue, ThreadArguments{ThreadID: threadID}, nil)
+	require.True(t, response.Success)
+
+	var exited ExitedEventBody
+	client.expectEvent(eventExited, &exited)
+	assert.Equal(t, 1, exited.ExitCode)
+	assert.Contains(t, client.output, "unexpectedly found nil")
+
+	client.expectEvent(eventTerminated, nil)
+
+	response = client.request(commandDisconnect, nil, nil)
+	require.True(t, response.Success)
+
+	require.NoError(t, <-errs)
+}
+
+func TestServerDisconnectDisablesBreakOnError(t *testing.T) {
+
+	t.Parallel()
+
+	path := writeProgram(t, `
+access(all) fun main() {
+    let values: {String: Int} = {"present": 1}
+    let value = values["missing"]!
+    log(value)
+}
+`)
+
+	programErrs := make(chan error, 1)
+	launcher := func(path string, debugger *interpreter.Debugger, output io.Writer) error {
+		err := LaunchProgram(path, debugger, output)
+		programErrs <- err
+		return err
+	}
+
+	client, errs := newTestSession(t, launcher)
+	client.exceptionFilters = []string{exceptionFilterErrors}
+
+	// Break at the declaration of `values`
+	client.initialize(path, false, 3)
+
+	client.expectStopped(stoppedReasonBreakpoint)
+
+	response := client.request(commandDisconnect, nil, nil)
+	require.True(t, response.Success)
+
+	require.NoError(t, <-errs)
+
+	// The program does not stop at the error anymore, as nobody could continue it
+
+	select {
+	case err := <-programErrs:
+		require.ErrorContains(t, err, "unexpectedly found nil")
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "program did not finish after disconnecting")
+	}
+}
+
+func TestServerProgramError(t *testing.T) {
+
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "error.cdc")
+	require.NoError(t, os.WriteFile(path, []byte(`access(all) fun main() { let x: Int = "" }`), 0600))
+
+	client, errs := newTestSession(t, LaunchProgram)
+
+	client.initialize(path, false)
+
+	var exited ExitedEventBody
+	client.expectEvent(eventExited, &exited)
+	assert.Equal(t, 1, exited.ExitCode)
+	assert.Contains(t, client.output, "mismatched types")
+
+	client.expectEvent(eventTerminated, nil)
+
+	response := client.request(commandDisconnect, nil, nil)
+	require.True(t, response.Success)
+
+	require.NoError(t, <-errs)
+}
@@ -0,0 +1,124 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dap
+
+import (
+	"path/filepath"
+	"strconv"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+)
+
+// variablesContainer is the target of a variables reference.
+// It is either an activation (a scope), or a value with children,
+// e.g. an array, a dictionary, or a composite.
+//
+// Variables references are only valid while the program is stopped.
+type variablesContainer struct {
+	interpreter *interpreter.Interpreter
+	activation  *interpreter.VariableActivation
+	value       interpreter.Value
+}
+
+func hasChildren(value interpreter.Value) bool {
+	switch value := value.(type) {
+	case *interpreter.ArrayValue:
+		return value.Count() > 0
+	case *interpreter.DictionaryValue:
+		return value.Count() > 0
+	case *interpreter.SetValue:
+		return value.Count() > 0
+	case *interpreter.CompositeValue:
+		return true
+	case *interpreter.SomeValue:
+		return hasChildren(value.InnerValue())
+	default:
+		return false
+	}
+}
+
+func (c variablesContainer) forEachChild(
+	inter *interpreter.Interpreter,
+	f func(name string, value interpreter.Value),
+) {
+	locationRange := interpreter.EmptyLocationRange
+
+	value := c.value
+	if someValue, ok := value.(*interpreter.SomeValue); ok {
+		value = someValue.InnerValue()
+	}
+
+	switch value := value.(type) {
+	case *interpreter.ArrayValue:
+		count := value.Count()
+		for index := 0; index < count; index++ {
+			f(
+				strconv.Itoa(index),
+				value.Get(inter, locationRange, index),
+			)
+		}
+
+	case *interpreter.DictionaryValue:
+		value.IterateReadOnly(
+			inter,
+			locationRange,
+			func(key, value interpreter.Value) (resume bool) {
+				f(key.String(), value)
+				return true
+			},
+		)
+
+	case *interpreter.SetValue:
+		index := 0
+		value.Iterate(
+			inter,
+			func(element interpreter.Value) (resume bool) {
+				f(strconv.Itoa(index), element)
+				index++
+				return true
+			},
+		)
+
+	case *interpreter.CompositeValue:
+		value.ForEachReadOnlyLoadedField(
+			inter,
+			func(name string, value interpreter.Value) (resume bool) {
+				f(name, value)
+				return true
+			},
+			locationRange,
+		)
+	}
+}
+
+func locationSource(location common.Location) Source {
+	stringLocation, ok := location.(common.StringLocation)
+	if !ok {
+		return Source{
+			Name: location.String(),
+		}
+	}
+
+	path := string(stringLocation)
+	return Source{
+		Name: filepath.Base(path),
+		Path: path,
+	}
+}
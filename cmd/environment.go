@@ -0,0 +1,323 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/onflow/cadence/activations"
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/cadence/pretty"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/stdlib"
+)
+
+// Environment parses, checks, and interprets programs in files,
+// and resolves their imports using an import resolver.
+//
+// Unlike PrepareProgram, PrepareChecker, and PrepareInterpreter,
+// the functions of the environment return errors instead of exiting.
+type Environment struct {
+	ImportResolver *ImportResolver
+	// Codes contains the code of all parsed programs,
+	// e.g. for pretty-printing errors
+	Codes map[common.Location][]byte
+	// MemberAccountAccess allows account access from the source locations
+	// to the target locations
+	MemberAccountAccess map[common.Location]map[common.Location]struct{}
+	// EnumSwitchExhaustivenessCheckEnabled determines if switch statements over enums,
+	// which have no default case and do not cover all enum cases, are reported
+	EnumSwitchExhaustivenessCheckEnabled bool
+	// Debugger is used by interpreters, if any
+	Debugger              *interpreter.Debugger
+	standardLibraryValues []stdlib.StandardLibraryValue
+	standardLibrary       *StandardLibraryHandler
+	checkers              map[common.Location]*sema.Checker
+	checkerConfig         *sema.Config
+}
+
+// NewEnvironment returns a new environment.
+// Log messages and events are written to the given output
+func NewEnvironment(importResolver *ImportResolver, output io.Writer) *Environment {
+	standardLibraryHandler := &StandardLibraryHandler{
+		Output: output,
+	}
+
+	return &Environment{
+		ImportResolver:        importResolver,
+		Codes:                 map[common.Location][]byte{},
+		standardLibrary:       standardLibraryHandler,
+		standardLibraryValues: stdlib.DefaultScriptStandardLibraryValues(standardLibraryHandler),
+		checkers:              map[common.Location]*sema.Checker{},
+	}
+}
+
+// ReadFile reads the file with the given path,
+// and returns its location and code
+func (e *Environment) ReadFile(path string) (common.StringLocation, []byte, error) {
+	location := ResolveFile(path)
+
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return location, nil, err
+	}
+
+	e.Codes[location] = code
+
+	return location, code, nil
+}
+
+// Parse parses the given code of the program with the given location
+func (e *Environment) Parse(code []byte, location common.Location) (*ast.Program, error) {
+	e.Codes[location] = code
+
+	return parser.ParseProgram(nil, code, parser.Config{})
+}
+
+// Check checks the given program with the given location
+func (e *Environment) Check(program *ast.Program, location common.Location) (*sema.Checker, error) {
+	checker, err := sema.NewChecker(
+		program,
+		location,
+		nil,
+		e.newCheckerConfig(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e.checkers[location] = checker
+
+	err = checker.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	return checker, nil
+}
+
+// ParseAndCheckFile reads, parses, and checks the program in the file with the given path
+func (e *Environment) ParseAndCheckFile(path string) (*sema.Checker, error) {
+	location, code, err := e.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	program, err := e.Parse(code, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Check(program, location)
+}
+
+// Interpret interprets the program of the given checker.
+// Each interpreted program and its imported programs have their own storage
+func (e *Environment) Interpret(checker *sema.Checker) (*interpreter.Interpreter, error) {
+	inter, err := interpreter.NewInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		checker.Location,
+		e.newInterpreterConfig(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = inter.Interpret()
+	if err != nil {
+		return nil, err
+	}
+
+	return inter, nil
+}
+
+// PrettyPrintError pretty-prints the given error of the program with the given location
+func (e *Environment) PrettyPrintError(
+	writer pretty.Writer,
+	err error,
+	location common.Location,
+	useColor bool,
+) error {
+	return pretty.NewErrorPrettyPrinter(writer, useColor).
+		PrettyPrintError(err, location, e.Codes)
+}
+
+func (e *Environment) newCheckerConfig() *sema.Config {
+	if e.checkerConfig != nil {
+		return e.checkerConfig
+	}
+
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	for _, valueDeclaration := range e.standardLibraryValues {
+		baseValueActivation.DeclareValue(valueDeclaration)
+	}
+
+	e.checkerConfig = &sema.Config{
+		BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+			return baseValueActivation
+		},
+		AccessCheckMode: sema.AccessCheckModeStrict,
+		LocationHandler: e.ImportResolver.LocationHandler(),
+		ImportHandler:   e.importProgram,
+		MemberAccountAccessHandler: func(checker *sema.Checker, memberLocation common.Location) bool {
+			targets, ok := e.MemberAccountAccess[checker.Location]
+			if !ok {
+				return false
+			}
+
+			_, ok = targets[memberLocation]
+			return ok
+		},
+		EnumSwitchExhaustivenessCheckEnabled: e.EnumSwitchExhaustivenessCheckEnabled,
+	}
+
+	return e.checkerConfig
+}
+
+// importProgram parses and checks the imported program with the given resolved location
+func (e *Environment) importProgram(
+	checker *sema.Checker,
+	importedLocation common.Location,
+	importRange ast.Range,
+) (sema.Import, error) {
+
+	importedChecker, ok := e.checkers[importedLocation]
+	if ok {
+		// The imported program is still being checked
+		if !importedChecker.IsChecked() {
+			return nil, &sema.CyclicImportsError{
+				Location: importedLocation,
+				Range:    importRange,
+			}
+		}
+	} else {
+		path, err := e.ImportResolver.Path(importedLocation)
+		if err != nil {
+			return nil, err
+		}
+
+		code, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		program, err := e.Parse(code, importedLocation)
+		if err != nil {
+			return nil, err
+		}
+
+		importedChecker, err = checker.SubChecker(program, importedLocation)
+		if err != nil {
+			return nil, err
+		}
+		e.checkers[importedLocation] = importedChecker
+
+		err = importedChecker.Check()
+		if err != nil {
+			delete(e.checkers, importedLocation)
+			return nil, err
+		}
+	}
+
+	return sema.ElaborationImport{
+		Elaboration: importedChecker.Elaboration,
+	}, nil
+}
+
+func (e *Environment) newInterpreterConfig() *interpreter.Config {
+	baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
+	for _, value := range e.standardLibraryValues {
+		interpreter.Declare(baseActivation, value)
+	}
+
+	var uuid uint64
+
+	return &interpreter.Config{
+		BaseActivationHandler: func(_ common.Location) *interpreter.VariableActivation {
+			return baseActivation
+		},
+		Storage: interpreter.NewInMemoryStorage(nil),
+		UUIDHandler: func() (uint64, error) {
+			defer func() { uuid++ }()
+			return uuid, nil
+		},
+		OnEventEmitted:        e.standardLibrary.NewOnEventEmittedHandler(),
+		Debugger:              e.Debugger,
+		ImportLocationHandler: e.importInterpreter,
+		ContractValueHandler:  newContractValue,
+	}
+}
+
+// importInterpreter returns an interpreter for the imported program with the given location,
+// which was already checked when the importing program was checked
+func (e *Environment) importInterpreter(inter *interpreter.Interpreter, location common.Location) interpreter.Import {
+	checker, ok := e.checkers[location]
+	if !ok {
+		panic(errors.NewUnexpectedError("imported program was not checked: %s", location))
+	}
+
+	subInterpreter, err := inter.NewSubInterpreter(
+		interpreter.ProgramFromChecker(checker),
+		location,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return interpreter.InterpreterImport{
+		Interpreter: subInterpreter,
+	}
+}
+
+// newContractValue creates the value of a contract by invoking its initializer.
+// Contracts of address locations are deployed to the address.
+// Contracts with initializer parameters are not supported
+func newContractValue(
+	inter *interpreter.Interpreter,
+	compositeType *sema.CompositeType,
+	constructorGenerator func(common.Address) *interpreter.HostFunctionValue,
+	invocationRange ast.Range,
+) interpreter.ContractValue {
+
+	var address common.Address
+	if addressLocation, ok := compositeType.Location.(common.AddressLocation); ok {
+		address = addressLocation.Address
+	}
+
+	constructor := constructorGenerator(address)
+
+	value, err := inter.InvokeFunctionValue(
+		constructor,
+		nil,
+		nil,
+		nil,
+		compositeType,
+		invocationRange,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return value.(*interpreter.CompositeValue)
+}
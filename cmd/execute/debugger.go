@@ -21,6 +21,8 @@ package execute
 import (
 	"fmt"
 	"os"
+	"os/signal"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -35,17 +37,52 @@ const commandShortContinue = "c"
 const commandLongContinue = "continue"
 const commandShortNext = "n"
 const commandLongNext = "next"
+const commandLongStep = "step"
+const commandShortStepOut = "o"
+const commandLongStepOut = "out"
 const commandLongExit = "exit"
 const commandShortShow = "s"
 const commandLongShow = "show"
 const commandShortWhere = "w"
 const commandLongWhere = "where"
+const commandShortBacktrace = "bt"
+const commandLongBacktrace = "backtrace"
+const commandShortBreak = "b"
+const commandLongBreak = "break"
+const commandLongLogpoint = "logpoint"
+const commandShortDelete = "d"
+const commandLongDelete = "delete"
+const commandLongBreakpoints = "breakpoints"
+const commandLongCatch = "catch"
+const commandShortPrint = "p"
+const commandLongPrint = "print"
+const commandShortEval = "e"
+const commandLongEval = "eval"
+const commandLongWatch = "watch"
+const commandLongUnwatch = "unwatch"
+const commandLongSet = "set"
+
+const breakKeywordHits = "hits"
+const breakKeywordIf = "if"
 
 var debuggerCommandSuggestions = []prompt.Suggest{
 	{Text: commandLongContinue, Description: "Continue"},
-	{Text: commandLongNext, Description: "Next / step"},
+	{Text: commandLongNext, Description: "Next / step over"},
+	{Text: commandLongStep, Description: "Step into"},
+	{Text: commandLongStepOut, Description: "Step out"},
 	{Text: commandLongWhere, Description: "Location info"},
+	{Text: commandLongBacktrace, Description: "Call stack"},
 	{Text: commandLongShow, Description: "Show variable(s)"},
+	{Text: commandLongPrint, Description: "Evaluate and print expression: print <expression>"},
+	{Text: commandLongEval, Description: "Evaluate and print expression: eval <expression>"},
+	{Text: commandLongWatch, Description: "Add watch expression: watch <expression>. Without expression, show watches"},
+	{Text: commandLongUnwatch, Description: "Remove watch expression: unwatch <number>"},
+	{Text: commandLongSet, Description: "Set variable: set <name> = <expression>"},
+	{Text: commandLongBreak, Description: "Add breakpoint: break <line> [hits <count>] [if <condition>]"},
+	{Text: commandLongLogpoint, Description: "Add logpoint: logpoint <line> <message with {expressions}>"},
+	{Text: commandLongDelete, Description: "Delete breakpoint: delete <line>"},
+	{Text: commandLongBreakpoints, Description: "List breakpoints"},
+	{Text: commandLongCatch, Description: "Stop when an error is raised: catch [on|off]"},
 	{Text: commandLongExit, Description: "Exit"},
 	{Text: commandLongHelp, Description: "Help"},
 }
@@ -56,6 +93,8 @@ type InteractiveDebugger struct {
 }
 
 func NewInteractiveDebugger(debugger *interpreter.Debugger, stop interpreter.Stop) *InteractiveDebugger {
+	debugger.SetLogHandler(printBreakpointMessage)
+
 	return &InteractiveDebugger{
 		debugger: debugger,
 		stop:     stop,
@@ -67,7 +106,150 @@ func (d *InteractiveDebugger) Continue() {
 }
 
 func (d *InteractiveDebugger) Next() {
-	d.stop = d.debugger.Next()
+	d.stop = d.debugger.StepOver()
+	d.showStop()
+}
+
+func (d *InteractiveDebugger) Step() {
+	d.stop = d.debugger.StepInto()
+	d.showStop()
+}
+
+func (d *InteractiveDebugger) StepOut() {
+	d.stop = d.debugger.StepOut()
+	d.showStop()
+}
+
+// showStop shows the error which caused the stop, if any,
+// and the values of the watch expressions
+func (d *InteractiveDebugger) showStop() {
+	d.showError()
+	d.showWatches()
+}
+
+// showError shows the error which caused the stop, if any
+func (d *InteractiveDebugger) showError() {
+	err := d.stop.Err
+	if err == nil {
+		return
+	}
+
+	fmt.Println(colorizeError(fmt.Sprintf("error: %s", err)))
+	fmt.Println("Continuing aborts the program")
+}
+
+func (d *InteractiveDebugger) evaluate(expression string) (interpreter.Value, error) {
+	inter := d.stop.Interpreter
+	return d.debugger.EvaluateExpression(
+		inter,
+		d.debugger.CurrentActivation(inter),
+		expression,
+		nil,
+	)
+}
+
+// Print evaluates the given expression in the current scope, and prints the result
+func (d *InteractiveDebugger) Print(expression string) {
+	if expression == "" {
+		fmt.Println(colorizeError("error: missing expression"))
+		return
+	}
+
+	value, err := d.evaluate(expression)
+	if err != nil {
+		fmt.Println(colorizeError(fmt.Sprintf("error: %s", err)))
+		return
+	}
+
+	fmt.Println(colorizeValue(value))
+}
+
+// Watch adds the given watch expression.
+// Without an expression, it shows the values of all watch expressions
+func (d *InteractiveDebugger) Watch(expression string) {
+	if expression == "" {
+		d.showWatches()
+		return
+	}
+
+	d.debugger.AddWatch(expression)
+	d.showWatch(len(d.debugger.Watches())-1, expression)
+}
+
+// Unwatch removes the watch expression with the given number
+func (d *InteractiveDebugger) Unwatch(arguments []string) {
+	if len(arguments) < 1 {
+		fmt.Println(colorizeError("error: missing watch expression number"))
+		return
+	}
+
+	index, err := strconv.Atoi(arguments[0])
+	if err != nil || !d.debugger.RemoveWatch(index) {
+		fmt.Println(colorizeError(fmt.Sprintf("error: invalid watch expression number '%s'", arguments[0])))
+	}
+}
+
+func (d *InteractiveDebugger) showWatches() {
+	for index, expression := range d.debugger.Watches() {
+		d.showWatch(index, expression)
+	}
+}
+
+func (d *InteractiveDebugger) showWatch(index int, expression string) {
+	value, err := d.evaluate(expression)
+	var result string
+	if err != nil {
+		result = colorizeError(fmt.Sprintf("error: %s", err))
+	} else {
+		result = colorizeValue(value)
+	}
+
+	fmt.Printf("%d: %s = %s\n", index, expression, result)
+}
+
+// Set assigns the value of an expression to a variable in the current scope.
+// The argument has the form `<name> = <expression>`
+func (d *InteractiveDebugger) Set(argument string) {
+	name, expression, ok := strings.Cut(argument, "=")
+	name = strings.TrimSpace(name)
+	expression = strings.TrimSpace(expression)
+	if !ok || name == "" || expression == "" {
+		fmt.Println(colorizeError("error: expected '<name> = <expression>'"))
+		return
+	}
+
+	inter := d.stop.Interpreter
+	err := d.debugger.SetVariable(
+		inter,
+		d.debugger.CurrentActivation(inter),
+		name,
+		expression,
+	)
+	if err != nil {
+		fmt.Println(colorizeError(fmt.Sprintf("error: %s", err)))
+	}
+}
+
+// Catch determines if the program stops when an error is raised.
+// Without an argument, it shows the current setting
+func (d *InteractiveDebugger) Catch(arguments []string) {
+	if len(arguments) == 0 {
+		if d.debugger.BreakOnError() {
+			fmt.Println("on")
+		} else {
+			fmt.Println("off")
+		}
+		return
+	}
+
+	switch arguments[0] {
+	case "on":
+		d.debugger.SetBreakOnError(true)
+	case "off":
+		d.debugger.SetBreakOnError(false)
+	default:
+		fmt.Println(colorizeError(fmt.Sprintf("error: expected 'on' or 'off', got '%s'", arguments[0])))
+	}
 }
 
 // Show shows the values for the variables with the given names.
@@ -116,6 +298,9 @@ func (d *InteractiveDebugger) Run() {
 
 		command, arguments := parts[0], parts[1:]
 
+		// Expressions may contain spaces, so use the rest of the input as-is
+		rest := strings.TrimSpace(strings.TrimPrefix(in, command))
+
 		switch command {
 		case "":
 			break
@@ -123,10 +308,34 @@ func (d *InteractiveDebugger) Run() {
 			d.Continue()
 		case commandShortNext, commandLongNext:
 			d.Next()
+		case commandLongStep:
+			d.Step()
+		case commandShortStepOut, commandLongStepOut:
+			d.StepOut()
 		case commandShortShow, commandLongShow:
 			d.Show(arguments)
 		case commandShortWhere, commandLongWhere:
 			d.Where()
+		case commandShortBacktrace, commandLongBacktrace:
+			d.Backtrace()
+		case commandShortBreak, commandLongBreak:
+			d.Break(arguments)
+		case commandLongLogpoint:
+			d.Logpoint(arguments)
+		case commandShortDelete, commandLongDelete:
+			d.Delete(arguments)
+		case commandLongBreakpoints:
+			d.Breakpoints()
+		case commandLongCatch:
+			d.Catch(arguments)
+		case commandShortPrint, commandLongPrint, commandShortEval, commandLongEval:
+			d.Print(rest)
+		case commandLongWatch:
+			d.Watch(rest)
+		case commandLongUnwatch:
+			d.Unwatch(arguments)
+		case commandLongSet:
+			d.Set(rest)
 		case commandShortHelp, commandLongHelp:
 			d.Help()
 		case commandLongExit:
@@ -156,6 +365,8 @@ func (d *InteractiveDebugger) Run() {
 
 	fmt.Println()
 
+	d.showStop()
+
 	prompt.New(
 		executor,
 		suggest,
@@ -183,3 +394,161 @@ func (d *InteractiveDebugger) Where() {
 		d.stop.Statement.StartPosition().Line,
 	)
 }
+
+// Backtrace prints the call stack, starting with the innermost frame
+func (d *InteractiveDebugger) Backtrace() {
+	frames := d.stop.Frames
+	for i := len(frames) - 1; i >= 0; i-- {
+		frame := frames[i]
+
+		functionName := frame.FunctionName
+		if functionName == "" {
+			functionName = "<anonymous>"
+		}
+
+		fmt.Printf(
+			"#%d %s at %s @ %d\n",
+			len(frames)-1-i,
+			functionName,
+			frame.Location,
+			frame.Position.Line,
+		)
+	}
+}
+
+func printBreakpointMessage(breakpoint interpreter.Breakpoint, message string) {
+	fmt.Printf(
+		"%s @ %d: %s\n",
+		breakpoint.Location,
+		breakpoint.Line,
+		message,
+	)
+}
+
+func parseBreakpointLine(arguments []string) (uint, bool) {
+	if len(arguments) < 1 {
+		fmt.Println(colorizeError("error: missing line"))
+		return 0, false
+	}
+
+	line, err := strconv.ParseUint(arguments[0], 10, 0)
+	if err != nil || line == 0 {
+		fmt.Println(colorizeError(fmt.Sprintf("error: invalid line '%s'", arguments[0])))
+		return 0, false
+	}
+
+	return uint(line), true
+}
+
+// Break adds a breakpoint in the current location.
+// The arguments are the line, an optional hit count,
+// and an optional condition, e.g. `12 hits 3 if x > 2`
+func (d *InteractiveDebugger) Break(arguments []string) {
+	line, ok := parseBreakpointLine(arguments)
+	if !ok {
+		return
+	}
+
+	breakpoint := interpreter.Breakpoint{
+		Location: d.stop.Interpreter.Location,
+		Line:     line,
+	}
+
+	arguments = arguments[1:]
+
+	if len(arguments) >= 2 && arguments[0] == breakKeywordHits {
+		hitCount, err := strconv.ParseUint(arguments[1], 10, 0)
+		if err != nil {
+			fmt.Println(colorizeError(fmt.Sprintf("error: invalid hit count '%s'", arguments[1])))
+			return
+		}
+		breakpoint.HitCount = uint(hitCount)
+		arguments = arguments[2:]
+	}
+
+	if len(arguments) > 0 {
+		if arguments[0] != breakKeywordIf || len(arguments) < 2 {
+			fmt.Println(colorizeError("error: expected 'if <condition>'"))
+			return
+		}
+		breakpoint.Condition = strings.Join(arguments[1:], " ")
+	}
+
+	d.debugger.SetBreakpoint(breakpoint)
+}
+
+// Logpoint adds a logpoint in the current location.
+// The arguments are the line and the message
+func (d *InteractiveDebugger) Logpoint(arguments []string) {
+	line, ok := parseBreakpointLine(arguments)
+	if !ok {
+		return
+	}
+
+	message := strings.Join(arguments[1:], " ")
+	if message == "" {
+		fmt.Println(colorizeError("error: missing message"))
+		return
+	}
+
+	d.debugger.SetBreakpoint(interpreter.Breakpoint{
+		Location:   d.stop.Interpreter.Location,
+		Line:       line,
+		LogMessage: message,
+	})
+}
+
+// Delete removes the breakpoint at the given line in the current location
+func (d *InteractiveDebugger) Delete(arguments []string) {
+	line, ok := parseBreakpointLine(arguments)
+	if !ok {
+		return
+	}
+
+	d.debugger.RemoveBreakpoint(d.stop.Interpreter.Location, line)
+}
+
+// Breakpoints lists the breakpoints in the current location
+func (d *InteractiveDebugger) Breakpoints() {
+	for _, breakpoint := range d.debugger.Breakpoints(d.stop.Interpreter.Location) {
+		fmt.Printf("%d", breakpoint.Line)
+		if breakpoint.HitCount > 0 {
+			fmt.Printf(" hits %d", breakpoint.HitCount)
+		}
+		if breakpoint.Condition != "" {
+			fmt.Printf(" if %s", breakpoint.Condition)
+		}
+		if breakpoint.LogMessage != "" {
+			fmt.Printf(" log %q", breakpoint.LogMessage)
+		}
+		fmt.Printf(" (hit %d times)\n", breakpoint.Hits)
+	}
+}
+
+// NewDebugger returns a new debugger for programs run from the console.
+// The program is paused when an interrupt signal is received,
+// and the interactive debugger is run whenever the program stops.
+func NewDebugger() *interpreter.Debugger {
+	signals := make(chan os.Signal, 1)
+
+	signal.Notify(signals, os.Interrupt)
+
+	debugger := interpreter.NewDebugger()
+
+	go func() {
+		for range signals {
+			debugger.RequestPause()
+		}
+	}()
+
+	// Stops are caused by pauses, breakpoints, and steps.
+	// The interactive debugger continues the program
+
+	go func() {
+		for stop := range debugger.Stops() {
+			NewInteractiveDebugger(debugger, stop).Run()
+		}
+	}()
+
+	return debugger
+}
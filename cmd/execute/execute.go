@@ -19,6 +19,9 @@
 package execute
 
 import (
+	"os"
+	"strings"
+
 	"github.com/onflow/cadence/cmd"
 	"github.com/onflow/cadence/interpreter"
 )
@@ -27,18 +30,42 @@ import (
 // If there are no syntax errors, the program is interpreted.
 // If after the interpretation a global function `main` is defined, it will be called.
 // The program may call the function `log` to print a value.
-func Execute(args []string, debugger *interpreter.Debugger) {
+//
+// Imports are resolved using the given import resolver.
+func Execute(args []string, importResolver *cmd.ImportResolver, debugger *interpreter.Debugger) {
 
 	if len(args) < 1 {
 		cmd.ExitWithError("no input file")
 	}
 
-	inter, _, must := cmd.PrepareInterpreter(args[0], debugger)
+	environment := cmd.NewEnvironment(importResolver, nil)
+	environment.Debugger = debugger
+
+	location := cmd.ResolveFile(args[0])
+
+	must := func(err error) {
+		if err == nil {
+			return
+		}
+		var builder strings.Builder
+		printErr := environment.PrettyPrintError(&builder, err, location, true)
+		if printErr != nil {
+			panic(printErr)
+		}
+		_, _ = os.Stderr.WriteString(builder.String())
+		os.Exit(1)
+	}
+
+	checker, err := environment.ParseAndCheckFile(args[0])
+	must(err)
+
+	inter, err := environment.Interpret(checker)
+	must(err)
 
 	if !inter.Globals.Contains("main") {
 		return
 	}
 
-	_, err := inter.Invoke("main")
+	_, err = inter.Invoke("main")
 	must(err)
 }
@@ -48,13 +48,15 @@ type ConsoleREPL struct {
 	historyWriter      *csv.Writer
 }
 
-func NewConsoleREPL() (*ConsoleREPL, error) {
+// NewConsoleREPL returns a new console REPL.
+// If a debugger is given, functions invoked by the entered code can be debugged
+func NewConsoleREPL(debugger *interpreter.Debugger) (*ConsoleREPL, error) {
 	consoleREPL := &ConsoleREPL{
 		lineNumber:         1,
 		errorPrettyPrinter: pretty.NewErrorPrettyPrinter(os.Stderr, true),
 	}
 
-	repl, err := runtime.NewREPL()
+	repl, err := runtime.NewREPL(debugger)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,239 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/sema"
+)
+
+// FileExtension is the extension of Cadence source files
+const FileExtension = ".cdc"
+
+// ImportResolver resolves imports to local files.
+//
+// Imports of contracts declared in the project, if any, are resolved first (see Project).
+//
+// Other string and identifier imports, e.g. `import "Foo"` or `import Foo`,
+// are resolved to files in the directories, in order.
+// The file extension may be omitted.
+//
+// Other address imports, e.g. `import Foo from 0x1`,
+// are resolved using the address mappings:
+// The directory mapped to the address contains a file for each contract,
+// named after the contract, e.g. `Foo.cdc`.
+type ImportResolver struct {
+	// Directories are searched for imported files, in order.
+	// If there are no directories, the current working directory is searched
+	Directories []string
+	// AddressMappings maps addresses to directories
+	AddressMappings map[common.Address]string
+	// Project declares the contracts of the project, if any
+	Project *Project
+}
+
+// ResolveFile returns the location of the file with the given path.
+// Files are identified by their cleaned path
+func ResolveFile(path string) common.StringLocation {
+	return common.StringLocation(filepath.Clean(path))
+}
+
+// LocationHandler returns a location handler for checkers,
+// which resolves imports to the locations of files
+func (r *ImportResolver) LocationHandler() sema.LocationHandlerFunc {
+	addressLocationHandler := sema.AddressLocationHandlerFunc(r.addressContractNames)
+
+	return func(identifiers []ast.Identifier, location common.Location) ([]sema.ResolvedLocation, error) {
+		var name string
+
+		switch location := location.(type) {
+		case common.StringLocation:
+			name = string(location)
+
+		case common.IdentifierLocation:
+			name = string(location)
+
+		case common.AddressLocation:
+			if !r.isAddressMapped(location.Address) {
+				return nil, fmt.Errorf("cannot import from %s: address is not mapped", location.Address.ShortHexWithPrefix())
+			}
+			return addressLocationHandler(identifiers, location)
+
+		default:
+			return nil, fmt.Errorf("cannot import %s: unsupported location", location)
+		}
+
+		if contractLocation, ok := r.Project.contractLocation(name); ok {
+			return []sema.ResolvedLocation{
+				{
+					Location:    contractLocation,
+					Identifiers: identifiers,
+				},
+			}, nil
+		}
+
+		path, err := r.resolvePath(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return []sema.ResolvedLocation{
+			{
+				Location:    ResolveFile(path),
+				Identifiers: identifiers,
+			},
+		}, nil
+	}
+}
+
+// Path returns the path of the file for the given resolved location
+func (r *ImportResolver) Path(location common.Location) (string, error) {
+	switch location := location.(type) {
+	case common.StringLocation:
+		return string(location), nil
+
+	case common.AddressLocation:
+		if source, ok := r.Project.addressContractSource(location); ok {
+			return source, nil
+		}
+
+		directory, ok := r.AddressMappings[location.Address]
+		if !ok {
+			if r.isAddressMapped(location.Address) {
+				return "", fmt.Errorf(
+					"cannot import `%s` from %s: contract not found",
+					location.Name,
+					location.Address.ShortHexWithPrefix(),
+				)
+			}
+			return "", fmt.Errorf("cannot import from %s: address is not mapped", location.Address.ShortHexWithPrefix())
+		}
+		return filepath.Join(directory, location.Name+FileExtension), nil
+
+	default:
+		return "", fmt.Errorf("cannot import %s: unsupported location", location)
+	}
+}
+
+func (r *ImportResolver) resolvePath(name string) (string, error) {
+	candidates := []string{name}
+	if !strings.HasSuffix(name, FileExtension) {
+		candidates = append(candidates, name+FileExtension)
+	}
+
+	if filepath.IsAbs(name) {
+		for _, candidate := range candidates {
+			if isFile(candidate) {
+				return candidate, nil
+			}
+		}
+		return "", fmt.Errorf("cannot import `%s`: file not found", name)
+	}
+
+	directories := r.Directories
+	if len(directories) == 0 {
+		directories = []string{"."}
+	}
+
+	for _, directory := range directories {
+		for _, candidate := range candidates {
+			path := filepath.Join(directory, candidate)
+			if isFile(path) {
+				return path, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf(
+		"cannot import `%s`: file not found in %s",
+		name,
+		strings.Join(directories, ", "),
+	)
+}
+
+func (r *ImportResolver) isAddressMapped(address common.Address) bool {
+	if _, ok := r.AddressMappings[address]; ok {
+		return true
+	}
+	return len(r.Project.addressContractNames(address)) > 0
+}
+
+// addressContractNames returns the names of all contracts of the given address,
+// i.e. the names of all contracts declared in the project for the address,
+// and the names of all files in the directory mapped to the address
+func (r *ImportResolver) addressContractNames(address common.Address) ([]string, error) {
+	names := r.Project.addressContractNames(address)
+
+	directory, ok := r.AddressMappings[address]
+	if !ok {
+		return names, nil
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, FileExtension) {
+			continue
+		}
+		name = strings.TrimSuffix(name, FileExtension)
+		if slices.Contains(names, name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// ParseAddressMapping parses an address mapping of the form `address=directory`,
+// e.g. `0x1=./contracts`
+func ParseAddressMapping(mapping string) (common.Address, string, error) {
+	addressHex, directory, ok := strings.Cut(mapping, "=")
+	if !ok || directory == "" {
+		return common.Address{}, "", fmt.Errorf(
+			"invalid address mapping: got '%s', expected 'address=directory'",
+			mapping,
+		)
+	}
+
+	address, err := common.HexToAddress(addressHex)
+	if err != nil {
+		return common.Address{}, "", fmt.Errorf("invalid address mapping: %s: %w", addressHex, err)
+	}
+
+	return address, directory, nil
+}
@@ -19,34 +19,37 @@
 package main
 
 import (
-	"os"
-	"os/signal"
+	"flag"
 
+	"github.com/onflow/cadence/cmd"
 	"github.com/onflow/cadence/cmd/execute"
-	"github.com/onflow/cadence/interpreter"
 )
 
-func main() {
-	if len(os.Args) > 1 {
-		// TODO: also make the REPL support the interactive debugger
+var projectFlag = flag.String(
+	"project",
+	"",
+	"resolve imports using the project file. defaults to the "+cmd.ProjectFileName+" file in the current directory or its parents",
+)
 
-		signals := make(chan os.Signal, 1)
+func main() {
+	flag.Parse()
 
-		signal.Notify(signals, os.Interrupt)
+	debugger := execute.NewDebugger()
 
-		debugger := interpreter.NewDebugger()
+	args := flag.Args()
+	if len(args) > 0 {
+		project, err := cmd.LoadProjectFile(*projectFlag)
+		if err != nil {
+			cmd.ExitWithError(err.Error())
+		}
 
-		go func() {
-			for range signals {
-				stop := debugger.Pause()
-				execute.NewInteractiveDebugger(debugger, stop).Run()
-				debugger.Continue()
-			}
-		}()
+		importResolver := &cmd.ImportResolver{
+			Project: project,
+		}
 
-		execute.Execute(os.Args[1:], debugger)
+		execute.Execute(args, importResolver, debugger)
 	} else {
-		repl, err := execute.NewConsoleREPL()
+		repl, err := execute.NewConsoleREPL(debugger)
 		if err != nil {
 			panic(err)
 		}
@@ -0,0 +1,215 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/onflow/cadence/common"
+)
+
+// ProjectFileName is the name of project files.
+// Project files are searched for in the working directory and its parents
+const ProjectFileName = "cadence.json"
+
+// Project is a project configuration, which declares the contracts of a project.
+//
+// A project file maps contract names to contracts, e.g.:
+//
+//	{
+//	  "contracts": {
+//	    "Utils": "./contracts/Utils.cdc",
+//	    "FungibleToken": {
+//	      "source": "./contracts/FungibleToken.cdc",
+//	      "address": "0xf233dcee88fe0abe"
+//	    }
+//	  }
+//	}
+//
+// A contract with an address is imported from its address,
+// both by address imports (`import FungibleToken from 0xf233dcee88fe0abe`),
+// and by string and identifier imports (`import "FungibleToken"`).
+// A contract without an address is imported from its source file.
+type Project struct {
+	Contracts map[string]ProjectContract `json:"contracts"`
+}
+
+// ProjectContract is a contract declared in a project
+type ProjectContract struct {
+	// Source is the path of the source file of the contract.
+	// Relative paths are relative to the directory of the project file
+	Source string `json:"source"`
+	// Address is the address the contract is deployed to, if any
+	Address *common.Address `json:"-"`
+}
+
+type projectContractJSON struct {
+	Source  string `json:"source"`
+	Address string `json:"address,omitempty"`
+}
+
+func (c *ProjectContract) UnmarshalJSON(data []byte) error {
+	// A contract may be given as just its source path
+	var source string
+	if err := json.Unmarshal(data, &source); err == nil {
+		c.Source = source
+		return nil
+	}
+
+	var contract projectContractJSON
+	err := json.Unmarshal(data, &contract)
+	if err != nil {
+		return err
+	}
+
+	c.Source = contract.Source
+
+	if contract.Address != "" {
+		address, err := common.HexToAddress(contract.Address)
+		if err != nil {
+			return fmt.Errorf("invalid address %s: %w", contract.Address, err)
+		}
+		c.Address = &address
+	}
+
+	return nil
+}
+
+// LoadProject reads the project file with the given path.
+// The source paths of the contracts are resolved relative to the directory of the project file
+func LoadProject(path string) (*Project, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	err = json.Unmarshal(data, &project)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project file %s: %w", path, err)
+	}
+
+	directory := filepath.Dir(path)
+
+	for name, contract := range project.Contracts { //nolint:maprange
+		if contract.Source == "" {
+			return nil, fmt.Errorf("invalid project file %s: missing source of contract %s", path, name)
+		}
+
+		if !filepath.IsAbs(contract.Source) {
+			contract.Source = filepath.Join(directory, contract.Source)
+		}
+		project.Contracts[name] = contract
+	}
+
+	return &project, nil
+}
+
+// FindProjectFile returns the path of the project file in the given directory or its parents.
+// It returns an empty path if there is no project file
+func FindProjectFile(directory string) (string, error) {
+	directory, err := filepath.Abs(directory)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		path := filepath.Join(directory, ProjectFileName)
+		if isFile(path) {
+			return path, nil
+		}
+
+		parent := filepath.Dir(directory)
+		if parent == directory {
+			return "", nil
+		}
+		directory = parent
+	}
+}
+
+// LoadProjectFile loads the project file with the given path.
+// If the path is empty, the project file is searched for in the working directory and its parents.
+// It returns nil if no path is given and there is no project file
+func LoadProjectFile(path string) (*Project, error) {
+	if path == "" {
+		var err error
+		path, err = FindProjectFile(".")
+		if err != nil || path == "" {
+			return nil, err
+		}
+	}
+
+	return LoadProject(path)
+}
+
+// contractLocation returns the location of the contract with the given name, if any
+func (p *Project) contractLocation(name string) (common.Location, bool) {
+	if p == nil {
+		return nil, false
+	}
+
+	contract, ok := p.Contracts[name]
+	if !ok {
+		return nil, false
+	}
+
+	if contract.Address != nil {
+		return common.AddressLocation{
+			Address: *contract.Address,
+			Name:    name,
+		}, true
+	}
+
+	return ResolveFile(contract.Source), true
+}
+
+// addressContractSource returns the source path of the contract with the given address location, if any
+func (p *Project) addressContractSource(location common.AddressLocation) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+
+	contract, ok := p.Contracts[location.Name]
+	if !ok || contract.Address == nil || *contract.Address != location.Address {
+		return "", false
+	}
+
+	return contract.Source, true
+}
+
+// addressContractNames returns the names of all contracts deployed to the given address
+func (p *Project) addressContractNames(address common.Address) []string {
+	if p == nil {
+		return nil
+	}
+
+	var names []string
+	for name, contract := range p.Contracts { //nolint:maprange
+		if contract.Address != nil && *contract.Address == address {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -196,6 +196,14 @@ func (k CompositeKind) SupportsInterfaces() bool {
 	panic(errors.NewUnreachableError())
 }
 
+// CanConformTo returns true if a composite of this kind
+// may conform to an interface of the given kind.
+// Enums are structures, so they may conform to struct interfaces.
+func (k CompositeKind) CanConformTo(interfaceKind CompositeKind) bool {
+	return k == interfaceKind ||
+		(k == CompositeKindEnum && interfaceKind == CompositeKindStructure)
+}
+
 func (k CompositeKind) SupportsAttachments() bool {
 	switch k {
 	case CompositeKindStructure,
@@ -84,8 +84,8 @@ const (
 	_
 	_
 	_
-	_
-	_
+	ComputationKindCreateSetValue
+	ComputationKindTransferSetValue
 	_
 	_
 	_
@@ -61,6 +61,7 @@ const (
 	DeclarationKindEnum
 	DeclarationKindEnumCase
 	DeclarationKindAttachment
+	DeclarationKindTypeAlias
 )
 
 func DeclarationKindCount() int {
@@ -80,7 +81,8 @@ func (k DeclarationKind) IsTypeDeclaration() bool {
 		DeclarationKindContractInterface,
 		DeclarationKindTypeParameter,
 		DeclarationKindEnum,
-		DeclarationKindAttachment:
+		DeclarationKindAttachment,
+		DeclarationKindTypeAlias:
 
 		return true
 
@@ -151,6 +153,8 @@ func (k DeclarationKind) Name() string {
 		return "enum"
 	case DeclarationKindEnumCase:
 		return "enum case"
+	case DeclarationKindTypeAlias:
+		return "type alias"
 	case DeclarationKindUnknown:
 		return "unknown"
 	}
@@ -206,6 +210,8 @@ func (k DeclarationKind) Keywords() string {
 		return "enum"
 	case DeclarationKindEnumCase:
 		return "case"
+	case DeclarationKindTypeAlias:
+		return "typealias"
 	default:
 		return ""
 	}
@@ -257,6 +257,16 @@ const (
 	MemoryKindOrderedMapEntryList
 	MemoryKindOrderedMapEntry
 
+	// Kinds added after the kinds above,
+	// appended to keep the values of the kinds above unchanged
+
+	MemoryKindTypeAliasDeclaration
+	MemoryKindSetValueBase
+	MemoryKindSetStaticType
+	MemoryKindCadenceSetValue
+	MemoryKindCadenceSetType
+	MemoryKindSetSemaType
+
 	// Placeholder kind to allow consistent indexing
 	// this should always be the last kind
 	MemoryKindLast
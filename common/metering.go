@@ -73,6 +73,7 @@ var (
 	VariableDeclarationMemoryUsage           = NewConstantMemoryUsage(MemoryKindVariableDeclaration)
 	SpecialFunctionDeclarationMemoryUsage    = NewConstantMemoryUsage(MemoryKindSpecialFunctionDeclaration)
 	PragmaDeclarationMemoryUsage             = NewConstantMemoryUsage(MemoryKindPragmaDeclaration)
+	TypeAliasDeclarationMemoryUsage          = NewConstantMemoryUsage(MemoryKindTypeAliasDeclaration)
 
 	// AST Statements
 
@@ -140,6 +141,7 @@ var (
 	CompositeTypeInfoMemoryUsage                = NewConstantMemoryUsage(MemoryKindCompositeTypeInfo)
 	CompositeFieldMemoryUsage                   = NewConstantMemoryUsage(MemoryKindCompositeField)
 	DictionaryValueBaseMemoryUsage              = NewConstantMemoryUsage(MemoryKindDictionaryValueBase)
+	SetValueBaseMemoryUsage                     = NewConstantMemoryUsage(MemoryKindSetValueBase)
 	ArrayValueBaseMemoryUsage                   = NewConstantMemoryUsage(MemoryKindArrayValueBase)
 	CompositeValueBaseMemoryUsage               = NewConstantMemoryUsage(MemoryKindCompositeValueBase)
 	AddressValueMemoryUsage                     = NewConstantMemoryUsage(MemoryKindAddressValue)
@@ -171,6 +173,7 @@ var (
 	FunctionStaticTypeMemoryUsage       = NewConstantMemoryUsage(MemoryKindFunctionStaticType)
 	EntitlementMapStaticTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindEntitlementMapStaticAccess)
 	InclusiveRangeStaticTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindInclusiveRangeStaticType)
+	SetStaticTypeMemoryUsage            = NewConstantMemoryUsage(MemoryKindSetStaticType)
 
 	// Sema types
 
@@ -185,6 +188,7 @@ var (
 	EntitlementRelationSemaTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindEntitlementRelationSemaType)
 	CapabilitySemaTypeMemoryUsage          = NewConstantMemoryUsage(MemoryKindCapabilitySemaType)
 	InclusiveRangeSemaTypeMemoryUsage      = NewConstantMemoryUsage(MemoryKindInclusiveRangeSemaType)
+	SetSemaTypeMemoryUsage                 = NewConstantMemoryUsage(MemoryKindSetSemaType)
 
 	// Storage related memory usages
 
@@ -197,6 +201,7 @@ var (
 
 	CadenceDictionaryValueMemoryUsage               = NewConstantMemoryUsage(MemoryKindCadenceDictionaryValue)
 	CadenceInclusiveRangeValueMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceInclusiveRangeValue)
+	CadenceSetValueMemoryUsage                      = NewConstantMemoryUsage(MemoryKindCadenceSetValue)
 	CadenceArrayValueBaseMemoryUsage                = NewConstantMemoryUsage(MemoryKindCadenceArrayValueBase)
 	CadenceStructValueBaseMemoryUsage               = NewConstantMemoryUsage(MemoryKindCadenceStructValueBase)
 	CadenceResourceValueBaseMemoryUsage             = NewConstantMemoryUsage(MemoryKindCadenceResourceValueBase)
@@ -224,6 +229,7 @@ var (
 	CadenceContractTypeMemoryUsage             = NewConstantMemoryUsage(MemoryKindCadenceContractType)
 	CadenceDictionaryTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceDictionaryType)
 	CadenceInclusiveRangeTypeMemoryUsage       = NewConstantMemoryUsage(MemoryKindCadenceInclusiveRangeType)
+	CadenceSetTypeMemoryUsage                  = NewConstantMemoryUsage(MemoryKindCadenceSetType)
 	CadenceEnumTypeMemoryUsage                 = NewConstantMemoryUsage(MemoryKindCadenceEnumType)
 	CadenceEventTypeMemoryUsage                = NewConstantMemoryUsage(MemoryKindCadenceEventType)
 	CadenceFunctionTypeMemoryUsage             = NewConstantMemoryUsage(MemoryKindCadenceFunctionType)
@@ -273,6 +279,7 @@ var (
 	IntersectionStaticTypeStringMemoryUsage          = NewRawStringMemoryUsage(2)  // {}
 	IntersectionStaticTypeSeparatorStringMemoryUsage = NewRawStringMemoryUsage(2)  // ,
 	InclusiveRangeStaticTypeStringMemoryUsage        = NewRawStringMemoryUsage(16) // InclusiveRange<>
+	SetStaticTypeStringMemoryUsage                   = NewRawStringMemoryUsage(5)  // Set<>
 )
 
 func UseMemory(gauge MemoryGauge, usage MemoryUsage) {
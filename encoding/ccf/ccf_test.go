@@ -2861,6 +2861,190 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeFix128(t *testing.T) {
+
+	t.Parallel()
+
+	positive, err := cadence.NewFix128("1.5")
+	require.NoError(t, err)
+
+	negative, err := cadence.NewFix128("-1.5")
+	require.NoError(t, err)
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			name: "Zero",
+			val:  cadence.Fix128{Value: big.NewInt(0)},
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Fix128","value":"0.000000000000000000000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(99), 0])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Fix128 type ID (99)
+				0x18, 0x63,
+				// tag big num
+				0xc2,
+				// bytes, 0 bytes follow
+				0x40,
+			},
+		},
+		{
+			name: "Positive",
+			val:  positive,
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Fix128","value":"1.500000000000000000000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(99), 1500000000000000000000000])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Fix128 type ID (99)
+				0x18, 0x63,
+				// tag big num
+				0xc2,
+				// bytes, 11 bytes follow
+				0x4b,
+				// 1500000000000000000000000
+				0x01, 0x3d, 0xa3, 0x29, 0xb6, 0x33, 0x64, 0x71, 0x80, 0x00, 0x00,
+			},
+		},
+		{
+			name: "Negative",
+			val:  negative,
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Fix128","value":"-1.500000000000000000000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(99), -1500000000000000000000000])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Fix128 type ID (99)
+				0x18, 0x63,
+				// tag big num
+				0xc3,
+				// bytes, 11 bytes follow
+				0x4b,
+				// -1500000000000000000000000
+				0x01, 0x3d, 0xa3, 0x29, 0xb6, 0x33, 0x64, 0x71, 0x7f, 0xff, 0xff,
+			},
+		},
+	}...)
+}
+
+func TestEncodeUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	positive, err := cadence.NewUFix128("1.5")
+	require.NoError(t, err)
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			name: "Zero",
+			val:  cadence.UFix128{Value: big.NewInt(0)},
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"UFix128","value":"0.000000000000000000000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(100), 0])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// UFix128 type ID (100)
+				0x18, 0x64,
+				// tag big num
+				0xc2,
+				// bytes, 0 bytes follow
+				0x40,
+			},
+		},
+		{
+			name: "Positive",
+			val:  positive,
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"UFix128","value":"1.500000000000000000000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(100), 1500000000000000000000000])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// UFix128 type ID (100)
+				0x18, 0x64,
+				// tag big num
+				0xc2,
+				// bytes, 11 bytes follow
+				0x4b,
+				// 1500000000000000000000000
+				0x01, 0x3d, 0xa3, 0x29, 0xb6, 0x33, 0x64, 0x71, 0x80, 0x00, 0x00,
+			},
+		},
+		{
+			name: "Max",
+			val:  cadence.UFix128{Value: sema.UFix128TypeMaxBig},
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"UFix128","value":"340282366920938.463463374607431768211455"}
+				//
+				// language=edn, format=ccf
+				// 130([137(100), 340282366920938463463374607431768211455])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// UFix128 type ID (100)
+				0x18, 0x64,
+				// tag big num
+				0xc2,
+				// bytes, 16 bytes follow
+				0x50,
+				// 340282366920938463463374607431768211455
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			},
+		},
+	}...)
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
@@ -4948,6 +5132,130 @@ func TestEncodeSortedDictionary(t *testing.T) {
 	}
 }
 
+func TestEncodeSet(t *testing.T) {
+
+	t.Parallel()
+
+	emptySet := encodeTest{
+		name: "Empty",
+		val:  cadence.NewSet([]cadence.Value{}).WithType(cadence.NewSetType(cadence.IntType)),
+		expected: []byte{
+			// language=json, format=json-cdc
+			// {"type":"Set","value":[]}
+			//
+			// language=edn, format=ccf
+			// 130([148(137(4)), []])
+			//
+			// language=cbor, format=ccf
+			// tag
+			0xd8, ccf.CBORTagTypeAndValue,
+			// array, 2 items follow
+			0x82,
+			// type (Set<Int>)
+			// tag
+			0xd8, ccf.CBORTagSetType,
+			// tag
+			0xd8, ccf.CBORTagSimpleType,
+			// Int type ID (4)
+			0x04,
+			// array data without inlined type definition
+			// array, 0 items follow
+			0x80,
+		},
+	}
+
+	testAllEncodeAndDecode(t, emptySet)
+
+	t.Run("sorted", func(t *testing.T) {
+		t.Parallel()
+
+		set := cadence.NewSet([]cadence.Value{
+			cadence.String("c"),
+			cadence.String("a"),
+			cadence.String("b"),
+		}).WithType(cadence.NewSetType(cadence.StringType))
+
+		expectedSet := cadence.NewSet([]cadence.Value{
+			cadence.String("a"),
+			cadence.String("b"),
+			cadence.String("c"),
+		}).WithType(cadence.NewSetType(cadence.StringType))
+
+		actualCBOR := testEncode(
+			t,
+			set,
+			[]byte{
+				// language=json, format=json-cdc
+				// {"type":"Set","value":[{"type":"String","value":"a"},{"type":"String","value":"b"},{"type":"String","value":"c"}]}
+				//
+				// language=edn, format=ccf
+				// 130([148(137(1)), ["a", "b", "c"]])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// type (Set<String>)
+				// tag
+				0xd8, ccf.CBORTagSetType,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// String type ID (1)
+				0x01,
+				// array data without inlined type definition
+				// array, 3 items follow
+				0x83,
+				// string, 1 bytes follow
+				0x61,
+				// a
+				0x61,
+				// string, 1 bytes follow
+				0x61,
+				// b
+				0x62,
+				// string, 1 bytes follow
+				0x61,
+				// c
+				0x63,
+			},
+		)
+		testDecode(t, actualCBOR, expectedSet)
+	})
+
+	t.Run("unsorted", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ccf.Decode(nil, []byte{
+			// language=edn, format=ccf
+			// 130([148(137(1)), ["b", "a"]])
+			//
+			// language=cbor, format=ccf
+			// tag
+			0xd8, ccf.CBORTagTypeAndValue,
+			// array, 2 items follow
+			0x82,
+			// tag
+			0xd8, ccf.CBORTagSetType,
+			// tag
+			0xd8, ccf.CBORTagSimpleType,
+			// String type ID (1)
+			0x01,
+			// array, 2 items follow
+			0x82,
+			// string, 1 bytes follow
+			0x61,
+			// b
+			0x62,
+			// string, 1 bytes follow
+			0x61,
+			// a
+			0x61,
+		})
+		require.Error(t, err)
+	})
+}
+
 func exportFromScript(t *testing.T, code string) cadence.Value {
 	checker, err := ParseAndCheck(t, code)
 	require.NoError(t, err)
@@ -8504,7 +8812,9 @@ func TestEncodeSimpleTypes(t *testing.T) {
 		ccf.SimpleTypeWord128:                          cadence.Word128Type,
 		ccf.SimpleTypeWord256:                          cadence.Word256Type,
 		ccf.SimpleTypeFix64:                            cadence.Fix64Type,
+		ccf.SimpleTypeFix128:                           cadence.Fix128Type,
 		ccf.SimpleTypeUFix64:                           cadence.UFix64Type,
+		ccf.SimpleTypeUFix128:                          cadence.UFix128Type,
 		ccf.SimpleTypeBlock:                            cadence.BlockType,
 		ccf.SimpleTypePath:                             cadence.PathType,
 		ccf.SimpleTypeCapabilityPath:                   cadence.CapabilityPathType,
@@ -8833,6 +9143,41 @@ func TestEncodeType(t *testing.T) {
 
 	})
 
+	t.Run("with static Set<Int>", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: cadence.NewSetType(cadence.IntType),
+			},
+			[]byte{
+				// language=json, format=json-cdc
+				// {"type":"Type","value":{"staticType":{"kind":"Set", "type" : {"kind" : "Int"}}}}
+				//
+				// language=edn, format=ccf
+				// 130([137(41), 197(185(4))])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 elements follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Meta type ID (41)
+				0x18, 0x29,
+				// tag
+				0xd8, ccf.CBORTagSetTypeValue,
+				// tag
+				0xd8, ccf.CBORTagSimpleTypeValue,
+				// Int type (4)
+				0x04,
+			},
+		)
+
+	})
+
 	t.Run("with static struct with no field", func(t *testing.T) {
 		t.Parallel()
 
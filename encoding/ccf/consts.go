@@ -72,7 +72,7 @@ const (
 	CBORTagInclusiveRangeType
 	CBORTagEntitlementSetAuthorizationAccessType
 	CBORTagEntitlementMapAuthorizationAccessType
-	_
+	CBORTagSetType
 	_
 	_
 	_
@@ -128,7 +128,7 @@ const (
 	CBORTagInclusiveRangeTypeValue
 	CBORTagEntitlementSetAuthorizationAccessTypeValue
 	CBORTagEntitlementMapAuthorizationAccessTypeValue
-	_
+	CBORTagSetTypeValue
 	_
 	_
 	_
@@ -505,6 +505,12 @@ func (d *Decoder) decodeValue(t cadence.Type, types *cadenceTypeByCCFTypeID) (ca
 	case cadence.UFix64Type:
 		return d.decodeUFix64()
 
+	case cadence.Fix128Type:
+		return d.decodeFix128()
+
+	case cadence.UFix128Type:
+		return d.decodeUFix128()
+
 	case cadence.StoragePathType:
 		return d.decodePath()
 
@@ -568,6 +574,9 @@ func (d *Decoder) decodeValue(t cadence.Type, types *cadenceTypeByCCFTypeID) (ca
 	case *cadence.InclusiveRangeType:
 		return d.decodeInclusiveRange(t, types)
 
+	case *cadence.SetType:
+		return d.decodeSet(t, types)
+
 	default:
 		nt, err := d.dec.NextType()
 		if err != nil {
@@ -1025,6 +1034,30 @@ func (d *Decoder) decodeUFix64() (cadence.Value, error) {
 	return cadence.NewMeteredUFix64FromRawFixedPointNumber(d.gauge, i)
 }
 
+// decodeFix128 decodes fix128-value as
+// language=CDDL
+// fix128-value = bigint
+func (d *Decoder) decodeFix128() (cadence.Value, error) {
+	// NewMeteredFix128FromRawFixedPointNumber checks if decoded big.Int is in range.
+	i, err := d.dec.DecodeBigInt()
+	if err != nil {
+		return nil, err
+	}
+	return cadence.NewMeteredFix128FromRawFixedPointNumber(d.gauge, i)
+}
+
+// decodeUFix128 decodes ufix128-value as
+// language=CDDL
+// ufix128-value = bigint .ge 0
+func (d *Decoder) decodeUFix128() (cadence.Value, error) {
+	// NewMeteredUFix128FromRawFixedPointNumber checks if decoded big.Int is in range.
+	i, err := d.dec.DecodeBigInt()
+	if err != nil {
+		return nil, err
+	}
+	return cadence.NewMeteredUFix128FromRawFixedPointNumber(d.gauge, i)
+}
+
 // decodeOptional decodes encoded optional-value as
 // language=CDDL
 // optional-value = nil / value
@@ -1360,6 +1393,66 @@ func (d *Decoder) decodeAttachment(typ *cadence.AttachmentType, types *cadenceTy
 	return v.WithType(typ), nil
 }
 
+// decodeSet decodes encoded set-value as
+// language=CDDL
+// set-value = [* value]
+func (d *Decoder) decodeSet(typ *cadence.SetType, types *cadenceTypeByCCFTypeID) (cadence.Value, error) {
+	// Decode array length.
+	n, err := d.dec.DecodeArrayHead()
+	if err != nil {
+		return nil, err
+	}
+
+	elementCount := int(n)
+
+	value, err := cadence.NewMeteredSet(
+		d.gauge,
+		elementCount,
+		func() ([]cadence.Value, error) {
+			values := make([]cadence.Value, elementCount)
+
+			// previousElementRawBytes is used to determine if set elements are sorted
+			var previousElementRawBytes []byte
+
+			for i := 0; i < elementCount; i++ {
+				// Decode element as raw bytes to check that elements are sorted.
+				elementRawBytes, err := d.dec.DecodeRawBytes()
+				if err != nil {
+					return nil, err
+				}
+
+				// "Deterministic CCF Encoding Requirements" in CCF specs:
+				//
+				//   "set-value elements MUST be sorted."
+				if !bytesAreSortedBytewise(previousElementRawBytes, elementRawBytes) {
+					return nil, fmt.Errorf("encoded set-value elements are not sorted")
+				}
+
+				previousElementRawBytes = elementRawBytes
+
+				// decode element from raw bytes
+				elementDecoder := d.dm.NewDecoder(d.gauge, elementRawBytes)
+				element, err := elementDecoder.decodeValue(typ.ElementType, types)
+				if err != nil {
+					return nil, err
+				}
+
+				values[i] = element
+			}
+
+			// Here, decoder doesn't check uniqueness of set elements
+			// because checking is delegated (entrusted) to Cadence runtime,
+			// like for the keys of dict-value.
+			return values, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.WithType(typ), nil
+}
+
 // decodeInclusiveRange decodes encoded inclusiverange-value as
 // language=CDDL
 // inclusiverange-value = [
@@ -1551,6 +1644,9 @@ func (d *Decoder) decodeTypeValue(visited *cadenceTypeByCCFTypeID) (cadence.Type
 	case CBORTagInclusiveRangeTypeValue:
 		return d.decodeInclusiveRangeType(visited, d.decodeTypeValue)
 
+	case CBORTagSetTypeValue:
+		return d.decodeSetType(visited, d.decodeTypeValue)
+
 	case CBORTagCapabilityTypeValue:
 		return d.decodeCapabilityType(visited, d.decodeNullableTypeValue)
 
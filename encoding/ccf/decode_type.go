@@ -75,6 +75,9 @@ func (d *Decoder) decodeInlineType(types *cadenceTypeByCCFTypeID) (cadence.Type,
 	case CBORTagInclusiveRangeType:
 		return d.decodeInclusiveRangeType(types, d.decodeInlineType)
 
+	case CBORTagSetType:
+		return d.decodeSetType(types, d.decodeInlineType)
+
 	case CBORTagReferenceType:
 		return d.decodeReferenceType(types, d.decodeInlineType, true)
 
@@ -307,6 +310,36 @@ func (d *Decoder) decodeInclusiveRangeType(
 	return cadence.NewMeteredInclusiveRangeType(d.gauge, elementType), nil
 }
 
+// decodeSetType decodes set-type or set-type-value as
+// language=CDDL
+// set-type =
+//
+//	; cbor-tag-set-type
+//	#6.148(inline-type)
+//
+// set-type-value =
+//
+//	; cbor-tag-set-type-value
+//	#6.197(type-value)
+//
+// NOTE: decodeTypeFn is responsible for decoding inline-type or type-value.
+func (d *Decoder) decodeSetType(
+	types *cadenceTypeByCCFTypeID,
+	decodeTypeFn decodeTypeFn,
+) (cadence.Type, error) {
+	// element 0: element type (inline-type or type-value)
+	elementType, err := decodeTypeFn(types)
+	if err != nil {
+		return nil, err
+	}
+
+	if elementType == nil {
+		return nil, errors.New("unexpected nil type as Set element type")
+	}
+
+	return cadence.NewMeteredSetType(d.gauge, elementType), nil
+}
+
 // decodeCapabilityType decodes capability-type or capability-type-value as
 // language=CDDL
 // capability-type =
@@ -604,6 +604,12 @@ func (e *Encoder) encodeValue(
 	case cadence.UFix64:
 		return e.encodeUFix64(v)
 
+	case cadence.Fix128:
+		return e.encodeFix128(v)
+
+	case cadence.UFix128:
+		return e.encodeUFix128(v)
+
 	case cadence.Array:
 		return e.encodeArray(v, tids)
 
@@ -613,6 +619,9 @@ func (e *Encoder) encodeValue(
 	case *cadence.InclusiveRange:
 		return e.encodeInclusiveRange(v, tids)
 
+	case cadence.Set:
+		return e.encodeSet(v, tids)
+
 	case cadence.Struct:
 		return e.encodeStruct(v, tids)
 
@@ -872,6 +881,20 @@ func (e *Encoder) encodeUFix64(v cadence.UFix64) error {
 	return e.enc.EncodeUint64(uint64(v))
 }
 
+// encodeFix128 encodes cadence.Fix128 as
+// language=CDDL
+// fix128-value = bigint
+func (e *Encoder) encodeFix128(v cadence.Fix128) error {
+	return e.enc.EncodeBigInt(v.Big())
+}
+
+// encodeUFix128 encodes cadence.UFix128 as
+// language=CDDL
+// ufix128-value = bigint .ge 0
+func (e *Encoder) encodeUFix128(v cadence.UFix128) error {
+	return e.enc.EncodeBigInt(v.Big())
+}
+
 // encodeArray encodes cadence.Array as
 // language=CDDL
 // array-value = [* value]
@@ -1061,6 +1084,116 @@ func (e *Encoder) encodeInclusiveRange(v *cadence.InclusiveRange, tids ccfTypeID
 	return e.encodeValue(v.Step, staticElementType, tids)
 }
 
+// encodeSet encodes cadence.Set as
+// language=CDDL
+// set-value = [* value]
+func (e *Encoder) encodeSet(v cadence.Set, tids ccfTypeIDByCadenceType) error {
+	if len(v.Values) > 1 {
+		return e.encodeSortedSet(v, tids)
+	}
+
+	staticElementType := v.SetType.ElementType
+
+	// Encode array head with number of set elements.
+	err := e.enc.EncodeArrayHead(uint64(len(v.Values)))
+	if err != nil {
+		return err
+	}
+
+	for _, element := range v.Values {
+		// Encode element as value.
+		err = e.encodeValue(element, staticElementType, tids)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodeSortedSet encodes cadence.Set as
+// language=CDDL
+// set-value = [* value]
+func (e *Encoder) encodeSortedSet(v cadence.Set, tids ccfTypeIDByCadenceType) error {
+	// "Deterministic CCF Encoding Requirements" in CCF specs:
+	//
+	//   "set-value elements MUST be sorted."
+
+	// Use a new buffer for sorting elements.
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	// Encode and sort elements.
+	sortedElements, err := encodeAndSortSetElements(buf, v, tids, e.em)
+	if err != nil {
+		return err
+	}
+
+	// Encode array head with number of set elements.
+	err = e.enc.EncodeArrayHead(uint64(len(v.Values)))
+	if err != nil {
+		return err
+	}
+
+	for _, element := range sortedElements {
+		// Encode element.
+		err = e.enc.EncodeRawBytes(element)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeAndSortSetElements(
+	buf *bytes.Buffer,
+	v cadence.Set,
+	tids ccfTypeIDByCadenceType,
+	em *encMode,
+) (
+	[][]byte,
+	error,
+) {
+	staticElementType := v.SetType.ElementType
+
+	elementLengths := make([]int, len(v.Values))
+
+	e := em.NewEncoder(buf)
+
+	for i, element := range v.Values {
+
+		off := buf.Len()
+
+		// Encode set element as value.
+		err := e.encodeValue(element, staticElementType, tids)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get encoded element length (must flush first).
+		e.enc.Flush()
+		elementLengths[i] = buf.Len() - off
+	}
+
+	// Reslice buf for encoded elements by offset and length.
+	encodedElements := make([][]byte, len(v.Values))
+	b := buf.Bytes()
+	off := 0
+	for i, elementLength := range elementLengths {
+		encodedElements[i] = b[off : off+elementLength]
+		off += elementLength
+	}
+	if off != len(b) {
+		// Sanity check
+		panic(cadenceErrors.NewUnexpectedError("encoded set elements' offset %d doesn't match buffer length %d", off, len(b)))
+	}
+
+	sort.Sort(bytewiseEncodedValueSorter(encodedElements))
+
+	return encodedElements, nil
+}
+
 //go:linkname getCompositeFieldValues github.com/onflow/cadence.getCompositeFieldValues
 func getCompositeFieldValues(cadence.Composite) []cadence.Value
 
@@ -1403,6 +1536,9 @@ func (e *Encoder) encodeTypeValue(typ cadence.Type, visited ccfTypeIDByCadenceTy
 	case *cadence.InclusiveRangeType:
 		return e.encodeInclusiveRangeTypeValue(typ, visited)
 
+	case *cadence.SetType:
+		return e.encodeSetTypeValue(typ, visited)
+
 	case *cadence.StructInterfaceType:
 		return e.encodeStructInterfaceTypeValue(typ, visited)
 
@@ -1548,6 +1684,22 @@ func (e *Encoder) encodeInclusiveRangeTypeValue(typ *cadence.InclusiveRangeType,
 	)
 }
 
+// encodeSetTypeValue encodes cadence.SetType as
+// language=CDDL
+// set-type-value =
+//
+//	; cbor-tag-set-type-value
+//	#6.197(type-value)
+func (e *Encoder) encodeSetTypeValue(typ *cadence.SetType, visited ccfTypeIDByCadenceType) error {
+	rawTagNum := []byte{0xd8, CBORTagSetTypeValue}
+	return e.encodeSetTypeWithRawTag(
+		typ,
+		visited,
+		e.encodeTypeValue,
+		rawTagNum,
+	)
+}
+
 // encodeReferenceTypeValue encodes cadence.ReferenceType as
 // language=CDDL
 // reference-type-value =
@@ -139,6 +139,8 @@ const ( // Cadence simple type IDs
 	SimpleTypeAccountMapping
 	SimpleTypeHashableStruct
 	SimpleTypeFixedSizeUnsignedInteger
+	SimpleTypeFix128
+	SimpleTypeUFix128
 
 	// !!! *WARNING* !!!
 	// ADD NEW TYPES *BEFORE* THIS WARNING.
@@ -197,6 +199,8 @@ func initSimpleTypeIDBiMap() (m *bimap.BiMap[cadence.PrimitiveType, SimpleType])
 	m.Insert(cadence.Word256Type, SimpleTypeWord256)
 	m.Insert(cadence.Fix64Type, SimpleTypeFix64)
 	m.Insert(cadence.UFix64Type, SimpleTypeUFix64)
+	m.Insert(cadence.Fix128Type, SimpleTypeFix128)
+	m.Insert(cadence.UFix128Type, SimpleTypeUFix128)
 
 	m.Insert(cadence.BlockType, SimpleTypeBlock)
 	m.Insert(cadence.PathType, SimpleTypePath)
@@ -94,6 +94,23 @@ func (x bytewiseKeyValuePairSorter) Less(i, j int) bool {
 	return bytes.Compare(x[i].encodedKey, x[j].encodedKey) <= 0
 }
 
+// bytewiseEncodedValueSorter
+
+// bytewiseEncodedValueSorter is used to sort encoded values, e.g. set elements.
+type bytewiseEncodedValueSorter [][]byte
+
+func (x bytewiseEncodedValueSorter) Len() int {
+	return len(x)
+}
+
+func (x bytewiseEncodedValueSorter) Swap(i, j int) {
+	x[i], x[j] = x[j], x[i]
+}
+
+func (x bytewiseEncodedValueSorter) Less(i, j int) bool {
+	return bytes.Compare(x[i], x[j]) <= 0
+}
+
 // bytewiseCadenceTypeInPlaceSorter
 
 // bytewiseCadenceTypeInPlaceSorter is used to sort Cadence types by Cadence type ID.
@@ -96,6 +96,11 @@ func (ct *compositeTypes) traverseValue(v cadence.Value) {
 			ct.traverseValue(pair.Value)
 		}
 
+	case cadence.Set:
+		for _, element := range v.Values {
+			ct.traverseValue(element)
+		}
+
 	case cadence.Struct:
 		for _, field := range getCompositeFieldValues(v) {
 			ct.traverseValue(field)
@@ -142,6 +147,9 @@ func (ct *compositeTypes) traverseType(typ cadence.Type) (checkRuntimeType bool)
 		checkValueRuntimeType := ct.traverseType(typ.ElementType)
 		return checkKeyRuntimeType || checkValueRuntimeType
 
+	case *cadence.SetType:
+		return ct.traverseType(typ.ElementType)
+
 	case *cadence.CapabilityType:
 		return ct.traverseType(typ.BorrowType)
 
@@ -221,6 +229,8 @@ func (ct *compositeTypes) traverseType(typ cadence.Type) (checkRuntimeType bool)
 		cadence.Word256Type,
 		cadence.Fix64Type,
 		cadence.UFix64Type,
+		cadence.Fix128Type,
+		cadence.UFix128Type,
 		cadence.PathType,
 		cadence.StoragePathType,
 		cadence.PublicPathType,
@@ -232,6 +232,10 @@ func (d *Decoder) DecodeJSON(v any) cadence.Value {
 		return d.decodeFix64(valueJSON)
 	case ufix64TypeStr:
 		return d.decodeUFix64(valueJSON)
+	case fix128TypeStr:
+		return d.decodeFix128(valueJSON)
+	case ufix128TypeStr:
+		return d.decodeUFix128(valueJSON)
 	case arrayTypeStr:
 		return d.decodeArray(valueJSON)
 	case dictionaryTypeStr:
@@ -246,6 +250,8 @@ func (d *Decoder) DecodeJSON(v any) cadence.Value {
 		return d.decodeContract(valueJSON)
 	case inclusiveRangeTypeStr:
 		return d.decodeInclusiveRange(valueJSON)
+	case setTypeStr:
+		return d.decodeSet(valueJSON)
 	case pathTypeStr:
 		return d.decodePath(valueJSON)
 	case typeTypeStr:
@@ -660,6 +666,26 @@ func (d *Decoder) decodeUFix64(valueJSON any) cadence.UFix64 {
 	return v
 }
 
+func (d *Decoder) decodeFix128(valueJSON any) cadence.Fix128 {
+	v, err := cadence.NewMeteredFix128(d.gauge, func() (string, error) {
+		return toString(valueJSON), nil
+	})
+	if err != nil {
+		panic(errors.NewDefaultUserError("invalid Fix128: %w", err))
+	}
+	return v
+}
+
+func (d *Decoder) decodeUFix128(valueJSON any) cadence.UFix128 {
+	v, err := cadence.NewMeteredUFix128(d.gauge, func() (string, error) {
+		return toString(valueJSON), nil
+	})
+	if err != nil {
+		panic(errors.NewDefaultUserError("invalid UFix128: %w", err))
+	}
+	return v
+}
+
 func (d *Decoder) decodeArray(valueJSON any) cadence.Array {
 	v := toSlice(valueJSON)
 
@@ -918,6 +944,27 @@ func (d *Decoder) decodeInclusiveRange(valueJSON any) *cadence.InclusiveRange {
 	))
 }
 
+func (d *Decoder) decodeSet(valueJSON any) cadence.Set {
+	v := toSlice(valueJSON)
+
+	value, err := cadence.NewMeteredSet(
+		d.gauge,
+		len(v),
+		func() ([]cadence.Value, error) {
+			values := make([]cadence.Value, len(v))
+			for i, val := range v {
+				values[i] = d.DecodeJSON(val)
+			}
+			return values, nil
+		},
+	)
+
+	if err != nil {
+		panic(errors.NewDefaultUserError("invalid set: %w", err))
+	}
+	return value
+}
+
 func (d *Decoder) decodePath(valueJSON any) cadence.Path {
 	obj := toObject(valueJSON)
 
@@ -1329,6 +1376,11 @@ func (d *Decoder) decodeType(valueJSON any, results typeDecodingResults) cadence
 			d.gauge,
 			d.decodeType(obj.Get(elementKey), results),
 		)
+	case "Set":
+		return cadence.NewMeteredSetType(
+			d.gauge,
+			d.decodeType(obj.Get(typeKey), results),
+		)
 	case "ConstantSizedArray":
 		size := toUInt(obj.Get(sizeKey))
 		return cadence.NewMeteredConstantSizedArrayType(
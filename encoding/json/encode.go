@@ -31,6 +31,7 @@ import (
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/format"
 	"github.com/onflow/cadence/sema"
 )
 
@@ -258,6 +259,8 @@ const (
 	word256TypeStr        = "Word256"
 	fix64TypeStr          = "Fix64"
 	ufix64TypeStr         = "UFix64"
+	fix128TypeStr         = "Fix128"
+	ufix128TypeStr        = "UFix128"
 	arrayTypeStr          = "Array"
 	dictionaryTypeStr     = "Dictionary"
 	structTypeStr         = "Struct"
@@ -271,6 +274,7 @@ const (
 	enumTypeStr           = "Enum"
 	functionTypeStr       = "Function"
 	inclusiveRangeTypeStr = "InclusiveRange"
+	setTypeStr            = "Set"
 )
 
 // Prepare traverses the object graph of the provided value and constructs
@@ -333,12 +337,18 @@ func Prepare(v cadence.Value) jsonValue {
 		return prepareFix64(v)
 	case cadence.UFix64:
 		return prepareUFix64(v)
+	case cadence.Fix128:
+		return prepareFix128(v)
+	case cadence.UFix128:
+		return prepareUFix128(v)
 	case cadence.Array:
 		return prepareArray(v)
 	case cadence.Dictionary:
 		return prepareDictionary(v)
 	case *cadence.InclusiveRange:
 		return prepareInclusiveRange(v)
+	case cadence.Set:
+		return prepareSet(v)
 	case cadence.Struct:
 		return prepareStruct(v)
 	case cadence.Resource:
@@ -565,6 +575,20 @@ func prepareUFix64(v cadence.UFix64) jsonValue {
 	}
 }
 
+func prepareFix128(v cadence.Fix128) jsonValue {
+	return jsonValueObject{
+		Type:  fix128TypeStr,
+		Value: format.Fix128(v.Big()),
+	}
+}
+
+func prepareUFix128(v cadence.UFix128) jsonValue {
+	return jsonValueObject{
+		Type:  ufix128TypeStr,
+		Value: format.UFix128(v.Big()),
+	}
+}
+
 func prepareArray(v cadence.Array) jsonValue {
 	values := make([]jsonValue, len(v.Values))
 
@@ -605,6 +629,19 @@ func prepareInclusiveRange(v *cadence.InclusiveRange) jsonValue {
 	}
 }
 
+func prepareSet(v cadence.Set) jsonValue {
+	values := make([]jsonValue, len(v.Values))
+
+	for i, value := range v.Values {
+		values[i] = Prepare(value)
+	}
+
+	return jsonValueObject{
+		Type:  setTypeStr,
+		Value: values,
+	}
+}
+
 //go:linkname getCompositeFieldValues github.com/onflow/cadence.getCompositeFieldValues
 func getCompositeFieldValues(cadence.Composite) []cadence.Value
 
@@ -862,6 +899,11 @@ func PrepareType(typ cadence.Type, results TypePreparationResults) jsonValue {
 			Kind:        "InclusiveRange",
 			ElementType: PrepareType(typ.ElementType, results),
 		}
+	case *cadence.SetType:
+		return jsonUnaryType{
+			Kind: "Set",
+			Type: PrepareType(typ.ElementType, results),
+		}
 	case *cadence.StructType:
 		return jsonNominalType{
 			Kind:         "Struct",
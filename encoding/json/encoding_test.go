@@ -736,6 +736,90 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeFix128(t *testing.T) {
+
+	t.Parallel()
+
+	positive, err := cadence.NewFix128("789.000000000000000000123010")
+	require.NoError(t, err)
+
+	negative, err := cadence.NewFix128("-12345.006789")
+	require.NoError(t, err)
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.Fix128{Value: big.NewInt(0)},
+			// language=json
+			`{"type":"Fix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"789.000000000000000000123010",
+			positive,
+			// language=json
+			`{"type":"Fix128","value":"789.000000000000000000123010"}`,
+		},
+		{
+			"-12345.006789",
+			negative,
+			// language=json
+			`{"type":"Fix128","value":"-12345.006789000000000000000000"}`,
+		},
+		{
+			"Min",
+			cadence.Fix128{Value: sema.Fix128TypeMinBig},
+			// language=json
+			`{"type":"Fix128","value":"-170141183460469.231731687303715884105728"}`,
+		},
+	}...)
+}
+
+func TestEncodeUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	value, err := cadence.NewUFix128("1234.056")
+	require.NoError(t, err)
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.UFix128{Value: big.NewInt(0)},
+			// language=json
+			`{"type":"UFix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"1234.056",
+			value,
+			// language=json
+			`{"type":"UFix128","value":"1234.056000000000000000000000"}`,
+		},
+		{
+			"Max",
+			cadence.UFix128{Value: sema.UFix128TypeMaxBig},
+			// language=json
+			`{"type":"UFix128","value":"340282366920938.463463374607431768211455"}`,
+		},
+	}...)
+}
+
+func TestDecodeInvalidFix128(t *testing.T) {
+
+	t.Parallel()
+
+	for _, input := range []string{
+		// language=json
+		`{"type":"Fix128","value":"1.0000000000000000000000001"}`,
+		// language=json
+		`{"type":"Fix128","value":"170141183460470.0"}`,
+		// language=json
+		`{"type":"UFix128","value":"-1.0"}`,
+	} {
+		_, err := Decode(nil, []byte(input))
+		require.Error(t, err, input)
+	}
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
@@ -1493,6 +1577,44 @@ func TestEncodeInclusiveRange(t *testing.T) {
 	testAllEncodeAndDecode(t, simpleInclusiveRange)
 }
 
+func TestEncodeSet(t *testing.T) {
+
+	t.Parallel()
+
+	emptySet := encodeTest{
+		"Empty",
+		cadence.NewSet([]cadence.Value{}),
+		// language=json
+		`{"type":"Set","value":[]}`,
+	}
+
+	stringSet := encodeTest{
+		"Strings",
+		cadence.NewSet([]cadence.Value{
+			cadence.String("a"),
+			cadence.String("b"),
+		}),
+		// language=json
+		`
+          {
+            "type": "Set",
+            "value": [
+              {
+                "type": "String",
+                "value": "a"
+              },
+              {
+                "type": "String",
+                "value": "b"
+              }
+            ]
+          }
+        `,
+	}
+
+	testAllEncodeAndDecode(t, emptySet, stringSet)
+}
+
 func TestEncodeEvent(t *testing.T) {
 
 	t.Parallel()
@@ -1909,6 +2031,31 @@ func TestEncodeType(t *testing.T) {
 
 	})
 
+	t.Run("with static Set<String>", func(t *testing.T) {
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: cadence.NewSetType(cadence.StringType),
+			},
+			// language=json
+			`
+				{
+				"type": "Type",
+				"value": {
+					"staticType": {
+					"kind": "Set",
+					"type": {
+						"kind": "String"
+					}
+					}
+				}
+				}
+			`,
+		)
+
+	})
+
 	t.Run("with static struct", func(t *testing.T) {
 
 		testEncodeAndDecode(
@@ -57,3 +57,63 @@ func TestParseFixedPoint(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFix128(t *testing.T) {
+
+	t.Parallel()
+
+	parse := func(s string) *big.Int {
+		result, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			panic("invalid test input")
+		}
+		return result
+	}
+
+	for input, expected := range map[string]*big.Int{
+		"0.0":                         big.NewInt(0),
+		"1.0":                         parse("1000000000000000000000000"),
+		"-1.5":                        parse("-1500000000000000000000000"),
+		"0.000000000000000000000001":  big.NewInt(1),
+		"-0.000000000000000000000001": big.NewInt(-1),
+		"170141183460469.231731687303715884105727":  parse("170141183460469231731687303715884105727"),
+		"-170141183460469.231731687303715884105728": parse("-170141183460469231731687303715884105728"),
+	} {
+		t.Run(input, func(t *testing.T) {
+			result, err := ParseFix128(input)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+	}
+
+	for _, input := range []string{
+		"1",
+		"0.0000000000000000000000001",
+		"170141183460469.231731687303715884105728",
+		"-170141183460469.231731687303715884105729",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseFix128(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	result, err := ParseUFix128("340282366920938.463463374607431768211455")
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1)), result)
+
+	for _, input := range []string{
+		"-1.0",
+		"340282366920938.463463374607431768211456",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseUFix128(input)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -19,6 +19,7 @@
 package format
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -30,3 +31,33 @@ func TestUFix64(t *testing.T) {
 
 	require.Equal(t, "99999999999.70000000", UFix64(9999999999970000000))
 }
+
+func TestFix128(t *testing.T) {
+
+	t.Parallel()
+
+	parse := func(s string) *big.Int {
+		result, ok := new(big.Int).SetString(s, 10)
+		require.True(t, ok)
+		return result
+	}
+
+	require.Equal(t, "0.000000000000000000000000", Fix128(big.NewInt(0)))
+	require.Equal(t, "-0.000000000000000000000001", Fix128(big.NewInt(-1)))
+	require.Equal(t, "-1.500000000000000000000000", Fix128(parse("-1500000000000000000000000")))
+	require.Equal(t,
+		"170141183460469.231731687303715884105727",
+		Fix128(parse("170141183460469231731687303715884105727")),
+	)
+}
+
+func TestUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	require.Equal(t, "0.000000000000000000000001", UFix128(big.NewInt(1)))
+	require.Equal(t,
+		"340282366920938.463463374607431768211455",
+		UFix128(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))),
+	)
+}
@@ -86,12 +86,18 @@ func TestInterpretToString(t *testing.T) {
 
 			isSigned := sema.IsSubType(ty, sema.SignedFixedPointType)
 
+			fractional := "34000000"
+			switch ty {
+			case sema.Fix128Type, sema.UFix128Type:
+				fractional = "340000000000000000000000"
+			}
+
 			if isSigned {
 				literal = "-12.34"
-				expected = interpreter.NewUnmeteredStringValue("-12.34000000")
+				expected = interpreter.NewUnmeteredStringValue("-12." + fractional)
 			} else {
 				literal = "12.34"
-				expected = interpreter.NewUnmeteredStringValue("12.34000000")
+				expected = interpreter.NewUnmeteredStringValue("12." + fractional)
 			}
 
 			inter := parseCheckAndInterpret(t,
@@ -527,12 +533,23 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 			"-1.0":  {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			"42.0":  {0, 0, 0, 0, 0, 34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {0, 0, 0, 0, 0, 34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+			"-1.0":  {255, 255, 255, 255, 255, 255, 44, 61, 228, 49, 51, 18, 95, 0, 0, 0},
+		},
 		// UFix*
 		"UFix64": {
 			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0},
 			"42.0":  {0, 0, 0, 0, 250, 86, 234, 0},
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			"42.0":  {0, 0, 0, 0, 0, 34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {0, 0, 0, 0, 0, 34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+		},
 	}
 
 	sizes := map[string]uint{
@@ -549,6 +566,8 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 		"UInt64":  sema.UInt64TypeSize,
 		"Fix64":   sema.Fix64TypeSize,
 		"UFix64":  sema.UFix64TypeSize,
+		"Fix128":  sema.Fix128TypeSize,
+		"UFix128": sema.UFix128TypeSize,
 		"Word64":  sema.Word64TypeSize,
 		"Int128":  sema.Int128TypeSize,
 		"UInt128": sema.UInt128TypeSize,
@@ -791,6 +810,13 @@ func TestInterpretFromBigEndianBytes(t *testing.T) {
 			"[0, 0, 0, 0, 251, 197, 32, 0]":        interpreter.NewUnmeteredFix64Value(4224_000_000),          // 42.24
 			"[255, 255, 255, 255, 250, 10, 31, 0]": interpreter.NewUnmeteredFix64Value(-1 * sema.Fix64Factor), // -1.0
 		},
+		"Fix128": {
+			"[0]": interpreter.NewUnmeteredFix128Value(big.NewInt(0)),
+			"[0, 0, 0, 0, 0, 34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0]":      interpreter.NewUnmeteredFix128Value(fix128BigInt(42, 0)),   // 42.0
+			"[34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0]":                     interpreter.NewUnmeteredFix128Value(fix128BigInt(42, 0)),   // 42.0 with padding
+			"[0, 0, 0, 0, 0, 34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0]":         interpreter.NewUnmeteredFix128Value(fix128BigInt(4224, 2)), // 42.24
+			"[255, 255, 255, 255, 255, 255, 44, 61, 228, 49, 51, 18, 95, 0, 0, 0]": interpreter.NewUnmeteredFix128Value(fix128BigInt(-1, 0)),   // -1.0
+		},
 		// UFix*
 		"UFix64": {
 			"[0, 0, 0, 0, 0, 0, 0, 0]":      interpreter.NewUnmeteredUFix64Value(0),
@@ -798,6 +824,12 @@ func TestInterpretFromBigEndianBytes(t *testing.T) {
 			"[0, 0, 0, 0, 250, 86, 234, 0]": interpreter.NewUnmeteredUFix64Value(42 * sema.Fix64Factor), // 42.0
 			"[0, 0, 0, 0, 251, 197, 32, 0]": interpreter.NewUnmeteredUFix64Value(4224_000_000),          // 42.24
 		},
+		"UFix128": {
+			"[0]": interpreter.NewUnmeteredUFix128Value(big.NewInt(0)),
+			"[0, 0, 0, 0, 0, 34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0]": interpreter.NewUnmeteredUFix128Value(fix128BigInt(42, 0)),   // 42.0
+			"[34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0]":                interpreter.NewUnmeteredUFix128Value(fix128BigInt(42, 0)),   // 42.0 with padding
+			"[0, 0, 0, 0, 0, 34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0]":    interpreter.NewUnmeteredUFix128Value(fix128BigInt(4224, 2)), // 42.24
+		},
 	}
 
 	invalidTests := map[string][]string{
@@ -883,11 +915,19 @@ func TestInterpretFromBigEndianBytes(t *testing.T) {
 			"[0, 0, 0, 0, 0, 0, 0, 0, 0]",
 			"[0, 22, 0, 0, 0, 0, 0, 0, 0]",
 		},
+		"Fix128": {
+			"[0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]",
+			"[0, 22, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]",
+		},
 		// UFix*
 		"UFix64": {
 			"[0, 0, 0, 0, 0, 0, 0, 0, 0]",
 			"[0, 22, 0, 0, 0, 0, 0, 0, 0]",
 		},
+		"UFix128": {
+			"[0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]",
+			"[0, 22, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]",
+		},
 	}
 
 	// Ensure the test cases are complete
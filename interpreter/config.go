@@ -59,6 +59,10 @@ type Config struct {
 	OnStatement OnStatementFunc
 	// OnLoopIteration is triggered when a loop iteration is about to be executed
 	OnLoopIteration OnLoopIterationFunc
+	// OnBranch is triggered when a branch of a conditional element is about to be executed
+	OnBranch OnBranchFunc
+	// OnInterpretedFunctionInvocation is triggered when an interpreted function is about to be invoked
+	OnInterpretedFunctionInvocation OnInterpretedFunctionInvocationFunc
 	// TracingEnabled determines if tracing is enabled.
 	// Tracing reports certain operations, e.g. composite value transfers
 	TracingEnabled bool
@@ -19,31 +19,137 @@
 package interpreter
 
 import (
+	"fmt"
+	"strings"
+	"sync"
 	"sync/atomic"
 
 	"github.com/bits-and-blooms/bitset"
+	"golang.org/x/xerrors"
 
 	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/sema"
 )
 
+// Stop is a stop of the program, e.g. at a breakpoint, or after a step.
 type Stop struct {
 	Interpreter *Interpreter
 	Statement   ast.Statement
+	// Frames is the call stack at the time of the stop,
+	// ordered from the outermost to the innermost (current) frame
+	Frames []StackFrame
+	// Err is the error which is raised by the statement,
+	// if the program stopped because of an error.
+	// Continuing the program aborts it
+	Err error
 }
 
+// StackFrame is a frame of the call stack of a stopped program
+type StackFrame struct {
+	// FunctionName is the name of the invoked function.
+	// Composite functions are qualified with the name of the composite type.
+	// It is empty for function expressions and top-level code
+	FunctionName string
+	Location     common.Location
+	// Position is the position of the statement currently executed in the frame,
+	// or, for an outer frame, the position of the invocation of the inner frame's function
+	Position    ast.Position
+	Interpreter *Interpreter
+	Activation  *VariableActivation
+	// variables are the declarations of the variables declared in the frame
+	variables map[Variable]variableDeclaration
+	// variablesLimit is the number of recorded variable declarations
+	// after which the declarations of variables no longer in scope are pruned
+	variablesLimit int
+}
+
+// variableDeclaration is the declared kind and static type of a variable
+type variableDeclaration struct {
+	kind common.DeclarationKind
+	ty   sema.Type
+}
+
+// minFrameVariablesLimit is the minimum number of variable declarations
+// which are recorded for a frame before pruning
+const minFrameVariablesLimit = 64
+
+// Breakpoint is a breakpoint at a line of a location
+type Breakpoint struct {
+	Location common.Location
+	Line     uint
+	// Condition is an optional boolean expression.
+	// The breakpoint is only hit if the condition evaluates to true
+	// in the scope of the statement at the breakpoint
+	Condition string
+	// HitCount is the optional number of hits
+	// after which the program stops at the breakpoint
+	HitCount uint
+	// LogMessage is an optional message, which turns the breakpoint into a logpoint:
+	// Instead of stopping the program, the message is logged.
+	// Expressions enclosed in braces, e.g. `{x}`, are evaluated and interpolated
+	LogMessage string
+	// Hits is the number of times the breakpoint was hit
+	Hits uint
+}
+
+// LogHandler is called with messages of logpoints,
+// and with errors that occurred while evaluating breakpoint conditions
+type LogHandler func(breakpoint Breakpoint, message string)
+
+type locationBreakpoints struct {
+	lines       *bitset.BitSet
+	breakpoints map[uint]*Breakpoint
+}
+
+type stepMode uint8
+
+const (
+	stepModeNone stepMode = iota
+	stepModeInto
+	stepModeOver
+	stepModeOut
+)
+
 type Debugger struct {
 	stops          chan Stop
 	continues      chan struct{}
-	breakpoints    map[common.Location]*bitset.BitSet
+	logHandler     LogHandler
 	pauseRequested uint32
+	// breakOnError determines if the program stops when an error is raised.
+	// It is non-zero if enabled
+	breakOnError uint32
+	// mutex guards the breakpoints, the step request, and the watches,
+	// which are accessed by the program's goroutine and by the debugger's client
+	mutex       sync.Mutex
+	breakpoints map[common.Location]*locationBreakpoints
+	step        stepMode
+	stepDepth   int
+	// watches are the expressions which are evaluated on every stop
+	watches []string
+	// frames is the call stack of the program.
+	// It is only accessed by the program's goroutine,
+	// or while the program is stopped
+	frames []*StackFrame
+	// globalVariables are the declarations of the global variables.
+	// Like the frames, they are only accessed by the program's goroutine,
+	// or while the program is stopped
+	globalVariables map[Variable]variableDeclaration
+	// evaluating is true while an expression is evaluated,
+	// e.g. the condition of a breakpoint
+	evaluating bool
+	// stoppedOnError is true if the program stopped because of an error,
+	// and the error is still propagating
+	stoppedOnError bool
 }
 
 func NewDebugger() *Debugger {
 	return &Debugger{
-		stops:       make(chan Stop),
-		continues:   make(chan struct{}),
-		breakpoints: map[common.Location]*bitset.BitSet{},
+		stops:           make(chan Stop),
+		continues:       make(chan struct{}),
+		breakpoints:     map[common.Location]*locationBreakpoints{},
+		globalVariables: map[Variable]variableDeclaration{},
 	}
 }
 
@@ -51,58 +157,568 @@ func (d *Debugger) Stops() <-chan Stop {
 	return d.stops
 }
 
+// SetLogHandler sets the function which is called with messages of logpoints
+func (d *Debugger) SetLogHandler(handler LogHandler) {
+	d.logHandler = handler
+}
+
+// SetBreakOnError determines if the program stops when an error is raised,
+// e.g. when a condition fails, when a nil optional is force-unwrapped,
+// when an arithmetic operation overflows, or when the program panics.
+// The program stops at the statement raising the error.
+// Internal errors of the implementation do not stop the program
+func (d *Debugger) SetBreakOnError(breakOnError bool) {
+	var value uint32
+	if breakOnError {
+		value = 1
+	}
+	atomic.StoreUint32(&d.breakOnError, value)
+}
+
+func (d *Debugger) BreakOnError() bool {
+	return atomic.LoadUint32(&d.breakOnError) != 0
+}
+
 func (d *Debugger) AddBreakpoint(location common.Location, line uint) {
+	d.SetBreakpoint(Breakpoint{
+		Location: location,
+		Line:     line,
+	})
+}
+
+// SetBreakpoint adds the given breakpoint,
+// or replaces the existing breakpoint at the same line
+func (d *Debugger) SetBreakpoint(breakpoint Breakpoint) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	location := breakpoint.Location
+
 	breakpoints, ok := d.breakpoints[location]
 	if !ok {
-		breakpoints = bitset.New(1024)
+		breakpoints = &locationBreakpoints{
+			lines:       bitset.New(1024),
+			breakpoints: map[uint]*Breakpoint{},
+		}
 		d.breakpoints[location] = breakpoints
 	}
-	breakpoints.Set(line)
+
+	breakpoint.Hits = 0
+
+	breakpoints.lines.Set(breakpoint.Line)
+	breakpoints.breakpoints[breakpoint.Line] = &breakpoint
 }
 
 func (d *Debugger) RemoveBreakpoint(location common.Location, line uint) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	breakpoints, ok := d.breakpoints[location]
 	if !ok {
 		return
 	}
-	breakpoints.Clear(line)
+	breakpoints.lines.Clear(line)
+	delete(breakpoints.breakpoints, line)
+}
+
+// Breakpoints returns all breakpoints for the given location, ordered by line
+func (d *Debugger) Breakpoints(location common.Location) []Breakpoint {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	breakpoints, ok := d.breakpoints[location]
+	if !ok {
+		return nil
+	}
+
+	var result []Breakpoint
+	for line, ok := breakpoints.lines.NextSet(0); ok; line, ok = breakpoints.lines.NextSet(line + 1) {
+		result = append(result, *breakpoints.breakpoints[line])
+	}
+	return result
 }
 
 func (d *Debugger) ClearBreakpoints() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	for location := range d.breakpoints { //nolint:maprange
 		delete(d.breakpoints, location)
 	}
 }
 
 func (d *Debugger) ClearBreakpointsForLocation(location common.Location) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	delete(d.breakpoints, location)
 }
 
+// AddWatch adds an expression which should be evaluated on every stop
+func (d *Debugger) AddWatch(expression string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.watches = append(d.watches, expression)
+}
+
+// RemoveWatch removes the watch expression with the given index.
+// It returns false if there is no watch expression with the given index
+func (d *Debugger) RemoveWatch(index int) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if index < 0 || index >= len(d.watches) {
+		return false
+	}
+	d.watches = append(d.watches[:index], d.watches[index+1:]...)
+	return true
+}
+
+// Watches returns the watch expressions
+func (d *Debugger) Watches() []string {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return append([]string(nil), d.watches...)
+}
+
+func (d *Debugger) onFunctionEntry(
+	interpreter *Interpreter,
+	function *InterpretedFunctionValue,
+	invocation Invocation,
+) {
+	// The position of the outer frame is the position of the invocation
+
+	if len(d.frames) > 0 {
+		invocationPosition := invocation.LocationRange.HasPosition
+		if invocationPosition != nil {
+			d.frames[len(d.frames)-1].Position = invocationPosition.StartPosition()
+		}
+	}
+
+	functionName := function.Name
+	if functionName != "" && invocation.Self != nil {
+		if compositeValue, ok := (*invocation.Self).(*CompositeValue); ok {
+			functionName = compositeValue.QualifiedIdentifier + "." + functionName
+		}
+	}
+
+	d.frames = append(
+		d.frames,
+		&StackFrame{
+			FunctionName: functionName,
+			Location:     interpreter.Location,
+			Interpreter:  interpreter,
+			Activation:   interpreter.activations.Current(),
+		},
+	)
+}
+
+// onVariableDeclaration records the declared kind and static type of the given variable,
+// which was declared in the current frame
+func (d *Debugger) onVariableDeclaration(
+	interpreter *Interpreter,
+	variable Variable,
+	kind common.DeclarationKind,
+	ty sema.Type,
+) {
+	declaration := variableDeclaration{
+		kind: kind,
+		ty:   ty,
+	}
+
+	if len(d.frames) == 0 {
+		d.globalVariables[variable] = declaration
+		return
+	}
+
+	frame := d.frames[len(d.frames)-1]
+	if frame.variables == nil {
+		frame.variables = map[Variable]variableDeclaration{}
+		frame.variablesLimit = minFrameVariablesLimit
+	}
+	frame.variables[variable] = declaration
+
+	if len(frame.variables) <= frame.variablesLimit {
+		return
+	}
+
+	// Variables declared in a loop are declared anew in each iteration.
+	// Prune the declarations of the variables which are no longer in scope,
+	// so the declarations of a long-running frame do not grow unbounded
+
+	inScope := map[Variable]struct{}{}
+	_ = interpreter.activations.Current().ForEach(func(_ string, variable Variable) error {
+		inScope[variable] = struct{}{}
+		return nil
+	})
+
+	for variable := range frame.variables { //nolint:maprange
+		if _, ok := inScope[variable]; !ok {
+			delete(frame.variables, variable)
+		}
+	}
+
+	frame.variablesLimit = max(2*len(frame.variables), minFrameVariablesLimit)
+}
+
+// onGlobalVariableDeclaration records the declared kind and static type of the given global variable
+func (d *Debugger) onGlobalVariableDeclaration(
+	variable Variable,
+	kind common.DeclarationKind,
+	ty sema.Type,
+) {
+	d.globalVariables[variable] = variableDeclaration{
+		kind: kind,
+		ty:   ty,
+	}
+}
+
+// variableDeclaration returns the recorded declaration of the given variable, if any
+func (d *Debugger) variableDeclaration(variable Variable) (variableDeclaration, bool) {
+	for i := len(d.frames) - 1; i >= 0; i-- {
+		declaration, ok := d.frames[i].variables[variable]
+		if ok {
+			return declaration, true
+		}
+	}
+
+	declaration, ok := d.globalVariables[variable]
+	return declaration, ok
+}
+
+func (d *Debugger) onFunctionExit() {
+	depth := len(d.frames)
+	d.frames[depth-1] = nil
+	d.frames = d.frames[:depth-1]
+}
+
 func (d *Debugger) onStatement(interpreter *Interpreter, statement ast.Statement) {
-	if !atomic.CompareAndSwapUint32(&d.pauseRequested, 1, 0) {
-		breakpoints, ok := d.breakpoints[interpreter.Location]
+
+	if d.evaluating {
+		return
+	}
+
+	// An error which stopped the program was handled
+	d.stoppedOnError = false
+
+	startPosition := statement.StartPosition()
+
+	if len(d.frames) > 0 {
+		frame := d.frames[len(d.frames)-1]
+		frame.Location = interpreter.Location
+		frame.Position = startPosition
+		frame.Interpreter = interpreter
+		frame.Activation = interpreter.activations.Current()
+	}
+
+	if !atomic.CompareAndSwapUint32(&d.pauseRequested, 1, 0) &&
+		!d.stepCompleted() {
+
+		breakpoint, ok := d.breakpoint(interpreter.Location, uint(startPosition.Line))
 		if !ok {
 			return
 		}
 
-		startPosition := statement.StartPosition()
-		if !breakpoints.Test(uint(startPosition.Line)) {
+		if !d.breakpointHit(interpreter, breakpoint) {
 			return
 		}
 	}
 
+	d.clearStep()
+
+	d.stops <- Stop{
+		Interpreter: interpreter,
+		Statement:   statement,
+		Frames:      d.stackFrames(interpreter, statement),
+	}
+
+	<-d.continues
+}
+
+// onStatementPanic stops the program if the evaluation of the given statement panicked,
+// i.e. an error was raised. The panic is continued afterwards.
+//
+// It must be deferred, so it is able to recover.
+// The program is only stopped at the innermost statement,
+// while its activation and the call stack are still intact.
+//
+// The program is only stopped for errors raised by the program, e.g. a failed condition.
+// Other panics, e.g. internal errors, are continued immediately
+func (d *Debugger) onStatementPanic(interpreter *Interpreter, statement ast.Statement) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	err, ok := programError(r)
+	if ok {
+		d.onError(interpreter, statement, err)
+	}
+
+	panic(r)
+}
+
+// programError returns the given recovered value as an error,
+// if it is an error raised by the program, i.e. a user error or an interpreter error
+func programError(r any) (error, bool) {
+	err, ok := r.(error)
+	if !ok {
+		return nil, false
+	}
+
+	for {
+		switch typedError := err.(type) {
+		case Error, errors.UserError:
+			return typedError, true
+		case xerrors.Wrapper:
+			err = typedError.Unwrap()
+		default:
+			return nil, false
+		}
+	}
+}
+
+// onError stops the program because the given statement raises the given error.
+// The program is only stopped once for an error
+func (d *Debugger) onError(interpreter *Interpreter, statement ast.Statement, err error) {
+	// Breaking on errors might have been disabled while the statement was executed
+	if d.evaluating || d.stoppedOnError || !d.BreakOnError() {
+		return
+	}
+
+	d.stoppedOnError = true
+
+	d.clearStep()
+
 	d.stops <- Stop{
 		Interpreter: interpreter,
 		Statement:   statement,
+		Frames:      d.stackFrames(interpreter, statement),
+		Err:         err,
 	}
 
 	<-d.continues
 }
 
+// breakpoint returns a copy of the breakpoint at the given line of the given location, if any
+func (d *Debugger) breakpoint(location common.Location, line uint) (Breakpoint, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	breakpoints, ok := d.breakpoints[location]
+	if !ok || !breakpoints.lines.Test(line) {
+		return Breakpoint{}, false
+	}
+
+	return *breakpoints.breakpoints[line], true
+}
+
+// recordBreakpointHit increments the number of hits of the given breakpoint,
+// and returns the new number of hits.
+// The breakpoint might have been removed or replaced in the meantime,
+// in which case the given breakpoint's number of hits is used
+func (d *Debugger) recordBreakpointHit(breakpoint Breakpoint) uint {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	breakpoints, ok := d.breakpoints[breakpoint.Location]
+	if !ok || !breakpoints.lines.Test(breakpoint.Line) {
+		return breakpoint.Hits + 1
+	}
+
+	current := breakpoints.breakpoints[breakpoint.Line]
+	current.Hits++
+	return current.Hits
+}
+
+// breakpointHit returns true if the program should stop at the given breakpoint.
+// If the breakpoint is a logpoint, the message is logged instead.
+//
+// The breakpoint's condition and log message are evaluated without holding the mutex,
+// so the debugger's client is not blocked while the program evaluates them
+func (d *Debugger) breakpointHit(interpreter *Interpreter, breakpoint Breakpoint) bool {
+	activation := interpreter.activations.Current()
+
+	if breakpoint.Condition != "" {
+		result, err := d.EvaluateExpression(
+			interpreter,
+			activation,
+			breakpoint.Condition,
+			sema.BoolType,
+		)
+		if err != nil {
+			// Stop the program, so the condition can be fixed
+			d.log(breakpoint, fmt.Sprintf("failed to evaluate breakpoint condition: %s", err))
+			return true
+		}
+
+		if result != TrueValue {
+			return false
+		}
+	}
+
+	breakpoint.Hits = d.recordBreakpointHit(breakpoint)
+
+	if breakpoint.Hits < breakpoint.HitCount {
+		return false
+	}
+
+	if breakpoint.LogMessage != "" {
+		d.log(breakpoint, d.formatLogMessage(interpreter, activation, breakpoint.LogMessage))
+		return false
+	}
+
+	return true
+}
+
+// formatLogMessage interpolates the expressions enclosed in braces in the given message.
+// Braces can be escaped with a backslash
+func (d *Debugger) formatLogMessage(
+	interpreter *Interpreter,
+	activation *VariableActivation,
+	message string,
+) string {
+	var builder strings.Builder
+
+	for len(message) > 0 {
+		index := strings.IndexAny(message, "\\{")
+		if index < 0 {
+			builder.WriteString(message)
+			break
+		}
+
+		builder.WriteString(message[:index])
+
+		if message[index] == '\\' {
+			if index+1 < len(message) {
+				builder.WriteByte(message[index+1])
+				message = message[index+2:]
+			} else {
+				message = ""
+			}
+			continue
+		}
+
+		end := strings.IndexByte(message[index:], '}')
+		if end < 0 {
+			builder.WriteString(message[index:])
+			break
+		}
+		end += index
+
+		code := message[index+1 : end]
+		message = message[end+1:]
+
+		value, err := d.EvaluateExpression(interpreter, activation, code, nil)
+		if err != nil {
+			builder.WriteString("<error: ")
+			builder.WriteString(err.Error())
+			builder.WriteString(">")
+			continue
+		}
+
+		if stringValue, ok := value.(*StringValue); ok {
+			builder.WriteString(stringValue.Str)
+		} else {
+			builder.WriteString(value.String())
+		}
+	}
+
+	return builder.String()
+}
+
+func (d *Debugger) log(breakpoint Breakpoint, message string) {
+	if d.logHandler == nil {
+		return
+	}
+	d.logHandler(breakpoint, message)
+}
+
+func (d *Debugger) stepCompleted() bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	switch d.step {
+	case stepModeInto:
+		return true
+	case stepModeOver:
+		return len(d.frames) <= d.stepDepth
+	case stepModeOut:
+		return len(d.frames) < d.stepDepth
+	default:
+		return false
+	}
+}
+
+// stackFrames returns a copy of the current call stack.
+// Top-level code, which is not executed as part of an invocation,
+// is represented by a single, unnamed frame
+func (d *Debugger) stackFrames(interpreter *Interpreter, statement ast.Statement) []StackFrame {
+	if len(d.frames) == 0 {
+		return []StackFrame{
+			{
+				Location:    interpreter.Location,
+				Position:    statement.StartPosition(),
+				Interpreter: interpreter,
+				Activation:  interpreter.activations.Current(),
+			},
+		}
+	}
+
+	frames := make([]StackFrame, len(d.frames))
+	for i, frame := range d.frames {
+		frames[i] = *frame
+	}
+	return frames
+}
+
 func (d *Debugger) RequestPause() {
 	atomic.StoreUint32(&d.pauseRequested, 1)
 }
 
+func (d *Debugger) requestStep(mode stepMode) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.step = mode
+	// The frames are not modified while the program is stopped
+	d.stepDepth = len(d.frames)
+}
+
+func (d *Debugger) clearStep() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.step = stepModeNone
+}
+
+// RequestStepInto requests the program to stop at the next statement,
+// which might be in an invoked function.
+// It must only be called while the program is stopped,
+// and the program must be continued afterwards
+func (d *Debugger) RequestStepInto() {
+	d.requestStep(stepModeInto)
+}
+
+// RequestStepOver requests the program to stop at the next statement
+// in the current function, or in one of its callers.
+// Statements in invoked functions are skipped.
+// It must only be called while the program is stopped,
+// and the program must be continued afterwards
+func (d *Debugger) RequestStepOver() {
+	d.requestStep(stepModeOver)
+}
+
+// RequestStepOut requests the program to stop at the next statement
+// after the current function returned.
+// It must only be called while the program is stopped,
+// and the program must be continued afterwards
+func (d *Debugger) RequestStepOut() {
+	d.requestStep(stepModeOut)
+}
+
 func (d *Debugger) Continue() {
 	d.continues <- struct{}{}
 }
@@ -112,12 +728,32 @@ func (d *Debugger) Pause() Stop {
 	return <-d.Stops()
 }
 
+// Next continues the program and stops it at the next statement.
+// It is equivalent to StepInto
 func (d *Debugger) Next() Stop {
 	d.RequestPause()
 	d.Continue()
 	return <-d.Stops()
 }
 
+func (d *Debugger) StepInto() Stop {
+	d.RequestStepInto()
+	d.Continue()
+	return <-d.Stops()
+}
+
+func (d *Debugger) StepOver() Stop {
+	d.RequestStepOver()
+	d.Continue()
+	return <-d.Stops()
+}
+
+func (d *Debugger) StepOut() Stop {
+	d.RequestStepOut()
+	d.Continue()
+	return <-d.Stops()
+}
+
 func (d *Debugger) CurrentActivation(interpreter *Interpreter) *VariableActivation {
 	return interpreter.activations.Current()
 }
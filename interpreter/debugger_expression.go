@@ -0,0 +1,308 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/activations"
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/cadence/sema"
+)
+
+// EvaluateExpression parses, checks, and evaluates the given expression
+// in the scope of the given activation, e.g. the activation of a stack frame.
+//
+// If an expected type is given, the expression must be of that type.
+//
+// It must only be called while the program is stopped.
+// The program does not stop while the expression is evaluated,
+// e.g. at breakpoints in invoked functions.
+func (d *Debugger) EvaluateExpression(
+	inter *Interpreter,
+	activation *VariableActivation,
+	code string,
+	expectedType sema.Type,
+) (
+	Value,
+	error,
+) {
+	expression, err := parseDebuggerExpression(code)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.evaluate(
+		inter,
+		activation,
+		func(checker *sema.Checker) {
+			checker.VisitExpression(
+				expression,
+				ast.NewExpressionStatement(nil, expression),
+				expectedType,
+			)
+		},
+		func(expressionInterpreter *Interpreter) Value {
+			return expressionInterpreter.evalExpression(expression)
+		},
+	)
+}
+
+// SetVariable assigns the value of the given expression to the variable with the given name.
+// The variable must be declared in the given activation or one of its parents,
+// it must be declared with `var`, and the value must be of the variable's declared type.
+//
+// It must only be called while the program is stopped.
+func (d *Debugger) SetVariable(
+	inter *Interpreter,
+	activation *VariableActivation,
+	name string,
+	code string,
+) error {
+	if activation.Find(name) == nil {
+		return errors.NewDefaultUserError("variable `%s` is not in scope", name)
+	}
+
+	expression, err := parseDebuggerExpression(code)
+	if err != nil {
+		return err
+	}
+
+	position := expression.StartPosition()
+
+	statement := ast.NewAssignmentStatement(
+		nil,
+		ast.NewIdentifierExpression(
+			nil,
+			ast.NewIdentifier(nil, name, position),
+		),
+		ast.NewTransfer(nil, ast.TransferOperationCopy, position),
+		expression,
+	)
+
+	_, err = d.evaluate(
+		inter,
+		activation,
+		func(checker *sema.Checker) {
+			checker.CheckStatement(statement)
+		},
+		func(statementInterpreter *Interpreter) Value {
+			ast.AcceptStatement[StatementResult](statement, statementInterpreter)
+			return nil
+		},
+	)
+	return err
+}
+
+func parseDebuggerExpression(code string) (ast.Expression, error) {
+	expression, errs := parser.ParseExpression(nil, []byte(code), parser.Config{})
+	if len(errs) > 0 {
+		return nil, parser.Error{
+			Code:   []byte(code),
+			Errors: errs,
+		}
+	}
+	return expression, nil
+}
+
+// evaluate checks code using the given check function,
+// and then evaluates it using the given evaluate function
+func (d *Debugger) evaluate(
+	inter *Interpreter,
+	activation *VariableActivation,
+	check func(checker *sema.Checker),
+	evaluate func(inter *Interpreter) Value,
+) (
+	result Value,
+	err error,
+) {
+	checker, err := d.newExpressionChecker(inter, activation)
+	if err != nil {
+		return nil, err
+	}
+
+	err = runChecker(checker, check)
+	if err != nil {
+		return nil, err
+	}
+
+	// Evaluate in a new interpreter,
+	// which uses the elaboration of the checked code,
+	// and which shares the state of the stopped interpreter.
+	// The new interpreter is not registered with the shared state
+
+	evaluationInterpreter := &Interpreter{
+		Program: &Program{
+			Elaboration: checker.Elaboration,
+		},
+		Location:    inter.Location,
+		SharedState: inter.SharedState,
+		Globals:     inter.Globals,
+	}
+	evaluationInterpreter.activations = activations.NewActivations[Variable](evaluationInterpreter)
+	evaluationInterpreter.activations.PushNewWithParent(activation)
+
+	d.evaluating = true
+	defer func() {
+		d.evaluating = false
+	}()
+
+	defer evaluationInterpreter.RecoverErrors(func(internalErr error) {
+		err = internalErr
+	})
+
+	return evaluate(evaluationInterpreter), nil
+}
+
+// newExpressionChecker returns a checker for expressions and statements,
+// which declares all variables of the given activation,
+// and all global types of the stopped program, e.g. composites, interfaces, and type aliases
+func (d *Debugger) newExpressionChecker(
+	inter *Interpreter,
+	activation *VariableActivation,
+) (*sema.Checker, error) {
+
+	var elaboration *sema.Elaboration
+	if inter.Program != nil {
+		elaboration = inter.Program.Elaboration
+	}
+
+	valueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	typeActivation := sema.NewVariableActivation(sema.BaseTypeActivation)
+
+	if elaboration != nil {
+		elaboration.ForEachGlobalType(func(name string, variable *sema.Variable) {
+			typeActivation.Set(name, variable)
+		})
+	}
+
+	declared := map[string]struct{}{}
+
+	_ = activation.ForEach(func(name string, variable Variable) error {
+		if _, ok := declared[name]; ok {
+			return nil
+		}
+		declared[name] = struct{}{}
+
+		if sema.BaseValueActivation.Find(name) != nil {
+			return nil
+		}
+
+		// Use the checked declaration of global variables of the program, if any,
+		// e.g. the constructors of composites, which also have the enum cases as members
+
+		if elaboration != nil && inter.Globals.Get(name) == variable {
+			globalVariable, ok := elaboration.GetGlobalValue(name)
+			if ok {
+				valueActivation.Set(name, globalVariable)
+				return nil
+			}
+		}
+
+		// Use the declared kind and static type of the variable, if known.
+		// Otherwise, e.g. for `self`, declare a constant of the value's type
+
+		declarationKind := common.DeclarationKindConstant
+		var ty sema.Type
+
+		declaration, ok := d.variableDeclaration(variable)
+		if ok {
+			declarationKind = declaration.kind
+			ty = declaration.ty
+		} else {
+			ty = variableSemaType(inter, variable)
+		}
+		if ty == nil {
+			return nil
+		}
+
+		valueActivation.Set(
+			name,
+			&sema.Variable{
+				Identifier:      name,
+				Type:            ty,
+				DeclarationKind: declarationKind,
+				IsConstant:      declarationKind != common.DeclarationKindVariable,
+				Access:          sema.PrimitiveAccess(ast.AccessAll),
+			},
+		)
+
+		return nil
+	})
+
+	return sema.NewChecker(
+		nil,
+		inter.Location,
+		nil,
+		&sema.Config{
+			BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+				return valueActivation
+			},
+			BaseTypeActivationHandler: func(_ common.Location) *sema.VariableActivation {
+				return typeActivation
+			},
+			AccessCheckMode: sema.AccessCheckModeNotSpecifiedUnrestricted,
+		},
+	)
+}
+
+// variableSemaType returns the type of the value of the given variable,
+// or nil if the value has no type that can be used in expressions
+func variableSemaType(inter *Interpreter, variable Variable) (ty sema.Type) {
+	defer func() {
+		if recover() != nil {
+			ty = nil
+		}
+	}()
+
+	value := variable.GetValue(inter)
+	if value == nil {
+		return nil
+	}
+
+	staticType := value.StaticType(inter)
+	if staticType == nil {
+		return nil
+	}
+
+	ty, err := inter.ConvertStaticToSemaType(staticType)
+	if err != nil {
+		return nil
+	}
+
+	return ty
+}
+
+func runChecker(checker *sema.Checker, check func(checker *sema.Checker)) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = asCadenceError(r)
+		}
+	}()
+
+	check(checker)
+
+	checkerError := checker.CheckerError()
+	if checkerError != nil {
+		return checkerError
+	}
+
+	return nil
+}
@@ -345,11 +345,17 @@ func (d StorableDecoder) decodeStorable() (atree.Storable, error) {
 		case values.CBORTagFix64Value:
 			storable, err = d.decodeFix64()
 
+		case values.CBORTagFix128Value:
+			storable, err = d.decodeFix128()
+
 		// UFix*
 
 		case values.CBORTagUFix64Value:
 			storable, err = d.decodeUFix64()
 
+		case values.CBORTagUFix128Value:
+			storable, err = d.decodeUFix128()
+
 		// Storage
 
 		case values.CBORTagPathValue:
@@ -860,6 +866,51 @@ func (d StorableDecoder) decodeUFix64() (UFix64Value, error) {
 	return NewUnmeteredUFix64Value(value), nil
 }
 
+func (d StorableDecoder) decodeFix128() (Fix128Value, error) {
+	bigInt, err := d.decodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128 encoding: %s", e.ActualType.String())
+		}
+		return Fix128Value{}, err
+	}
+
+	min := sema.Fix128TypeMinBig
+	if bigInt.Cmp(min) < 0 {
+		return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128: got %s, expected min %s", bigInt, min)
+	}
+
+	max := sema.Fix128TypeMaxBig
+	if bigInt.Cmp(max) > 0 {
+		return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128: got %s, expected max %s", bigInt, max)
+	}
+
+	// NOTE: already metered by `decodeBigInt`
+	return NewUnmeteredFix128Value(bigInt), nil
+}
+
+func (d StorableDecoder) decodeUFix128() (UFix128Value, error) {
+	bigInt, err := d.decodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128 encoding: %s", e.ActualType.String())
+		}
+		return UFix128Value{}, err
+	}
+
+	if bigInt.Sign() < 0 {
+		return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128: got %s, expected positive", bigInt)
+	}
+
+	max := sema.UFix128TypeMaxBig
+	if bigInt.Cmp(max) > 0 {
+		return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128: got %s, expected max %s", bigInt, max)
+	}
+
+	// NOTE: already metered by `decodeBigInt`
+	return NewUnmeteredUFix128Value(bigInt), nil
+}
+
 func (d StorableDecoder) decodeSome() (SomeStorable, error) {
 	storable, err := d.decodeStorable()
 	if err != nil {
@@ -1544,6 +1595,9 @@ func (d TypeDecoder) DecodeStaticType() (StaticType, error) {
 	case values.CBORTagInclusiveRangeStaticType:
 		return d.decodeInclusiveRangeStaticType()
 
+	case values.CBORTagSetStaticType:
+		return d.decodeSetStaticType()
+
 	default:
 		return nil, errors.NewUnexpectedError("invalid static type encoding tag: %d", number)
 	}
@@ -1590,7 +1644,9 @@ func (d TypeDecoder) decodeCompositeStaticType() (StaticType, error) {
 		return nil, err
 	}
 
-	if size != expectedLength {
+	if size != expectedLength &&
+		size != encodedInstantiatedCompositeStaticTypeLength {
+
 		return nil, errors.NewUnexpectedError(
 			"invalid composite static type encoding: expected [%d]any, got [%d]any",
 			expectedLength,
@@ -1616,7 +1672,41 @@ func (d TypeDecoder) decodeCompositeStaticType() (StaticType, error) {
 		return nil, err
 	}
 
-	return NewCompositeStaticTypeComputeTypeID(d.memoryGauge, location, qualifiedIdentifier), nil
+	staticType := NewCompositeStaticTypeComputeTypeID(d.memoryGauge, location, qualifiedIdentifier)
+
+	if size == encodedInstantiatedCompositeStaticTypeLength {
+		// Decode type arguments at array index encodedCompositeStaticTypeTypeArgumentsFieldKey
+		staticType.TypeArguments, err = d.decodeStaticTypes()
+		if err != nil {
+			return nil, errors.NewUnexpectedError(
+				"invalid composite static type type arguments encoding: %w",
+				err,
+			)
+		}
+	}
+
+	return staticType, nil
+}
+
+func (d TypeDecoder) decodeStaticTypes() ([]StaticType, error) {
+	length, err := d.decoder.DecodeArrayHead()
+	if err != nil {
+		return nil, err
+	}
+
+	if length == 0 {
+		return nil, errors.NewUnexpectedError("expected at least one type, got none")
+	}
+
+	types := make([]StaticType, length)
+	for i := 0; i < int(length); i++ {
+		types[i], err = d.DecodeStaticType()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return types, nil
 }
 
 func (d TypeDecoder) decodeInterfaceStaticType() (*InterfaceStaticType, error) {
@@ -2106,7 +2196,9 @@ func (d TypeDecoder) decodeCompositeTypeInfo() (atree.TypeInfo, error) {
 		return nil, err
 	}
 
-	if length != encodedCompositeTypeInfoLength {
+	if length != encodedCompositeTypeInfoLength &&
+		length != encodedInstantiatedCompositeTypeInfoLength {
+
 		return nil, errors.NewUnexpectedError(
 			"invalid composite type info: expected %d elements, got %d",
 			encodedCompositeTypeInfoLength, length,
@@ -2135,6 +2227,21 @@ func (d TypeDecoder) decodeCompositeTypeInfo() (atree.TypeInfo, error) {
 		)
 	}
 
+	if length == encodedInstantiatedCompositeTypeInfoLength {
+		typeArguments, err := d.decodeStaticTypes()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewInstantiatedCompositeTypeInfo(
+			d.memoryGauge,
+			location,
+			qualifiedIdentifier,
+			common.CompositeKind(kind),
+			typeArguments,
+		), nil
+	}
+
 	return NewCompositeTypeInfo(
 		d.memoryGauge,
 		location,
@@ -2154,6 +2261,17 @@ func (d TypeDecoder) decodeInclusiveRangeStaticType() (StaticType, error) {
 	return NewInclusiveRangeStaticType(d.memoryGauge, elementType), nil
 }
 
+func (d TypeDecoder) decodeSetStaticType() (*SetStaticType, error) {
+	elementType, err := d.DecodeStaticType()
+	if err != nil {
+		return nil, errors.NewUnexpectedError(
+			"invalid set static type encoding: %w",
+			err,
+		)
+	}
+	return NewSetStaticType(d.memoryGauge, elementType), nil
+}
+
 func DecodeTypeInfo(decoder *cbor.StreamDecoder, memoryGauge common.MemoryGauge) (atree.TypeInfo, error) {
 	d := NewTypeDecoder(decoder, memoryGauge)
 
@@ -2176,6 +2294,8 @@ func DecodeTypeInfo(decoder *cbor.StreamDecoder, memoryGauge common.MemoryGauge)
 			return d.decodeVariableSizedStaticType()
 		case values.CBORTagDictionaryStaticType:
 			return d.decodeDictionaryStaticType()
+		case values.CBORTagSetStaticType:
+			return d.decodeSetStaticType()
 		case values.CBORTagCompositeValue:
 			return d.decodeCompositeTypeInfo()
 		default:
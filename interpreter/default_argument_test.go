@@ -0,0 +1,266 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/test_utils/interpreter_utils"
+)
+
+func TestInterpretDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	newIntArray := func(inter *interpreter.Interpreter, values ...int64) *interpreter.ArrayValue {
+		elements := make([]interpreter.Value, len(values))
+		for i, value := range values {
+			elements[i] = interpreter.NewUnmeteredIntValueFromInt64(value)
+		}
+		return interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeInt,
+			},
+			common.ZeroAddress,
+			elements...,
+		)
+	}
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let offset = 100
+
+          fun add(_ a: Int, _ b: Int = 1, _ c: Int = offset): Int {
+              return a + b + c
+          }
+
+          fun test(): [Int] {
+              let offset = 1000
+              return [
+                  add(1),
+                  add(1, 2),
+                  add(1, 2, 3)
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newIntArray(inter, 102, 103, 6),
+			value,
+		)
+	})
+
+	t.Run("evaluated on each invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          var counter = 0
+
+          fun next(): Int {
+              counter = counter + 1
+              return counter
+          }
+
+          fun foo(a: Int = next()): Int {
+              return a
+          }
+
+          fun test(): [Int] {
+              return [foo(), foo(a: 10), foo()]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newIntArray(inter, 1, 10, 2),
+			value,
+		)
+	})
+
+	t.Run("optional parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun foo(a: Int? = 1): Int? {
+              return a
+          }
+
+          fun test(): Int? {
+              return foo()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+
+	t.Run("composite function and initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let x: Int
+
+              init(x: Int = 10) {
+                  self.x = x
+              }
+
+              fun add(_ a: Int = 1): Int {
+                  return self.x + a
+              }
+          }
+
+          fun test(): [Int] {
+              let s = S()
+              let ref = &s as &S
+              return [
+                  s.x,
+                  S(x: 20).x,
+                  s.add(),
+                  s.add(2),
+                  ref.add()
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newIntArray(inter, 10, 20, 11, 12, 11),
+			value,
+		)
+	})
+
+	t.Run("external invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(a: Int, b: Int = 2): Int {
+              return a * b
+          }
+        `)
+
+		value, err := inter.Invoke(
+			"test",
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+		)
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(6),
+			value,
+		)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+
+		var argumentCountErr interpreter.ArgumentCountError
+		require.ErrorAs(t, err, &argumentCountErr)
+	})
+}
+
+func TestInterpretDefaultArgumentsInterfaceConditions(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct interface I {
+          init(a: Int) {
+              pre { a > 0: "a must be positive" }
+          }
+
+          fun foo(a: Int): Int {
+              pre { a > 0: "a must be positive" }
+          }
+      }
+
+      struct S: I {
+          init(a: Int = 1) {}
+
+          fun foo(a: Int = -1): Int { return a }
+      }
+
+      fun testInitializer() {
+          S()
+      }
+
+      fun testFunction(): Int {
+          return S().foo()
+      }
+
+      fun testFunctionArgument(): Int {
+          return S().foo(a: 2)
+      }
+    `)
+
+	_, err := inter.Invoke("testInitializer")
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("testFunction")
+	require.Error(t, err)
+
+	var conditionErr interpreter.ConditionError
+	require.ErrorAs(t, err, &conditionErr)
+
+	value, err := inter.Invoke("testFunctionArgument")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(2),
+		value,
+	)
+}
@@ -1003,12 +1003,17 @@ func (t *OptionalStaticType) Encode(e *cbor.StreamEncoder) error {
 const (
 	// encodedCompositeStaticTypeLocationFieldKey            uint64 = 0
 	// encodedCompositeStaticTypeQualifiedIdentifierFieldKey uint64 = 1
+	// encodedCompositeStaticTypeTypeArgumentsFieldKey       uint64 = 2
 
 	// !!! *WARNING* !!!
 	//
 	// encodedCompositeStaticTypeLength MUST be updated when new element is added.
 	// It is used to verify encoded composite static type length during decoding.
 	encodedCompositeStaticTypeLength = 2
+
+	// encodedInstantiatedCompositeStaticTypeLength is the length
+	// of an encoded composite static type which has type arguments.
+	encodedInstantiatedCompositeStaticTypeLength = 3
 )
 
 // Encode encodes CompositeStaticType as
@@ -1018,16 +1023,31 @@ const (
 //				Content: cborArray{
 //					encodedCompositeStaticTypeLocationFieldKey:            Location(v.Location),
 //					encodedCompositeStaticTypeQualifiedIdentifierFieldKey: string(v.QualifiedIdentifier),
+//					encodedCompositeStaticTypeTypeArgumentsFieldKey:       []StaticType(v.TypeArguments),
 //			},
 //	}
+//
+// The type arguments are only encoded if the type has type arguments.
 func (t *CompositeStaticType) Encode(e *cbor.StreamEncoder) error {
+	hasTypeArguments := len(t.TypeArguments) > 0
+
 	// Encode tag number and array head
-	err := e.EncodeRawBytes([]byte{
-		// tag number
-		0xd8, values.CBORTagCompositeStaticType,
-		// array, 2 items follow
-		0x82,
-	})
+	var err error
+	if hasTypeArguments {
+		err = e.EncodeRawBytes([]byte{
+			// tag number
+			0xd8, values.CBORTagCompositeStaticType,
+			// array, 3 items follow
+			0x83,
+		})
+	} else {
+		err = e.EncodeRawBytes([]byte{
+			// tag number
+			0xd8, values.CBORTagCompositeStaticType,
+			// array, 2 items follow
+			0x82,
+		})
+	}
 	if err != nil {
 		return err
 	}
@@ -1039,7 +1059,33 @@ func (t *CompositeStaticType) Encode(e *cbor.StreamEncoder) error {
 	}
 
 	// Encode qualified identifier at array index encodedCompositeStaticTypeQualifiedIdentifierFieldKey
-	return e.EncodeString(t.QualifiedIdentifier)
+	err = e.EncodeString(t.QualifiedIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if !hasTypeArguments {
+		return nil
+	}
+
+	// Encode type arguments (as array) at array index encodedCompositeStaticTypeTypeArgumentsFieldKey
+	return encodeStaticTypes(e, t.TypeArguments)
+}
+
+func encodeStaticTypes(e *cbor.StreamEncoder, types []StaticType) error {
+	err := e.EncodeArrayHead(uint64(len(types)))
+	if err != nil {
+		return err
+	}
+
+	for _, typ := range types {
+		err = typ.Encode(e)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // NOTE: NEVER change, only add/increment; ensure uint64
@@ -1315,6 +1361,25 @@ func (t InclusiveRangeStaticType) Encode(e *cbor.StreamEncoder) error {
 	return t.ElementType.Encode(e)
 }
 
+// Encode encodes SetStaticType as
+//
+//	cbor.Tag{
+//			Number: CBORTagSetStaticType,
+//			Content: StaticType(v.ElementType),
+//	}
+func (t *SetStaticType) Encode(e *cbor.StreamEncoder) error {
+	// Encode tag number
+	err := e.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, values.CBORTagSetStaticType,
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.ElementType.Encode(e)
+}
+
 // NOTE: NEVER change, only add/increment; ensure uint64
 const (
 	// encodedIntersectionStaticTypeLegacyTypeFieldKey  uint64 = 0
@@ -1409,6 +1474,8 @@ type compositeTypeInfo struct {
 	location            common.Location
 	qualifiedIdentifier string
 	kind                common.CompositeKind
+	// typeArguments are the type arguments of an instantiated generic composite type
+	typeArguments []StaticType
 }
 
 func NewCompositeTypeInfo(
@@ -1426,10 +1493,31 @@ func NewCompositeTypeInfo(
 	}
 }
 
+func NewInstantiatedCompositeTypeInfo(
+	memoryGauge common.MemoryGauge,
+	location common.Location,
+	qualifiedIdentifier string,
+	kind common.CompositeKind,
+	typeArguments []StaticType,
+) compositeTypeInfo {
+	typeInfo := NewCompositeTypeInfo(
+		memoryGauge,
+		location,
+		qualifiedIdentifier,
+		kind,
+	)
+	typeInfo.typeArguments = typeArguments
+	return typeInfo
+}
+
 var _ atree.TypeInfo = compositeTypeInfo{}
 
 const encodedCompositeTypeInfoLength = 3
 
+// encodedInstantiatedCompositeTypeInfoLength is the length
+// of an encoded composite type info which has type arguments.
+const encodedInstantiatedCompositeTypeInfoLength = 4
+
 func (c compositeTypeInfo) IsComposite() bool {
 	return true
 }
@@ -1440,12 +1528,24 @@ func (c compositeTypeInfo) Copy() atree.TypeInfo {
 }
 
 func (c compositeTypeInfo) Encode(e *cbor.StreamEncoder) error {
-	err := e.EncodeRawBytes([]byte{
-		// tag number
-		0xd8, values.CBORTagCompositeValue,
-		// array, 3 items follow
-		0x83,
-	})
+	hasTypeArguments := len(c.typeArguments) > 0
+
+	var err error
+	if hasTypeArguments {
+		err = e.EncodeRawBytes([]byte{
+			// tag number
+			0xd8, values.CBORTagCompositeValue,
+			// array, 4 items follow
+			0x84,
+		})
+	} else {
+		err = e.EncodeRawBytes([]byte{
+			// tag number
+			0xd8, values.CBORTagCompositeValue,
+			// array, 3 items follow
+			0x83,
+		})
+	}
 	if err != nil {
 		return err
 	}
@@ -1465,15 +1565,34 @@ func (c compositeTypeInfo) Encode(e *cbor.StreamEncoder) error {
 		return err
 	}
 
+	if hasTypeArguments {
+		err = encodeStaticTypes(e, c.typeArguments)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (c compositeTypeInfo) Equal(o atree.TypeInfo) bool {
 	other, ok := o.(compositeTypeInfo)
-	return ok &&
-		c.location == other.location &&
-		c.qualifiedIdentifier == other.qualifiedIdentifier &&
-		c.kind == other.kind
+	if !ok ||
+		c.location != other.location ||
+		c.qualifiedIdentifier != other.qualifiedIdentifier ||
+		c.kind != other.kind ||
+		len(c.typeArguments) != len(other.typeArguments) {
+
+		return false
+	}
+
+	for i, typeArgument := range c.typeArguments {
+		if !typeArgument.Equal(other.typeArguments[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // EmptyTypeInfo
@@ -4010,6 +4010,37 @@ func TestEncodeDecodeTypeValue(t *testing.T) {
 		)
 	})
 
+	t.Run("Set, Int", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := TypeValue{
+			Type: &SetStaticType{
+				ElementType: PrimitiveStaticTypeInt,
+			},
+		}
+
+		encoded := []byte{
+			// tag
+			0xd8, values.CBORTagTypeValue,
+			// array, 1 items follow
+			0x81,
+			// tag
+			0xd8, values.CBORTagSetStaticType,
+			// tag
+			0xd8, values.CBORTagPrimitiveStaticType,
+			// positive integer 36
+			0x18, 0x24,
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value:   value,
+				encoded: encoded,
+			},
+		)
+	})
+
 	t.Run("without static type", func(t *testing.T) {
 
 		t.Parallel()
@@ -4107,13 +4138,59 @@ func TestEncodeDecodeStaticType(t *testing.T) {
 
 		require.Equal(t, ty, actualType)
 	})
+
+	t.Run("composite, with type arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := NewCompositeStaticTypeComputeTypeID(nil, nil, "Box")
+		ty.TypeArguments = []StaticType{
+			PrimitiveStaticTypeInt,
+			PrimitiveStaticTypeString,
+		}
+
+		require.Equal(t, TypeID("Box<Int,String>"), ty.ID())
+
+		encoded := cbor.RawMessage{
+			// tag
+			0xd8, values.CBORTagCompositeStaticType,
+			// array, 3 items follow
+			0x83,
+			// location: nil
+			0xf6,
+			// UTF-8 string, length 3
+			0x63,
+			// Box
+			0x42, 0x6f, 0x78,
+			// array, 2 items follow
+			0x82,
+			// tag
+			0xd8, values.CBORTagPrimitiveStaticType,
+			// positive integer 36
+			0x18, 0x24,
+			// tag
+			0xd8, values.CBORTagPrimitiveStaticType,
+			// positive integer 8
+			0x8,
+		}
+
+		actualEncoded, err := StaticTypeToBytes(ty)
+		require.NoError(t, err)
+
+		AssertEqualWithDiff(t, encoded, actualEncoded)
+
+		actualType, err := staticTypeFromBytes(encoded)
+		require.NoError(t, err)
+
+		require.Equal(t, ty, actualType)
+	})
 }
 
 func TestCBORTagValue(t *testing.T) {
 	t.Parallel()
 
 	t.Run("No new types added in between", func(t *testing.T) {
-		require.Equal(t, byte(231), byte(values.CBORTag_Count))
+		require.Equal(t, byte(232), byte(values.CBORTag_Count))
 	})
 }
 
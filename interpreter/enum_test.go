@@ -267,3 +267,168 @@ func TestInterpretEnumInContract(t *testing.T) {
 		rawValue,
 	)
 }
+
+func TestInterpretEnumFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      enum Status: UInt8 {
+          case active
+          case inactive
+
+          view fun isActive(): Bool {
+              return self == Status.active
+          }
+
+          view fun next(): Status {
+              switch self {
+                  case Status.active:
+                      return Status.inactive
+                  case Status.inactive:
+                      return Status.active
+              }
+              return self
+          }
+      }
+
+      fun test(): [Bool] {
+          return [
+              Status.active.isActive(),
+              Status.inactive.isActive(),
+              Status.active.next().isActive(),
+              Status.inactive.next().isActive()
+          ]
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeBool,
+			},
+			common.ZeroAddress,
+			interpreter.TrueValue,
+			interpreter.FalseValue,
+			interpreter.FalseValue,
+			interpreter.TrueValue,
+		),
+		value,
+	)
+}
+
+func TestInterpretEnumComputedFields(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      enum Status: UInt8 {
+          case active
+          case inactive
+
+          access(all) let label: String {
+              switch self {
+                  case Status.active:
+                      return "Active"
+                  default:
+                      return "Inactive"
+              }
+          }
+
+          access(all) let code: UInt8 {
+              return self.rawValue + 10
+          }
+      }
+
+      fun test(): [String] {
+          return [
+              Status.active.label,
+              Status.inactive.label,
+              Status.inactive.code.toString()
+          ]
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeString,
+			},
+			common.ZeroAddress,
+			interpreter.NewUnmeteredStringValue("Active"),
+			interpreter.NewUnmeteredStringValue("Inactive"),
+			interpreter.NewUnmeteredStringValue("11"),
+		),
+		value,
+	)
+}
+
+func TestInterpretEnumInterfaceConformance(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct interface Named {
+          access(all) view fun name(): String {
+              post { result != "": "name must not be empty" }
+          }
+
+          access(all) view fun describe(): String {
+              return "status ".concat(self.name())
+          }
+      }
+
+      enum Status: UInt8, Named {
+          case active
+          case unknown
+
+          access(all) view fun name(): String {
+              switch self {
+                  case Status.active:
+                      return "active"
+                  default:
+                      return ""
+              }
+          }
+      }
+
+      fun test(): String {
+          let named: {Named} = Status.active
+          return named.describe()
+      }
+
+      fun testCondition(): String {
+          return Status.unknown.name()
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("status active"),
+		value,
+	)
+
+	_, err = inter.Invoke("testCondition")
+	require.Error(t, err)
+
+	var conditionErr interpreter.ConditionError
+	require.ErrorAs(t, err, &conditionErr)
+}
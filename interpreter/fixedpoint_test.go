@@ -33,6 +33,17 @@ import (
 	. "github.com/onflow/cadence/test_utils/interpreter_utils"
 )
 
+// fix128BigInt returns the 128-bit fixed-point representation
+// of the decimal number mantissa * 10^-decimals
+func fix128BigInt(mantissa int64, decimals int64) *big.Int {
+	factor := new(big.Int).Exp(
+		big.NewInt(10),
+		big.NewInt(sema.Fix128Scale-decimals),
+		nil,
+	)
+	return new(big.Int).Mul(big.NewInt(mantissa), factor)
+}
+
 func TestInterpretNegativeZeroFixedPoint(t *testing.T) {
 
 	t.Parallel()
@@ -55,9 +66,11 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 
 	tests := map[string]interpreter.Value{
 		// Fix*
-		"Fix64": interpreter.NewUnmeteredFix64Value(123000000),
+		"Fix64":  interpreter.NewUnmeteredFix64Value(123000000),
+		"Fix128": interpreter.NewUnmeteredFix128Value(fix128BigInt(123, 2)),
 		// UFix*
-		"UFix64": interpreter.NewUnmeteredUFix64Value(123000000),
+		"UFix64":  interpreter.NewUnmeteredUFix64Value(123000000),
+		"UFix128": interpreter.NewUnmeteredUFix128Value(fix128BigInt(123, 2)),
 	}
 
 	for _, fixedPointType := range sema.AllFixedPointTypes {
@@ -113,8 +126,10 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 }
 
 var testFixedPointValues = map[string]interpreter.Value{
-	"Fix64":  interpreter.NewUnmeteredFix64Value(50 * sema.Fix64Factor),
-	"UFix64": interpreter.NewUnmeteredUFix64Value(50 * sema.Fix64Factor),
+	"Fix64":   interpreter.NewUnmeteredFix64Value(50 * sema.Fix64Factor),
+	"UFix64":  interpreter.NewUnmeteredUFix64Value(50 * sema.Fix64Factor),
+	"Fix128":  interpreter.NewUnmeteredFix128Value(fix128BigInt(50, 0)),
+	"UFix128": interpreter.NewUnmeteredUFix128Value(fix128BigInt(50, 0)),
 }
 
 func init() {
@@ -578,6 +593,14 @@ func TestInterpretFixedPointMinMax(t *testing.T) {
 			min: interpreter.NewUnmeteredUFix64Value(0),
 			max: interpreter.NewUnmeteredUFix64Value(math.MaxUint64),
 		},
+		sema.Fix128Type: {
+			min: interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+			max: interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+		},
+		sema.UFix128Type: {
+			min: interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+			max: interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+		},
 	}
 
 	for _, ty := range sema.AllFixedPointTypes {
@@ -643,6 +666,30 @@ func TestInterpretStringFixedPointConversion(t *testing.T) {
 			[]*big.Int{sema.UFix64TypeMinIntBig, sema.UFix64TypeMaxIntBig, bigZero, bigOne},
 			[]*big.Int{sema.UFix64TypeMinFractionalBig, sema.UFix64TypeMaxFractionalBig, bigZero, bigOne},
 		},
+		{
+			"Fix128",
+			func(isNeg bool, decimal, fractional *big.Int, scale uint) (interpreter.Value, error) {
+				fixedVal, err := fixedpoint.NewFix128(isNeg, decimal, fractional, scale)
+				if err != nil {
+					return nil, err
+				}
+				return interpreter.NewUnmeteredFix128Value(fixedVal), nil
+			},
+			[]*big.Int{sema.Fix128TypeMinIntBig, sema.Fix128TypeMaxIntBig, bigZero, bigOne},
+			[]*big.Int{sema.UFix128TypeMinFractionalBig, sema.Fix128TypeMaxFractionalBig, bigZero, bigOne},
+		},
+		{
+			"UFix128",
+			func(_ bool, decimal, fractional *big.Int, scale uint) (interpreter.Value, error) {
+				fixedVal, err := fixedpoint.NewUFix128(decimal, fractional, scale)
+				if err != nil {
+					return nil, err
+				}
+				return interpreter.NewUnmeteredUFix128Value(fixedVal), nil
+			},
+			[]*big.Int{sema.UFix128TypeMinIntBig, sema.UFix128TypeMaxIntBig, bigZero, bigOne},
+			[]*big.Int{sema.UFix128TypeMinFractionalBig, sema.UFix128TypeMaxFractionalBig, bigZero, bigOne},
+		},
 	}
 
 	genCases := func(intComponents, fracComponents []*big.Int) []testcase {
@@ -226,6 +226,161 @@ func TestInterpretForStatementEmpty(t *testing.T) {
 	)
 }
 
+func TestInterpretForStatementWithKeyValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var sum = 0
+               for (key, value) in {1: 10, 2: 20, 3: 30} {
+                   sum = sum + key * value
+               }
+               return sum
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(140),
+			value,
+		)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Bool {
+               var x = false
+               let dict: {String: Int} = {}
+               for (key, value) in dict {
+                   x = true
+               }
+               return x
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.FalseValue,
+			value,
+		)
+	})
+
+	t.Run("values are copied", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): [Int] {
+               let dict = {"a": [1]}
+               for (key, value) in dict {
+                   value.append(2)
+               }
+               return dict["a"]!
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           resource R {
+               let value: Int
+
+               init(value: Int) {
+                   self.value = value
+               }
+           }
+
+           fun test(): Int {
+               let dict <- {"a": <-create R(value: 1), "b": <-create R(value: 2)}
+               var sum = 0
+               for (key, value) in &dict as &{String: R} {
+                   sum = sum + value.value
+               }
+               destroy dict
+               return sum
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			value,
+		)
+	})
+
+	t.Run("break and continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var count = 0
+               for (key, value) in {1: 1, 2: 2, 3: 3, 4: 4} {
+                   if key == 1 {
+                       continue
+                   }
+                   count = count + 1
+                   if count == 2 {
+                       break
+                   }
+               }
+               return count
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+			value,
+		)
+	})
+}
+
 func TestInterpretForString(t *testing.T) {
 
 	t.Parallel()
@@ -0,0 +1,401 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/test_utils/common_utils"
+	. "github.com/onflow/cadence/test_utils/interpreter_utils"
+)
+
+func TestInterpretGenericFunction(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("identity", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun identity<T>(_ value: T): T {
+              return value
+          }
+
+          fun test(): Int {
+              return identity(42)
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			value,
+		)
+	})
+
+	t.Run("type argument in body", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun typeOf<T>(_ value: T): Type {
+              return Type<T>()
+          }
+
+          fun test(): Type {
+              return typeOf(Int8(1))
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTypeValue(interpreter.PrimitiveStaticTypeInt8),
+			value,
+		)
+	})
+
+	t.Run("array of type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun wrap<T>(_ value: T): [T] {
+              let values: [T] = [value]
+              return values
+          }
+
+          fun test(): Type {
+              return wrap<Int?>(1).getType()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTypeValue(
+				&interpreter.VariableSizedStaticType{
+					Type: &interpreter.OptionalStaticType{
+						Type: interpreter.PrimitiveStaticTypeInt,
+					},
+				},
+			),
+			value,
+		)
+	})
+
+	t.Run("cast to type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun cast<T>(_ value: AnyStruct): T? {
+              return value as? T
+          }
+
+          fun test(): [Bool] {
+              return [
+                  cast<Int>(1) != nil,
+                  cast<String>(1) != nil
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.ZeroAddress,
+				interpreter.TrueValue,
+				interpreter.FalseValue,
+			),
+			value,
+		)
+	})
+
+	t.Run("closure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun typeGetter<T>(): fun(): Type {
+              return fun(): Type {
+                  return Type<T>()
+              }
+          }
+
+          fun test(): Type {
+              let getter = typeGetter<String>()
+              return getter()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTypeValue(interpreter.PrimitiveStaticTypeString),
+			value,
+		)
+	})
+
+	t.Run("bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface Named {
+              fun name(): String
+          }
+
+          struct S: Named {
+              fun name(): String { return "S" }
+          }
+
+          fun firstName<T: {Named}>(_ values: [T]): String {
+              return values[0].name()
+          }
+
+          fun test(): String {
+              return firstName([S()])
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("S"),
+			value,
+		)
+	})
+}
+
+func TestInterpretGenericComposite(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct Box<T> {
+          let value: T
+
+          init(value: T) {
+              self.value = value
+          }
+
+          fun get(): T {
+              return self.value
+          }
+
+          fun valueType(): Type {
+              return Type<T>()
+          }
+
+          fun map<U>(_ f: fun(T): U): Box<U> {
+              return Box<U>(value: f(self.value))
+          }
+      }
+
+      resource Vault<T> {
+          var items: [T]
+
+          init() {
+              self.items = []
+          }
+
+          fun deposit(_ item: T) {
+              self.items.append(item)
+          }
+      }
+
+      fun testGet(): Int {
+          return Box(value: 1).get()
+      }
+
+      fun testType(): Type {
+          return Box(value: 1).getType()
+      }
+
+      fun testValueType(): Type {
+          return Box<Int8>(value: 1).valueType()
+      }
+
+      fun testMap(): Type {
+          return Box(value: 1).map(fun (x: Int): String { return x.toString() }).getType()
+      }
+
+      fun testCast(): [Bool] {
+          let box: AnyStruct = Box(value: 1)
+          return [
+              box as? Box<Int> != nil,
+              box as? Box<String> != nil,
+              box.isInstance(Type<Box<Int>>())
+          ]
+      }
+
+      fun testResource(): [Int] {
+          let vault <- create Vault<Int>()
+          vault.deposit(1)
+          vault.deposit(2)
+          let items = vault.items
+          destroy vault
+          return items
+      }
+    `)
+
+	boxType := func(typeArgument interpreter.StaticType) *interpreter.CompositeStaticType {
+		staticType := interpreter.NewCompositeStaticTypeComputeTypeID(
+			nil,
+			TestLocation,
+			"Box",
+		)
+		staticType.TypeArguments = []interpreter.StaticType{typeArgument}
+		return staticType
+	}
+
+	t.Run("get", func(t *testing.T) {
+
+		value, err := inter.Invoke("testGet")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("type", func(t *testing.T) {
+
+		value, err := inter.Invoke("testType")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTypeValue(boxType(interpreter.PrimitiveStaticTypeInt)),
+			value,
+		)
+
+		assert.Equal(t,
+			common.TypeID("S.test.Box<Int>"),
+			value.(interpreter.TypeValue).Type.ID(),
+		)
+	})
+
+	t.Run("type argument in function", func(t *testing.T) {
+
+		value, err := inter.Invoke("testValueType")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTypeValue(interpreter.PrimitiveStaticTypeInt8),
+			value,
+		)
+	})
+
+	t.Run("generic function", func(t *testing.T) {
+
+		value, err := inter.Invoke("testMap")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTypeValue(boxType(interpreter.PrimitiveStaticTypeString)),
+			value,
+		)
+	})
+
+	t.Run("cast", func(t *testing.T) {
+
+		value, err := inter.Invoke("testCast")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.ZeroAddress,
+				interpreter.TrueValue,
+				interpreter.FalseValue,
+				interpreter.TrueValue,
+			),
+			value,
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		value, err := inter.Invoke("testResource")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			value,
+		)
+	})
+}
@@ -76,9 +76,24 @@ type OnLoopIterationFunc func(
 	line int,
 )
 
+// OnBranchFunc is a function that is triggered when a branch of a conditional element is about to be executed,
+// e.g. the then-branch (0) or the else-branch (1) of an if-statement.
+type OnBranchFunc func(
+	inter *Interpreter,
+	element ast.Element,
+	branch int,
+)
+
 // OnFunctionInvocationFunc is a function that is triggered when a function is about to be invoked.
 type OnFunctionInvocationFunc func(inter *Interpreter)
 
+// OnInterpretedFunctionInvocationFunc is a function that is triggered
+// when an interpreted function is about to be invoked.
+type OnInterpretedFunctionInvocationFunc func(
+	inter *Interpreter,
+	function *InterpretedFunctionValue,
+)
+
 // OnInvokedFunctionReturnFunc is a function that is triggered when an invoked function returned.
 type OnInvokedFunctionReturnFunc func(inter *Interpreter)
 
@@ -198,7 +213,8 @@ type CompositeValueFunctionsHandlerFunc func(
 // As there is no support for inheritance of concrete types,
 // these are the "leaf" nodes in the call chain, and are functions.
 type CompositeTypeCode struct {
-	CompositeFunctions *FunctionOrderedMap
+	CompositeFunctions      *FunctionOrderedMap
+	CompositeComputedFields map[string]ComputedField
 }
 
 type FunctionWrapper = func(inner FunctionValue) FunctionValue
@@ -437,14 +453,16 @@ func (interpreter *Interpreter) InvokeExternally(
 
 	if argumentCount != parameterCount {
 
-		if argumentCount < functionType.Arity.MinCount(parameterCount) {
+		arity := functionType.EffectiveArity()
+
+		if argumentCount < arity.MinCount(parameterCount) {
 			return nil, ArgumentCountError{
 				ParameterCount: parameterCount,
 				ArgumentCount:  argumentCount,
 			}
 		}
 
-		maxCount := functionType.Arity.MaxCount(parameterCount)
+		maxCount := arity.MaxCount(parameterCount)
 		if maxCount != nil && argumentCount > *maxCount {
 			return nil, ArgumentCountError{
 				ParameterCount: parameterCount,
@@ -691,6 +709,15 @@ func (interpreter *Interpreter) VisitProgram(program *ast.Program) {
 			interpreter.setVariable(identifier, variable)
 			interpreter.Globals.Set(identifier, variable)
 
+			debugger := interpreter.SharedState.Config.Debugger
+			if debugger != nil {
+				debugger.onGlobalVariableDeclaration(
+					variable,
+					declaration.DeclarationKind(),
+					interpreter.Program.Elaboration.VariableDeclarationTypes(declaration).TargetType,
+				)
+			}
+
 			variableDeclarationVariables = append(variableDeclarationVariables, variable)
 		}
 	}
@@ -790,6 +817,7 @@ func (interpreter *Interpreter) functionDeclarationValue(
 
 	return NewInterpretedFunctionValue(
 		interpreter,
+		declaration.Identifier.Identifier,
 		declaration.ParameterList,
 		functionType,
 		lexicalScope,
@@ -941,14 +969,22 @@ func (interpreter *Interpreter) visitCondition(condition ast.Condition, kind ast
 			message = messageValue.(*StringValue).Str
 		}
 
-		panic(ConditionError{
+		err := ConditionError{
 			ConditionKind: kind,
 			Message:       message,
 			LocationRange: LocationRange{
 				Location:    interpreter.Location,
 				HasPosition: statement,
 			},
-		})
+		}
+
+		// Stop at the failed condition, instead of at the statement invoking the function
+		debugger := interpreter.SharedState.Config.Debugger
+		if debugger != nil && debugger.BreakOnError() {
+			debugger.onError(interpreter, statement, err)
+		}
+
+		panic(err)
 
 	case *ast.EmitCondition:
 		interpreter.evalStatement((*ast.EmitStatement)(condition))
@@ -972,6 +1008,24 @@ func (interpreter *Interpreter) declareVariable(identifier string, value Value)
 	return variable
 }
 
+// declareTypedVariable declares a variable in the latest scope,
+// and reports its declared kind and static type to the debugger, if any
+func (interpreter *Interpreter) declareTypedVariable(
+	identifier string,
+	value Value,
+	kind common.DeclarationKind,
+	ty sema.Type,
+) Variable {
+	variable := interpreter.declareVariable(identifier, value)
+
+	debugger := interpreter.SharedState.Config.Debugger
+	if debugger != nil {
+		debugger.onVariableDeclaration(interpreter, variable, kind, ty)
+	}
+
+	return variable
+}
+
 // declareSelfVariable declares a special "self" variable in the latest scope
 func (interpreter *Interpreter) declareSelfVariable(value Value, locationRange LocationRange) Variable {
 	identifier := sema.SelfIdentifier
@@ -1242,6 +1296,7 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 	declarationActivation := declarationInterpreter.activations.CurrentOrNew()
 
 	var initializerFunction FunctionValue
+	var initializerOverloads []initializerOverload
 	if declaration.Kind() == common.CompositeKindEvent {
 		// Initializer could ideally be a bound function.
 		// However, since it is created and being called here itself, and
@@ -1291,9 +1346,25 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 			},
 		)
 	} else {
-		compositeInitializerFunction := declarationInterpreter.compositeInitializerFunction(declaration, lexicalScope)
-		if compositeInitializerFunction != nil {
-			initializerFunction = compositeInitializerFunction
+		initializers := declaration.DeclarationMembers().Initializers()
+		if len(initializers) > 0 {
+			initializerFunction = declarationInterpreter.compositeInitializerFunction(initializers[0], lexicalScope)
+
+			// All other initializers of a composite with overloaded initializers
+			// are provided through separate constructors
+
+			if len(compositeType.InitializerOverloads) > 0 {
+				overloadTypes := compositeType.ConstructorOverloadFunctionTypes()
+				for i, initializer := range initializers[1:] {
+					initializerOverloads = append(
+						initializerOverloads,
+						initializerOverload{
+							constructorType: overloadTypes[i],
+							function:        declarationInterpreter.compositeInitializerFunction(initializer, lexicalScope),
+						},
+					)
+				}
+			}
 		}
 	}
 
@@ -1303,27 +1374,6 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 		functions.Set(resourceDefaultDestroyEventName(compositeType), destroyEventConstructor)
 	}
 
-	applyDefaultFunctions := func(_ *sema.InterfaceType, code WrapperCode) {
-
-		// Apply default functions, if conforming type does not provide the function
-
-		// Iterating over the map in a non-deterministic way is OK,
-		// we only apply the function wrapper to each function,
-		// the order does not matter.
-
-		if code.Functions != nil {
-			code.Functions.Foreach(func(name string, function FunctionValue) {
-				if functions == nil {
-					functions = orderedmap.New[FunctionOrderedMap](code.Functions.Len())
-				}
-				if functions.Contains(name) {
-					return
-				}
-				functions.Set(name, function)
-			})
-		}
-	}
-
 	config := declarationInterpreter.SharedState.Config
 
 	wrapFunctions := func(ty *sema.InterfaceType, code WrapperCode) {
@@ -1334,39 +1384,48 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 			code.InitializerFunctionWrapper
 
 		if initializerFunctionWrapper != nil {
-			initializerFunction = initializerFunctionWrapper(initializerFunction)
-		}
+			// If the initializers are overloaded,
+			// wrap the overload which fulfills the interface's initializer requirement
 
-		// Wrap functions
-
-		// Iterating over the map in a non-deterministic way is OK,
-		// we only apply the function wrapper to each function,
-		// the order does not matter.
-
-		for name, functionWrapper := range code.FunctionWrappers { //nolint:maprange
-			// If there's a default implementation, then skip explicitly/separately
-			// running the conditions of that functions.
-			// Because the conditions also get executed when the default implementation is executed.
-			// This works because:
-			// 	- `code.Functions` only contains default implementations.
-			//	- There is always only one default implementation (cannot override by other interfaces).
-			if code.Functions.Contains(name) {
-				continue
+			overloaded := false
+			if len(initializerOverloads) > 0 {
+				requiredType := &sema.FunctionType{
+					Parameters: ty.InitializerParameters,
+				}
+				for i, overload := range initializerOverloads {
+					if overload.constructorType.HasSameArgumentLabels(requiredType) {
+						initializerOverloads[i].function = initializerFunctionWrapper(overload.function)
+						overloaded = true
+						break
+					}
+				}
 			}
 
-			fn, ok := functions.Get(name)
-			// If there is a wrapper, there MUST be a body.
-			if !ok {
-				panic(errors.NewUnreachableError())
+			if !overloaded {
+				initializerFunction = initializerFunctionWrapper(initializerFunction)
 			}
-			functions.Set(name, functionWrapper(fn))
 		}
 
+		wrapConformanceFunctions(compositeType, functions, ty, code)
+
 		if code.DefaultDestroyEventConstructor != nil {
 			functions.Set(resourceDefaultDestroyEventName(ty), code.DefaultDestroyEventConstructor)
 		}
 	}
 
+	// Functions with default arguments fill in omitted arguments when they are invoked.
+	// The condition wrappers of the conformances expect all arguments to be provided,
+	// so record the functions before they get wrapped, see wrapDefaultArguments
+
+	defaultArgumentFunctionNames, originalFunctions := defaultArgumentFunctions(functions)
+
+	originalInitializerFunction, _ := initializerFunction.(*InterpretedFunctionValue)
+
+	originalInitializerOverloadFunctions := make([]*InterpretedFunctionValue, len(initializerOverloads))
+	for i, overload := range initializerOverloads {
+		originalInitializerOverloadFunctions[i], _ = overload.function.(*InterpretedFunctionValue)
+	}
+
 	conformances := compositeType.EffectiveInterfaceConformances()
 	interfaceCodes := declarationInterpreter.SharedState.typeCodes.InterfaceCodes
 
@@ -1377,7 +1436,7 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 
 	for i := len(conformances) - 1; i >= 0; i-- {
 		conformance := conformances[i].InterfaceType
-		applyDefaultFunctions(conformance, interfaceCodes[conformance.ID()])
+		applyDefaultFunctions(functions, interfaceCodes[conformance.ID()])
 	}
 
 	for i := len(conformances) - 1; i >= 0; i-- {
@@ -1385,6 +1444,26 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 		wrapFunctions(conformance, interfaceCodes[conformance.ID()])
 	}
 
+	for _, name := range defaultArgumentFunctionNames {
+		function, _ := functions.Get(name)
+		functions.Set(
+			name,
+			declarationInterpreter.wrapDefaultArguments(originalFunctions[name], function),
+		)
+	}
+
+	initializerFunction = declarationInterpreter.wrapDefaultArguments(
+		originalInitializerFunction,
+		initializerFunction,
+	)
+
+	for i, original := range originalInitializerOverloadFunctions {
+		initializerOverloads[i].function = declarationInterpreter.wrapDefaultArguments(
+			original,
+			initializerOverloads[i].function,
+		)
+	}
+
 	declarationInterpreter.SharedState.typeCodes.CompositeCodes[compositeType.ID()] = CompositeTypeCode{
 		CompositeFunctions: functions,
 	}
@@ -1395,7 +1474,11 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 
 	constructorType := compositeType.ConstructorFunctionType()
 
-	constructorGenerator := func(address common.Address) *HostFunctionValue {
+	newConstructor := func(
+		address common.Address,
+		constructorType *sema.FunctionType,
+		initializerFunction FunctionValue,
+	) *HostFunctionValue {
 		// Constructor is a static function.
 		return NewStaticHostFunctionValue(
 			declarationInterpreter,
@@ -1475,6 +1558,18 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 				value.injectedFields = injectedFields
 				value.Functions = functions
 
+				// Instances of generic composite types remember their type arguments
+
+				typeParameters := compositeType.TypeParameters()
+				if len(typeParameters) > 0 {
+					typeArguments := make([]StaticType, len(typeParameters))
+					for i, typeParameter := range typeParameters {
+						typeArgument := invocation.typeArgument(typeParameter)
+						typeArguments[i] = ConvertSemaToStaticType(interpreter, typeArgument)
+					}
+					value.SetTypeArguments(typeArguments)
+				}
+
 				var self Value = value
 				if declaration.Kind() == common.CompositeKindAttachment {
 
@@ -1526,6 +1621,10 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 		)
 	}
 
+	constructorGenerator := func(address common.Address) *HostFunctionValue {
+		return newConstructor(address, constructorType, initializerFunction)
+	}
+
 	// Contract declarations declare a value / instance (singleton),
 	// for all other composite kinds, the constructor is declared
 
@@ -1546,6 +1645,28 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 	} else {
 		constructor := constructorGenerator(common.ZeroAddress)
 		constructor.NestedVariables = nestedVariables
+
+		// Declare the constructors of the initializer overloads
+		// as nested variables of the constructor,
+		// under the overload names the checker resolved invocations to
+
+		for _, overload := range initializerOverloads {
+			overloadName := sema.OverloadName(
+				sema.InitializerOverloadNamePrefix,
+				overload.constructorType.ArgumentLabels(),
+			)
+
+			overloadConstructor := newConstructor(
+				common.ZeroAddress,
+				overload.constructorType,
+				overload.function,
+			)
+
+			nestedVariables[overloadName] = NewVariableWithValue(
+				declarationInterpreter,
+				overloadConstructor,
+			)
+		}
 		variable.SetValue(
 			declarationInterpreter,
 			LocationRange{
@@ -1559,6 +1680,136 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 	return lexicalScope, variable
 }
 
+// hasDefaultArguments returns true if any parameter of the given function has a default argument
+// applyDefaultFunctions applies the default functions of an interface
+// to the functions of a conforming type,
+// if the conforming type does not provide the function
+func applyDefaultFunctions(functions *FunctionOrderedMap, code WrapperCode) {
+	if code.Functions == nil {
+		return
+	}
+
+	code.Functions.Foreach(func(name string, function FunctionValue) {
+		if functions.Contains(name) {
+			return
+		}
+		functions.Set(name, function)
+	})
+}
+
+// wrapConformanceFunctions wraps the functions of a conforming type
+// with the function conditions of an interface
+func wrapConformanceFunctions(
+	compositeType *sema.CompositeType,
+	functions *FunctionOrderedMap,
+	interfaceType *sema.InterfaceType,
+	code WrapperCode,
+) {
+	// Iterating over the map in a non-deterministic way is OK,
+	// we only apply the function wrapper to each function,
+	// the order does not matter.
+
+	for name, functionWrapper := range code.FunctionWrappers { //nolint:maprange
+		// If there's a default implementation, then skip explicitly/separately
+		// running the conditions of that functions.
+		// Because the conditions also get executed when the default implementation is executed.
+		// This works because:
+		// 	- `code.Functions` only contains default implementations.
+		//	- There is always only one default implementation (cannot override by other interfaces).
+		if code.Functions.Contains(name) {
+			continue
+		}
+
+		// If the function is overloaded,
+		// wrap the overload which fulfills the interface's function requirement
+
+		if member, ok := compositeType.Members.Get(name); ok && len(member.Overloads) > 0 {
+			if interfaceMember, ok := interfaceType.Members.Get(name); ok {
+				name = member.Overload(interfaceMember.ArgumentLabels).DeclaredName()
+			}
+		}
+
+		fn, ok := functions.Get(name)
+		// If there is a wrapper, there MUST be a body.
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+		functions.Set(name, functionWrapper(fn))
+	}
+}
+
+// defaultArgumentFunctions returns the names of the given functions which have default arguments,
+// and the functions themselves, so they can be re-wrapped after they got wrapped with conditions
+func defaultArgumentFunctions(functions *FunctionOrderedMap) (
+	names []string,
+	originalFunctions map[string]*InterpretedFunctionValue,
+) {
+	originalFunctions = map[string]*InterpretedFunctionValue{}
+
+	functions.Foreach(func(name string, function FunctionValue) {
+		interpretedFunction, ok := function.(*InterpretedFunctionValue)
+		if !ok || !hasDefaultArguments(interpretedFunction) {
+			return
+		}
+		names = append(names, name)
+		originalFunctions[name] = interpretedFunction
+	})
+
+	return
+}
+
+func hasDefaultArguments(function *InterpretedFunctionValue) bool {
+	if function.ParameterList == nil {
+		return false
+	}
+	for _, parameter := range function.ParameterList.Parameters {
+		if parameter.DefaultArgument != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// wrapDefaultArguments returns a function which fills in the omitted arguments
+// with the default arguments of the given original function,
+// and then invokes the given wrapped function.
+//
+// If the original function was not wrapped, or if it has no default arguments,
+// the wrapped function is returned as-is
+func (interpreter *Interpreter) wrapDefaultArguments(
+	original *InterpretedFunctionValue,
+	wrapped FunctionValue,
+) FunctionValue {
+	if original == nil ||
+		wrapped == FunctionValue(original) ||
+		!hasDefaultArguments(original) {
+
+		return wrapped
+	}
+
+	// Default arguments wrapper is a static function.
+	return NewStaticHostFunctionValue(
+		interpreter,
+		original.Type,
+		func(invocation Invocation) Value {
+			invocation = original.Interpreter.withDefaultArguments(
+				original.ParameterList,
+				original.Type,
+				original.Activation,
+				invocation,
+			)
+			return wrapped.invoke(invocation)
+		},
+	)
+}
+
+// initializerOverload is an initializer of a composite with overloaded initializers,
+// other than the first one, which is invoked by the composite's constructor
+type initializerOverload struct {
+	constructorType *sema.FunctionType
+	function        FunctionValue
+}
+
 type EnumCase struct {
 	RawValue IntegerValue
 	Value    MemberAccessibleValue
@@ -1584,6 +1835,39 @@ func (interpreter *Interpreter) declareEnumConstructor(
 
 	intType := sema.IntType
 
+	// Enums may declare functions and computed fields,
+	// and may conform to struct interfaces
+
+	functions := interpreter.compositeFunctions(declaration, lexicalScope)
+
+	defaultArgumentFunctionNames, originalFunctions := defaultArgumentFunctions(functions)
+
+	conformances := compositeType.EffectiveInterfaceConformances()
+	interfaceCodes := interpreter.SharedState.typeCodes.InterfaceCodes
+
+	for i := len(conformances) - 1; i >= 0; i-- {
+		conformance := conformances[i].InterfaceType
+		applyDefaultFunctions(functions, interfaceCodes[conformance.ID()])
+	}
+
+	for i := len(conformances) - 1; i >= 0; i-- {
+		conformance := conformances[i].InterfaceType
+		wrapConformanceFunctions(compositeType, functions, conformance, interfaceCodes[conformance.ID()])
+	}
+
+	for _, name := range defaultArgumentFunctionNames {
+		function, _ := functions.Get(name)
+		functions.Set(
+			name,
+			interpreter.wrapDefaultArguments(originalFunctions[name], function),
+		)
+	}
+
+	interpreter.SharedState.typeCodes.CompositeCodes[compositeType.ID()] = CompositeTypeCode{
+		CompositeFunctions:      functions,
+		CompositeComputedFields: interpreter.compositeComputedFields(declaration, lexicalScope),
+	}
+
 	enumCases := declaration.Members.EnumCases()
 	caseValues := make([]EnumCase, len(enumCases))
 
@@ -1692,19 +1976,10 @@ func EnumConstructorFunction(
 }
 
 func (interpreter *Interpreter) compositeInitializerFunction(
-	compositeDeclaration ast.CompositeLikeDeclaration,
+	initializer *ast.SpecialFunctionDeclaration,
 	lexicalScope *VariableActivation,
 ) *InterpretedFunctionValue {
 
-	// TODO: support multiple overloaded initializers
-
-	initializers := compositeDeclaration.DeclarationMembers().Initializers()
-	var initializer *ast.SpecialFunctionDeclaration
-	if len(initializers) == 0 {
-		return nil
-	}
-
-	initializer = initializers[0]
 	functionType := interpreter.Program.Elaboration.ConstructorFunctionType(initializer)
 
 	parameterList := initializer.FunctionDeclaration.ParameterList
@@ -1730,6 +2005,7 @@ func (interpreter *Interpreter) compositeInitializerFunction(
 
 	return NewInterpretedFunctionValue(
 		interpreter,
+		initializer.FunctionDeclaration.Identifier.Identifier,
 		parameterList,
 		functionType,
 		lexicalScope,
@@ -1781,6 +2057,16 @@ func (interpreter *Interpreter) compositeFunctions(
 
 	for _, functionDeclaration := range compositeDeclaration.DeclarationMembers().Functions() {
 		name := functionDeclaration.Identifier.Identifier
+
+		// The first function of an overload set is declared under its identifier,
+		// the other overloads are declared under their overload name
+		if functions.Contains(name) {
+			name = sema.OverloadName(
+				name,
+				functionDeclaration.ParameterList.EffectiveArgumentLabels(),
+			)
+		}
+
 		functions.Set(
 			name,
 			interpreter.compositeFunction(
@@ -1826,29 +2112,46 @@ func (interpreter *Interpreter) compositeFunction(
 
 	functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionType(functionDeclaration)
 
+	return interpreter.functionBlockValue(
+		functionDeclaration.Identifier.Identifier,
+		functionDeclaration.ParameterList,
+		functionType,
+		functionDeclaration.FunctionBlock,
+		lexicalScope,
+	)
+}
+
+func (interpreter *Interpreter) functionBlockValue(
+	name string,
+	parameterList *ast.ParameterList,
+	functionType *sema.FunctionType,
+	functionBlock *ast.FunctionBlock,
+	lexicalScope *VariableActivation,
+) *InterpretedFunctionValue {
+
 	var preConditions []ast.Condition
 
-	if functionDeclaration.FunctionBlock.PreConditions != nil {
-		preConditions = functionDeclaration.FunctionBlock.PreConditions.Conditions
+	if functionBlock.PreConditions != nil {
+		preConditions = functionBlock.PreConditions.Conditions
 	}
 
 	var beforeStatements []ast.Statement
 	var rewrittenPostConditions []ast.Condition
 
-	if functionDeclaration.FunctionBlock.PostConditions != nil {
+	if functionBlock.PostConditions != nil {
 
 		postConditionsRewrite :=
-			interpreter.Program.Elaboration.PostConditionsRewrite(functionDeclaration.FunctionBlock.PostConditions)
+			interpreter.Program.Elaboration.PostConditionsRewrite(functionBlock.PostConditions)
 
 		beforeStatements = postConditionsRewrite.BeforeStatements
 		rewrittenPostConditions = postConditionsRewrite.RewrittenPostConditions
 	}
 
-	parameterList := functionDeclaration.ParameterList
-	statements := functionDeclaration.FunctionBlock.Block.Statements
+	statements := functionBlock.Block.Statements
 
 	return NewInterpretedFunctionValue(
 		interpreter,
+		name,
 		parameterList,
 		functionType,
 		lexicalScope,
@@ -1859,6 +2162,52 @@ func (interpreter *Interpreter) compositeFunction(
 	)
 }
 
+// compositeComputedFields returns the computed fields of the given composite declaration.
+// Each computed field invokes the field's getter with the composite value as `self`
+func (interpreter *Interpreter) compositeComputedFields(
+	compositeDeclaration ast.CompositeLikeDeclaration,
+	lexicalScope *VariableActivation,
+) map[string]ComputedField {
+
+	fields := compositeDeclaration.DeclarationMembers().ComputedFields()
+	if len(fields) == 0 {
+		return nil
+	}
+
+	computedFields := make(map[string]ComputedField, len(fields))
+
+	for _, field := range fields {
+		getter := interpreter.functionBlockValue(
+			field.Identifier.Identifier,
+			&ast.ParameterList{},
+			interpreter.Program.Elaboration.FieldDeclarationGetterType(field),
+			field.Getter,
+			lexicalScope,
+		)
+
+		computedFields[field.Identifier.Identifier] = func(
+			invocationInterpreter *Interpreter,
+			locationRange LocationRange,
+			compositeValue *CompositeValue,
+		) Value {
+			var self Value = compositeValue
+			invocation := NewInvocation(
+				invocationInterpreter,
+				&self,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				locationRange,
+			)
+			return getter.invoke(invocation)
+		}
+	}
+
+	return computedFields
+}
+
 func (interpreter *Interpreter) VisitFieldDeclaration(_ *ast.FieldDeclaration) StatementResult {
 	// fields aren't interpreted
 	panic(errors.NewUnreachableError())
@@ -1898,6 +2247,9 @@ func (interpreter *Interpreter) transferAndConvert(
 	locationRange LocationRange,
 ) Value {
 
+	valueType = interpreter.resolveTypeArguments(valueType)
+	targetType = interpreter.resolveTypeArguments(targetType)
+
 	transferredValue := value.Transfer(
 		interpreter,
 		locationRange,
@@ -1989,6 +2341,17 @@ func (interpreter *Interpreter) convertStaticType(
 			)
 		}
 
+	case *SetStaticType:
+		if targetSetType, isSetType := targetSemaType.(*sema.SetType); isSetType {
+			return NewSetStaticType(
+				interpreter,
+				interpreter.convertStaticType(
+					valueStaticType.ElementType,
+					targetSetType.ElementType,
+				),
+			)
+		}
+
 	case *VariableSizedStaticType:
 		if targetArrayType, isArrayType := targetSemaType.(*sema.VariableSizedType); isArrayType {
 			return NewVariableSizedStaticType(
@@ -2163,6 +2526,16 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 		if !valueType.Equal(unwrappedTargetType) {
 			return ConvertUFix64(interpreter, value, locationRange)
 		}
+
+	case sema.Fix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertFix128(interpreter, value, locationRange)
+		}
+
+	case sema.UFix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertUFix128(interpreter, value, locationRange)
+		}
 	}
 
 	switch unwrappedTargetType := unwrappedTargetType.(type) {
@@ -2938,6 +3311,26 @@ var fromStringFunctionValues = func() map[string]fromStringFunctionValue {
 			val := NewUFix64Value(inter, n.Uint64)
 			return NewSomeValueNonCopying(inter, val)
 		}),
+		newFromStringFunction(sema.Fix128Type, func(inter *Interpreter, input string) OptionalValue {
+			n, err := fixedpoint.ParseFix128(input)
+			if err != nil {
+				return NilOptionalValue
+			}
+			val := NewFix128Value(inter, func() *big.Int {
+				return n
+			})
+			return NewSomeValueNonCopying(inter, val)
+		}),
+		newFromStringFunction(sema.UFix128Type, func(inter *Interpreter, input string) OptionalValue {
+			n, err := fixedpoint.ParseUFix128(input)
+			if err != nil {
+				return NilOptionalValue
+			}
+			val := NewUFix128Value(inter, func() *big.Int {
+				return n
+			})
+			return NewSomeValueNonCopying(inter, val)
+		}),
 	}
 
 	values := make(map[string]fromStringFunctionValue, len(declarations))
@@ -3163,6 +3556,16 @@ var fromBigEndianBytesFunctionValues = func() map[string]fromBigEndianBytesFunct
 				return val
 			})
 		}),
+		newFromBigEndianBytesFunction(sema.Fix128Type, 16, func(i *Interpreter, b []byte) Value {
+			return NewFix128Value(i, func() *big.Int {
+				return values.BigEndianBytesToSignedBigInt(b)
+			})
+		}),
+		newFromBigEndianBytesFunction(sema.UFix128Type, 16, func(i *Interpreter, b []byte) Value {
+			return NewUFix128Value(i, func() *big.Int {
+				return values.BigEndianBytesToUnsignedBigInt(b)
+			})
+		}),
 	}
 
 	values := make(map[string]fromBigEndianBytesFunctionValue, len(declarations))
@@ -3383,6 +3786,24 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 		min: NewUnmeteredUFix64Value(0),
 		max: NewUnmeteredUFix64Value(math.MaxUint64),
 	},
+	{
+		name:         sema.Fix128TypeName,
+		functionType: sema.NumberConversionFunctionType(sema.Fix128Type),
+		convert: func(interpreter *Interpreter, value Value, locationRange LocationRange) Value {
+			return ConvertFix128(interpreter, value, locationRange)
+		},
+		min: NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+		max: NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+	},
+	{
+		name:         sema.UFix128TypeName,
+		functionType: sema.NumberConversionFunctionType(sema.UFix128Type),
+		convert: func(interpreter *Interpreter, value Value, locationRange LocationRange) Value {
+			return ConvertUFix128(interpreter, value, locationRange)
+		},
+		min: NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+		max: NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+	},
 	{
 		name:         sema.AddressTypeName,
 		functionType: sema.AddressConversionFunctionType,
@@ -4020,6 +4441,38 @@ var runtimeTypeConstructors = []runtimeTypeConstructor{
 			},
 		),
 	},
+	{
+		name: sema.SetTypeFunctionName,
+		converter: NewUnmeteredStaticHostFunctionValue(
+			sema.SetTypeFunctionType,
+			func(invocation Invocation) Value {
+				typeValue, ok := invocation.Arguments[0].(TypeValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				inter := invocation.Interpreter
+
+				ty := typeValue.Type
+				// Sets must hold hashable structs
+				elemSemaTy := MustConvertStaticToSemaType(ty, inter)
+				if !sema.IsSubType(elemSemaTy, sema.HashableStructType) {
+					return Nil
+				}
+
+				return NewSomeValueNonCopying(
+					inter,
+					NewTypeValue(
+						inter,
+						NewSetStaticType(
+							inter,
+							ty,
+						),
+					),
+				)
+			},
+		),
+	},
 }
 
 func defineRuntimeTypeConstructorFunctions(activation *VariableActivation) {
@@ -4843,7 +5296,8 @@ func (interpreter *Interpreter) GetCompositeValueComputedFields(v *CompositeValu
 
 	// TODO: add handler to config
 
-	return nil
+	compositeCodes := interpreter.SharedState.typeCodes.CompositeCodes
+	return compositeCodes[v.TypeID()].CompositeComputedFields
 }
 
 func (interpreter *Interpreter) GetCompositeValueInjectedFields(v *CompositeValue) map[string]Value {
@@ -4983,6 +5437,13 @@ func (interpreter *Interpreter) reportLoopIteration(pos ast.HasPosition) {
 	}
 }
 
+func (interpreter *Interpreter) reportBranch(element ast.Element, branch int) {
+	onBranch := interpreter.SharedState.Config.OnBranch
+	if onBranch != nil {
+		onBranch(interpreter, element, branch)
+	}
+}
+
 func (interpreter *Interpreter) reportFunctionInvocation() {
 	config := interpreter.SharedState.Config
 
@@ -5024,7 +5485,15 @@ func (interpreter *Interpreter) getAccessOfMember(self Value, identifier string)
 	if err != nil {
 		return sema.UnauthorizedAccess
 	}
-	member, hasMember := typ.GetMembers()[identifier]
+	members := typ.GetMembers()
+	member, hasMember := members[identifier]
+	if !hasMember {
+		// A function accessed by an overload name may not be overloaded,
+		// see getMember
+		if functionIdentifier, ok := sema.OverloadIdentifier(identifier); ok {
+			member, hasMember = members[functionIdentifier]
+		}
+	}
 	// certain values (like functions) have builtin members that are not present on the type
 	// in such cases the access is always unauthorized
 	if !hasMember {
@@ -5118,6 +5587,13 @@ func (interpreter *Interpreter) getMember(self Value, locationRange LocationRang
 		result = memberAccessibleValue.GetMember(interpreter, locationRange, identifier)
 	}
 	if result == nil {
+		// A function accessed through an interface type is accessed by an overload name,
+		// as it may be overloaded in the implementing composite.
+		// If the function is not overloaded, access it by its identifier
+		if functionIdentifier, ok := sema.OverloadIdentifier(identifier); ok {
+			return interpreter.getMember(self, locationRange, functionIdentifier)
+		}
+
 		switch identifier {
 		case sema.IsInstanceFunctionName:
 			return interpreter.isInstanceFunction(self)
@@ -5253,6 +5729,8 @@ func (interpreter *Interpreter) ValidateAtreeValue(value atree.Value) {
 			return info.Equal(other.(StaticType))
 		case *DictionaryStaticType:
 			return info.Equal(other.(StaticType))
+		case *SetStaticType:
+			return info.Equal(other.(StaticType))
 		case compositeTypeInfo:
 			return info.Equal(other)
 		case EmptyTypeInfo:
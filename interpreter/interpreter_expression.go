@@ -239,6 +239,13 @@ func (interpreter *Interpreter) memberExpressionGetterSetter(
 		panic(errors.NewUnreachableError())
 	}
 
+	// If an overload of an overloaded function was selected,
+	// or a function is accessed through an interface type,
+	// access the function by its overload name
+	if overloadName := memberAccessInfo.OverloadName; overloadName != "" {
+		identifier = overloadName
+	}
+
 	return getterSetter{
 		target: target,
 		get: func(allowMissing bool) Value {
@@ -287,7 +294,11 @@ func (interpreter *Interpreter) memberExpressionGetterSetter(
 			// This is pre-computed at the checker.
 			if memberAccessInfo.ReturnReference {
 				// Get a reference to the value
-				resultValue = interpreter.getReferenceValue(resultValue, memberAccessInfo.ResultingType, locationRange)
+				resultValue = interpreter.getReferenceValue(
+					resultValue,
+					interpreter.resolveTypeArguments(memberAccessInfo.ResultingType),
+					locationRange,
+				)
 			}
 
 			return resultValue
@@ -361,7 +372,7 @@ func (interpreter *Interpreter) checkMemberAccess(
 	checkInvalidatedResourceOrResourceReference(target, locationRange, interpreter)
 
 	memberInfo, _ := interpreter.Program.Elaboration.MemberExpressionMemberAccessInfo(memberExpression)
-	expectedType := memberInfo.AccessedType
+	expectedType := interpreter.resolveTypeArguments(memberInfo.AccessedType)
 
 	switch expectedType := expectedType.(type) {
 	case *sema.TransactionType:
@@ -643,14 +654,16 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 
 		// only evaluate right-hand side if left-hand side is nil
 		if some, ok := leftValue.(*SomeValue); ok {
+			interpreter.reportBranch(expression, 0)
 			return some.InnerValue()
 		}
 
+		interpreter.reportBranch(expression, 1)
 		value := rightValue()
 
 		binaryExpressionTypes := interpreter.Program.Elaboration.BinaryExpressionTypes(expression)
-		rightType := binaryExpressionTypes.RightType
-		resultType := binaryExpressionTypes.ResultType
+		rightType := interpreter.resolveTypeArguments(binaryExpressionTypes.RightType)
+		resultType := interpreter.resolveTypeArguments(binaryExpressionTypes.ResultType)
 
 		// NOTE: important to convert both any and optional
 		return interpreter.ConvertAndBox(locationRange, value, rightType, resultType)
@@ -915,17 +928,31 @@ func (interpreter *Interpreter) NewIntegerValueFromBigInt(value *big.Int, intege
 }
 
 func (interpreter *Interpreter) VisitFixedPointExpression(expression *ast.FixedPointExpression) Value {
-	// TODO: adjust once/if we support more fixed point types
-
 	fixedPointSubType := interpreter.Program.Elaboration.FixedPointExpression(expression)
 
-	value := fixedpoint.ConvertToFixedPointBigInt(
-		expression.Negative,
-		expression.UnsignedInteger,
-		expression.Fractional,
-		expression.Scale,
-		sema.Fix64Scale,
-	)
+	convert := func(targetScale uint) *big.Int {
+		return fixedpoint.ConvertToFixedPointBigInt(
+			expression.Negative,
+			expression.UnsignedInteger,
+			expression.Fractional,
+			expression.Scale,
+			targetScale,
+		)
+	}
+
+	switch fixedPointSubType {
+	case sema.Fix128Type:
+		return NewFix128Value(interpreter, func() *big.Int {
+			return convert(sema.Fix128Scale)
+		})
+	case sema.UFix128Type:
+		return NewUFix128Value(interpreter, func() *big.Int {
+			return convert(sema.Fix128Scale)
+		})
+	}
+
+	value := convert(sema.Fix64Scale)
+
 	switch fixedPointSubType {
 	case sema.Fix64Type, sema.SignedFixedPointType:
 		return NewFix64Value(interpreter, value.Int64)
@@ -987,7 +1014,7 @@ func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpres
 
 	arrayExpressionTypes := interpreter.Program.Elaboration.ArrayExpressionTypes(expression)
 	argumentTypes := arrayExpressionTypes.ArgumentTypes
-	arrayType := arrayExpressionTypes.ArrayType
+	arrayType := interpreter.resolveTypeArguments(arrayExpressionTypes.ArrayType).(sema.ArrayType)
 	elementType := arrayType.ElementType(false)
 
 	var copies []Value
@@ -1028,7 +1055,7 @@ func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.Dictio
 
 	dictionaryExpressionTypes := interpreter.Program.Elaboration.DictionaryExpressionTypes(expression)
 	entryTypes := dictionaryExpressionTypes.EntryTypes
-	dictionaryType := dictionaryExpressionTypes.DictionaryType
+	dictionaryType := interpreter.resolveTypeArguments(dictionaryExpressionTypes.DictionaryType).(*sema.DictionaryType)
 
 	var keyValuePairs []Value
 
@@ -1128,7 +1155,7 @@ func (interpreter *Interpreter) maybeGetReference(
 	indexExpressionTypes, _ := interpreter.Program.Elaboration.IndexExpressionTypes(expression)
 
 	if indexExpressionTypes.ReturnReference {
-		expectedType := indexExpressionTypes.ResultType
+		expectedType := interpreter.resolveTypeArguments(indexExpressionTypes.ResultType)
 
 		locationRange := LocationRange{
 			Location:    interpreter.Location,
@@ -1148,8 +1175,10 @@ func (interpreter *Interpreter) VisitConditionalExpression(expression *ast.Condi
 		panic(errors.NewUnreachableError())
 	}
 	if value {
+		interpreter.reportBranch(expression, 0)
 		return interpreter.evalExpression(expression.Then)
 	} else {
+		interpreter.reportBranch(expression, 1)
 		return interpreter.evalExpression(expression.Else)
 	}
 }
@@ -1200,6 +1229,17 @@ func (interpreter *Interpreter) visitInvocationExpressionWithImplicitArgument(in
 		}
 	}
 
+	elaboration := interpreter.Program.Elaboration
+
+	invocationExpressionTypes := elaboration.InvocationExpressionTypes(invocationExpression)
+
+	// If an overload of an overloaded constructor was selected,
+	// get the constructor for the overload from the invoked constructor
+
+	if overloadName := invocationExpressionTypes.OverloadName; overloadName != "" {
+		result = interpreter.constructorOverload(result, overloadName)
+	}
+
 	function, ok := result.(FunctionValue)
 	if !ok {
 		panic(errors.NewUnreachableError())
@@ -1219,15 +1259,21 @@ func (interpreter *Interpreter) visitInvocationExpressionWithImplicitArgument(in
 
 	arguments := interpreter.visitExpressionsNonCopying(argumentExpressions)
 
-	elaboration := interpreter.Program.Elaboration
-
-	invocationExpressionTypes := elaboration.InvocationExpressionTypes(invocationExpression)
-
 	typeParameterTypes := invocationExpressionTypes.TypeArguments
 	argumentTypes := invocationExpressionTypes.ArgumentTypes
 	parameterTypes := invocationExpressionTypes.TypeParameterTypes
 	returnType := invocationExpressionTypes.ReturnType
 
+	// If the invocation is in a generic function,
+	// the types may refer to the function's type parameters
+
+	if interpreter.SharedState.currentTypeArguments != nil {
+		typeParameterTypes = interpreter.resolveTypeParameterTypes(typeParameterTypes)
+		argumentTypes = interpreter.resolveTypeArgumentsOfTypes(argumentTypes)
+		parameterTypes = interpreter.resolveTypeArgumentsOfTypes(parameterTypes)
+		returnType = interpreter.resolveTypeArguments(returnType)
+	}
+
 	// add the implicit argument to the end of the argument list, if it exists
 	if implicitArg != nil {
 		arguments = append(arguments, *implicitArg)
@@ -1259,6 +1305,23 @@ func (interpreter *Interpreter) visitInvocationExpressionWithImplicitArgument(in
 	return resultValue
 }
 
+// constructorOverload returns the constructor for the initializer overload
+// with the given overload name of the given overloaded constructor.
+// The constructors of the other overloads are nested in the constructor of the first overload
+func (interpreter *Interpreter) constructorOverload(constructor Value, overloadName string) Value {
+	hostFunction, ok := constructor.(*HostFunctionValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	variable, ok := hostFunction.NestedVariables[overloadName]
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return variable.GetValue(interpreter)
+}
+
 func (interpreter *Interpreter) visitExpressionsNonCopying(expressions []ast.Expression) []Value {
 	var values []Value
 
@@ -1326,6 +1389,11 @@ func (interpreter *Interpreter) VisitFunctionExpression(expression *ast.Function
 
 	functionType := interpreter.Program.Elaboration.FunctionExpressionFunctionType(expression)
 
+	// If the function expression is in a generic function,
+	// its type may refer to the type parameters of the generic function
+
+	functionType = interpreter.resolveTypeArguments(functionType).(*sema.FunctionType)
+
 	var preConditions []ast.Condition
 	if expression.FunctionBlock.PreConditions != nil {
 		preConditions = expression.FunctionBlock.PreConditions.Conditions
@@ -1346,6 +1414,7 @@ func (interpreter *Interpreter) VisitFunctionExpression(expression *ast.Function
 
 	return NewInterpretedFunctionValue(
 		interpreter,
+		"",
 		expression.ParameterList,
 		functionType,
 		lexicalScope,
@@ -1365,7 +1434,9 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 	}
 
 	castingExpressionTypes := interpreter.Program.Elaboration.CastingExpressionTypes(expression)
-	expectedType := interpreter.SubstituteMappedEntitlements(castingExpressionTypes.TargetType)
+	expectedType := interpreter.SubstituteMappedEntitlements(
+		interpreter.resolveTypeArguments(castingExpressionTypes.TargetType),
+	)
 
 	switch expression.Operation {
 	case ast.OperationFailableCast, ast.OperationForceCast:
@@ -1456,7 +1527,9 @@ func (interpreter *Interpreter) VisitDestroyExpression(expression *ast.DestroyEx
 
 func (interpreter *Interpreter) VisitReferenceExpression(referenceExpression *ast.ReferenceExpression) Value {
 
-	borrowType := interpreter.Program.Elaboration.ReferenceExpressionBorrowType(referenceExpression)
+	borrowType := interpreter.resolveTypeArguments(
+		interpreter.Program.Elaboration.ReferenceExpressionBorrowType(referenceExpression),
+	)
 
 	result := interpreter.evalExpression(referenceExpression.Expression)
 
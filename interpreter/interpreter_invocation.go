@@ -22,6 +22,8 @@ import (
 	"github.com/onflow/atree"
 
 	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
 	"github.com/onflow/cadence/sema"
 )
 
@@ -170,6 +172,19 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 
 	interpreter.SharedState.callStack.Push(invocation)
 
+	config := interpreter.SharedState.Config
+
+	onInterpretedFunctionInvocation := config.OnInterpretedFunctionInvocation
+	if onInterpretedFunctionInvocation != nil {
+		onInterpretedFunctionInvocation(interpreter, function)
+	}
+
+	debugger := config.Debugger
+	if debugger != nil {
+		debugger.onFunctionEntry(interpreter, function, invocation)
+		defer debugger.onFunctionExit()
+	}
+
 	// Make `self` available, if any
 	if invocation.Self != nil {
 		interpreter.declareSelfVariable(*invocation.Self, invocation.LocationRange)
@@ -177,6 +192,17 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 	if invocation.Base != nil {
 		interpreter.declareVariable(sema.BaseIdentifier, invocation.Base)
 	}
+
+	// Make the type arguments of the function available, if any.
+	// The type arguments are always set, even if the function is not generic,
+	// so that the type arguments of a calling generic function are not visible in the function
+
+	oldTypeArguments := interpreter.SharedState.currentTypeArguments
+	interpreter.SharedState.currentTypeArguments = function.typeArguments(interpreter, invocation)
+	defer func() {
+		interpreter.SharedState.currentTypeArguments = oldTypeArguments
+	}()
+
 	if invocation.BoundAuthorization != nil {
 		oldInvocationValue := interpreter.SharedState.currentEntitlementMappedValue
 		interpreter.SharedState.currentEntitlementMappedValue = invocation.BoundAuthorization
@@ -205,6 +231,19 @@ func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 
 	if function.ParameterList != nil {
 		interpreter.bindParameterArguments(function.ParameterList, arguments)
+
+		debugger := interpreter.SharedState.Config.Debugger
+		if debugger != nil {
+			for parameterIndex, parameter := range function.ParameterList.Parameters {
+				identifier := parameter.Identifier.Identifier
+				debugger.onVariableDeclaration(
+					interpreter,
+					interpreter.activations.Find(identifier),
+					common.DeclarationKindParameter,
+					function.Type.Parameters[parameterIndex].TypeAnnotation.Type,
+				)
+			}
+		}
 	}
 
 	return interpreter.visitFunctionBody(
@@ -229,3 +268,181 @@ func (interpreter *Interpreter) bindParameterArguments(
 		interpreter.declareVariable(parameter.Identifier.Identifier, argument)
 	}
 }
+
+// withDefaultArguments returns the given invocation with the arguments
+// for omitted trailing parameters filled in with their default arguments, if any.
+//
+// Default arguments are evaluated in the lexical scope of the function declaration,
+// not the scope of the invocation
+func (interpreter *Interpreter) withDefaultArguments(
+	parameterList *ast.ParameterList,
+	functionType *sema.FunctionType,
+	lexicalScope *VariableActivation,
+	invocation Invocation,
+) Invocation {
+	if parameterList == nil {
+		return invocation
+	}
+
+	parameters := parameterList.Parameters
+	argumentCount := len(invocation.Arguments)
+	if argumentCount >= len(parameters) {
+		return invocation
+	}
+
+	interpreter.activations.PushNewWithParent(lexicalScope)
+	defer interpreter.activations.Pop()
+
+	arguments := make([]Value, argumentCount, len(parameters))
+	copy(arguments, invocation.Arguments)
+
+	var argumentTypes []sema.Type
+	if len(invocation.ArgumentTypes) == argumentCount {
+		argumentTypes = make([]sema.Type, argumentCount, len(parameters))
+		copy(argumentTypes, invocation.ArgumentTypes)
+	}
+
+	for i := argumentCount; i < len(parameters); i++ {
+		defaultArgument := parameters[i].DefaultArgument
+		if defaultArgument == nil {
+			panic(errors.NewUnreachableError())
+		}
+
+		parameter := functionType.Parameters[i]
+		parameterType := parameter.TypeAnnotation.Type
+
+		defaultArgumentType := parameter.DefaultArgument
+		if defaultArgumentType == nil {
+			defaultArgumentType = parameterType
+		}
+
+		value := interpreter.transferAndConvert(
+			interpreter.evalExpression(defaultArgument),
+			defaultArgumentType,
+			parameterType,
+			LocationRange{
+				Location:    interpreter.Location,
+				HasPosition: defaultArgument,
+			},
+		)
+
+		arguments = append(arguments, value)
+		if argumentTypes != nil {
+			argumentTypes = append(argumentTypes, parameterType)
+		}
+	}
+
+	invocation.Arguments = arguments
+	if argumentTypes != nil {
+		invocation.ArgumentTypes = argumentTypes
+	}
+
+	return invocation
+}
+
+// typeArguments returns the type arguments for an invocation of the function:
+// The captured type arguments of the generic functions the function is nested in,
+// the type arguments of the generic composite value the function is invoked on,
+// and the type arguments of the invocation for the function's own type parameters
+func (f *InterpretedFunctionValue) typeArguments(
+	interpreter *Interpreter,
+	invocation Invocation,
+) *sema.TypeParameterTypeOrderedMap {
+	typeParameters := f.Type.TypeParameters
+
+	var selfType *sema.CompositeType
+	if invocation.Self != nil {
+		if compositeValue, ok := (*invocation.Self).(*CompositeValue); ok &&
+			len(compositeValue.typeArguments) > 0 {
+
+			staticType := compositeValue.StaticType(interpreter)
+			selfType, _ = MustConvertStaticToSemaType(staticType, interpreter).(*sema.CompositeType)
+		}
+	}
+
+	if len(typeParameters) == 0 && selfType == nil {
+		return f.TypeArguments
+	}
+
+	typeArguments := &sema.TypeParameterTypeOrderedMap{}
+	if f.TypeArguments != nil {
+		typeArguments.SetAll(f.TypeArguments)
+	}
+
+	if selfType != nil {
+		compositeTypeArguments := selfType.TypeArguments()
+		for i, typeParameter := range selfType.TypeParameters() {
+			typeArguments.Set(typeParameter, compositeTypeArguments[i])
+		}
+	}
+
+	for _, typeParameter := range typeParameters {
+		typeArguments.Set(typeParameter, invocation.typeArgument(typeParameter))
+	}
+
+	return typeArguments
+}
+
+// typeArgument returns the type argument of the invocation for the given type parameter.
+// If the invocation has no type argument for the type parameter,
+// e.g. when the function is invoked externally, the type bound is returned
+func (invocation Invocation) typeArgument(typeParameter *sema.TypeParameter) sema.Type {
+	if invocation.TypeParameterTypes != nil {
+		typeArgument, ok := invocation.TypeParameterTypes.Get(typeParameter)
+		if ok && typeArgument != nil {
+			return typeArgument
+		}
+	}
+
+	if typeParameter.TypeBound != nil {
+		return typeParameter.TypeBound
+	}
+	return sema.AnyStructType
+}
+
+// resolveTypeArguments returns the given type with all generic types
+// replaced by the current type arguments, if any.
+// See SharedState.currentTypeArguments
+func (interpreter *Interpreter) resolveTypeArguments(ty sema.Type) sema.Type {
+	typeArguments := interpreter.SharedState.currentTypeArguments
+	if typeArguments == nil || ty == nil {
+		return ty
+	}
+
+	resolvedType := ty.Resolve(typeArguments)
+	if resolvedType == nil {
+		return ty
+	}
+	return resolvedType
+}
+
+// resolveTypeArgumentsOfTypes is like resolveTypeArguments, but for multiple types
+func (interpreter *Interpreter) resolveTypeArgumentsOfTypes(types []sema.Type) []sema.Type {
+	if interpreter.SharedState.currentTypeArguments == nil || len(types) == 0 {
+		return types
+	}
+
+	resolvedTypes := make([]sema.Type, len(types))
+	for i, ty := range types {
+		resolvedTypes[i] = interpreter.resolveTypeArguments(ty)
+	}
+	return resolvedTypes
+}
+
+// resolveTypeParameterTypes is like resolveTypeArguments, but for the type arguments of an invocation
+func (interpreter *Interpreter) resolveTypeParameterTypes(
+	typeParameterTypes *sema.TypeParameterTypeOrderedMap,
+) *sema.TypeParameterTypeOrderedMap {
+	if interpreter.SharedState.currentTypeArguments == nil ||
+		typeParameterTypes == nil ||
+		typeParameterTypes.Len() == 0 {
+
+		return typeParameterTypes
+	}
+
+	resolvedTypeParameterTypes := &sema.TypeParameterTypeOrderedMap{}
+	typeParameterTypes.Foreach(func(typeParameter *sema.TypeParameter, ty sema.Type) {
+		resolvedTypeParameterTypes.Set(typeParameter, interpreter.resolveTypeArguments(ty))
+	})
+	return resolvedTypeParameterTypes
+}
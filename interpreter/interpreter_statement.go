@@ -19,6 +19,8 @@
 package interpreter
 
 import (
+	"github.com/onflow/atree"
+
 	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/errors"
@@ -46,6 +48,9 @@ func (interpreter *Interpreter) evalStatement(statement ast.Statement) Statement
 	debugger := config.Debugger
 	if debugger != nil {
 		debugger.onStatement(interpreter, statement)
+		if debugger.BreakOnError() {
+			defer debugger.onStatementPanic(interpreter, statement)
+		}
 	}
 
 	onStatement := config.OnStatement
@@ -95,13 +100,25 @@ func (interpreter *Interpreter) VisitReturnStatement(statement *ast.ReturnStatem
 
 var theBreakResult StatementResult = BreakResult{}
 
-func (interpreter *Interpreter) VisitBreakStatement(_ *ast.BreakStatement) StatementResult {
+func (interpreter *Interpreter) VisitBreakStatement(statement *ast.BreakStatement) StatementResult {
+	if statement.Label != nil {
+		return BreakResult{
+			Label: statement.Label.Identifier,
+		}
+	}
+
 	return theBreakResult
 }
 
 var theContinueResult StatementResult = ContinueResult{}
 
-func (interpreter *Interpreter) VisitContinueStatement(_ *ast.ContinueStatement) StatementResult {
+func (interpreter *Interpreter) VisitContinueStatement(statement *ast.ContinueStatement) StatementResult {
+	if statement.Label != nil {
+		return ContinueResult{
+			Label: statement.Label.Identifier,
+		}
+	}
+
 	return theContinueResult
 }
 
@@ -110,6 +127,11 @@ func (interpreter *Interpreter) VisitEntitlementDeclaration(_ *ast.EntitlementDe
 	panic(errors.NewUnreachableError())
 }
 
+func (interpreter *Interpreter) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) StatementResult {
+	// Type aliases are resolved statically
+	panic(errors.NewUnreachableError())
+}
+
 func (interpreter *Interpreter) VisitEntitlementMappingDeclaration(_ *ast.EntitlementMappingDeclaration) StatementResult {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -118,15 +140,16 @@ func (interpreter *Interpreter) VisitEntitlementMappingDeclaration(_ *ast.Entitl
 func (interpreter *Interpreter) VisitIfStatement(statement *ast.IfStatement) StatementResult {
 	switch test := statement.Test.(type) {
 	case ast.Expression:
-		return interpreter.visitIfStatementWithTestExpression(test, statement.Then, statement.Else)
+		return interpreter.visitIfStatementWithTestExpression(statement, test, statement.Then, statement.Else)
 	case *ast.VariableDeclaration:
-		return interpreter.visitIfStatementWithVariableDeclaration(test, statement.Then, statement.Else)
+		return interpreter.visitIfStatementWithVariableDeclaration(statement, test, statement.Then, statement.Else)
 	default:
 		panic(errors.NewUnreachableError())
 	}
 }
 
 func (interpreter *Interpreter) visitIfStatementWithTestExpression(
+	statement *ast.IfStatement,
 	test ast.Expression,
 	thenBlock, elseBlock *ast.Block,
 ) StatementResult {
@@ -137,8 +160,12 @@ func (interpreter *Interpreter) visitIfStatementWithTestExpression(
 	}
 
 	if value {
+		interpreter.reportBranch(statement, 0)
 		return interpreter.visitBlock(thenBlock)
-	} else if elseBlock != nil {
+	}
+
+	interpreter.reportBranch(statement, 1)
+	if elseBlock != nil {
 		return interpreter.visitBlock(elseBlock)
 	}
 
@@ -146,6 +173,7 @@ func (interpreter *Interpreter) visitIfStatementWithTestExpression(
 }
 
 func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
+	statement *ast.IfStatement,
 	declaration *ast.VariableDeclaration,
 	thenBlock, elseBlock *ast.Block,
 ) StatementResult {
@@ -159,13 +187,19 @@ func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
 		interpreter.activations.PushNewWithCurrent()
 		defer interpreter.activations.Pop()
 
-		interpreter.declareVariable(
+		interpreter.declareTypedVariable(
 			declaration.Identifier.Identifier,
 			innerValue,
+			common.DeclarationKindConstant,
+			interpreter.Program.Elaboration.VariableDeclarationTypes(declaration).TargetType,
 		)
 
+		interpreter.reportBranch(statement, 0)
 		return interpreter.visitBlock(thenBlock)
-	} else if elseBlock != nil {
+	}
+
+	interpreter.reportBranch(statement, 1)
+	if elseBlock != nil {
 		return interpreter.visitBlock(elseBlock)
 	}
 
@@ -179,9 +213,11 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 		panic(errors.NewUnreachableError())
 	}
 
-	for _, switchCase := range switchStatement.Cases {
+	for i, switchCase := range switchStatement.Cases {
 
 		runStatements := func() StatementResult {
+			interpreter.reportBranch(switchStatement, i)
+
 			// NOTE: the new block ensures that a new scope is introduced
 
 			block := ast.NewBlock(
@@ -192,7 +228,9 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 
 			result := interpreter.visitBlock(block)
 
-			if _, ok := result.(BreakResult); ok {
+			// Labeled breaks target a loop, not the switch statement
+
+			if result, ok := result.(BreakResult); ok && result.Label == "" {
 				return nil
 			}
 
@@ -233,6 +271,9 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 		// then try the next case
 	}
 
+	// No case matched. The implicit default branch follows the cases
+	interpreter.reportBranch(switchStatement, len(switchStatement.Cases))
+
 	return nil
 }
 
@@ -249,12 +290,17 @@ func (interpreter *Interpreter) VisitWhileStatement(statement *ast.WhileStatemen
 
 		result := interpreter.visitBlock(statement.Block)
 
-		switch result.(type) {
+		switch typedResult := result.(type) {
 		case BreakResult:
+			if !targetsLoop(typedResult.Label, statement.Label) {
+				return result
+			}
 			return nil
 
 		case ContinueResult:
-			// NO-OP
+			if !targetsLoop(typedResult.Label, statement.Label) {
+				return result
+			}
 
 		case ReturnResult:
 			return result
@@ -276,6 +322,26 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) (
 
 	value := interpreter.evalExpression(statement.Value)
 
+	forStmtTypes := interpreter.Program.Elaboration.ForStatementType(statement)
+
+	if statement.Key != nil {
+		interpreter.forEachDictionaryEntry(
+			value,
+			interpreter.resolveTypeArguments(forStmtTypes.ValueVariableType),
+			func(key Value, value Value) (resume bool) {
+				statementResult, done := interpreter.visitForStatementBody(statement, IntValue{}, key, value)
+				if done {
+					result = statementResult
+				}
+
+				return !done
+			},
+			locationRange,
+		)
+
+		return
+	}
+
 	// Do not transfer the iterable value.
 	// Instead, transfer each iterating element.
 	// This is done in `ForEach` method.
@@ -285,15 +351,13 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) (
 		panic(errors.NewUnreachableError())
 	}
 
-	forStmtTypes := interpreter.Program.Elaboration.ForStatementType(statement)
-
 	var index IntValue
 	if statement.Index != nil {
 		index = NewIntValueFromInt64(interpreter, 0)
 	}
 
 	executeBody := func(value Value) (resume bool) {
-		statementResult, done := interpreter.visitForStatementBody(statement, index, value)
+		statementResult, done := interpreter.visitForStatementBody(statement, index, nil, value)
 		if done {
 			result = statementResult
 		}
@@ -312,7 +376,7 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) (
 
 	iterable.ForEach(
 		interpreter,
-		forStmtTypes.ValueVariableType,
+		interpreter.resolveTypeArguments(forStmtTypes.ValueVariableType),
 		executeBody,
 		transferElements,
 		locationRange,
@@ -321,9 +385,74 @@ func (interpreter *Interpreter) VisitForStatement(statement *ast.ForStatement) (
 	return
 }
 
+// forEachDictionaryEntry calls the given function for each key-value pair
+// of the given dictionary, or of the dictionary referenced by the given reference
+func (interpreter *Interpreter) forEachDictionaryEntry(
+	value Value,
+	valueVariableType sema.Type,
+	function func(key Value, value Value) (resume bool),
+	locationRange LocationRange,
+) {
+	var reference ReferenceValue
+	if referenceValue, ok := value.(ReferenceValue); ok {
+		reference = referenceValue
+		value = *referenceValue.ReferencedValue(interpreter, locationRange, true)
+	}
+
+	dictionary, ok := value.(*DictionaryValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	_, isResultReference := sema.MaybeReferenceType(valueVariableType)
+
+	dictionary.Iterate(
+		interpreter,
+		locationRange,
+		func(key, value Value) (resume bool) {
+
+			if reference != nil {
+				// The loop dereferences the reference once, and holds onto that referenced value.
+				// But the reference could get invalidated during the iteration,
+				// so check the validity of the reference before each iteration.
+				checkInvalidatedResourceOrResourceReference(reference, locationRange, interpreter)
+			}
+
+			// Transfer the key and value before passing them to the loop body
+
+			key = key.Transfer(
+				interpreter,
+				locationRange,
+				atree.Address{},
+				false,
+				nil,
+				nil,
+				false, // key has a parent container because it is from iterator.
+			)
+
+			if isResultReference {
+				value = interpreter.getReferenceValue(value, valueVariableType, locationRange)
+			} else {
+				value = value.Transfer(
+					interpreter,
+					locationRange,
+					atree.Address{},
+					false,
+					nil,
+					nil,
+					false, // value has a parent container because it is from iterator.
+				)
+			}
+
+			return function(key, value)
+		},
+	)
+}
+
 func (interpreter *Interpreter) visitForStatementBody(
 	statement *ast.ForStatement,
 	index IntValue,
+	key Value,
 	value Value,
 ) (
 	result StatementResult,
@@ -334,26 +463,46 @@ func (interpreter *Interpreter) visitForStatementBody(
 	interpreter.activations.PushNewWithCurrent()
 	defer interpreter.activations.Pop()
 
+	forStatementTypes := interpreter.Program.Elaboration.ForStatementType(statement)
+
 	if index.BigInt != nil {
-		interpreter.declareVariable(
+		interpreter.declareTypedVariable(
 			statement.Index.Identifier,
 			index,
+			common.DeclarationKindConstant,
+			forStatementTypes.IndexVariableType,
 		)
 	}
 
-	interpreter.declareVariable(
+	if key != nil {
+		interpreter.declareTypedVariable(
+			statement.Key.Identifier,
+			key,
+			common.DeclarationKindConstant,
+			forStatementTypes.KeyVariableType,
+		)
+	}
+
+	interpreter.declareTypedVariable(
 		statement.Identifier.Identifier,
 		value,
+		common.DeclarationKindConstant,
+		forStatementTypes.ValueVariableType,
 	)
 
 	result = interpreter.visitBlock(statement.Block)
 
-	switch result.(type) {
+	switch typedResult := result.(type) {
 	case BreakResult:
+		if !targetsLoop(typedResult.Label, statement.Label) {
+			return result, true
+		}
 		return nil, true
 
 	case ContinueResult:
-		// NO-OP
+		if !targetsLoop(typedResult.Label, statement.Label) {
+			return result, true
+		}
 
 	case ReturnResult:
 		return result, true
@@ -460,9 +609,11 @@ func (interpreter *Interpreter) VisitVariableDeclaration(declaration *ast.Variab
 	// NOTE: lexical scope, always declare a new variable.
 	// Do not find an existing variable and assign the value!
 
-	_ = interpreter.declareVariable(
+	_ = interpreter.declareTypedVariable(
 		declaration.Identifier.Identifier,
 		value,
+		declaration.DeclarationKind(),
+		interpreter.Program.Elaboration.VariableDeclarationTypes(declaration).TargetType,
 	)
 
 	return nil
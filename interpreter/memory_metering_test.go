@@ -6826,6 +6826,82 @@ func TestInterpretStringMetering(t *testing.T) {
 		// 1 + 4 (max UTF8 encoding)
 		assert.Equal(t, uint64(5), meter.getMemory(common.MemoryKindStringValue))
 	})
+
+	t.Run("toUpper, ASCII", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x = "abc".toUpper()
+          }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// 1 + 3 (ABC)
+		assert.Equal(t, uint64(4), meter.getMemory(common.MemoryKindStringValue))
+	})
+
+	t.Run("trim", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x = "  abc  ".trim()
+          }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// 1 + 3 (abc)
+		assert.Equal(t, uint64(4), meter.getMemory(common.MemoryKindStringValue))
+	})
+
+	t.Run("padLeft", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x = "42".padLeft(toLength: 5, with: "0")
+          }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// 1 + 5 (00042)
+		assert.Equal(t, uint64(6), meter.getMemory(common.MemoryKindStringValue))
+	})
+
+	t.Run("characters", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x = "abc".characters
+          }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// 3 (a, b, c)
+		assert.Equal(t, uint64(3), meter.getMemory(common.MemoryKindCharacterValue))
+	})
 }
 
 func TestInterpretCharacterMetering(t *testing.T) {
@@ -6548,6 +6548,264 @@ func TestInterpretDictionaryForEachKey(t *testing.T) {
 
 }
 
+func TestInterpretDictionaryFilter(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("filter", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [AnyStruct] {
+              let dict = {"a": 1, "b": 2, "c": 3, "d": 4}
+              let filtered = dict.filter(view fun (key: String, value: Int): Bool {
+                  return value % 2 == 0 && key != "d"
+              })
+              return [filtered.length, filtered["b"], dict.length]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeAnyStruct,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredIntValueFromInt64(2),
+				),
+				interpreter.NewUnmeteredIntValueFromInt64(4),
+			),
+			value,
+		)
+	})
+
+	t.Run("copies values", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let dict = {"a": [1]}
+              let filtered = dict.filter(view fun (key: String, value: [Int]): Bool {
+                  return true
+              })
+              filtered["a"]!.append(2)
+              return dict["a"]!
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+}
+
+func TestInterpretDictionaryMap(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("map", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [String?] {
+              let dict = {"a": 1, "b": 2}
+              let mapped = dict.map(fun (key: String, value: Int): String {
+                  return key.concat(value.toString())
+              })
+              return [mapped["a"], mapped["b"], mapped["c"]]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: &interpreter.OptionalStaticType{
+						Type: interpreter.PrimitiveStaticTypeString,
+					},
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredStringValue("a1"),
+				),
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredStringValue("b2"),
+				),
+				interpreter.Nil,
+			),
+			value,
+		)
+	})
+
+	t.Run("mapValues", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): {String: Bool} {
+              let dict = {"a": 1, "b": 2}
+              return dict.mapValues(fun (_ value: Int): Bool {
+                  return value > 1
+              })
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		dictionary, ok := value.(*interpreter.DictionaryValue)
+		require.True(t, ok)
+
+		assert.Equal(t,
+			&interpreter.DictionaryStaticType{
+				KeyType:   interpreter.PrimitiveStaticTypeString,
+				ValueType: interpreter.PrimitiveStaticTypeBool,
+			},
+			dictionary.Type,
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(interpreter.FalseValue),
+			dictionary.GetKey(
+				inter,
+				interpreter.EmptyLocationRange,
+				interpreter.NewUnmeteredStringValue("a"),
+			),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(interpreter.TrueValue),
+			dictionary.GetKey(
+				inter,
+				interpreter.EmptyLocationRange,
+				interpreter.NewUnmeteredStringValue("b"),
+			),
+		)
+	})
+
+	t.Run("box and convert result", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int? {
+              let dict = {"a": 1}
+              let mapped = dict.mapValues(fun (_ value: Int): Int? {
+                  return value
+              })
+              return mapped["a"]!
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+}
+
+func TestInterpretDictionaryForEach(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all entries", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              var sum = 0
+              let dict = {1: 10, 2: 20, 3: 30}
+              dict.forEach(fun (key: Int, value: Int): Bool {
+                  sum = sum + key * value
+                  return true
+              })
+              return sum
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(140),
+			value,
+		)
+	})
+
+	t.Run("early exit", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              var count = 0
+              let dict = {1: 10, 2: 20, 3: 30}
+              dict.forEach(fun (key: Int, value: Int): Bool {
+                  count = count + 1
+                  return false
+              })
+              return count
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+}
+
 func TestInterpretDictionaryValues(t *testing.T) {
 
 	t.Parallel()
@@ -7852,11 +8110,19 @@ func TestInterpretEmitEventParameterTypes(t *testing.T) {
 			value: interpreter.NewUnmeteredFix64Value(123000000),
 			ty:    sema.Fix64Type,
 		},
+		"Fix128": {
+			value: interpreter.NewUnmeteredFix128Value(fix128BigInt(123, 2)),
+			ty:    sema.Fix128Type,
+		},
 		// UFix*
 		"UFix64": {
 			value: interpreter.NewUnmeteredUFix64Value(123000000),
 			ty:    sema.UFix64Type,
 		},
+		"UFix128": {
+			value: interpreter.NewUnmeteredUFix128Value(fix128BigInt(123, 2)),
+			ty:    sema.UFix128Type,
+		},
 		// TODO:
 		//// Struct
 		//"S": {
@@ -9133,59 +9399,190 @@ func TestInterpretFix64Mul(t *testing.T) {
 	)
 }
 
-func TestInterpretHexDecode(t *testing.T) {
+func TestInterpretFix128(t *testing.T) {
 
 	t.Parallel()
 
-	expected := []interpreter.Value{
-		interpreter.NewUnmeteredUInt8Value(71),
-		interpreter.NewUnmeteredUInt8Value(111),
-		interpreter.NewUnmeteredUInt8Value(32),
-		interpreter.NewUnmeteredUInt8Value(87),
-		interpreter.NewUnmeteredUInt8Value(105),
-		interpreter.NewUnmeteredUInt8Value(116),
-		interpreter.NewUnmeteredUInt8Value(104),
-		interpreter.NewUnmeteredUInt8Value(32),
-		interpreter.NewUnmeteredUInt8Value(116),
-		interpreter.NewUnmeteredUInt8Value(104),
-		interpreter.NewUnmeteredUInt8Value(101),
-		interpreter.NewUnmeteredUInt8Value(32),
-		interpreter.NewUnmeteredUInt8Value(70),
-		interpreter.NewUnmeteredUInt8Value(108),
-		interpreter.NewUnmeteredUInt8Value(111),
-		interpreter.NewUnmeteredUInt8Value(119),
-	}
+	t.Run("literals and arithmetic", func(t *testing.T) {
 
-	t.Run("in Cadence", func(t *testing.T) {
+		t.Parallel()
 
-		baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
-		baseValueActivation.DeclareValue(stdlib.PanicFunction)
+		inter := parseCheckAndInterpret(t, `
+          let a: Fix128 = 0.000000000000000000000001
+          let b: UFix128 = 1.5
+          let c = Fix128(1.1) * -1.1
+          let d = Fix128(1.0) / 3.0
+          let e = UFix128(Fix64(-1.5) + 2.0)
+          let f = Fix64(Fix128(42.5))
+        `)
 
-		baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
-		interpreter.Declare(baseActivation, stdlib.PanicFunction)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128Value(big.NewInt(1)),
+			inter.Globals.Get("a").GetValue(inter),
+		)
 
-		inter, err := parseCheckAndInterpretWithOptions(t,
-			`
-              fun hexDecode(_ s: String): [UInt8] {
-                  if s.length % 2 != 0 {
-                      panic("Input must have even number of characters")
-                  }
-                  let table: {String: UInt8} = {
-                          "0" : 0,
-                          "1" : 1,
-                          "2" : 2,
-                          "3" : 3,
-                          "4" : 4,
-                          "5" : 5,
-                          "6" : 6,
-                          "7" : 7,
-                          "8" : 8,
-                          "9" : 9,
-                          "a" : 10,
-                          "A" : 10,
-                          "b" : 11,
-                          "B" : 11,
-                          "c" : 12,
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix128Value(fix128BigInt(15, 1)),
+			inter.Globals.Get("b").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128Value(fix128BigInt(-121, 2)),
+			inter.Globals.Get("c").GetValue(inter),
+		)
+
+		oneThird, ok := new(big.Int).SetString("333333333333333333333333", 10)
+		require.True(t, ok)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128Value(oneThird),
+			inter.Globals.Get("d").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix128Value(fix128BigInt(5, 1)),
+			inter.Globals.Get("e").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix64Value(4_250_000_000),
+			inter.Globals.Get("f").GetValue(inter),
+		)
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix128 {
+              let zero: Fix128 = 0.0
+              return 1.0 / zero
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix128 {
+              return UFix128.max + 1.0
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("underflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix128 {
+              let x: UFix128 = 1.0
+              return x - 2.0
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.UnderflowError{})
+	})
+
+	t.Run("too large for Fix64", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix64 {
+              let x: Fix128 = 100000000000000.0
+              return Fix64(x)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+}
+
+func TestInterpretHexDecode(t *testing.T) {
+
+	t.Parallel()
+
+	expected := []interpreter.Value{
+		interpreter.NewUnmeteredUInt8Value(71),
+		interpreter.NewUnmeteredUInt8Value(111),
+		interpreter.NewUnmeteredUInt8Value(32),
+		interpreter.NewUnmeteredUInt8Value(87),
+		interpreter.NewUnmeteredUInt8Value(105),
+		interpreter.NewUnmeteredUInt8Value(116),
+		interpreter.NewUnmeteredUInt8Value(104),
+		interpreter.NewUnmeteredUInt8Value(32),
+		interpreter.NewUnmeteredUInt8Value(116),
+		interpreter.NewUnmeteredUInt8Value(104),
+		interpreter.NewUnmeteredUInt8Value(101),
+		interpreter.NewUnmeteredUInt8Value(32),
+		interpreter.NewUnmeteredUInt8Value(70),
+		interpreter.NewUnmeteredUInt8Value(108),
+		interpreter.NewUnmeteredUInt8Value(111),
+		interpreter.NewUnmeteredUInt8Value(119),
+	}
+
+	t.Run("in Cadence", func(t *testing.T) {
+
+		baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+		baseValueActivation.DeclareValue(stdlib.PanicFunction)
+
+		baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
+		interpreter.Declare(baseActivation, stdlib.PanicFunction)
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              fun hexDecode(_ s: String): [UInt8] {
+                  if s.length % 2 != 0 {
+                      panic("Input must have even number of characters")
+                  }
+                  let table: {String: UInt8} = {
+                          "0" : 0,
+                          "1" : 1,
+                          "2" : 2,
+                          "3" : 3,
+                          "4" : 4,
+                          "5" : 5,
+                          "6" : 6,
+                          "7" : 7,
+                          "8" : 8,
+                          "9" : 9,
+                          "a" : 10,
+                          "A" : 10,
+                          "b" : 11,
+                          "B" : 11,
+                          "c" : 12,
                           "C" : 12,
                           "d" : 13,
                           "D" : 13,
@@ -11473,6 +11870,453 @@ func TestInterpretArrayMap(t *testing.T) {
 	})
 }
 
+func TestInterpretArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	newIntArray := func(inter *interpreter.Interpreter, staticType interpreter.ArrayStaticType, values ...int64) *interpreter.ArrayValue {
+		elements := make([]interpreter.Value, len(values))
+		for i, value := range values {
+			elements[i] = interpreter.NewUnmeteredIntValueFromInt64(value)
+		}
+		return interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			staticType,
+			common.ZeroAddress,
+			elements...,
+		)
+	}
+
+	variableSizedIntArrayType := &interpreter.VariableSizedStaticType{
+		Type: interpreter.PrimitiveStaticTypeInt,
+	}
+
+	t.Run("variable sized", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			let xs = [5, 3, 8, 1, 9, 2, 7]
+
+			fun sorted(): [Int] {
+				return xs.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+					return a < b
+				})
+			}
+
+			fun original(): [Int] {
+				return xs
+			}
+		`)
+
+		value, err := inter.Invoke("sorted")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newIntArray(inter, variableSizedIntArrayType, 1, 2, 3, 5, 7, 8, 9),
+			value,
+		)
+
+		// Original array remains unchanged
+
+		value, err = inter.Invoke("original")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newIntArray(inter, variableSizedIntArrayType, 5, 3, 8, 1, 9, 2, 7),
+			value,
+		)
+	})
+
+	t.Run("constant sized", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test(): [Int; 4] {
+				let xs: [Int; 4] = [2, 4, 1, 3]
+				return xs.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+					return a > b
+				})
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newIntArray(
+				inter,
+				&interpreter.ConstantSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+					Size: 4,
+				},
+				4, 3, 2, 1,
+			),
+			value,
+		)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test(): [Int] {
+				let xs: [Int] = []
+				return xs.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+					return a < b
+				})
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newIntArray(inter, variableSizedIntArrayType),
+			value,
+		)
+	})
+
+	t.Run("stable", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			struct Item {
+				let key: Int
+				let id: Int
+
+				init(key: Int, id: Int) {
+					self.key = key
+					self.id = id
+				}
+			}
+
+			fun test(): [Int] {
+				let items = [
+					Item(key: 2, id: 1),
+					Item(key: 1, id: 2),
+					Item(key: 2, id: 3),
+					Item(key: 1, id: 4),
+					Item(key: 0, id: 5)
+				]
+				let sorted = items.sort(by: view fun (_ a: Item, _ b: Item): Bool {
+					return a.key < b.key
+				})
+				return sorted.map(fun (_ item: Item): Int {
+					return item.id
+				})
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newIntArray(inter, variableSizedIntArrayType, 5, 2, 4, 1, 3),
+			value,
+		)
+	})
+
+	t.Run("reference", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test(): [Int] {
+				let xs = [3, 1, 2]
+				let ref = &xs as &[Int]
+				return ref.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+					return a < b
+				})
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newIntArray(inter, variableSizedIntArrayType, 1, 2, 3),
+			value,
+		)
+	})
+}
+
+func TestInterpretArrayReduce(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("sum", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test(): Int {
+				let xs = [1, 2, 3, 4]
+				return xs.reduce(initial: 10, view fun (_ acc: Int, _ x: Int): Int {
+					return acc + x
+				})
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(20),
+			value,
+		)
+	})
+
+	t.Run("different accumulator type", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test(): String {
+				let xs = [1, 2, 3]
+				return xs.reduce(initial: "", view fun (_ acc: String, _ x: Int): String {
+					return acc.concat(x.toString())
+				})
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("123"),
+			value,
+		)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test(): Int {
+				let xs: [Int] = []
+				return xs.reduce(initial: 42, view fun (_ acc: Int, _ x: Int): Int {
+					return acc + x
+				})
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			value,
+		)
+	})
+}
+
+func TestInterpretArrayAnyAll(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+		let xs = [1, 2, 3]
+		let empty: [Int] = []
+
+		let isPositive = view fun (_ x: Int): Bool {
+			return x > 0
+		}
+
+		let isEven = view fun (_ x: Int): Bool {
+			return x % 2 == 0
+		}
+
+		let isNegative = view fun (_ x: Int): Bool {
+			return x < 0
+		}
+
+		fun test(): [Bool] {
+			return [
+				xs.any(isEven),
+				xs.any(isNegative),
+				empty.any(isPositive),
+				xs.all(isPositive),
+				xs.all(isEven),
+				empty.all(isNegative)
+			]
+		}
+	`)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeBool,
+			},
+			common.ZeroAddress,
+			interpreter.TrueValue,
+			interpreter.FalseValue,
+			interpreter.FalseValue,
+			interpreter.TrueValue,
+			interpreter.FalseValue,
+			interpreter.TrueValue,
+		),
+		value,
+	)
+}
+
+func TestInterpretArrayFirstIndexWhere(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+		let xs = [1, 2, 3, 4]
+
+		fun found(): Int? {
+			return xs.firstIndex(where: view fun (_ x: Int): Bool {
+				return x > 2
+			})
+		}
+
+		fun notFound(): Int? {
+			return xs.firstIndex(where: view fun (_ x: Int): Bool {
+				return x > 4
+			})
+		}
+
+		fun of(): Int? {
+			return xs.firstIndex(of: 2)
+		}
+	`)
+
+	value, err := inter.Invoke("found")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+		),
+		value,
+	)
+
+	value, err = inter.Invoke("notFound")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.Nil,
+		value,
+	)
+
+	value, err = inter.Invoke("of")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+		),
+		value,
+	)
+}
+
+func TestInterpretArrayForEach(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("side effects", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test(): Int {
+				var sum = 0
+				let xs = [1, 2, 3]
+				xs.forEach(fun (_ x: Int): Bool {
+					sum = sum * 10 + x
+					return true
+				})
+				return sum
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(123),
+			value,
+		)
+	})
+
+	t.Run("early exit", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test(): Int {
+				var sum = 0
+				let xs = [1, 2, 3]
+				xs.forEach(fun (_ x: Int): Bool {
+					sum = sum * 10 + x
+					return x < 2
+				})
+				return sum
+			}
+		`)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(12),
+			value,
+		)
+	})
+
+	t.Run("mutation of array", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+			fun test() {
+				let xs = [1, 2, 3]
+				xs.forEach(fun (_ x: Int): Bool {
+					xs.append(x)
+					return true
+				})
+			}
+		`)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	})
+}
+
 func TestInterpretArrayToVariableSized(t *testing.T) {
 	t.Parallel()
 
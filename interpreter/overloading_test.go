@@ -0,0 +1,343 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/test_utils/interpreter_utils"
+)
+
+func TestInterpretCompositeFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct S {
+          fun foo(a: Int): Int { return a }
+          fun foo(b: Int): Int { return b * 10 }
+          fun foo(_ x: Int, _ y: Int): Int { return x + y }
+      }
+
+      fun test(): [Int] {
+          let s = S()
+          let ref = &s as &S
+          let opt: S? = s
+          return [
+              s.foo(a: 1),
+              s.foo(b: 2),
+              s.foo(3, 4),
+              ref.foo(b: 5),
+              opt?.foo(a: 6)!
+          ]
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeInt,
+			},
+			common.ZeroAddress,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			interpreter.NewUnmeteredIntValueFromInt64(20),
+			interpreter.NewUnmeteredIntValueFromInt64(7),
+			interpreter.NewUnmeteredIntValueFromInt64(50),
+			interpreter.NewUnmeteredIntValueFromInt64(6),
+		),
+		value,
+	)
+}
+
+func TestInterpretCompositeFunctionOverloadingInterface(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct interface I {
+          fun f(y: Int): Int
+      }
+
+      struct interface J {
+          fun f(x: Int): Int
+      }
+
+      struct interface K {
+          fun g(): Int {
+              return 3
+          }
+      }
+
+      struct S: I, J, K {
+          fun f(x: Int): Int { return x }
+          fun f(y: Int): Int { return y * 2 }
+      }
+
+      struct T: I {
+          fun f(y: Int): Int { return y * 4 }
+      }
+
+      fun test(): [Int] {
+          let s = S()
+          let i: {I} = s
+          let j: {J} = s
+          let k: {K} = s
+          let ref = &s as &{I}
+          let t: {I} = T()
+          return [
+              i.f(y: 4),
+              j.f(x: 4),
+              k.g(),
+              ref.f(y: 5),
+              t.f(y: 1)
+          ]
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeInt,
+			},
+			common.ZeroAddress,
+			interpreter.NewUnmeteredIntValueFromInt64(8),
+			interpreter.NewUnmeteredIntValueFromInt64(4),
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			interpreter.NewUnmeteredIntValueFromInt64(10),
+			interpreter.NewUnmeteredIntValueFromInt64(4),
+		),
+		value,
+	)
+}
+
+func TestInterpretCompositeFunctionOverloadingInterfaceConditions(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct interface I {
+          fun foo(b: Int): Int {
+              pre { b > 0: "b must be positive" }
+          }
+      }
+
+      struct S: I {
+          fun foo(a: Int): Int { return a }
+          fun foo(b: Int): Int { return b }
+      }
+
+      fun testA(): Int {
+          return S().foo(a: -1)
+      }
+
+      fun testB(): Int {
+          return S().foo(b: -1)
+      }
+    `)
+
+	value, err := inter.Invoke("testA")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(-1),
+		value,
+	)
+
+	_, err = inter.Invoke("testB")
+	require.Error(t, err)
+
+	var conditionErr interpreter.ConditionError
+	require.ErrorAs(t, err, &conditionErr)
+}
+
+func TestInterpretCompositeInitializerOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+
+              init() {
+                  self.x = 42
+              }
+
+              init(_ a: Int, _ b: Int) {
+                  self.x = a + b
+              }
+          }
+
+          fun test(): [Int] {
+              return [S(x: 1).x, S().x, S(2, 3).x]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(42),
+				interpreter.NewUnmeteredIntValueFromInt64(5),
+			),
+			value,
+		)
+	})
+
+	t.Run("resource, nested in contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              contract C {
+
+                  resource R {
+                      let x: Int
+
+                      init(x: Int) {
+                          self.x = x
+                      }
+
+                      init() {
+                          self.x = 42
+                      }
+                  }
+
+                  fun test(): Int {
+                      let r1 <- create R(x: 1)
+                      let r2 <- create C.R()
+                      let sum = r1.x + r2.x
+                      destroy r1
+                      destroy r2
+                      return sum
+                  }
+
+                  init() {}
+              }
+
+              fun main(): Int {
+                  return C.test()
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					ContractValueHandler: makeContractValueHandler(nil, nil, nil),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		value, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(43),
+			value,
+		)
+	})
+
+	t.Run("interface conditions", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface I {
+              init(b: Int) {
+                  pre { b > 0: "b must be positive" }
+              }
+          }
+
+          struct S: I {
+              let x: Int
+
+              init(a: Int) {
+                  self.x = a
+              }
+
+              init(b: Int) {
+                  self.x = b
+              }
+          }
+
+          fun testA(): Int {
+              return S(a: -1).x
+          }
+
+          fun testB(): Int {
+              return S(b: -1).x
+          }
+        `)
+
+		value, err := inter.Invoke("testA")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(-1),
+			value,
+		)
+
+		_, err = inter.Invoke("testB")
+		require.Error(t, err)
+
+		var conditionErr interpreter.ConditionError
+		require.ErrorAs(t, err, &conditionErr)
+	})
+}
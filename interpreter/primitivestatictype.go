@@ -147,7 +147,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	PrimitiveStaticTypeFix64
-	_ // future: Fix128
+	PrimitiveStaticTypeFix128
 	_ // future: Fix256
 	_
 
@@ -157,7 +157,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	PrimitiveStaticTypeUFix64
-	_ // future: UFix128
+	PrimitiveStaticTypeUFix128
 	_ // future: UFix256
 	_
 
@@ -302,6 +302,8 @@ func (t PrimitiveStaticType) elementSize() uint {
 		PrimitiveStaticTypeInt256,
 		PrimitiveStaticTypeWord128,
 		PrimitiveStaticTypeWord256,
+		PrimitiveStaticTypeFix128,
+		PrimitiveStaticTypeUFix128,
 		PrimitiveStaticTypeInteger,
 		PrimitiveStaticTypeSignedInteger,
 		PrimitiveStaticTypeFixedSizeUnsignedInteger,
@@ -573,10 +575,14 @@ func (t PrimitiveStaticType) SemaType() sema.Type {
 	// Fix*
 	case PrimitiveStaticTypeFix64:
 		return sema.Fix64Type
+	case PrimitiveStaticTypeFix128:
+		return sema.Fix128Type
 
 	// UFix*
 	case PrimitiveStaticTypeUFix64:
 		return sema.UFix64Type
+	case PrimitiveStaticTypeUFix128:
+		return sema.UFix128Type
 
 	// Storage
 
@@ -819,10 +825,14 @@ func ConvertSemaToPrimitiveStaticType(
 	// Fix*
 	case sema.Fix64Type:
 		typ = PrimitiveStaticTypeFix64
+	case sema.Fix128Type:
+		typ = PrimitiveStaticTypeFix128
 
 	// UFix*
 	case sema.UFix64Type:
 		typ = PrimitiveStaticTypeUFix64
+	case sema.UFix128Type:
+		typ = PrimitiveStaticTypeUFix128
 
 	case sema.PathType:
 		typ = PrimitiveStaticTypePath
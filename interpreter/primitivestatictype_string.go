@@ -51,7 +51,9 @@ func _() {
 	_ = x[PrimitiveStaticTypeWord128-57]
 	_ = x[PrimitiveStaticTypeWord256-58]
 	_ = x[PrimitiveStaticTypeFix64-64]
+	_ = x[PrimitiveStaticTypeFix128-65]
 	_ = x[PrimitiveStaticTypeUFix64-72]
+	_ = x[PrimitiveStaticTypeUFix128-73]
 	_ = x[PrimitiveStaticTypePath-76]
 	_ = x[PrimitiveStaticTypeCapability-77]
 	_ = x[PrimitiveStaticTypeStoragePath-78]
@@ -115,7 +117,7 @@ func _() {
 	_ = x[PrimitiveStaticType_Count-152]
 }
 
-const _PrimitiveStaticType_name = "UnknownVoidAnyNeverAnyStructAnyResourceBoolAddressStringCharacterMetaTypeBlockAnyResourceAttachmentAnyStructAttachmentHashableStructNumberSignedNumberIntegerSignedIntegerFixedSizeUnsignedIntegerFixedPointSignedFixedPointIntInt8Int16Int32Int64Int128Int256UIntUInt8UInt16UInt32UInt64UInt128UInt256Word8Word16Word32Word64Word128Word256Fix64UFix64PathCapabilityStoragePathCapabilityPathPublicPathPrivatePathAuthAccountPublicAccountDeployedContractAuthAccountContractsPublicAccountContractsAuthAccountKeysPublicAccountKeysAccountKeyAuthAccountInboxStorageCapabilityControllerAccountCapabilityControllerAuthAccountStorageCapabilitiesAuthAccountAccountCapabilitiesAuthAccountCapabilitiesPublicAccountCapabilitiesAccountAccount_ContractsAccount_KeysAccount_InboxAccount_StorageCapabilitiesAccount_AccountCapabilitiesAccount_CapabilitiesAccount_StorageMutateInsertRemoveIdentityStorageSaveValueLoadValueCopyValueBorrowValueContractsAddContractUpdateContractRemoveContractKeysAddKeyRevokeKeyInboxPublishInboxCapabilityUnpublishInboxCapabilityClaimInboxCapabilityCapabilitiesStorageCapabilitiesAccountCapabilitiesPublishCapabilityUnpublishCapabilityGetStorageCapabilityControllerIssueStorageCapabilityControllerGetAccountCapabilityControllerIssueAccountCapabilityControllerCapabilitiesMappingAccountMapping_Count"
+const _PrimitiveStaticType_name = "UnknownVoidAnyNeverAnyStructAnyResourceBoolAddressStringCharacterMetaTypeBlockAnyResourceAttachmentAnyStructAttachmentHashableStructNumberSignedNumberIntegerSignedIntegerFixedSizeUnsignedIntegerFixedPointSignedFixedPointIntInt8Int16Int32Int64Int128Int256UIntUInt8UInt16UInt32UInt64UInt128UInt256Word8Word16Word32Word64Word128Word256Fix64Fix128UFix64UFix128PathCapabilityStoragePathCapabilityPathPublicPathPrivatePathAuthAccountPublicAccountDeployedContractAuthAccountContractsPublicAccountContractsAuthAccountKeysPublicAccountKeysAccountKeyAuthAccountInboxStorageCapabilityControllerAccountCapabilityControllerAuthAccountStorageCapabilitiesAuthAccountAccountCapabilitiesAuthAccountCapabilitiesPublicAccountCapabilitiesAccountAccount_ContractsAccount_KeysAccount_InboxAccount_StorageCapabilitiesAccount_AccountCapabilitiesAccount_CapabilitiesAccount_StorageMutateInsertRemoveIdentityStorageSaveValueLoadValueCopyValueBorrowValueContractsAddContractUpdateContractRemoveContractKeysAddKeyRevokeKeyInboxPublishInboxCapabilityUnpublishInboxCapabilityClaimInboxCapabilityCapabilitiesStorageCapabilitiesAccountCapabilitiesPublishCapabilityUnpublishCapabilityGetStorageCapabilityControllerIssueStorageCapabilityControllerGetAccountCapabilityControllerIssueAccountCapabilityControllerCapabilitiesMappingAccountMapping_Count"
 
 var _PrimitiveStaticType_map = map[PrimitiveStaticType]string{
 	0:   _PrimitiveStaticType_name[0:7],
@@ -161,68 +163,70 @@ var _PrimitiveStaticType_map = map[PrimitiveStaticType]string{
 	57:  _PrimitiveStaticType_name[318:325],
 	58:  _PrimitiveStaticType_name[325:332],
 	64:  _PrimitiveStaticType_name[332:337],
-	72:  _PrimitiveStaticType_name[337:343],
-	76:  _PrimitiveStaticType_name[343:347],
-	77:  _PrimitiveStaticType_name[347:357],
-	78:  _PrimitiveStaticType_name[357:368],
-	79:  _PrimitiveStaticType_name[368:382],
-	80:  _PrimitiveStaticType_name[382:392],
-	81:  _PrimitiveStaticType_name[392:403],
-	90:  _PrimitiveStaticType_name[403:414],
-	91:  _PrimitiveStaticType_name[414:427],
-	92:  _PrimitiveStaticType_name[427:443],
-	93:  _PrimitiveStaticType_name[443:463],
-	94:  _PrimitiveStaticType_name[463:485],
-	95:  _PrimitiveStaticType_name[485:500],
-	96:  _PrimitiveStaticType_name[500:517],
-	97:  _PrimitiveStaticType_name[517:527],
-	98:  _PrimitiveStaticType_name[527:543],
-	99:  _PrimitiveStaticType_name[543:570],
-	100: _PrimitiveStaticType_name[570:597],
-	101: _PrimitiveStaticType_name[597:627],
-	102: _PrimitiveStaticType_name[627:657],
-	103: _PrimitiveStaticType_name[657:680],
-	104: _PrimitiveStaticType_name[680:705],
-	105: _PrimitiveStaticType_name[705:712],
-	106: _PrimitiveStaticType_name[712:729],
-	107: _PrimitiveStaticType_name[729:741],
-	108: _PrimitiveStaticType_name[741:754],
-	109: _PrimitiveStaticType_name[754:781],
-	110: _PrimitiveStaticType_name[781:808],
-	111: _PrimitiveStaticType_name[808:828],
-	112: _PrimitiveStaticType_name[828:843],
-	118: _PrimitiveStaticType_name[843:849],
-	119: _PrimitiveStaticType_name[849:855],
-	120: _PrimitiveStaticType_name[855:861],
-	121: _PrimitiveStaticType_name[861:869],
-	125: _PrimitiveStaticType_name[869:876],
-	126: _PrimitiveStaticType_name[876:885],
-	127: _PrimitiveStaticType_name[885:894],
-	128: _PrimitiveStaticType_name[894:903],
-	129: _PrimitiveStaticType_name[903:914],
-	130: _PrimitiveStaticType_name[914:923],
-	131: _PrimitiveStaticType_name[923:934],
-	132: _PrimitiveStaticType_name[934:948],
-	133: _PrimitiveStaticType_name[948:962],
-	134: _PrimitiveStaticType_name[962:966],
-	135: _PrimitiveStaticType_name[966:972],
-	136: _PrimitiveStaticType_name[972:981],
-	137: _PrimitiveStaticType_name[981:986],
-	138: _PrimitiveStaticType_name[986:1008],
-	139: _PrimitiveStaticType_name[1008:1032],
-	140: _PrimitiveStaticType_name[1032:1052],
-	141: _PrimitiveStaticType_name[1052:1064],
-	142: _PrimitiveStaticType_name[1064:1083],
-	143: _PrimitiveStaticType_name[1083:1102],
-	144: _PrimitiveStaticType_name[1102:1119],
-	145: _PrimitiveStaticType_name[1119:1138],
-	146: _PrimitiveStaticType_name[1138:1168],
-	147: _PrimitiveStaticType_name[1168:1200],
-	148: _PrimitiveStaticType_name[1200:1230],
-	149: _PrimitiveStaticType_name[1230:1262],
-	150: _PrimitiveStaticType_name[1262:1281],
-	151: _PrimitiveStaticType_name[1281:1295],
-	152: _PrimitiveStaticType_name[1295:1301],
+	65:  _PrimitiveStaticType_name[337:343],
+	72:  _PrimitiveStaticType_name[343:349],
+	73:  _PrimitiveStaticType_name[349:356],
+	76:  _PrimitiveStaticType_name[356:360],
+	77:  _PrimitiveStaticType_name[360:370],
+	78:  _PrimitiveStaticType_name[370:381],
+	79:  _PrimitiveStaticType_name[381:395],
+	80:  _PrimitiveStaticType_name[395:405],
+	81:  _PrimitiveStaticType_name[405:416],
+	90:  _PrimitiveStaticType_name[416:427],
+	91:  _PrimitiveStaticType_name[427:440],
+	92:  _PrimitiveStaticType_name[440:456],
+	93:  _PrimitiveStaticType_name[456:476],
+	94:  _PrimitiveStaticType_name[476:498],
+	95:  _PrimitiveStaticType_name[498:513],
+	96:  _PrimitiveStaticType_name[513:530],
+	97:  _PrimitiveStaticType_name[530:540],
+	98:  _PrimitiveStaticType_name[540:556],
+	99:  _PrimitiveStaticType_name[556:583],
+	100: _PrimitiveStaticType_name[583:610],
+	101: _PrimitiveStaticType_name[610:640],
+	102: _PrimitiveStaticType_name[640:670],
+	103: _PrimitiveStaticType_name[670:693],
+	104: _PrimitiveStaticType_name[693:718],
+	105: _PrimitiveStaticType_name[718:725],
+	106: _PrimitiveStaticType_name[725:742],
+	107: _PrimitiveStaticType_name[742:754],
+	108: _PrimitiveStaticType_name[754:767],
+	109: _PrimitiveStaticType_name[767:794],
+	110: _PrimitiveStaticType_name[794:821],
+	111: _PrimitiveStaticType_name[821:841],
+	112: _PrimitiveStaticType_name[841:856],
+	118: _PrimitiveStaticType_name[856:862],
+	119: _PrimitiveStaticType_name[862:868],
+	120: _PrimitiveStaticType_name[868:874],
+	121: _PrimitiveStaticType_name[874:882],
+	125: _PrimitiveStaticType_name[882:889],
+	126: _PrimitiveStaticType_name[889:898],
+	127: _PrimitiveStaticType_name[898:907],
+	128: _PrimitiveStaticType_name[907:916],
+	129: _PrimitiveStaticType_name[916:927],
+	130: _PrimitiveStaticType_name[927:936],
+	131: _PrimitiveStaticType_name[936:947],
+	132: _PrimitiveStaticType_name[947:961],
+	133: _PrimitiveStaticType_name[961:975],
+	134: _PrimitiveStaticType_name[975:979],
+	135: _PrimitiveStaticType_name[979:985],
+	136: _PrimitiveStaticType_name[985:994],
+	137: _PrimitiveStaticType_name[994:999],
+	138: _PrimitiveStaticType_name[999:1021],
+	139: _PrimitiveStaticType_name[1021:1045],
+	140: _PrimitiveStaticType_name[1045:1065],
+	141: _PrimitiveStaticType_name[1065:1077],
+	142: _PrimitiveStaticType_name[1077:1096],
+	143: _PrimitiveStaticType_name[1096:1115],
+	144: _PrimitiveStaticType_name[1115:1132],
+	145: _PrimitiveStaticType_name[1132:1151],
+	146: _PrimitiveStaticType_name[1151:1181],
+	147: _PrimitiveStaticType_name[1181:1213],
+	148: _PrimitiveStaticType_name[1213:1243],
+	149: _PrimitiveStaticType_name[1243:1275],
+	150: _PrimitiveStaticType_name[1275:1294],
+	151: _PrimitiveStaticType_name[1294:1308],
+	152: _PrimitiveStaticType_name[1308:1314],
 }
 
 func (i PrimitiveStaticType) String() string {
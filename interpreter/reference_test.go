@@ -1942,8 +1942,10 @@ func TestInterpretDereference(t *testing.T) {
 		t.Parallel()
 
 		expectedValues := map[sema.Type]interpreter.FixedPointValue{
-			sema.UFix64Type: interpreter.NewUnmeteredUFix64Value(4224_000_000),
-			sema.Fix64Type:  interpreter.NewUnmeteredFix64Value(4224_000_000),
+			sema.UFix64Type:  interpreter.NewUnmeteredUFix64Value(4224_000_000),
+			sema.Fix64Type:   interpreter.NewUnmeteredFix64Value(4224_000_000),
+			sema.UFix128Type: interpreter.NewUnmeteredUFix128Value(fix128BigInt(4224, 2)),
+			sema.Fix128Type:  interpreter.NewUnmeteredFix128Value(fix128BigInt(4224, 2)),
 		}
 
 		for _, typ := range sema.AllFixedPointTypes {
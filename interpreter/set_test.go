@@ -0,0 +1,359 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/activations"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/stdlib"
+	. "github.com/onflow/cadence/test_utils/common_utils"
+	. "github.com/onflow/cadence/test_utils/interpreter_utils"
+)
+
+func parseCheckAndInterpretWithSet(t *testing.T, code string) (*interpreter.Interpreter, error) {
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.SetConstructorFunction)
+
+	baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
+	interpreter.Declare(baseActivation, stdlib.SetConstructorFunction)
+
+	return parseCheckAndInterpretWithOptions(t,
+		code,
+		ParseCheckAndInterpretOptions{
+			CheckerConfig: &sema.Config{
+				BaseValueActivationHandler: func(common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+			Config: &interpreter.Config{
+				BaseActivationHandler: func(common.Location) *interpreter.VariableActivation {
+					return baseActivation
+				},
+			},
+		},
+	)
+}
+
+func newTestIntSetValue(inter *interpreter.Interpreter, elements ...int64) *interpreter.SetValue {
+	values := make([]interpreter.Value, 0, len(elements))
+	for _, element := range elements {
+		values = append(values, interpreter.NewUnmeteredIntValueFromInt64(element))
+	}
+
+	return interpreter.NewSetValue(
+		inter,
+		interpreter.EmptyLocationRange,
+		interpreter.NewSetStaticType(nil, interpreter.PrimitiveStaticTypeInt),
+		values...,
+	)
+}
+
+func TestInterpretSet(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("construction", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithSet(t, `
+          let empty = Set<Int>()
+          let s = Set([1, 2, 2, 3, 1])
+        `)
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestIntSetValue(inter),
+			inter.Globals.Get("empty").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestIntSetValue(inter, 1, 2, 3),
+			inter.Globals.Get("s").GetValue(inter),
+		)
+	})
+
+	t.Run("insert and remove", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithSet(t, `
+          fun test(): [AnyStruct] {
+              let s = Set([1, 2])
+              let results: [AnyStruct] = []
+              results.append(s.insert(3))
+              results.append(s.insert(3))
+              results.append(s.remove(1))
+              results.append(s.remove(1))
+              results.append(s.length)
+              results.append(s.contains(1))
+              results.append(s.contains(3))
+              return results
+          }
+        `)
+		require.NoError(t, err)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeAnyStruct,
+				},
+				common.ZeroAddress,
+				interpreter.TrueValue,
+				interpreter.FalseValue,
+				interpreter.TrueValue,
+				interpreter.FalseValue,
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.FalseValue,
+				interpreter.TrueValue,
+			),
+			value,
+		)
+	})
+
+	t.Run("set operations", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithSet(t, `
+          let a = Set([1, 2, 3])
+          let b = Set([3, 4])
+          let union = a.union(b)
+          let intersection = a.intersection(b)
+          let difference = a.difference(b)
+        `)
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestIntSetValue(inter, 1, 2, 3, 4),
+			inter.Globals.Get("union").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestIntSetValue(inter, 3),
+			inter.Globals.Get("intersection").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestIntSetValue(inter, 1, 2),
+			inter.Globals.Get("difference").GetValue(inter),
+		)
+
+		// The operands are not modified
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestIntSetValue(inter, 1, 2, 3),
+			inter.Globals.Get("a").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			newTestIntSetValue(inter, 3, 4),
+			inter.Globals.Get("b").GetValue(inter),
+		)
+	})
+
+	t.Run("forEach", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithSet(t, `
+          fun test(): [Int] {
+              let s = Set([1, 2, 3, 4])
+              var sum = 0
+              var count = 0
+              s.forEach(fun (element: Int): Bool {
+                  sum = sum + element
+                  count = count + 1
+                  return true
+              })
+
+              var visited = 0
+              s.forEach(fun (element: Int): Bool {
+                  visited = visited + 1
+                  return visited < 2
+              })
+
+              return [sum, count, visited]
+          }
+        `)
+		require.NoError(t, err)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(10),
+				interpreter.NewUnmeteredIntValueFromInt64(4),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			value,
+		)
+	})
+
+	t.Run("forEach, mutation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithSet(t, `
+          fun test() {
+              let s = Set([1, 2, 3])
+              s.forEach(fun (element: Int): Bool {
+                  s.insert(element + 10)
+                  return true
+              })
+          }
+        `)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		RequireError(t, err)
+
+		assert.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	})
+
+	t.Run("value semantics", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithSet(t, `
+          fun test(): Bool {
+              let s1 = Set([1])
+              let s2 = s1
+              s2.insert(2)
+              return s1.contains(2)
+          }
+        `)
+		require.NoError(t, err)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.FalseValue, value)
+	})
+
+	t.Run("equality", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithSet(t, `
+          let s = Set([1, 2, 3])
+        `)
+		require.NoError(t, err)
+
+		value := inter.Globals.Get("s").GetValue(inter).(*interpreter.SetValue)
+
+		assert.True(t,
+			value.Equal(inter, interpreter.EmptyLocationRange, newTestIntSetValue(inter, 3, 2, 1)),
+		)
+		assert.False(t,
+			value.Equal(inter, interpreter.EmptyLocationRange, newTestIntSetValue(inter, 1, 2)),
+		)
+	})
+
+	t.Run("string", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithSet(t, `
+          let s = Set(["a"])
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			`Set(["a"])`,
+			inter.Globals.Get("s").GetValue(inter).String(),
+		)
+	})
+
+	t.Run("runtime type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithSet(t, `
+          let t1 = SetType(Type<Int>())
+          let t2 = SetType(Type<[Int]>())
+          let t3 = Set([1]).getType()
+        `)
+		require.NoError(t, err)
+
+		expectedType := interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.TypeValue{
+				Type: interpreter.NewSetStaticType(nil, interpreter.PrimitiveStaticTypeInt),
+			},
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			expectedType,
+			inter.Globals.Get("t1").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.Nil,
+			inter.Globals.Get("t2").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			expectedType.InnerValue(),
+			inter.Globals.Get("t3").GetValue(inter),
+		)
+	})
+}
@@ -46,6 +46,9 @@ type SharedState struct {
 	containerValueIteration                     map[atree.ValueID]struct{}
 	destroyedResources                          map[atree.ValueID]struct{}
 	currentEntitlementMappedValue               Authorization
+	// currentTypeArguments are the type arguments of the currently invoked generic function
+	// and the generic functions it is nested in, if any
+	currentTypeArguments *sema.TypeParameterTypeOrderedMap
 }
 
 func NewSharedState(config *Config) *SharedState {
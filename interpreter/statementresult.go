@@ -18,6 +18,10 @@
 
 package interpreter
 
+import (
+	"github.com/onflow/cadence/ast"
+)
+
 type StatementResult interface {
 	isStatementResult()
 }
@@ -27,16 +31,30 @@ type controlResult interface {
 	isControlResult()
 }
 
-type BreakResult struct{}
+type BreakResult struct {
+	// Label is the label of the targeted loop, if any
+	Label string
+}
 
 func (BreakResult) isStatementResult() {}
 func (BreakResult) isControlResult()   {}
 
-type ContinueResult struct{}
+type ContinueResult struct {
+	// Label is the label of the targeted loop, if any
+	Label string
+}
 
 func (ContinueResult) isStatementResult() {}
 func (ContinueResult) isControlResult()   {}
 
+// targetsLoop returns true if a jump with the given label
+// targets the loop with the given label.
+// Jumps without a label target the innermost loop
+func targetsLoop(jumpLabel string, loopLabel *ast.Identifier) bool {
+	return jumpLabel == "" ||
+		(loopLabel != nil && loopLabel.Identifier == jumpLabel)
+}
+
 type ReturnResult struct {
 	Value
 }
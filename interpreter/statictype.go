@@ -59,7 +59,11 @@ type TypeID = common.TypeID
 type CompositeStaticType struct {
 	Location            common.Location
 	QualifiedIdentifier string
-	TypeID              TypeID
+	// TypeID is the type ID of the (generic) composite type,
+	// without any type arguments
+	TypeID TypeID
+	// TypeArguments are the type arguments of an instantiated generic composite type
+	TypeArguments []StaticType
 }
 
 var _ StaticType = &CompositeStaticType{}
@@ -113,8 +117,9 @@ func (t *CompositeStaticType) String() string {
 }
 
 func (t *CompositeStaticType) MeteredString(memoryGauge common.MemoryGauge) string {
-	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(len(t.TypeID)))
-	return string(t.TypeID)
+	typeID := t.ID()
+	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(len(typeID)))
+	return string(typeID)
 }
 
 func (t *CompositeStaticType) Equal(other StaticType) bool {
@@ -123,11 +128,32 @@ func (t *CompositeStaticType) Equal(other StaticType) bool {
 		return false
 	}
 
-	return otherCompositeType.TypeID == t.TypeID
+	if otherCompositeType.TypeID != t.TypeID ||
+		len(otherCompositeType.TypeArguments) != len(t.TypeArguments) {
+
+		return false
+	}
+
+	for i, typeArgument := range t.TypeArguments {
+		if !typeArgument.Equal(otherCompositeType.TypeArguments[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (t *CompositeStaticType) ID() TypeID {
-	return t.TypeID
+	if len(t.TypeArguments) == 0 {
+		return t.TypeID
+	}
+
+	typeArgumentIDs := make([]TypeID, len(t.TypeArguments))
+	for i, typeArgument := range t.TypeArguments {
+		typeArgumentIDs[i] = typeArgument.ID()
+	}
+
+	return sema.FormatInstantiatedTypeID(t.TypeID, typeArgumentIDs)
 }
 
 func (*CompositeStaticType) IsDeprecated() bool {
@@ -496,6 +522,70 @@ func (t *DictionaryStaticType) IsDeprecated() bool {
 		t.ValueType.IsDeprecated()
 }
 
+// SetStaticType
+
+type SetStaticType struct {
+	ElementType StaticType
+}
+
+var _ StaticType = &SetStaticType{}
+var _ atree.TypeInfo = &SetStaticType{}
+
+func NewSetStaticType(
+	memoryGauge common.MemoryGauge,
+	elementType StaticType,
+) *SetStaticType {
+	common.UseMemory(memoryGauge, common.SetStaticTypeMemoryUsage)
+
+	return &SetStaticType{
+		ElementType: elementType,
+	}
+}
+
+func (*SetStaticType) IsComposite() bool {
+	return false
+}
+
+func (t *SetStaticType) Copy() atree.TypeInfo {
+	// SetStaticType is never mutated, return a shallow copy
+	return t
+}
+
+func (*SetStaticType) isStaticType() {}
+
+func (*SetStaticType) elementSize() uint {
+	return UnknownElementSize
+}
+
+func (t *SetStaticType) String() string {
+	return t.MeteredString(nil)
+}
+
+func (t *SetStaticType) MeteredString(memoryGauge common.MemoryGauge) string {
+	common.UseMemory(memoryGauge, common.SetStaticTypeStringMemoryUsage)
+
+	elementStr := t.ElementType.MeteredString(memoryGauge)
+
+	return fmt.Sprintf("Set<%s>", elementStr)
+}
+
+func (t *SetStaticType) Equal(other StaticType) bool {
+	otherSetType, ok := other.(*SetStaticType)
+	if !ok {
+		return false
+	}
+
+	return t.ElementType.Equal(otherSetType.ElementType)
+}
+
+func (t *SetStaticType) ID() TypeID {
+	return sema.SetTypeID(string(t.ElementType.ID()))
+}
+
+func (t *SetStaticType) IsDeprecated() bool {
+	return t.ElementType.IsDeprecated()
+}
+
 // OptionalStaticType
 
 type OptionalStaticType struct {
@@ -1054,6 +1144,10 @@ func ConvertSemaToStaticType(memoryGauge common.MemoryGauge, t sema.Type) Static
 		memberType := ConvertSemaToStaticType(memoryGauge, t.MemberType)
 		return NewInclusiveRangeStaticType(memoryGauge, memberType)
 
+	case *sema.SetType:
+		elementType := ConvertSemaToStaticType(memoryGauge, t.ElementType)
+		return NewSetStaticType(memoryGauge, elementType)
+
 	case *sema.FunctionType:
 		return NewFunctionStaticType(memoryGauge, t)
 	}
@@ -1145,12 +1239,30 @@ func ConvertSemaCompositeTypeToStaticCompositeType(
 	memoryGauge common.MemoryGauge,
 	t *sema.CompositeType,
 ) *CompositeStaticType {
-	return NewCompositeStaticType(
+	baseType := t.BaseType()
+	if baseType == nil {
+		return NewCompositeStaticType(
+			memoryGauge,
+			t.Location,
+			t.QualifiedIdentifier(),
+			t.ID(),
+		)
+	}
+
+	staticType := NewCompositeStaticType(
 		memoryGauge,
 		t.Location,
 		t.QualifiedIdentifier(),
-		t.ID(),
+		baseType.ID(),
 	)
+
+	typeArguments := t.TypeArguments()
+	staticType.TypeArguments = make([]StaticType, len(typeArguments))
+	for i, typeArgument := range typeArguments {
+		staticType.TypeArguments[i] = ConvertSemaToStaticType(memoryGauge, typeArgument)
+	}
+
+	return staticType
 }
 
 func ConvertSemaInterfaceTypeToStaticInterfaceType(
@@ -1221,11 +1333,35 @@ func ConvertStaticToSemaType(
 ) (_ sema.Type, err error) {
 	switch t := typ.(type) {
 	case *CompositeStaticType:
-		return handler.GetCompositeType(
+		compositeType, err := handler.GetCompositeType(
 			t.Location,
 			t.QualifiedIdentifier,
 			t.TypeID,
 		)
+		if err != nil || len(t.TypeArguments) == 0 {
+			return compositeType, err
+		}
+
+		typeArguments := make([]sema.Type, len(t.TypeArguments))
+		for i, typeArgument := range t.TypeArguments {
+			typeArguments[i], err = ConvertStaticToSemaType(
+				memoryGauge,
+				typeArgument,
+				handler,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		instantiatedType := compositeType.Instantiate(memoryGauge, typeArguments, nil, nil)
+		if instantiatedType.IsInvalidType() {
+			return nil, errors.NewUnexpectedError(
+				"invalid type arguments for composite type %s",
+				t.TypeID,
+			)
+		}
+		return instantiatedType, nil
 
 	case *InterfaceStaticType:
 		return handler.GetInterfaceType(
@@ -1301,6 +1437,21 @@ func ConvertStaticToSemaType(
 			elementType,
 		), nil
 
+	case *SetStaticType:
+		elementType, err := ConvertStaticToSemaType(
+			memoryGauge,
+			t.ElementType,
+			handler,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return sema.NewSetType(
+			memoryGauge,
+			elementType,
+		), nil
+
 	case *OptionalStaticType:
 		ty, err := ConvertStaticToSemaType(
 			memoryGauge,
@@ -1203,6 +1203,18 @@ func TestStaticTypeConversion(t *testing.T) {
 			staticType: PrimitiveStaticTypeUFix64,
 		},
 
+		{
+			name:       "Fix128",
+			semaType:   sema.Fix128Type,
+			staticType: PrimitiveStaticTypeFix128,
+		},
+
+		{
+			name:       "UFix128",
+			semaType:   sema.UFix128Type,
+			staticType: PrimitiveStaticTypeUFix128,
+		},
+
 		{
 			name:       "Path",
 			semaType:   sema.PathType,
@@ -84,6 +84,14 @@ func ConvertStoredValue(gauge common.MemoryGauge, value atree.Value) (Value, err
 				value,
 			), nil
 
+		case *SetStaticType:
+			return newSetValueFromAtreeMap(
+				gauge,
+				staticType,
+				SetElementSize(staticType),
+				value,
+			), nil
+
 		case compositeTypeInfo:
 			return newCompositeValueFromAtreeMap(
 				gauge,
@@ -104,6 +112,12 @@ func ConvertStoredValue(gauge common.MemoryGauge, value atree.Value) (Value, err
 	case values.UFix64Value:
 		return UFix64Value{UFix64Value: value}, nil
 
+	case values.Fix128Value:
+		return Fix128Value{Fix128Value: value}, nil
+
+	case values.UFix128Value:
+		return UFix128Value{UFix128Value: value}, nil
+
 	case Value:
 		return value, nil
 
@@ -937,3 +937,321 @@ func TestInterpretStringCount(t *testing.T) {
 		runTest(test)
 	}
 }
+
+func TestInterpretStringToUpper(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): String {
+          return "Flowers".toUpper()
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	require.Equal(t,
+		interpreter.NewUnmeteredStringValue("FLOWERS"),
+		result,
+	)
+}
+
+func TestInterpretStringTrim(t *testing.T) {
+
+	t.Parallel()
+
+	type test struct {
+		str    string
+		result string
+	}
+
+	tests := []test{
+		{"", ""},
+		{"abc", "abc"},
+		{"   ", ""},
+		{"  abc  ", "abc"},
+		{"\\t\\n abc def \\r\\n", "abc def"},
+		{"\\u{A0}abc\\u{3000}", "abc"},
+		// a space followed by a combining character is not whitespace
+		{" \\u{301}abc", " \\u{301}abc"},
+	}
+
+	runTest := func(test test) {
+
+		name := fmt.Sprintf("%q", test.str)
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      fun test(): Bool {
+                        return "%s".trim() == "%s"
+                      }
+                    `,
+					test.str,
+					test.result,
+				),
+			)
+
+			value, err := inter.Invoke("test")
+			require.NoError(t, err)
+
+			require.Equal(t, interpreter.TrueValue, value)
+		})
+	}
+
+	for _, test := range tests {
+		runTest(test)
+	}
+}
+
+func TestInterpretStringPrefixSuffix(t *testing.T) {
+
+	t.Parallel()
+
+	type test struct {
+		str        string
+		other      string
+		startsWith bool
+		endsWith   bool
+		trimPrefix string
+		trimSuffix string
+	}
+
+	tests := []test{
+		{"", "", true, true, "", ""},
+		{"abc", "", true, true, "abc", "abc"},
+		{"", "abc", false, false, "", ""},
+		{"abc", "abc", true, true, "", ""},
+		{"abcab", "ab", true, true, "cab", "abc"},
+		{"abc", "bc", false, true, "abc", "a"},
+		{"abc", "ab", true, false, "c", "abc"},
+		{"abc", "abcd", false, false, "abc", "abc"},
+
+		// 🇪🇸🇪🇪 ("ES", "EE") starts with 🇪🇸 ("ES")
+		{"\\u{1F1EA}\\u{1F1F8}\\u{1F1EA}\\u{1F1EA}", "\\u{1F1EA}\\u{1F1F8}", true, false, "\\u{1F1EA}\\u{1F1EA}", "\\u{1F1EA}\\u{1F1F8}\\u{1F1EA}\\u{1F1EA}"},
+		// 🇪🇸🇪🇪 ("ES", "EE") neither starts nor ends with a part of a character
+		{"\\u{1F1EA}\\u{1F1F8}\\u{1F1EA}\\u{1F1EA}", "\\u{1F1EA}", false, false, "\\u{1F1EA}\\u{1F1F8}\\u{1F1EA}\\u{1F1EA}", "\\u{1F1EA}\\u{1F1F8}\\u{1F1EA}\\u{1F1EA}"},
+		// "e" followed by a combining enclosing circle is a single character
+		{"e\\u{20DD}x", "e", false, false, "e\\u{20DD}x", "e\\u{20DD}x"},
+	}
+
+	runTest := func(test test) {
+
+		name := fmt.Sprintf("%s, %s", test.str, test.other)
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let s = "%[1]s"
+                      let other = "%[2]s"
+
+                      fun test(): [Bool] {
+                          return [
+                              s.startsWith(other),
+                              s.endsWith(other),
+                              s.trimPrefix(other) == "%[3]s",
+                              s.trimSuffix(other) == "%[4]s"
+                          ]
+                      }
+                    `,
+					test.str,
+					test.other,
+					test.trimPrefix,
+					test.trimSuffix,
+				),
+			)
+
+			value, err := inter.Invoke("test")
+			require.NoError(t, err)
+
+			AssertValuesEqual(
+				t,
+				inter,
+				interpreter.NewArrayValue(
+					inter,
+					interpreter.EmptyLocationRange,
+					&interpreter.VariableSizedStaticType{
+						Type: interpreter.PrimitiveStaticTypeBool,
+					},
+					common.ZeroAddress,
+					interpreter.BoolValue(test.startsWith),
+					interpreter.BoolValue(test.endsWith),
+					interpreter.TrueValue,
+					interpreter.TrueValue,
+				),
+				value,
+			)
+		})
+	}
+
+	for _, test := range tests {
+		runTest(test)
+	}
+}
+
+func TestInterpretStringPad(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): [String] {
+          return [
+              "42".padLeft(toLength: 5, with: "0"),
+              "42".padRight(toLength: 5, with: "."),
+              "12345".padLeft(toLength: 3, with: "0"),
+              "42".padLeft(toLength: -1, with: "0"),
+              "".padRight(toLength: 2, with: "\u{1F476}\u{1F3FB}")
+          ]
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeString,
+			},
+			common.ZeroAddress,
+			interpreter.NewUnmeteredStringValue("00042"),
+			interpreter.NewUnmeteredStringValue("42..."),
+			interpreter.NewUnmeteredStringValue("12345"),
+			interpreter.NewUnmeteredStringValue("42"),
+			interpreter.NewUnmeteredStringValue("\U0001F476\U0001F3FB\U0001F476\U0001F3FB"),
+		),
+		value,
+	)
+}
+
+func TestInterpretStringLastIndex(t *testing.T) {
+
+	t.Parallel()
+
+	type test struct {
+		str    string
+		subStr string
+		result int
+	}
+
+	tests := []test{
+		{"", "", 0},
+		{"", "a", -1},
+		{"abcdef", "", 6},
+		{"abcdef", "a", 0},
+		{"abcdef", "f", 5},
+		{"abcdef", "ac", -1},
+		{"abcabc", "bc", 4},
+		{"abcabc", "abc", 3},
+		{"aaa", "aa", 1},
+		{"abcdef", "abcdefg", -1},
+
+		// U+1F476 U+1F3FB is 👶🏻
+		{"\\u{1F476}\\u{1F3FB} a \\u{1F476}\\u{1F3FB} b", "\\u{1F476}\\u{1F3FB}", 4},
+		{"\\u{1F476}\\u{1F3FB} a \\u{1F476}\\u{1F3FB} b", "\\u{1F476}", -1},
+
+		// 🇪🇸🇪🇪🇪🇸 ("ES", "EE", "ES") contains 🇪🇸 ("ES") last at index 2
+		{"\\u{1F1EA}\\u{1F1F8}\\u{1F1EA}\\u{1F1EA}\\u{1F1EA}\\u{1F1F8}", "\\u{1F1EA}\\u{1F1F8}", 2},
+		// 🇪🇸🇪🇪 ("ES", "EE") does NOT contain 🇸🇪 ("SE")
+		{"\\u{1F1EA}\\u{1F1F8}\\u{1F1EA}\\u{1F1EA}", "\\u{1F1F8}\\u{1F1EA}", -1},
+	}
+
+	runTest := func(test test) {
+
+		name := fmt.Sprintf("%s, %s", test.str, test.subStr)
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      fun test(): Int {
+                        let s = "%s"
+                        return s.lastIndex(of: "%s")
+                      }
+                    `,
+					test.str,
+					test.subStr,
+				),
+			)
+
+			value, err := inter.Invoke("test")
+			require.NoError(t, err)
+
+			require.IsType(t, interpreter.IntValue{}, value)
+			actual := value.(interpreter.IntValue)
+			require.Equal(t, test.result, actual.ToInt(interpreter.EmptyLocationRange))
+		})
+	}
+
+	for _, test := range tests {
+		runTest(test)
+	}
+}
+
+func TestInterpretStringCharacters(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): [Character] {
+          return "a\u{1F476}\u{1F3FB}e\u{301}".characters
+      }
+
+      fun testEmpty(): [Character] {
+          return "".characters
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeCharacter,
+			},
+			common.ZeroAddress,
+			interpreter.NewUnmeteredCharacterValue("a"),
+			interpreter.NewUnmeteredCharacterValue("\U0001F476\U0001F3FB"),
+			interpreter.NewUnmeteredCharacterValue("é"),
+		),
+		value,
+	)
+
+	value, err = inter.Invoke("testEmpty")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeCharacter,
+			},
+			common.ZeroAddress,
+		),
+		value,
+	)
+}
@@ -970,7 +970,7 @@ func (v *ArrayValue) GetMember(interpreter *Interpreter, _ LocationRange, name s
 			},
 		)
 
-	case "firstIndex":
+	case sema.ArrayTypeFirstIndexFunctionName:
 		return NewBoundHostFunctionValue(
 			interpreter,
 			v,
@@ -1092,6 +1092,145 @@ func (v *ArrayValue) GetMember(interpreter *Interpreter, _ LocationRange, name s
 			},
 		)
 
+	case sema.ArrayTypeFirstIndexWhereFunctionOverloadName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.ArrayFirstIndexWhereFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+			func(v *ArrayValue, invocation Invocation) Value {
+				predicate, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.FirstIndexWhere(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					predicate,
+				)
+			},
+		)
+
+	case sema.ArrayTypeSortFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.ArraySortFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(v *ArrayValue, invocation Invocation) Value {
+				comparator, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Sort(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					comparator,
+				)
+			},
+		)
+
+	case sema.ArrayTypeReduceFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.ArrayReduceFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+			func(v *ArrayValue, invocation Invocation) Value {
+				typeParameterPair := invocation.TypeParameterTypes.Oldest()
+				if typeParameterPair == nil {
+					panic(errors.NewUnreachableError())
+				}
+
+				accumulatorType := typeParameterPair.Value
+
+				initial := invocation.Arguments[0]
+
+				procedure, ok := invocation.Arguments[1].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Reduce(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					initial,
+					accumulatorType,
+					procedure,
+				)
+			},
+		)
+
+	case sema.ArrayTypeAnyFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.ArrayAnyFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+			func(v *ArrayValue, invocation Invocation) Value {
+				predicate, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Any(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					predicate,
+				)
+			},
+		)
+
+	case sema.ArrayTypeAllFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.ArrayAllFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+			func(v *ArrayValue, invocation Invocation) Value {
+				predicate, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.All(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					predicate,
+				)
+			},
+		)
+
+	case sema.ArrayTypeForEachFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.ArrayForEachFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+			func(v *ArrayValue, invocation Invocation) Value {
+				procedure, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				v.ForEachElement(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					procedure,
+				)
+
+				return Void
+			},
+		)
+
 	case sema.ArrayTypeToVariableSizedFunctionName:
 		return NewBoundHostFunctionValue(
 			interpreter,
@@ -1826,6 +1965,331 @@ func (v *ArrayValue) Map(
 	)
 }
 
+// firstIndexWhere returns the index of the first element of the array
+// for which the given predicate function returns the given expected result,
+// or -1 if there is no such element
+func (v *ArrayValue) firstIndexWhere(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	predicate FunctionValue,
+	expectedResult bool,
+) int {
+
+	elementType := v.semaType.ElementType(false)
+
+	argumentTypes := []sema.Type{elementType}
+
+	predicateFunctionType := predicate.FunctionType()
+	parameterTypes := predicateFunctionType.ParameterTypes()
+	returnType := predicateFunctionType.ReturnTypeAnnotation.Type
+
+	result := -1
+	index := 0
+
+	v.Iterate(
+		interpreter,
+		func(element Value) (resume bool) {
+
+			// Meter computation for iterating the array.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			predicateResult := interpreter.invokeFunctionValue(
+				predicate,
+				[]Value{element},
+				nil,
+				argumentTypes,
+				parameterTypes,
+				returnType,
+				nil,
+				locationRange,
+			)
+
+			matches, ok := predicateResult.(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			if bool(matches) == expectedResult {
+				result = index
+				// stop iteration
+				return false
+			}
+
+			index++
+
+			// continue iteration
+			return true
+		},
+		false,
+		locationRange,
+	)
+
+	return result
+}
+
+func (v *ArrayValue) FirstIndexWhere(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	predicate FunctionValue,
+) OptionalValue {
+	index := v.firstIndexWhere(interpreter, locationRange, predicate, true)
+	if index < 0 {
+		return NilOptionalValue
+	}
+
+	value := NewIntValueFromInt64(interpreter, int64(index))
+	return NewSomeValueNonCopying(interpreter, value)
+}
+
+func (v *ArrayValue) Any(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	predicate FunctionValue,
+) BoolValue {
+	index := v.firstIndexWhere(interpreter, locationRange, predicate, true)
+	return BoolValue(index >= 0)
+}
+
+func (v *ArrayValue) All(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	predicate FunctionValue,
+) BoolValue {
+	index := v.firstIndexWhere(interpreter, locationRange, predicate, false)
+	return BoolValue(index < 0)
+}
+
+func (v *ArrayValue) Reduce(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	initial Value,
+	accumulatorType sema.Type,
+	procedure FunctionValue,
+) Value {
+
+	elementType := v.semaType.ElementType(false)
+
+	argumentTypes := []sema.Type{accumulatorType, elementType}
+
+	procedureFunctionType := procedure.FunctionType()
+	parameterTypes := procedureFunctionType.ParameterTypes()
+	returnType := procedureFunctionType.ReturnTypeAnnotation.Type
+
+	accumulator := initial
+
+	v.Iterate(
+		interpreter,
+		func(element Value) (resume bool) {
+
+			// Meter computation for iterating the array.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			accumulator = interpreter.invokeFunctionValue(
+				procedure,
+				[]Value{accumulator, element},
+				nil,
+				argumentTypes,
+				parameterTypes,
+				returnType,
+				nil,
+				locationRange,
+			)
+
+			// continue iteration
+			return true
+		},
+		false,
+		locationRange,
+	)
+
+	return accumulator
+}
+
+func (v *ArrayValue) Sort(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	comparator FunctionValue,
+) Value {
+
+	elementType := v.semaType.ElementType(false)
+
+	argumentTypes := []sema.Type{elementType, elementType}
+
+	comparatorFunctionType := comparator.FunctionType()
+	parameterTypes := comparatorFunctionType.ParameterTypes()
+	returnType := comparatorFunctionType.ReturnTypeAnnotation.Type
+
+	count := v.Count()
+
+	elements := make([]Value, 0, count)
+
+	v.Iterate(
+		interpreter,
+		func(element Value) (resume bool) {
+
+			// Meter computation for iterating the array.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			elements = append(elements, element)
+
+			// continue iteration
+			return true
+		},
+		false,
+		locationRange,
+	)
+
+	stableSortValues(
+		elements,
+		func(a, b Value) bool {
+
+			// Meter computation for comparing two elements.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			result := interpreter.invokeFunctionValue(
+				comparator,
+				[]Value{a, b},
+				nil,
+				argumentTypes,
+				parameterTypes,
+				returnType,
+				nil,
+				locationRange,
+			)
+
+			isOrderedBefore, ok := result.(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return bool(isOrderedBefore)
+		},
+	)
+
+	index := 0
+
+	return NewArrayValueWithIterator(
+		interpreter,
+		v.Type,
+		common.ZeroAddress,
+		uint64(count),
+		func() Value {
+			if index >= len(elements) {
+				return nil
+			}
+
+			value := elements[index]
+			index++
+
+			return value.Transfer(
+				interpreter,
+				locationRange,
+				atree.Address{},
+				false,
+				nil,
+				nil,
+				false, // value has a parent container because it is from iterator.
+			)
+		},
+	)
+}
+
+// stableSortValues sorts the given values using a merge sort.
+//
+// The sort is stable, and its sequence of comparisons only depends on the input,
+// so the computation metered for the comparisons is deterministic.
+// Do NOT replace with a sort function of the Go standard library,
+// as its algorithm may change between Go versions.
+func stableSortValues(values []Value, isOrderedBefore func(a, b Value) bool) {
+	if len(values) < 2 {
+		return
+	}
+
+	buffer := make([]Value, len(values))
+	mergeSortValues(values, buffer, isOrderedBefore)
+}
+
+func mergeSortValues(values []Value, buffer []Value, isOrderedBefore func(a, b Value) bool) {
+	count := len(values)
+	if count < 2 {
+		return
+	}
+
+	middle := count / 2
+
+	mergeSortValues(values[:middle], buffer[:middle], isOrderedBefore)
+	mergeSortValues(values[middle:], buffer[middle:], isOrderedBefore)
+
+	copy(buffer, values)
+
+	left := buffer[:middle]
+	right := buffer[middle:count]
+
+	var leftIndex, rightIndex, index int
+	for leftIndex < len(left) && rightIndex < len(right) {
+		// Only take the right element if it is ordered before the left element,
+		// so that the order of elements which are not ordered before each other is preserved
+		if isOrderedBefore(right[rightIndex], left[leftIndex]) {
+			values[index] = right[rightIndex]
+			rightIndex++
+		} else {
+			values[index] = left[leftIndex]
+			leftIndex++
+		}
+		index++
+	}
+
+	index += copy(values[index:], left[leftIndex:])
+	copy(values[index:], right[rightIndex:])
+}
+
+// ForEachElement invokes the given function on each element of the array, in order,
+// until the function returns false
+func (v *ArrayValue) ForEachElement(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	procedure FunctionValue,
+) {
+
+	elementType := v.semaType.ElementType(false)
+
+	argumentTypes := []sema.Type{elementType}
+
+	procedureFunctionType := procedure.FunctionType()
+	parameterTypes := procedureFunctionType.ParameterTypes()
+	returnType := procedureFunctionType.ReturnTypeAnnotation.Type
+
+	v.Iterate(
+		interpreter,
+		func(element Value) (resume bool) {
+
+			// Meter computation for iterating the array.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			result := interpreter.invokeFunctionValue(
+				procedure,
+				[]Value{element},
+				nil,
+				argumentTypes,
+				parameterTypes,
+				returnType,
+				nil,
+				locationRange,
+			)
+
+			shouldContinue, ok := result.(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return bool(shouldContinue)
+		},
+		// The function may mutate the elements, so they must be transferred
+		true,
+		locationRange,
+	)
+}
+
 func (v *ArrayValue) ForEach(
 	interpreter *Interpreter,
 	_ sema.Type,
@@ -60,8 +60,10 @@ type CompositeValue struct {
 	// 3) When a value is transferred, this field is copied between its attachments
 	base                *CompositeValue
 	QualifiedIdentifier string
-	Kind                common.CompositeKind
-	isDestroyed         bool
+	// typeArguments are the type arguments of an instance of a generic composite type
+	typeArguments []StaticType
+	Kind          common.CompositeKind
+	isDestroyed   bool
 }
 
 type ComputedField func(*Interpreter, LocationRange, *CompositeValue) Value
@@ -227,6 +229,7 @@ func newCompositeValueFromAtreeMap(
 		Location:            typeInfo.location,
 		QualifiedIdentifier: typeInfo.qualifiedIdentifier,
 		Kind:                typeInfo.kind,
+		typeArguments:       typeInfo.typeArguments,
 	}
 }
 
@@ -273,16 +276,37 @@ func (v *CompositeValue) StaticType(context ValueStaticTypeContext) StaticType {
 	if v.staticType == nil {
 		// NOTE: Instead of using NewCompositeStaticType, which always generates the type ID,
 		// use the TypeID accessor, which may return an already computed type ID
-		v.staticType = NewCompositeStaticType(
+		staticType := NewCompositeStaticType(
 			context,
 			v.Location,
 			v.QualifiedIdentifier,
 			v.TypeID(),
 		)
+		staticType.TypeArguments = v.typeArguments
+		v.staticType = staticType
 	}
 	return v.staticType
 }
 
+// SetTypeArguments sets the type arguments of an instance of a generic composite type
+func (v *CompositeValue) SetTypeArguments(typeArguments []StaticType) {
+	v.typeArguments = typeArguments
+	v.staticType = nil
+
+	err := v.dictionary.SetType(
+		NewInstantiatedCompositeTypeInfo(
+			nil,
+			v.Location,
+			v.QualifiedIdentifier,
+			v.Kind,
+			typeArguments,
+		),
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+}
+
 func (v *CompositeValue) IsImportable(inter *Interpreter, locationRange LocationRange) bool {
 	// Check type is importable
 	staticType := v.StaticType(inter)
@@ -451,6 +475,12 @@ func (v *CompositeValue) GetMember(interpreter *Interpreter, locationRange Locat
 		}()
 	}
 
+	// Overloaded functions are accessed by their overload name,
+	// which cannot be the name of any other member
+	if _, ok := sema.OverloadIdentifier(name); ok {
+		return v.GetFunction(v.getInterpreter(interpreter), locationRange, name)
+	}
+
 	if builtin := v.getBuiltinMember(interpreter, locationRange, name); builtin != nil {
 		return compositeMember(interpreter, v, builtin)
 	}
@@ -1324,11 +1354,12 @@ func (v *CompositeValue) Transfer(
 		v.dictionary = nil
 	}
 
-	info := NewCompositeTypeInfo(
+	info := NewInstantiatedCompositeTypeInfo(
 		interpreter,
 		v.Location,
 		v.QualifiedIdentifier,
 		v.Kind,
+		v.typeArguments,
 	)
 
 	res := newCompositeValueFromAtreeMap(
@@ -1426,6 +1457,7 @@ func (v *CompositeValue) Clone(interpreter *Interpreter) Value {
 		isDestroyed:         v.isDestroyed,
 		typeID:              v.typeID,
 		staticType:          v.staticType,
+		typeArguments:       v.typeArguments,
 		base:                v.base,
 	}
 }
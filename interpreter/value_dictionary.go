@@ -576,6 +576,243 @@ func (v *DictionaryValue) ForEachKey(
 	interpreter.withMutationPrevention(v.ValueID(), iterate)
 }
 
+// Filter returns a new dictionary containing the entries
+// for which the given predicate function returns true
+func (v *DictionaryValue) Filter(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	predicate FunctionValue,
+) *DictionaryValue {
+
+	dictionaryType := v.SemaType(interpreter)
+
+	argumentTypes := []sema.Type{
+		dictionaryType.KeyType,
+		dictionaryType.ValueType,
+	}
+
+	predicateFunctionType := predicate.FunctionType()
+	parameterTypes := predicateFunctionType.ParameterTypes()
+	returnType := predicateFunctionType.ReturnTypeAnnotation.Type
+
+	result := NewDictionaryValue(
+		interpreter,
+		locationRange,
+		v.Type,
+	)
+
+	v.Iterate(
+		interpreter,
+		locationRange,
+		func(key, value Value) (resume bool) {
+
+			// Meter computation for iterating the dictionary.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			shouldInclude, ok := interpreter.invokeFunctionValue(
+				predicate,
+				[]Value{key, value},
+				nil,
+				argumentTypes,
+				parameterTypes,
+				returnType,
+				nil,
+				locationRange,
+			).(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			if shouldInclude {
+				result.insertCopy(interpreter, locationRange, key, value)
+			}
+
+			// continue iteration
+			return true
+		},
+	)
+
+	return result
+}
+
+// Map returns a new dictionary with the same keys as the dictionary,
+// and the values returned by the given transform function.
+// If withKey is true, the transform function is passed the key and the value,
+// otherwise only the value
+func (v *DictionaryValue) Map(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	transform FunctionValue,
+	withKey bool,
+) *DictionaryValue {
+
+	dictionaryType := v.SemaType(interpreter)
+
+	var argumentTypes []sema.Type
+	if withKey {
+		argumentTypes = []sema.Type{
+			dictionaryType.KeyType,
+			dictionaryType.ValueType,
+		}
+	} else {
+		argumentTypes = []sema.Type{
+			dictionaryType.ValueType,
+		}
+	}
+
+	transformFunctionType := transform.FunctionType()
+	parameterTypes := transformFunctionType.ParameterTypes()
+	returnType := transformFunctionType.ReturnTypeAnnotation.Type
+
+	returnStaticType := ConvertSemaToStaticType(interpreter, returnType)
+
+	result := NewDictionaryValue(
+		interpreter,
+		locationRange,
+		NewDictionaryStaticType(
+			interpreter,
+			v.Type.KeyType,
+			returnStaticType,
+		),
+	)
+
+	v.Iterate(
+		interpreter,
+		locationRange,
+		func(key, value Value) (resume bool) {
+
+			// Meter computation for iterating the dictionary.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			var arguments []Value
+			if withKey {
+				arguments = []Value{key, value}
+			} else {
+				arguments = []Value{value}
+			}
+
+			newValue := interpreter.invokeFunctionValue(
+				transform,
+				arguments,
+				nil,
+				argumentTypes,
+				parameterTypes,
+				returnType,
+				nil,
+				locationRange,
+			)
+
+			result.insertCopy(interpreter, locationRange, key, newValue)
+
+			// continue iteration
+			return true
+		},
+	)
+
+	return result
+}
+
+// insertCopy inserts copies of the given key and value,
+// which may still be contained in another container, into the dictionary
+func (v *DictionaryValue) insertCopy(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	key, value Value,
+) {
+	address := v.dictionary.Address()
+
+	key = key.Transfer(
+		interpreter,
+		locationRange,
+		address,
+		false,
+		nil,
+		nil,
+		false, // key has a parent container because it is from iterator.
+	)
+
+	value = value.Transfer(
+		interpreter,
+		locationRange,
+		address,
+		false,
+		nil,
+		nil,
+		false, // value may have a parent container because it may be from iterator.
+	)
+
+	v.InsertWithoutTransfer(interpreter, locationRange, key, value)
+}
+
+// ForEachEntry invokes the given function for each key and value of the dictionary,
+// until the function returns false
+func (v *DictionaryValue) ForEachEntry(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	procedure FunctionValue,
+) {
+	dictionaryType := v.SemaType(interpreter)
+
+	argumentTypes := []sema.Type{
+		dictionaryType.KeyType,
+		dictionaryType.ValueType,
+	}
+
+	procedureFunctionType := procedure.FunctionType()
+	parameterTypes := procedureFunctionType.ParameterTypes()
+	returnType := procedureFunctionType.ReturnTypeAnnotation.Type
+
+	v.Iterate(
+		interpreter,
+		locationRange,
+		func(key, value Value) (resume bool) {
+
+			// Meter computation for iterating the dictionary.
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			// The function may mutate the key and value, so they must be transferred
+
+			key = key.Transfer(
+				interpreter,
+				locationRange,
+				atree.Address{},
+				false,
+				nil,
+				nil,
+				false, // key has a parent container because it is from iterator.
+			)
+
+			value = value.Transfer(
+				interpreter,
+				locationRange,
+				atree.Address{},
+				false,
+				nil,
+				nil,
+				false, // value has a parent container because it is from iterator.
+			)
+
+			result := interpreter.invokeFunctionValue(
+				procedure,
+				[]Value{key, value},
+				nil,
+				argumentTypes,
+				parameterTypes,
+				returnType,
+				nil,
+				locationRange,
+			)
+
+			shouldContinue, ok := result.(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return bool(shouldContinue)
+		},
+	)
+}
+
 func (v *DictionaryValue) ContainsKey(
 	interpreter *Interpreter,
 	locationRange LocationRange,
@@ -890,6 +1127,94 @@ func (v *DictionaryValue) GetMember(
 					funcArgument,
 				)
 
+				return Void
+			},
+		)
+
+	case sema.DictionaryTypeFilterFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.DictionaryFilterFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(v *DictionaryValue, invocation Invocation) Value {
+				predicate, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Filter(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					predicate,
+				)
+			},
+		)
+
+	case sema.DictionaryTypeMapFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.DictionaryMapFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(v *DictionaryValue, invocation Invocation) Value {
+				transform, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Map(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					transform,
+					true,
+				)
+			},
+		)
+
+	case sema.DictionaryTypeMapValuesFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.DictionaryMapValuesFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(v *DictionaryValue, invocation Invocation) Value {
+				transform, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Map(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					transform,
+					false,
+				)
+			},
+		)
+
+	case sema.DictionaryTypeForEachFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.DictionaryForEachFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(v *DictionaryValue, invocation Invocation) Value {
+				procedure, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				v.ForEachEntry(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					procedure,
+				)
+
 				return Void
 			},
 		)
@@ -0,0 +1,536 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/values"
+)
+
+// Fix128Value
+
+type Fix128Value struct {
+	values.Fix128Value
+}
+
+func NewFix128ValueWithInteger(gauge common.MemoryGauge, constructor func() *big.Int, locationRange LocationRange) Fix128Value {
+	fix128Value, err := values.NewFix128ValueWithInteger(gauge, func() (*big.Int, error) {
+		return constructor(), nil
+	})
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return Fix128Value{
+		Fix128Value: fix128Value,
+	}
+}
+
+func NewUnmeteredFix128ValueWithInteger(integer *big.Int, locationRange LocationRange) Fix128Value {
+	fix128Value, err := values.NewUnmeteredFix128ValueWithInteger(integer)
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return Fix128Value{
+		Fix128Value: fix128Value,
+	}
+}
+
+func NewFix128Value(gauge common.MemoryGauge, constructor func() *big.Int) Fix128Value {
+	fix128Value, err := values.NewFix128Value(gauge, func() (*big.Int, error) {
+		return constructor(), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return Fix128Value{
+		Fix128Value: fix128Value,
+	}
+}
+
+func NewUnmeteredFix128Value(value *big.Int) Fix128Value {
+	return Fix128Value{
+		Fix128Value: values.NewUnmeteredFix128Value(value),
+	}
+}
+
+// panicFixedPointRangeError panics with the interpreter error
+// corresponding to the given range error of the values package
+func panicFixedPointRangeError(err error, locationRange LocationRange) {
+	switch err.(type) {
+	case values.OverflowError:
+		panic(OverflowError{
+			LocationRange: locationRange,
+		})
+	case values.UnderflowError:
+		panic(UnderflowError{
+			LocationRange: locationRange,
+		})
+	default:
+		panic(err)
+	}
+}
+
+// fix128ScaleFactorBig is the factor between the scale of Fix64 / UFix64
+// and the scale of Fix128 / UFix128
+var fix128ScaleFactorBig = new(big.Int).Div(sema.Fix128FactorBig, sema.Fix64FactorBig)
+
+func ConvertFix128(memoryGauge common.MemoryGauge, value Value, locationRange LocationRange) Fix128Value {
+	switch value := value.(type) {
+	case Fix128Value:
+		return value
+
+	case UFix128Value:
+		if value.BigInt.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			panic(OverflowError{
+				LocationRange: locationRange,
+			})
+		}
+		return NewFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				return value.BigInt
+			},
+		)
+
+	case Fix64Value:
+		return NewFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				result := new(big.Int).SetInt64(int64(value))
+				return result.Mul(result, fix128ScaleFactorBig)
+			},
+		)
+
+	case UFix64Value:
+		return NewFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				result := new(big.Int).SetUint64(uint64(value.UFix64Value))
+				return result.Mul(result, fix128ScaleFactorBig)
+			},
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return value.ToBigInt(memoryGauge)
+			},
+			locationRange,
+		)
+
+	case NumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return big.NewInt(int64(value.ToInt(locationRange)))
+			},
+			locationRange,
+		)
+
+	default:
+		panic(fmt.Sprintf("can't convert to Fix128: %s", value))
+	}
+}
+
+var _ Value = Fix128Value{}
+var _ atree.Storable = Fix128Value{}
+var _ NumberValue = Fix128Value{}
+var _ FixedPointValue = Fix128Value{}
+var _ EquatableValue = Fix128Value{}
+var _ ComparableValue = Fix128Value{}
+var _ HashableValue = Fix128Value{}
+var _ MemberAccessibleValue = Fix128Value{}
+
+func (Fix128Value) isValue() {}
+
+func (v Fix128Value) Accept(interpreter *Interpreter, visitor Visitor, _ LocationRange) {
+	visitor.VisitFix128Value(interpreter, v)
+}
+
+func (Fix128Value) Walk(_ *Interpreter, _ func(Value), _ LocationRange) {
+	// NO-OP
+}
+
+func (Fix128Value) StaticType(context ValueStaticTypeContext) StaticType {
+	return NewPrimitiveStaticType(context, PrimitiveStaticTypeFix128)
+}
+
+func (Fix128Value) IsImportable(_ *Interpreter, _ LocationRange) bool {
+	return true
+}
+
+func (v Fix128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Fix128Value) MeteredString(interpreter *Interpreter, _ SeenReferences, _ LocationRange) string {
+	common.UseMemory(
+		interpreter,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(interpreter, v),
+		),
+	)
+	return v.String()
+}
+
+func (v Fix128Value) ToInt(locationRange LocationRange) int {
+	result, err := v.Fix128Value.ToInt()
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return result
+}
+
+func (v Fix128Value) Negate(context NumberValueArithmeticContext, locationRange LocationRange) NumberValue {
+	// INT32-C
+	if v.BigInt.Cmp(sema.Fix128TypeMinBig) == 0 {
+		panic(OverflowError{
+			LocationRange: locationRange,
+		})
+	}
+
+	return Fix128Value{
+		Fix128Value: v.Fix128Value.Negate(context),
+	}
+}
+
+func (v Fix128Value) Plus(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationPlus,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.Fix128Value.Plus(context, o.Fix128Value)
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return Fix128Value{Fix128Value: result}
+}
+
+func (v Fix128Value) SaturatingPlus(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.Fix128Value.SaturatingPlus(context, o.Fix128Value)
+	if err != nil {
+		panic(err)
+	}
+	return Fix128Value{Fix128Value: result}
+}
+
+func (v Fix128Value) Minus(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMinus,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.Fix128Value.Minus(context, o.Fix128Value)
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return Fix128Value{Fix128Value: result}
+}
+
+func (v Fix128Value) SaturatingMinus(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.Fix128Value.SaturatingMinus(context, o.Fix128Value)
+	if err != nil {
+		panic(err)
+	}
+	return Fix128Value{Fix128Value: result}
+}
+
+func (v Fix128Value) Mul(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMul,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.Fix128Value.Mul(context, o.Fix128Value)
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return Fix128Value{Fix128Value: result}
+}
+
+func (v Fix128Value) SaturatingMul(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.Fix128Value.SaturatingMul(context, o.Fix128Value)
+	if err != nil {
+		panic(err)
+	}
+	return Fix128Value{Fix128Value: result}
+}
+
+func (v Fix128Value) Div(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationDiv,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.Fix128Value.Div(context, o.Fix128Value)
+	if err != nil {
+		panicFixedPointArithmeticError(err, locationRange)
+	}
+	return Fix128Value{Fix128Value: result}
+}
+
+func (v Fix128Value) SaturatingDiv(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.Fix128Value.SaturatingDiv(context, o.Fix128Value)
+	if err != nil {
+		panicFixedPointArithmeticError(err, locationRange)
+	}
+	return Fix128Value{Fix128Value: result}
+}
+
+func (v Fix128Value) Mod(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMod,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.Fix128Value.Mod(context, o.Fix128Value)
+	if err != nil {
+		panicFixedPointArithmeticError(err, locationRange)
+	}
+	return Fix128Value{Fix128Value: result}
+}
+
+// panicFixedPointArithmeticError panics with the interpreter error
+// corresponding to the given arithmetic error of the values package
+func panicFixedPointArithmeticError(err error, locationRange LocationRange) {
+	if _, ok := err.(values.DivisionByZeroError); ok {
+		panic(DivisionByZeroError{
+			LocationRange: locationRange,
+		})
+	}
+	panicFixedPointRangeError(err, locationRange)
+}
+
+func (v Fix128Value) Less(context ValueComparisonContext, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLess,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	return BoolValue(v.Fix128Value.Less(o.Fix128Value))
+}
+
+func (v Fix128Value) LessEqual(context ValueComparisonContext, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLessEqual,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	return BoolValue(v.Fix128Value.LessEqual(o.Fix128Value))
+}
+
+func (v Fix128Value) Greater(context ValueComparisonContext, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreater,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	return BoolValue(v.Fix128Value.Greater(o.Fix128Value))
+}
+
+func (v Fix128Value) GreaterEqual(context ValueComparisonContext, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreaterEqual,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	return BoolValue(v.Fix128Value.GreaterEqual(o.Fix128Value))
+}
+
+func (v Fix128Value) Equal(_ ValueComparisonContext, _ LocationRange, other Value) bool {
+	otherFix128, ok := other.(Fix128Value)
+	if !ok {
+		return false
+	}
+
+	return v.Fix128Value.Equal(otherFix128.Fix128Value)
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v Fix128Value) HashInput(_ common.MemoryGauge, _ LocationRange, scratch []byte) []byte {
+	b := values.SignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeFix128)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func (v Fix128Value) GetMember(interpreter *Interpreter, locationRange LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.Fix128Type, locationRange)
+}
+
+func (Fix128Value) RemoveMember(_ *Interpreter, _ LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (Fix128Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) bool {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v Fix128Value) ConformsToStaticType(
+	_ *Interpreter,
+	_ LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (Fix128Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (Fix128Value) IsResourceKinded(_ ValueStaticTypeContext) bool {
+	return false
+}
+
+func (v Fix128Value) Transfer(
+	interpreter *Interpreter,
+	_ LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+	_ map[atree.ValueID]struct{},
+	_ bool,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v Fix128Value) Clone(_ *Interpreter) Value {
+	return NewUnmeteredFix128Value(v.BigInt)
+}
+
+func (Fix128Value) DeepRemove(_ *Interpreter, _ bool) {
+	// NO-OP
+}
+
+func (v Fix128Value) IntegerPart() NumberValue {
+	return NewUnmeteredInt128ValueFromBigInt(v.Fix128Value.IntegerPart())
+}
@@ -492,6 +492,22 @@ func ConvertFix64(memoryGauge common.MemoryGauge, value Value, locationRange Loc
 			},
 		)
 
+	case Fix128Value:
+		return NewFix64Value(
+			memoryGauge,
+			func() int64 {
+				return convertFix128ToFix64(value.BigInt, locationRange)
+			},
+		)
+
+	case UFix128Value:
+		return NewFix64Value(
+			memoryGauge,
+			func() int64 {
+				return convertFix128ToFix64(value.BigInt, locationRange)
+			},
+		)
+
 	case BigNumberValue:
 		converter := func() int64 {
 			v := value.ToBigInt(memoryGauge)
@@ -527,6 +543,24 @@ func ConvertFix64(memoryGauge common.MemoryGauge, value Value, locationRange Loc
 	}
 }
 
+// convertFix128ToFix64 converts the given Fix128 or UFix128 value
+// to the scale of Fix64, truncating excess fractional digits
+func convertFix128ToFix64(value *big.Int, locationRange LocationRange) int64 {
+	result := new(big.Int).Quo(value, fix128ScaleFactorBig)
+
+	if result.Cmp(minInt64Big) < 0 {
+		panic(UnderflowError{
+			LocationRange: locationRange,
+		})
+	} else if result.Cmp(maxInt64Big) > 0 {
+		panic(OverflowError{
+			LocationRange: locationRange,
+		})
+	}
+
+	return result.Int64()
+}
+
 func (v Fix64Value) GetMember(interpreter *Interpreter, locationRange LocationRange, name string) Value {
 	return getNumberValueMember(interpreter, v, name, sema.Fix64Type, locationRange)
 }
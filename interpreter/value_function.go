@@ -48,10 +48,17 @@ type InterpretedFunctionValue struct {
 	PreConditions    []ast.Condition
 	Statements       []ast.Statement
 	PostConditions   []ast.Condition
+	// Name is the name of the declared function.
+	// It is empty for function expressions
+	Name string
+	// TypeArguments are the type arguments of the generic functions
+	// the function is nested in, if any
+	TypeArguments *sema.TypeParameterTypeOrderedMap
 }
 
 func NewInterpretedFunctionValue(
 	interpreter *Interpreter,
+	name string,
 	parameterList *ast.ParameterList,
 	functionType *sema.FunctionType,
 	lexicalScope *VariableActivation,
@@ -65,6 +72,7 @@ func NewInterpretedFunctionValue(
 
 	return &InterpretedFunctionValue{
 		Interpreter:      interpreter,
+		Name:             name,
 		ParameterList:    parameterList,
 		Type:             functionType,
 		Activation:       lexicalScope,
@@ -72,6 +80,8 @@ func NewInterpretedFunctionValue(
 		PreConditions:    preConditions,
 		Statements:       statements,
 		PostConditions:   postConditions,
+		// Functions declared in generic functions capture the type arguments
+		TypeArguments: interpreter.SharedState.currentTypeArguments,
 	}
 }
 
@@ -122,6 +132,13 @@ func (f *InterpretedFunctionValue) invoke(invocation Invocation) Value {
 	// The check that arguments' dynamic types match the parameter types
 	// was already performed by the interpreter's checkValueTransferTargetType function
 
+	invocation = f.Interpreter.withDefaultArguments(
+		f.ParameterList,
+		f.Type,
+		f.Activation,
+		invocation,
+	)
+
 	return f.Interpreter.invokeInterpretedFunction(f, invocation)
 }
 
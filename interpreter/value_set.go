@@ -0,0 +1,945 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	goerrors "errors"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/format"
+	"github.com/onflow/cadence/sema"
+)
+
+// SetValue is a set of hashable values.
+//
+// Sets are backed by an atree.OrderedMap,
+// the elements are the keys of the map,
+// and the values of the map are always Void.
+
+type SetValue struct {
+	Type        *SetStaticType
+	semaType    *sema.SetType
+	set         *atree.OrderedMap
+	elementSize uint
+}
+
+func NewSetValue(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	setType *SetStaticType,
+	elements ...Value,
+) *SetValue {
+	return NewSetValueWithAddress(
+		interpreter,
+		locationRange,
+		setType,
+		common.ZeroAddress,
+		elements...,
+	)
+}
+
+func NewSetValueWithAddress(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	setType *SetStaticType,
+	address common.Address,
+	elements ...Value,
+) *SetValue {
+
+	interpreter.ReportComputation(common.ComputationKindCreateSetValue, 1)
+
+	config := interpreter.SharedState.Config
+
+	constructor := func() *atree.OrderedMap {
+		set, err := atree.NewMap(
+			config.Storage,
+			atree.Address(address),
+			atree.NewDefaultDigesterBuilder(),
+			setType,
+		)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		return set
+	}
+
+	// elements are added to the set after creation, not here
+	v := newSetValueFromConstructor(interpreter, setType, 0, constructor)
+
+	for _, element := range elements {
+		v.Insert(interpreter, locationRange, element)
+	}
+
+	return v
+}
+
+func SetElementSize(staticType *SetStaticType) uint {
+	return staticType.ElementType.elementSize()
+}
+
+func newSetValueFromConstructor(
+	gauge common.MemoryGauge,
+	staticType *SetStaticType,
+	count uint64,
+	constructor func() *atree.OrderedMap,
+) *SetValue {
+
+	elementSize := SetElementSize(staticType)
+
+	overheadUsage, dataSlabs, metaDataSlabs :=
+		common.NewAtreeMapMemoryUsages(count, elementSize)
+	common.UseMemory(gauge, overheadUsage)
+	common.UseMemory(gauge, dataSlabs)
+	common.UseMemory(gauge, metaDataSlabs)
+
+	return newSetValueFromAtreeMap(
+		gauge,
+		staticType,
+		elementSize,
+		constructor(),
+	)
+}
+
+func newSetValueFromAtreeMap(
+	gauge common.MemoryGauge,
+	staticType *SetStaticType,
+	elementSize uint,
+	atreeOrderedMap *atree.OrderedMap,
+) *SetValue {
+
+	common.UseMemory(gauge, common.SetValueBaseMemoryUsage)
+
+	return &SetValue{
+		Type:        staticType,
+		set:         atreeOrderedMap,
+		elementSize: elementSize,
+	}
+}
+
+var _ Value = &SetValue{}
+var _ atree.Value = &SetValue{}
+var _ atree.WrapperValue = &SetValue{}
+var _ EquatableValue = &SetValue{}
+var _ MemberAccessibleValue = &SetValue{}
+var _ atreeContainerBackedValue = &SetValue{}
+
+func (*SetValue) isValue() {}
+
+func (*SetValue) isAtreeContainerBackedValue() {}
+
+func (v *SetValue) Accept(interpreter *Interpreter, visitor Visitor, locationRange LocationRange) {
+	descend := visitor.VisitSetValue(interpreter, v)
+	if !descend {
+		return
+	}
+
+	v.Walk(
+		interpreter,
+		func(value Value) {
+			value.Accept(interpreter, visitor, locationRange)
+		},
+		locationRange,
+	)
+}
+
+// Iterate iterates over all elements of the set.
+// The set must not be mutated in the callback.
+func (v *SetValue) Iterate(
+	interpreter *Interpreter,
+	f func(element Value) (resume bool),
+) {
+	iterate := func() {
+		err := v.set.IterateReadOnlyKeys(
+			func(key atree.Value) (resume bool, err error) {
+				// atree.OrderedMap iteration provides low-level atree.Value,
+				// convert to high-level interpreter.Value
+
+				resume = f(
+					MustConvertStoredValue(interpreter, key),
+				)
+
+				return resume, nil
+			},
+		)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+	}
+
+	interpreter.withMutationPrevention(v.ValueID(), iterate)
+}
+
+func (v *SetValue) Walk(interpreter *Interpreter, walkChild func(Value), _ LocationRange) {
+	v.Iterate(
+		interpreter,
+		func(element Value) (resume bool) {
+			walkChild(element)
+			return true
+		},
+	)
+}
+
+func (v *SetValue) StaticType(_ ValueStaticTypeContext) StaticType {
+	// TODO meter
+	return v.Type
+}
+
+func (v *SetValue) IsImportable(inter *Interpreter, locationRange LocationRange) bool {
+	importable := true
+	v.Iterate(
+		inter,
+		func(element Value) (resume bool) {
+			if !element.IsImportable(inter, locationRange) {
+				importable = false
+				// stop iteration
+				return false
+			}
+
+			// continue iteration
+			return true
+		},
+	)
+
+	return importable
+}
+
+func (v *SetValue) ForEach(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	procedure FunctionValue,
+) {
+	elementType := v.SemaType(interpreter).ElementType
+
+	argumentTypes := []sema.Type{elementType}
+
+	procedureFunctionType := procedure.FunctionType()
+	parameterTypes := procedureFunctionType.ParameterTypes()
+	returnType := procedureFunctionType.ReturnTypeAnnotation.Type
+
+	v.Iterate(
+		interpreter,
+		func(element Value) (resume bool) {
+			result := interpreter.invokeFunctionValue(
+				procedure,
+				[]Value{element},
+				nil,
+				argumentTypes,
+				parameterTypes,
+				returnType,
+				nil,
+				locationRange,
+			)
+
+			shouldContinue, ok := result.(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return bool(shouldContinue)
+		},
+	)
+}
+
+func (v *SetValue) Contains(
+	context ValueComparisonContext,
+	locationRange LocationRange,
+	element Value,
+) BoolValue {
+
+	valueComparator := newValueComparator(context, locationRange)
+	hashInputProvider := newHashInputProvider(context, locationRange)
+
+	exists, err := v.set.Has(
+		valueComparator,
+		hashInputProvider,
+		element,
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	return BoolValue(exists)
+}
+
+// Insert inserts the given element into the set.
+// It returns true if the element was inserted,
+// and false if the set already contained the element.
+func (v *SetValue) Insert(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	element Value,
+) BoolValue {
+
+	interpreter.validateMutation(v.ValueID(), locationRange)
+
+	// Check if the set already contains the element before transferring it,
+	// so no transferred element needs to be removed again
+
+	if v.Contains(interpreter, locationRange, element) {
+		return FalseValue
+	}
+
+	address := v.set.Address()
+
+	preventTransfer := map[atree.ValueID]struct{}{
+		v.ValueID(): {},
+	}
+
+	element = element.Transfer(
+		interpreter,
+		locationRange,
+		address,
+		true,
+		nil,
+		preventTransfer,
+		true, // element is standalone before it is inserted into parent container.
+	)
+
+	interpreter.checkContainerMutation(v.Type.ElementType, element, locationRange)
+
+	// length increases by 1
+	dataSlabs, metaDataSlabs := common.AdditionalAtreeMemoryUsage(v.set.Count(), v.elementSize, false)
+	common.UseMemory(interpreter, common.AtreeMapElementOverhead)
+	common.UseMemory(interpreter, dataSlabs)
+	common.UseMemory(interpreter, metaDataSlabs)
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	existingValueStorable, err := v.set.Set(
+		valueComparator,
+		hashInputProvider,
+		element,
+		Void,
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	// The set was checked to not contain the element
+	if existingValueStorable != nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	interpreter.maybeValidateAtreeValue(v.set)
+	interpreter.maybeValidateAtreeStorage()
+
+	return TrueValue
+}
+
+// Remove removes the given element from the set.
+// It returns true if the element was removed,
+// and false if the set did not contain the element.
+func (v *SetValue) Remove(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	element Value,
+) BoolValue {
+
+	interpreter.validateMutation(v.ValueID(), locationRange)
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	// No need to clean up storable for passed-in element,
+	// as atree never calls Storable()
+	existingElementStorable, existingValueStorable, err := v.set.Remove(
+		valueComparator,
+		hashInputProvider,
+		element,
+	)
+	if err != nil {
+		var keyNotFoundError *atree.KeyNotFoundError
+		if goerrors.As(err, &keyNotFoundError) {
+			return FalseValue
+		}
+		panic(errors.NewExternalError(err))
+	}
+
+	interpreter.maybeValidateAtreeValue(v.set)
+	interpreter.maybeValidateAtreeStorage()
+
+	storage := interpreter.Storage()
+
+	existingElement := StoredValue(interpreter, existingElementStorable, storage)
+	existingElement.DeepRemove(interpreter, true) // existingElement is standalone because it was removed from parent container.
+	interpreter.RemoveReferencedSlab(existingElementStorable)
+
+	interpreter.RemoveReferencedSlab(existingValueStorable)
+
+	return TrueValue
+}
+
+// Union returns a new set with the elements of this set and the given set
+func (v *SetValue) Union(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	other *SetValue,
+) *SetValue {
+
+	result := NewSetValue(interpreter, locationRange, v.Type)
+
+	insert := func(element Value) (resume bool) {
+		result.Insert(interpreter, locationRange, element)
+		return true
+	}
+
+	v.Iterate(interpreter, insert)
+	other.Iterate(interpreter, insert)
+
+	return result
+}
+
+// Intersection returns a new set with the elements of this set
+// which are also elements of the given set
+func (v *SetValue) Intersection(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	other *SetValue,
+) *SetValue {
+
+	result := NewSetValue(interpreter, locationRange, v.Type)
+
+	v.Iterate(
+		interpreter,
+		func(element Value) (resume bool) {
+			if other.Contains(interpreter, locationRange, element) {
+				result.Insert(interpreter, locationRange, element)
+			}
+			return true
+		},
+	)
+
+	return result
+}
+
+// Difference returns a new set with the elements of this set
+// which are not elements of the given set
+func (v *SetValue) Difference(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	other *SetValue,
+) *SetValue {
+
+	result := NewSetValue(interpreter, locationRange, v.Type)
+
+	v.Iterate(
+		interpreter,
+		func(element Value) (resume bool) {
+			if !other.Contains(interpreter, locationRange, element) {
+				result.Insert(interpreter, locationRange, element)
+			}
+			return true
+		},
+	)
+
+	return result
+}
+
+func (v *SetValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
+
+func (v *SetValue) RecursiveString(seenReferences SeenReferences) string {
+	return v.MeteredString(nil, seenReferences, EmptyLocationRange)
+}
+
+func (v *SetValue) MeteredString(interpreter *Interpreter, seenReferences SeenReferences, locationRange LocationRange) string {
+
+	elements := make([]string, 0, v.Count())
+
+	v.Iterate(
+		interpreter,
+		func(element Value) (resume bool) {
+			elements = append(
+				elements,
+				element.MeteredString(interpreter, seenReferences, locationRange),
+			)
+			return true
+		},
+	)
+
+	// len = len("Set(") + len("[") + len("]") + len(")") + ((n-1) times comma+space)
+	//     = 6 + 2n - 2
+	//
+	// Since (-2) only occurs if its non-empty (i.e: n>0), ignore the (-2). i.e: overestimate
+	//    len = 2n + 6
+	//
+	// String of each element is metered separately.
+	strLen := len(elements)*2 + 6
+
+	common.UseMemory(interpreter, common.NewRawStringMemoryUsage(strLen))
+
+	return format.Set(elements)
+}
+
+func (v *SetValue) GetMember(
+	interpreter *Interpreter,
+	_ LocationRange,
+	name string,
+) Value {
+
+	switch name {
+	case sema.SetTypeLengthFieldName:
+		return NewIntValueFromInt64(interpreter, int64(v.Count()))
+
+	case sema.SetTypeContainsFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.SetContainsFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(v *SetValue, invocation Invocation) Value {
+				return v.Contains(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					invocation.Arguments[0],
+				)
+			},
+		)
+
+	case sema.SetTypeInsertFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.SetInsertFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(v *SetValue, invocation Invocation) Value {
+				return v.Insert(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					invocation.Arguments[0],
+				)
+			},
+		)
+
+	case sema.SetTypeRemoveFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.SetRemoveFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(v *SetValue, invocation Invocation) Value {
+				return v.Remove(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					invocation.Arguments[0],
+				)
+			},
+		)
+
+	case sema.SetTypeUnionFunctionName:
+		return v.newOperationFunction(interpreter, (*SetValue).Union)
+
+	case sema.SetTypeIntersectionFunctionName:
+		return v.newOperationFunction(interpreter, (*SetValue).Intersection)
+
+	case sema.SetTypeDifferenceFunctionName:
+		return v.newOperationFunction(interpreter, (*SetValue).Difference)
+
+	case sema.SetTypeForEachFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.SetForEachFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(v *SetValue, invocation Invocation) Value {
+				funcArgument, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				v.ForEach(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					funcArgument,
+				)
+
+				return Void
+			},
+		)
+	}
+
+	return nil
+}
+
+func (v *SetValue) newOperationFunction(
+	interpreter *Interpreter,
+	operation func(v *SetValue, interpreter *Interpreter, locationRange LocationRange, other *SetValue) *SetValue,
+) BoundFunctionValue {
+	return NewBoundHostFunctionValue(
+		interpreter,
+		v,
+		sema.SetOperationFunctionType(
+			v.SemaType(interpreter),
+		),
+		func(v *SetValue, invocation Invocation) Value {
+			other, ok := invocation.Arguments[0].(*SetValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return operation(
+				v,
+				invocation.Interpreter,
+				invocation.LocationRange,
+				other,
+			)
+		},
+	)
+}
+
+func (v *SetValue) RemoveMember(_ *Interpreter, _ LocationRange, _ string) Value {
+	// Sets have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v *SetValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) bool {
+	// Sets have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v *SetValue) Count() int {
+	return int(v.set.Count())
+}
+
+func (v *SetValue) ConformsToStaticType(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	results TypeConformanceResults,
+) bool {
+
+	elementType := v.Type.ElementType
+
+	conforms := true
+
+	v.Iterate(
+		interpreter,
+		func(element Value) (resume bool) {
+			if !interpreter.IsSubType(element.StaticType(interpreter), elementType) ||
+				!element.ConformsToStaticType(interpreter, locationRange, results) {
+
+				conforms = false
+				// stop iteration
+				return false
+			}
+
+			// continue iteration
+			return true
+		},
+	)
+
+	return conforms
+}
+
+func (v *SetValue) Equal(context ValueComparisonContext, locationRange LocationRange, other Value) bool {
+
+	otherSet, ok := other.(*SetValue)
+	if !ok {
+		return false
+	}
+
+	if v.Count() != otherSet.Count() {
+		return false
+	}
+
+	if !v.Type.Equal(otherSet.Type) {
+		return false
+	}
+
+	iterator, err := v.set.ReadOnlyIterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	for {
+		element, err := iterator.NextKey()
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		if element == nil {
+			return true
+		}
+
+		// Do NOT use an iterator, as other value may be stored in another account,
+		// leading to a different iteration order, as the storage ID is used in the seed
+		if !otherSet.Contains(
+			context,
+			locationRange,
+			MustConvertStoredValue(context, element),
+		) {
+			return false
+		}
+	}
+}
+
+func (v *SetValue) Storable(
+	storage atree.SlabStorage,
+	address atree.Address,
+	maxInlineSize uint64,
+) (atree.Storable, error) {
+	// NOTE: Need to change SetValue.UnwrapAtreeValue()
+	// if SetValue is stored with wrapping.
+	return v.set.Storable(storage, address, maxInlineSize)
+}
+
+func (v *SetValue) UnwrapAtreeValue() (atree.Value, uint64) {
+	// Wrapper size is 0 because SetValue is stored as
+	// atree.OrderedMap without any physical wrapping (see SetValue.Storable()).
+	return v.set, 0
+}
+
+func (*SetValue) IsResourceKinded(_ ValueStaticTypeContext) bool {
+	return false
+}
+
+func (v *SetValue) NeedsStoreTo(address atree.Address) bool {
+	return address != v.StorageAddress()
+}
+
+func (v *SetValue) Transfer(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	address atree.Address,
+	remove bool,
+	storable atree.Storable,
+	preventTransfer map[atree.ValueID]struct{},
+	hasNoParentContainer bool,
+) Value {
+
+	config := interpreter.SharedState.Config
+
+	interpreter.ReportComputation(
+		common.ComputationKindTransferSetValue,
+		uint(v.Count()),
+	)
+
+	currentValueID := v.ValueID()
+
+	if preventTransfer == nil {
+		preventTransfer = map[atree.ValueID]struct{}{}
+	} else if _, ok := preventTransfer[currentValueID]; ok {
+		panic(RecursiveTransferError{
+			LocationRange: locationRange,
+		})
+	}
+	preventTransfer[currentValueID] = struct{}{}
+	defer delete(preventTransfer, currentValueID)
+
+	// Sets are never resource-kinded, so they are always copied
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	// Use non-readonly iterator here because iterated
+	// value can be removed if remove parameter is true.
+	iterator, err := v.set.Iterator(valueComparator, hashInputProvider)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	elementCount := v.set.Count()
+
+	elementOverhead, dataUse, metaDataUse := common.NewAtreeMapMemoryUsages(
+		elementCount,
+		v.elementSize,
+	)
+	common.UseMemory(interpreter, elementOverhead)
+	common.UseMemory(interpreter, dataUse)
+	common.UseMemory(interpreter, metaDataUse)
+
+	elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(
+		elementCount,
+		v.elementSize,
+	)
+	common.UseMemory(config.MemoryGauge, elementMemoryUse)
+
+	set, err := atree.NewMapFromBatchData(
+		config.Storage,
+		address,
+		atree.NewDefaultDigesterBuilder(),
+		v.set.Type(),
+		valueComparator,
+		hashInputProvider,
+		v.set.Seed(),
+		func() (atree.Value, atree.Value, error) {
+
+			atreeElement, atreeValue, err := iterator.Next()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeElement == nil || atreeValue == nil {
+				return nil, nil, nil
+			}
+
+			element := MustConvertStoredValue(interpreter, atreeElement).
+				Transfer(
+					interpreter,
+					locationRange,
+					address,
+					remove,
+					nil,
+					preventTransfer,
+					false, // atreeElement has parent container because it is returned from iterator.
+				)
+
+			return element, Void, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	if remove {
+		err = v.set.PopIterate(func(elementStorable atree.Storable, valueStorable atree.Storable) {
+			interpreter.RemoveReferencedSlab(elementStorable)
+			interpreter.RemoveReferencedSlab(valueStorable)
+		})
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+
+		interpreter.maybeValidateAtreeValue(v.set)
+		if hasNoParentContainer {
+			interpreter.maybeValidateAtreeStorage()
+		}
+
+		interpreter.RemoveReferencedSlab(storable)
+	}
+
+	res := newSetValueFromAtreeMap(
+		interpreter,
+		v.Type,
+		v.elementSize,
+		set,
+	)
+
+	res.semaType = v.semaType
+
+	return res
+}
+
+func (v *SetValue) Clone(interpreter *Interpreter) Value {
+	config := interpreter.SharedState.Config
+
+	valueComparator := newValueComparator(interpreter, EmptyLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, EmptyLocationRange)
+
+	iterator, err := v.set.ReadOnlyIterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	orderedMap, err := atree.NewMapFromBatchData(
+		config.Storage,
+		v.StorageAddress(),
+		atree.NewDefaultDigesterBuilder(),
+		v.set.Type(),
+		valueComparator,
+		hashInputProvider,
+		v.set.Seed(),
+		func() (atree.Value, atree.Value, error) {
+
+			atreeElement, atreeValue, err := iterator.Next()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeElement == nil || atreeValue == nil {
+				return nil, nil, nil
+			}
+
+			element := MustConvertStoredValue(interpreter, atreeElement).
+				Clone(interpreter)
+
+			return element, Void, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	set := newSetValueFromAtreeMap(
+		interpreter,
+		v.Type,
+		v.elementSize,
+		orderedMap,
+	)
+
+	set.semaType = v.semaType
+
+	return set
+}
+
+func (v *SetValue) DeepRemove(interpreter *Interpreter, hasNoParentContainer bool) {
+
+	// Remove nested values and storables
+
+	storage := v.set.Storage
+
+	err := v.set.PopIterate(func(elementStorable atree.Storable, valueStorable atree.Storable) {
+
+		element := StoredValue(interpreter, elementStorable, storage)
+		element.DeepRemove(interpreter, false) // element is an element of v.set because it is from PopIterate() callback.
+		interpreter.RemoveReferencedSlab(elementStorable)
+
+		interpreter.RemoveReferencedSlab(valueStorable)
+	})
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	interpreter.maybeValidateAtreeValue(v.set)
+	if hasNoParentContainer {
+		interpreter.maybeValidateAtreeStorage()
+	}
+}
+
+func (v *SetValue) GetOwner() common.Address {
+	return common.Address(v.StorageAddress())
+}
+
+func (v *SetValue) SlabID() atree.SlabID {
+	return v.set.SlabID()
+}
+
+func (v *SetValue) StorageAddress() atree.Address {
+	return v.set.Address()
+}
+
+func (v *SetValue) ValueID() atree.ValueID {
+	return v.set.ValueID()
+}
+
+func (v *SetValue) SemaType(typeConverter TypeConverter) *sema.SetType {
+	if v.semaType == nil {
+		// this function will panic already if this conversion fails
+		v.semaType, _ = MustConvertStaticToSemaType(v.Type, typeConverter).(*sema.SetType)
+	}
+	return v.semaType
+}
+
+func (v *SetValue) Inlined() bool {
+	return v.set.Inlined()
+}
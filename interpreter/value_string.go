@@ -96,6 +96,8 @@ var _ IterableValue = &StringValue{}
 
 var VarSizedArrayOfStringType = NewVariableSizedStaticType(nil, PrimitiveStaticTypeString)
 
+var VarSizedArrayOfCharacterType = NewVariableSizedStaticType(nil, PrimitiveStaticTypeCharacter)
+
 func (v *StringValue) prepareGraphemes() {
 	// If the string is empty, methods of StringValue should never call prepareGraphemes,
 	// as it is not only unnecessary, but also means that the value is the empty string singleton EmptyString,
@@ -363,6 +365,9 @@ func (v *StringValue) GetMember(interpreter *Interpreter, locationRange Location
 	case sema.StringTypeUtf8FieldName:
 		return ByteSliceToByteArrayValue(interpreter, []byte(v.Str))
 
+	case sema.StringTypeCharactersFieldName:
+		return v.Characters(interpreter, locationRange)
+
 	case sema.StringTypeConcatFunctionName:
 		return NewBoundHostFunctionValue(
 			interpreter,
@@ -470,6 +475,131 @@ func (v *StringValue) GetMember(interpreter *Interpreter, locationRange Location
 			},
 		)
 
+	case sema.StringTypeToUpperFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.StringTypeToUpperFunctionType,
+			func(v *StringValue, invocation Invocation) Value {
+				return v.ToUpper(invocation.Interpreter)
+			},
+		)
+
+	case sema.StringTypeTrimFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.StringTypeTrimFunctionType,
+			func(v *StringValue, invocation Invocation) Value {
+				return v.Trim(invocation.Interpreter)
+			},
+		)
+
+	case sema.StringTypeTrimPrefixFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.StringTypeTrimPrefixFunctionType,
+			func(v *StringValue, invocation Invocation) Value {
+				prefix, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.TrimPrefix(invocation.Interpreter, prefix)
+			},
+		)
+
+	case sema.StringTypeTrimSuffixFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.StringTypeTrimSuffixFunctionType,
+			func(v *StringValue, invocation Invocation) Value {
+				suffix, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.TrimSuffix(invocation.Interpreter, suffix)
+			},
+		)
+
+	case sema.StringTypeStartsWithFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.StringTypeStartsWithFunctionType,
+			func(v *StringValue, invocation Invocation) Value {
+				prefix, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.StartsWith(invocation.Interpreter, prefix)
+			},
+		)
+
+	case sema.StringTypeEndsWithFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.StringTypeEndsWithFunctionType,
+			func(v *StringValue, invocation Invocation) Value {
+				suffix, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.EndsWith(invocation.Interpreter, suffix)
+			},
+		)
+
+	case sema.StringTypePadLeftFunctionName,
+		sema.StringTypePadRightFunctionName:
+
+		padLeft := name == sema.StringTypePadLeftFunctionName
+
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.StringTypePadFunctionType,
+			func(v *StringValue, invocation Invocation) Value {
+				length, ok := invocation.Arguments[0].(IntValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				character, ok := invocation.Arguments[1].(CharacterValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Pad(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					length,
+					character,
+					padLeft,
+				)
+			},
+		)
+
+	case sema.StringTypeLastIndexFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.StringTypeLastIndexFunctionType,
+			func(v *StringValue, invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.LastIndexOf(invocation.Interpreter, other)
+			},
+		)
+
 	case sema.StringTypeSplitFunctionName:
 		return NewBoundHostFunctionValue(
 			interpreter,
@@ -578,6 +708,253 @@ func (v *StringValue) ToLower(interpreter *Interpreter) *StringValue {
 	)
 }
 
+func (v *StringValue) ToUpper(interpreter *Interpreter) *StringValue {
+
+	// Meter computation as if the string was iterated.
+	interpreter.ReportComputation(common.ComputationKindLoop, uint(len(v.Str)))
+
+	// Over-estimate resulting string length,
+	// as a lowercase character may be converted to an uppercase character
+	// which has a longer UTF-8 encoding, e.g. ɐ => Ɐ
+
+	var lengthEstimate int
+	for _, r := range v.Str {
+		if r < unicode.MaxASCII {
+			lengthEstimate += 1
+		} else {
+			lengthEstimate += utf8.UTFMax
+		}
+	}
+
+	memoryUsage := common.NewStringMemoryUsage(lengthEstimate)
+
+	return NewStringValue(
+		interpreter,
+		memoryUsage,
+		func() string {
+			return strings.ToUpper(v.Str)
+		},
+	)
+}
+
+// Trim returns the string without leading and trailing characters (grapheme clusters)
+// which only consist of whitespace
+func (v *StringValue) Trim(interpreter *Interpreter) *StringValue {
+
+	// If the string is empty, exit early.
+	//
+	// That ensures that if the value is the empty string singleton EmptyString,
+	// which should not be mutated because it may be used from different goroutines,
+	// it does not get mutated by preparing the graphemes iterator.
+	if len(v.Str) == 0 {
+		return v
+	}
+
+	// Meter computation as if the string was iterated.
+	interpreter.ReportComputation(common.ComputationKindLoop, uint(len(v.Str)))
+
+	v.prepareGraphemes()
+
+	start := -1
+	end := 0
+
+	for v.graphemes.Next() {
+		if isWhitespace(v.graphemes.Str()) {
+			continue
+		}
+
+		boundaryStart, boundaryEnd := v.graphemes.Positions()
+		if start < 0 {
+			start = boundaryStart
+		}
+		end = boundaryEnd
+	}
+
+	// The string only consists of whitespace
+	if start < 0 {
+		return EmptyString
+	}
+
+	if start == 0 && end == len(v.Str) {
+		return v
+	}
+
+	return v.substring(interpreter, start, end)
+}
+
+func isWhitespace(s string) bool {
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// substring returns a new string for the given byte offsets,
+// which must be at grapheme cluster boundaries
+func (v *StringValue) substring(interpreter *Interpreter, start, end int) *StringValue {
+	if start == end {
+		return EmptyString
+	}
+
+	str := v.Str[start:end]
+
+	return NewStringValue(
+		interpreter,
+		common.NewStringMemoryUsage(len(str)),
+		func() string {
+			return str
+		},
+	)
+}
+
+func (v *StringValue) hasPrefix(interpreter *Interpreter, prefix *StringValue) bool {
+	if len(prefix.Str) == 0 {
+		return true
+	}
+
+	if !strings.HasPrefix(v.Str, prefix.Str) {
+		return false
+	}
+
+	// Meter computation as if the string was iterated.
+	interpreter.ReportComputation(common.ComputationKindLoop, uint(len(prefix.Str)))
+
+	// The prefix must end at a grapheme boundary,
+	// e.g. "e" is not a prefix of "e\u{301}"
+
+	return v.IsGraphemeBoundaryEnd(len(prefix.Str))
+}
+
+func (v *StringValue) hasSuffix(interpreter *Interpreter, suffix *StringValue) bool {
+	if len(suffix.Str) == 0 {
+		return true
+	}
+
+	if !strings.HasSuffix(v.Str, suffix.Str) {
+		return false
+	}
+
+	// Meter computation as if the string was iterated.
+	interpreter.ReportComputation(common.ComputationKindLoop, uint(len(v.Str)))
+
+	// The suffix must start at a grapheme boundary,
+	// e.g. "\u{1F1F8}" is not a suffix of "\u{1F1FA}\u{1F1F8}"
+
+	return v.IsGraphemeBoundaryStart(len(v.Str) - len(suffix.Str))
+}
+
+func (v *StringValue) StartsWith(interpreter *Interpreter, prefix *StringValue) BoolValue {
+	return BoolValue(v.hasPrefix(interpreter, prefix))
+}
+
+func (v *StringValue) EndsWith(interpreter *Interpreter, suffix *StringValue) BoolValue {
+	return BoolValue(v.hasSuffix(interpreter, suffix))
+}
+
+func (v *StringValue) TrimPrefix(interpreter *Interpreter, prefix *StringValue) *StringValue {
+	if len(prefix.Str) == 0 || !v.hasPrefix(interpreter, prefix) {
+		return v
+	}
+
+	return v.substring(interpreter, len(prefix.Str), len(v.Str))
+}
+
+func (v *StringValue) TrimSuffix(interpreter *Interpreter, suffix *StringValue) *StringValue {
+	if len(suffix.Str) == 0 || !v.hasSuffix(interpreter, suffix) {
+		return v
+	}
+
+	return v.substring(interpreter, 0, len(v.Str)-len(suffix.Str))
+}
+
+// Pad returns the string padded with the given character to the given length (number of characters).
+// If padLeft is true, the padding is added at the start of the string, otherwise at the end
+func (v *StringValue) Pad(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	length IntValue,
+	character CharacterValue,
+	padLeft bool,
+) *StringValue {
+
+	targetLength := length.ToInt(locationRange)
+
+	padCount := targetLength - v.Length()
+	if padCount <= 0 {
+		return v
+	}
+
+	padding := character.Str
+
+	newLength := safeAdd(
+		len(v.Str),
+		safeMul(padCount, len(padding), locationRange),
+		locationRange,
+	)
+
+	memoryUsage := common.NewStringMemoryUsage(newLength)
+
+	// Meter computation as if the resulting string was iterated.
+	interpreter.ReportComputation(common.ComputationKindLoop, uint(newLength))
+
+	return NewStringValue(
+		interpreter,
+		memoryUsage,
+		func() string {
+			var b strings.Builder
+			b.Grow(newLength)
+
+			if !padLeft {
+				b.WriteString(v.Str)
+			}
+
+			for i := 0; i < padCount; i++ {
+				b.WriteString(padding)
+			}
+
+			if padLeft {
+				b.WriteString(v.Str)
+			}
+
+			return b.String()
+		},
+	)
+}
+
+// Characters returns a Cadence array of type [Character],
+// where each element is a character (grapheme cluster) of the string
+func (v *StringValue) Characters(interpreter *Interpreter, locationRange LocationRange) *ArrayValue {
+
+	iterator := v.Iterator()
+
+	return NewArrayValueWithIterator(
+		interpreter,
+		VarSizedArrayOfCharacterType,
+		common.ZeroAddress,
+		uint64(v.Length()),
+		func() Value {
+
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			if !iterator.graphemes.Next() {
+				return nil
+			}
+
+			str := iterator.graphemes.Str()
+
+			return NewCharacterValue(
+				interpreter,
+				common.NewCharacterMemoryUsage(len(str)),
+				func() string {
+					return str
+				},
+			)
+		},
+	)
+}
+
 func (v *StringValue) Split(inter *Interpreter, locationRange LocationRange, separator *StringValue) *ArrayValue {
 
 	if len(separator.Str) == 0 {
@@ -1026,6 +1403,62 @@ func (v *StringValue) indexOf(inter *Interpreter, other *StringValue) (character
 	return -1, -1
 }
 
+func (v *StringValue) LastIndexOf(inter *Interpreter, other *StringValue) IntValue {
+	index := v.lastIndexOf(inter, other)
+	return NewIntValueFromInt64(inter, int64(index))
+}
+
+func (v *StringValue) lastIndexOf(inter *Interpreter, other *StringValue) (characterIndex int) {
+
+	if len(other.Str) == 0 {
+		return v.Length()
+	}
+
+	// If the string is empty, exit early.
+	//
+	// That ensures that if the checked value is the empty string singleton EmptyString,
+	// which should not be mutated because it may be used from different goroutines,
+	// it does not get mutated by preparing the graphemes iterator.
+	if len(v.Str) == 0 {
+		return -1
+	}
+
+	// Meter computation as if the string was iterated.
+	// This is a conservative over-estimation.
+	inter.ReportComputation(common.ComputationKindLoop, uint(len(v.Str)*len(other.Str)))
+
+	// Find the position of the substring in the string,
+	// by using strings.LastIndex with a decreasing end byte offset.
+	//
+	// Like in indexOf, the byte offset returned from strings.LastIndex
+	// may not be at a grapheme boundary, so we need to check
+	// that both the start and end byte offsets are grapheme boundaries,
+	// and determine the character index by iterating over the grapheme clusters.
+
+	for searchEndByteOffset := len(v.Str); searchEndByteOffset > 0; {
+
+		foundByteOffset := strings.LastIndex(v.Str[:searchEndByteOffset], other.Str)
+		if foundByteOffset < 0 {
+			break
+		}
+
+		v.prepareGraphemes()
+
+		characterIndex = 0
+
+		if v.seekGraphemeBoundaryStartPrepared(foundByteOffset, &characterIndex) &&
+			v.isGraphemeBoundaryEndPrepared(foundByteOffset+len(other.Str)) {
+
+			return characterIndex
+		}
+
+		// Continue searching for occurrences which start before the found one
+		searchEndByteOffset = foundByteOffset + len(other.Str) - 1
+	}
+
+	return -1
+}
+
 func (v *StringValue) Contains(inter *Interpreter, other *StringValue) BoolValue {
 	characterIndex, _ := v.indexOf(inter, other)
 	return characterIndex >= 0
@@ -4032,8 +4032,10 @@ func TestValue_ConformsToStaticType(t *testing.T) {
 		t.Parallel()
 
 		testCases := map[*sema.FixedPointNumericType]NumberValue{
-			sema.UFix64Type: NewUnmeteredUFix64ValueWithInteger(42, EmptyLocationRange),
-			sema.Fix64Type:  NewUnmeteredFix64ValueWithInteger(42, EmptyLocationRange),
+			sema.UFix64Type:  NewUnmeteredUFix64ValueWithInteger(42, EmptyLocationRange),
+			sema.Fix64Type:   NewUnmeteredFix64ValueWithInteger(42, EmptyLocationRange),
+			sema.UFix128Type: NewUnmeteredUFix128ValueWithInteger(big.NewInt(42), EmptyLocationRange),
+			sema.Fix128Type:  NewUnmeteredFix128ValueWithInteger(big.NewInt(42), EmptyLocationRange),
 		}
 
 		for _, ty := range sema.AllFixedPointTypes {
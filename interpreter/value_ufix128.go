@@ -0,0 +1,500 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/values"
+)
+
+// UFix128Value
+
+type UFix128Value struct {
+	values.UFix128Value
+}
+
+func NewUFix128ValueWithInteger(gauge common.MemoryGauge, constructor func() *big.Int, locationRange LocationRange) UFix128Value {
+	ufix128Value, err := values.NewUFix128ValueWithInteger(gauge, func() (*big.Int, error) {
+		return constructor(), nil
+	})
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return UFix128Value{
+		UFix128Value: ufix128Value,
+	}
+}
+
+func NewUnmeteredUFix128ValueWithInteger(integer *big.Int, locationRange LocationRange) UFix128Value {
+	ufix128Value, err := values.NewUnmeteredUFix128ValueWithInteger(integer)
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return UFix128Value{
+		UFix128Value: ufix128Value,
+	}
+}
+
+func NewUFix128Value(gauge common.MemoryGauge, constructor func() *big.Int) UFix128Value {
+	ufix128Value, err := values.NewUFix128Value(gauge, func() (*big.Int, error) {
+		return constructor(), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return UFix128Value{
+		UFix128Value: ufix128Value,
+	}
+}
+
+func NewUnmeteredUFix128Value(value *big.Int) UFix128Value {
+	return UFix128Value{
+		UFix128Value: values.NewUnmeteredUFix128Value(value),
+	}
+}
+
+func ConvertUFix128(memoryGauge common.MemoryGauge, value Value, locationRange LocationRange) UFix128Value {
+	switch value := value.(type) {
+	case UFix128Value:
+		return value
+
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
+			panic(UnderflowError{
+				LocationRange: locationRange,
+			})
+		}
+		return NewUFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				return value.BigInt
+			},
+		)
+
+	case UFix64Value:
+		return NewUFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				result := new(big.Int).SetUint64(uint64(value.UFix64Value))
+				return result.Mul(result, fix128ScaleFactorBig)
+			},
+		)
+
+	case Fix64Value:
+		if value < 0 {
+			panic(UnderflowError{
+				LocationRange: locationRange,
+			})
+		}
+		return NewUFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				result := new(big.Int).SetInt64(int64(value))
+				return result.Mul(result, fix128ScaleFactorBig)
+			},
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return value.ToBigInt(memoryGauge)
+			},
+			locationRange,
+		)
+
+	case NumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return big.NewInt(int64(value.ToInt(locationRange)))
+			},
+			locationRange,
+		)
+
+	default:
+		panic(fmt.Sprintf("can't convert to UFix128: %s", value))
+	}
+}
+
+var _ Value = UFix128Value{}
+var _ atree.Storable = UFix128Value{}
+var _ NumberValue = UFix128Value{}
+var _ FixedPointValue = UFix128Value{}
+var _ EquatableValue = UFix128Value{}
+var _ ComparableValue = UFix128Value{}
+var _ HashableValue = UFix128Value{}
+var _ MemberAccessibleValue = UFix128Value{}
+
+func (UFix128Value) isValue() {}
+
+func (v UFix128Value) Accept(interpreter *Interpreter, visitor Visitor, _ LocationRange) {
+	visitor.VisitUFix128Value(interpreter, v)
+}
+
+func (UFix128Value) Walk(_ *Interpreter, _ func(Value), _ LocationRange) {
+	// NO-OP
+}
+
+func (UFix128Value) StaticType(context ValueStaticTypeContext) StaticType {
+	return NewPrimitiveStaticType(context, PrimitiveStaticTypeUFix128)
+}
+
+func (UFix128Value) IsImportable(_ *Interpreter, _ LocationRange) bool {
+	return true
+}
+
+func (v UFix128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v UFix128Value) MeteredString(interpreter *Interpreter, _ SeenReferences, _ LocationRange) string {
+	common.UseMemory(
+		interpreter,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(interpreter, v),
+		),
+	)
+	return v.String()
+}
+
+func (v UFix128Value) ToInt(locationRange LocationRange) int {
+	result, err := v.UFix128Value.ToInt()
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return result
+}
+
+func (v UFix128Value) Negate(NumberValueArithmeticContext, LocationRange) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix128Value) Plus(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationPlus,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.UFix128Value.Plus(context, o.UFix128Value)
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return UFix128Value{UFix128Value: result}
+}
+
+func (v UFix128Value) SaturatingPlus(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.UFix128Value.SaturatingPlus(context, o.UFix128Value)
+	if err != nil {
+		panic(err)
+	}
+	return UFix128Value{UFix128Value: result}
+}
+
+func (v UFix128Value) Minus(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMinus,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.UFix128Value.Minus(context, o.UFix128Value)
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return UFix128Value{UFix128Value: result}
+}
+
+func (v UFix128Value) SaturatingMinus(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.UFix128Value.SaturatingMinus(context, o.UFix128Value)
+	if err != nil {
+		panic(err)
+	}
+	return UFix128Value{UFix128Value: result}
+}
+
+func (v UFix128Value) Mul(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMul,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.UFix128Value.Mul(context, o.UFix128Value)
+	if err != nil {
+		panicFixedPointRangeError(err, locationRange)
+	}
+	return UFix128Value{UFix128Value: result}
+}
+
+func (v UFix128Value) SaturatingMul(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.UFix128Value.SaturatingMul(context, o.UFix128Value)
+	if err != nil {
+		panic(err)
+	}
+	return UFix128Value{UFix128Value: result}
+}
+
+func (v UFix128Value) Div(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationDiv,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.UFix128Value.Div(context, o.UFix128Value)
+	if err != nil {
+		panicFixedPointArithmeticError(err, locationRange)
+	}
+	return UFix128Value{UFix128Value: result}
+}
+
+func (v UFix128Value) SaturatingDiv(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName:  sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.UFix128Value.SaturatingDiv(context, o.UFix128Value)
+	if err != nil {
+		panicFixedPointArithmeticError(err, locationRange)
+	}
+	return UFix128Value{UFix128Value: result}
+}
+
+func (v UFix128Value) Mod(context NumberValueArithmeticContext, other NumberValue, locationRange LocationRange) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMod,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	result, err := v.UFix128Value.Mod(context, o.UFix128Value)
+	if err != nil {
+		panicFixedPointArithmeticError(err, locationRange)
+	}
+	return UFix128Value{UFix128Value: result}
+}
+
+func (v UFix128Value) Less(context ValueComparisonContext, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLess,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	return BoolValue(v.UFix128Value.Less(o.UFix128Value))
+}
+
+func (v UFix128Value) LessEqual(context ValueComparisonContext, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLessEqual,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	return BoolValue(v.UFix128Value.LessEqual(o.UFix128Value))
+}
+
+func (v UFix128Value) Greater(context ValueComparisonContext, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreater,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	return BoolValue(v.UFix128Value.Greater(o.UFix128Value))
+}
+
+func (v UFix128Value) GreaterEqual(context ValueComparisonContext, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreaterEqual,
+			LeftType:      v.StaticType(context),
+			RightType:     other.StaticType(context),
+			LocationRange: locationRange,
+		})
+	}
+
+	return BoolValue(v.UFix128Value.GreaterEqual(o.UFix128Value))
+}
+
+func (v UFix128Value) Equal(_ ValueComparisonContext, _ LocationRange, other Value) bool {
+	otherUFix128, ok := other.(UFix128Value)
+	if !ok {
+		return false
+	}
+
+	return v.UFix128Value.Equal(otherUFix128.UFix128Value)
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeUFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v UFix128Value) HashInput(_ common.MemoryGauge, _ LocationRange, scratch []byte) []byte {
+	b := values.UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeUFix128)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func (v UFix128Value) GetMember(interpreter *Interpreter, locationRange LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.UFix128Type, locationRange)
+}
+
+func (UFix128Value) RemoveMember(_ *Interpreter, _ LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (UFix128Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) bool {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix128Value) ConformsToStaticType(
+	_ *Interpreter,
+	_ LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (UFix128Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (UFix128Value) IsResourceKinded(_ ValueStaticTypeContext) bool {
+	return false
+}
+
+func (v UFix128Value) Transfer(
+	interpreter *Interpreter,
+	_ LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+	_ map[atree.ValueID]struct{},
+	_ bool,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v UFix128Value) Clone(_ *Interpreter) Value {
+	return NewUnmeteredUFix128Value(v.BigInt)
+}
+
+func (UFix128Value) DeepRemove(_ *Interpreter, _ bool) {
+	// NO-OP
+}
+
+func (v UFix128Value) IntegerPart() NumberValue {
+	return NewUnmeteredUInt128ValueFromBigInt(v.UFix128Value.IntegerPart())
+}
@@ -22,6 +22,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"math/big"
 
 	"github.com/onflow/atree"
 
@@ -108,6 +109,22 @@ func ConvertUFix64(memoryGauge common.MemoryGauge, value Value, locationRange Lo
 			},
 		)
 
+	case Fix128Value:
+		return NewUFix64Value(
+			memoryGauge,
+			func() uint64 {
+				return convertFix128ToUFix64(value.BigInt, locationRange)
+			},
+		)
+
+	case UFix128Value:
+		return NewUFix64Value(
+			memoryGauge,
+			func() uint64 {
+				return convertFix128ToUFix64(value.BigInt, locationRange)
+			},
+		)
+
 	case BigNumberValue:
 		converter := func() uint64 {
 			v := value.ToBigInt(memoryGauge)
@@ -154,6 +171,26 @@ func ConvertUFix64(memoryGauge common.MemoryGauge, value Value, locationRange Lo
 	}
 }
 
+// convertFix128ToUFix64 converts the given Fix128 or UFix128 value
+// to the scale of UFix64, truncating excess fractional digits
+func convertFix128ToUFix64(value *big.Int, locationRange LocationRange) uint64 {
+	if value.Sign() < 0 {
+		panic(UnderflowError{
+			LocationRange: locationRange,
+		})
+	}
+
+	result := new(big.Int).Quo(value, fix128ScaleFactorBig)
+
+	if !result.IsUint64() {
+		panic(OverflowError{
+			LocationRange: locationRange,
+		})
+	}
+
+	return result.Uint64()
+}
+
 var _ Value = UFix64Value{}
 var _ atree.Storable = UFix64Value{}
 var _ NumberValue = UFix64Value{}
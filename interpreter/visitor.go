@@ -47,9 +47,12 @@ type Visitor interface {
 	VisitWord128Value(interpreter *Interpreter, value Word128Value)
 	VisitWord256Value(interpreter *Interpreter, value Word256Value)
 	VisitFix64Value(interpreter *Interpreter, value Fix64Value)
+	VisitFix128Value(interpreter *Interpreter, value Fix128Value)
 	VisitUFix64Value(interpreter *Interpreter, value UFix64Value)
+	VisitUFix128Value(interpreter *Interpreter, value UFix128Value)
 	VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool
 	VisitDictionaryValue(interpreter *Interpreter, value *DictionaryValue) bool
+	VisitSetValue(interpreter *Interpreter, value *SetValue) bool
 	VisitNilValue(interpreter *Interpreter, value NilValue)
 	VisitSomeValue(interpreter *Interpreter, value *SomeValue) bool
 	VisitStorageReferenceValue(interpreter *Interpreter, value *StorageReferenceValue)
@@ -94,9 +97,12 @@ type EmptyVisitor struct {
 	Word128ValueVisitor                     func(interpreter *Interpreter, value Word128Value)
 	Word256ValueVisitor                     func(interpreter *Interpreter, value Word256Value)
 	Fix64ValueVisitor                       func(interpreter *Interpreter, value Fix64Value)
+	Fix128ValueVisitor                      func(interpreter *Interpreter, value Fix128Value)
 	UFix64ValueVisitor                      func(interpreter *Interpreter, value UFix64Value)
+	UFix128ValueVisitor                     func(interpreter *Interpreter, value UFix128Value)
 	CompositeValueVisitor                   func(interpreter *Interpreter, value *CompositeValue) bool
 	DictionaryValueVisitor                  func(interpreter *Interpreter, value *DictionaryValue) bool
+	SetValueVisitor                         func(interpreter *Interpreter, value *SetValue) bool
 	NilValueVisitor                         func(interpreter *Interpreter, value NilValue)
 	SomeValueVisitor                        func(interpreter *Interpreter, value *SomeValue) bool
 	StorageReferenceValueVisitor            func(interpreter *Interpreter, value *StorageReferenceValue)
@@ -310,6 +316,13 @@ func (v EmptyVisitor) VisitFix64Value(interpreter *Interpreter, value Fix64Value
 	v.Fix64ValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitFix128Value(interpreter *Interpreter, value Fix128Value) {
+	if v.Fix128ValueVisitor == nil {
+		return
+	}
+	v.Fix128ValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitUFix64Value(interpreter *Interpreter, value UFix64Value) {
 	if v.UFix64ValueVisitor == nil {
 		return
@@ -317,6 +330,13 @@ func (v EmptyVisitor) VisitUFix64Value(interpreter *Interpreter, value UFix64Val
 	v.UFix64ValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitUFix128Value(interpreter *Interpreter, value UFix128Value) {
+	if v.UFix128ValueVisitor == nil {
+		return
+	}
+	v.UFix128ValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool {
 	if v.CompositeValueVisitor == nil {
 		return true
@@ -331,6 +351,13 @@ func (v EmptyVisitor) VisitDictionaryValue(interpreter *Interpreter, value *Dict
 	return v.DictionaryValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitSetValue(interpreter *Interpreter, value *SetValue) bool {
+	if v.SetValueVisitor == nil {
+		return true
+	}
+	return v.SetValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitNilValue(interpreter *Interpreter, value NilValue) {
 	if v.NilValueVisitor == nil {
 		return
@@ -138,3 +138,103 @@ func TestInterpretWhileStatementWithBreak(t *testing.T) {
 		value,
 	)
 }
+
+func TestInterpretLabeledLoops(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("continue outer loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): String {
+               var s = ""
+               var i = 0
+               outer: while i < 3 {
+                   i = i + 1
+                   for x in [1, 2, 3] {
+                       if x == 2 {
+                           continue outer
+                       }
+                       s = s.concat(i.toString()).concat(x.toString()).concat(" ")
+                   }
+               }
+               return s
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("11 21 31 "),
+			value,
+		)
+	})
+
+	t.Run("break outer loop from switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): String {
+               var s = ""
+               outer: for x in [1, 2, 3] {
+                   for y in [1, 2, 3] {
+                       switch y {
+                           case 2:
+                               if x == 2 {
+                                   break outer
+                               }
+                               break
+                           default:
+                               s = s.concat(x.toString()).concat(y.toString()).concat(" ")
+                       }
+                   }
+               }
+               return s
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("11 13 21 "),
+			value,
+		)
+	})
+
+	t.Run("break innermost loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+           fun test(): Int {
+               var count = 0
+               outer: for x in [1, 2, 3] {
+                   inner: while true {
+                       count = count + 1
+                       break inner
+                   }
+               }
+               return count
+           }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			value,
+		)
+	})
+}
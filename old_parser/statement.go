@@ -257,14 +257,14 @@ func parseBreakStatement(p *parser) *ast.BreakStatement {
 	tokenRange := p.current.Range
 	p.next()
 
-	return ast.NewBreakStatement(p.memoryGauge, tokenRange)
+	return ast.NewBreakStatement(p.memoryGauge, nil, tokenRange)
 }
 
 func parseContinueStatement(p *parser) *ast.ContinueStatement {
 	tokenRange := p.current.Range
 	p.next()
 
-	return ast.NewContinueStatement(p.memoryGauge, tokenRange)
+	return ast.NewContinueStatement(p.memoryGauge, nil, tokenRange)
 }
 
 func parseIfStatement(p *parser) (*ast.IfStatement, error) {
@@ -381,7 +381,7 @@ func parseWhileStatement(p *parser) (*ast.WhileStatement, error) {
 		return nil, err
 	}
 
-	return ast.NewWhileStatement(p.memoryGauge, expression, block, startPos), nil
+	return ast.NewWhileStatement(p.memoryGauge, nil, expression, block, startPos), nil
 }
 
 func parseForStatement(p *parser) (*ast.ForStatement, error) {
@@ -442,8 +442,10 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 
 	return ast.NewForStatement(
 		p.memoryGauge,
+		nil,
 		identifier,
 		index,
+		nil,
 		block,
 		expression,
 		startPos,
@@ -0,0 +1,503 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parser
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/parser/lexer"
+)
+
+// commentAttacher attaches the comments and blank-line trivia of a program
+// to the elements of the program.
+//
+// Comments are attached to the closest enclosing statement or declaration:
+// Comments before an element are leading comments of the element,
+// comments after an element on the same line are trailing comments of the element,
+// and comments after the last element of a body (e.g. a block) are inner comments
+// of the element that has the body.
+type commentAttacher struct {
+	// comments are all comments, in source order
+	comments []*ast.Comment
+	// nextComment is the index of the next comment which is not attached yet
+	nextComment int
+	// nonBlankLines are the lines which contain a token other than whitespace
+	nonBlankLines map[int]struct{}
+	// firstLine and lastLine are the first and last line
+	// which contain a token other than whitespace
+	firstLine int
+	lastLine  int
+}
+
+// commentTarget is an element to which comments can be attached
+type commentTarget struct {
+	element ast.HasPosition
+	attach  func(comments *ast.Comments)
+}
+
+func newCommentTarget(element ast.HasPosition) (commentTarget, bool) {
+	commentedElement, ok := element.(ast.CommentedElement)
+	if !ok {
+		return commentTarget{}, false
+	}
+
+	return commentTarget{
+		element: element,
+		attach:  commentedElement.AttachComments,
+	}, true
+}
+
+func newCommentAttacher(tokens lexer.TokenStream) *commentAttacher {
+	attacher := &commentAttacher{
+		nonBlankLines: map[int]struct{}{},
+	}
+
+	code := tokens.Input()
+
+	tokens.Revert(0)
+
+	var blockCommentStart ast.Position
+	var blockCommentDepth int
+
+	// Comments which share their first line with a preceding token,
+	// or their last line with a following token.
+	// Only comments on lines of their own are separated by blank lines
+	precededOnLine := map[*ast.Comment]struct{}{}
+	followedOnLine := map[*ast.Comment]struct{}{}
+
+	// previousLine is the last line of the previous token outside of comments
+	var previousLine int
+	var blockCommentPreceded bool
+	var previousComment *ast.Comment
+
+	addComment := func(comment *ast.Comment, preceded bool) {
+		attacher.comments = append(attacher.comments, comment)
+		if preceded {
+			precededOnLine[comment] = struct{}{}
+		}
+		previousComment = comment
+	}
+
+	for {
+		token := tokens.Next()
+
+		switch token.Type {
+		case lexer.TokenEOF:
+			for _, comment := range attacher.comments {
+				if _, ok := precededOnLine[comment]; !ok {
+					comment.BlankLineBefore = attacher.isBlankLine(comment.StartPos.Line - 1)
+				}
+				if _, ok := followedOnLine[comment]; !ok {
+					comment.BlankLineAfter = attacher.isBlankLine(comment.EndPos.Line + 1)
+				}
+			}
+			return attacher
+
+		case lexer.TokenSpace:
+			continue
+		}
+
+		if blockCommentDepth == 0 && previousComment != nil {
+			if token.StartPos.Line == previousComment.EndPos.Line {
+				followedOnLine[previousComment] = struct{}{}
+			}
+			previousComment = nil
+		}
+
+		switch token.Type {
+		case lexer.TokenLineComment:
+			text := bytes.TrimRight(
+				code[token.StartPos.Offset:token.EndPos.Offset+1],
+				" \t\r",
+			)
+			addComment(
+				&ast.Comment{
+					Text:  string(text),
+					Range: token.Range,
+				},
+				previousLine == token.StartPos.Line,
+			)
+
+		case lexer.TokenBlockCommentStart:
+			if blockCommentDepth == 0 {
+				blockCommentStart = token.StartPos
+				blockCommentPreceded = previousLine == token.StartPos.Line
+			}
+			blockCommentDepth++
+
+		case lexer.TokenBlockCommentEnd:
+			blockCommentDepth--
+			if blockCommentDepth == 0 {
+				addComment(
+					&ast.Comment{
+						Text: string(code[blockCommentStart.Offset : token.EndPos.Offset+1]),
+						Range: ast.NewUnmeteredRange(
+							blockCommentStart,
+							token.EndPos,
+						),
+					},
+					blockCommentPreceded,
+				)
+			}
+		}
+
+		if blockCommentDepth == 0 {
+			previousLine = token.EndPos.Line
+		}
+
+		for line := token.StartPos.Line; line <= token.EndPos.Line; line++ {
+			attacher.nonBlankLines[line] = struct{}{}
+		}
+		if attacher.firstLine == 0 {
+			attacher.firstLine = token.StartPos.Line
+		}
+		attacher.lastLine = token.EndPos.Line
+	}
+}
+
+// attachProgramComments attaches the comments in the given token stream
+// to the declarations of the given program
+func attachProgramComments(program *ast.Program, tokens lexer.TokenStream) {
+	attacher := newCommentAttacher(tokens)
+
+	inner := attacher.attachTargets(
+		declarationCommentTargets(program.Declarations()),
+		len(tokens.Input()),
+	)
+	attachInnerComments(program, inner)
+}
+
+// attachTargets attaches the comments before the given end offset
+// to the given targets, which must be in source order.
+//
+// The remaining comments, after the last target, are returned
+func (a *commentAttacher) attachTargets(targets []commentTarget, endOffset int) []*ast.Comment {
+	for i, target := range targets {
+		trailingEndOffset := endOffset
+		if i+1 < len(targets) {
+			trailingEndOffset = targets[i+1].element.StartPosition().Offset
+		}
+
+		a.attachTarget(target, trailingEndOffset)
+	}
+
+	return a.takeComments(endOffset)
+}
+
+func (a *commentAttacher) attachTarget(target commentTarget, trailingEndOffset int) {
+	element := target.element
+	startPos := element.StartPosition()
+	endPos := element.EndPosition(nil)
+
+	comments := &ast.Comments{}
+
+	comments.Leading = a.takeComments(startPos.Offset)
+
+	firstLine := startPos.Line
+	if len(comments.Leading) > 0 {
+		firstLine = comments.Leading[0].StartPos.Line
+	}
+	comments.BlankLineBefore = a.isBlankLine(firstLine - 1)
+
+	// Attach the comments inside the element to its nested elements, if any.
+	// Comments which cannot be attached are also leading comments
+
+	nestedLeading, inner := a.attachNested(element)
+	comments.Leading = append(comments.Leading, nestedLeading...)
+	comments.Leading = append(
+		comments.Leading,
+		a.takeComments(endPos.Offset+1)...,
+	)
+
+	comments.Trailing = a.takeTrailingComments(endPos.Line, trailingEndOffset)
+	comments.Inner = inner
+
+	if !comments.IsEmpty() {
+		target.attach(comments)
+	}
+}
+
+// attachNested attaches the comments inside the given element to its nested elements.
+// The comments which could not be attached are returned as leading comments,
+// and the comments after the last nested element of the element's body as inner comments
+func (a *commentAttacher) attachNested(element ast.HasPosition) (leading, inner []*ast.Comment) {
+	endOffset := element.EndPosition(nil).Offset + 1
+
+	switch element := element.(type) {
+	case *ast.CompositeDeclaration:
+		a.attachMembers(element.Members, endOffset)
+
+	case *ast.InterfaceDeclaration:
+		a.attachMembers(element.Members, endOffset)
+
+	case *ast.AttachmentDeclaration:
+		a.attachMembers(element.Members, endOffset)
+
+	case *ast.FunctionDeclaration:
+		leading = a.attachFunctionBlock(element.FunctionBlock)
+
+	case *ast.SpecialFunctionDeclaration:
+		leading = a.attachFunctionBlock(element.FunctionDeclaration.FunctionBlock)
+
+	case *ast.FieldDeclaration:
+		leading = a.attachFunctionBlock(element.Getter)
+
+	case *ast.TransactionDeclaration:
+		var targets []commentTarget
+
+		for _, field := range element.Fields {
+			targets = append(targets, commentTarget{
+				element: field,
+				attach:  field.AttachComments,
+			})
+		}
+
+		for _, function := range []*ast.SpecialFunctionDeclaration{
+			element.Prepare,
+			element.Execute,
+		} {
+			if function == nil {
+				continue
+			}
+			targets = append(targets, commentTarget{
+				element: function,
+				attach:  function.AttachComments,
+			})
+		}
+
+		for _, conditions := range []*ast.Conditions{
+			element.PreConditions,
+			element.PostConditions,
+		} {
+			if conditions == nil {
+				continue
+			}
+			targets = append(targets, commentTarget{
+				element: conditions,
+				attach:  conditions.AttachComments,
+			})
+		}
+
+		sortCommentTargets(targets)
+
+		inner = a.attachTargets(targets, endOffset)
+
+	case *ast.Conditions:
+		targets := make([]commentTarget, 0, len(element.Conditions))
+
+		for _, condition := range element.Conditions {
+			target, ok := newCommentTarget(condition)
+			if ok {
+				targets = append(targets, target)
+			}
+		}
+
+		inner = a.attachTargets(targets, endOffset)
+
+	case *ast.IfStatement:
+		leading = a.attachBlock(element.Then, nil)
+
+		elseBlock := element.Else
+		if elseBlock == nil {
+			break
+		}
+
+		// Attach the comments of the nested if statement of an `else if`
+		// to the nested elements of the nested if statement,
+		// as the nested if statement is printed as part of this statement
+
+		if len(elseBlock.Statements) == 1 {
+			if elseIfStatement, ok := elseBlock.Statements[0].(*ast.IfStatement); ok {
+
+				elseIfLeading, _ := a.attachNested(elseIfStatement)
+				leading = append(leading, elseIfLeading...)
+				break
+			}
+		}
+
+		leading = append(
+			leading,
+			a.attachBlock(elseBlock, nil)...,
+		)
+
+	case *ast.WhileStatement:
+		leading = a.attachBlock(element.Block, nil)
+
+	case *ast.ForStatement:
+		leading = a.attachBlock(element.Block, nil)
+
+	case *ast.SwitchStatement:
+		targets := make([]commentTarget, 0, len(element.Cases))
+
+		for _, switchCase := range element.Cases {
+			targets = append(targets, commentTarget{
+				element: switchCase,
+				attach:  switchCase.AttachComments,
+			})
+		}
+
+		inner = a.attachTargets(targets, endOffset)
+
+	case *ast.SwitchCase:
+		inner = a.attachTargets(
+			statementCommentTargets(element.Statements),
+			endOffset,
+		)
+	}
+
+	return
+}
+
+func (a *commentAttacher) attachMembers(members *ast.Members, endOffset int) {
+	inner := a.attachTargets(
+		declarationCommentTargets(members.Declarations()),
+		endOffset,
+	)
+	attachInnerComments(members, inner)
+}
+
+func (a *commentAttacher) attachFunctionBlock(functionBlock *ast.FunctionBlock) []*ast.Comment {
+	if functionBlock == nil {
+		return nil
+	}
+
+	var conditionTargets []commentTarget
+
+	for _, conditions := range []*ast.Conditions{
+		functionBlock.PreConditions,
+		functionBlock.PostConditions,
+	} {
+		if conditions == nil {
+			continue
+		}
+		conditionTargets = append(conditionTargets, commentTarget{
+			element: conditions,
+			attach:  conditions.AttachComments,
+		})
+	}
+
+	return a.attachBlock(functionBlock.Block, conditionTargets)
+}
+
+// attachBlock attaches the comments inside the given block
+// to the given additional targets and the statements of the block.
+// The comments before the block are returned
+func (a *commentAttacher) attachBlock(block *ast.Block, targets []commentTarget) []*ast.Comment {
+	if block == nil {
+		return nil
+	}
+
+	unattached := a.takeComments(block.StartPos.Offset)
+
+	targets = append(targets, statementCommentTargets(block.Statements)...)
+	sortCommentTargets(targets)
+
+	inner := a.attachTargets(targets, block.EndPos.Offset+1)
+	attachInnerComments(block, inner)
+
+	return unattached
+}
+
+// takeComments returns the next unattached comments which start before the given offset
+func (a *commentAttacher) takeComments(endOffset int) []*ast.Comment {
+	start := a.nextComment
+	for a.nextComment < len(a.comments) &&
+		a.comments[a.nextComment].StartPos.Offset < endOffset {
+
+		a.nextComment++
+	}
+	return a.takenComments(start)
+}
+
+// takeTrailingComments returns the next unattached comments
+// which start on the given line, before the given offset
+func (a *commentAttacher) takeTrailingComments(line int, endOffset int) []*ast.Comment {
+	start := a.nextComment
+	for a.nextComment < len(a.comments) {
+		comment := a.comments[a.nextComment]
+		if comment.StartPos.Line != line ||
+			comment.StartPos.Offset >= endOffset {
+
+			break
+		}
+		a.nextComment++
+	}
+	return a.takenComments(start)
+}
+
+// takenComments returns the comments taken since the given start index, if any
+func (a *commentAttacher) takenComments(start int) []*ast.Comment {
+	if start == a.nextComment {
+		return nil
+	}
+	return a.comments[start:a.nextComment:a.nextComment]
+}
+
+// isBlankLine returns true if the given line is empty or only contains whitespace.
+// Lines before the first and after the last non-blank line are not considered blank
+func (a *commentAttacher) isBlankLine(line int) bool {
+	if line < a.firstLine || line > a.lastLine {
+		return false
+	}
+	_, ok := a.nonBlankLines[line]
+	return !ok
+}
+
+// attachInnerComments attaches the given inner comments to the given element,
+// which is not a comment target itself, e.g. a block
+func attachInnerComments(element ast.CommentedElement, inner []*ast.Comment) {
+	if len(inner) == 0 {
+		return
+	}
+
+	element.AttachComments(&ast.Comments{
+		Inner: inner,
+	})
+}
+
+func declarationCommentTargets(declarations []ast.Declaration) []commentTarget {
+	targets := make([]commentTarget, 0, len(declarations))
+	for _, declaration := range declarations {
+		target, ok := newCommentTarget(declaration)
+		if ok {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+func statementCommentTargets(statements []ast.Statement) []commentTarget {
+	targets := make([]commentTarget, 0, len(statements))
+	for _, statement := range statements {
+		target, ok := newCommentTarget(statement)
+		if ok {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+func sortCommentTargets(targets []commentTarget) {
+	sort.SliceStable(targets, func(i, j int) bool {
+		return targets[i].element.StartPosition().Offset <
+			targets[j].element.StartPosition().Offset
+	})
+}
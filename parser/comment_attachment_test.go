@@ -0,0 +1,315 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parser
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/ast"
+)
+
+func testParseProgramWithComments(t *testing.T, code string) *ast.Program {
+	program, err := ParseProgram(
+		nil,
+		[]byte(code),
+		Config{
+			CommentsEnabled: true,
+		},
+	)
+	require.NoError(t, err)
+	return program
+}
+
+func TestParseComments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		program, err := testParseProgram(`
+          // a
+          let x = 1 // b
+        `)
+		require.NoError(t, err)
+
+		assert.Nil(t, program.Declarations()[0].(*ast.VariableDeclaration).Comments)
+	})
+
+	t.Run("leading, trailing, and blank lines", func(t *testing.T) {
+
+		t.Parallel()
+
+		program := testParseProgramWithComments(t, `
+/// docs
+let x = 1 // x
+
+/* y */
+let y = 2
+`)
+
+		declarations := program.Declarations()
+		require.Len(t, declarations, 2)
+
+		assert.Equal(t,
+			&ast.Comments{
+				Leading: []*ast.Comment{
+					{
+						Text: "/// docs",
+						Range: ast.Range{
+							StartPos: ast.Position{Offset: 1, Line: 2, Column: 0},
+							EndPos:   ast.Position{Offset: 8, Line: 2, Column: 7},
+						},
+					},
+				},
+				Trailing: []*ast.Comment{
+					{
+						Text: "// x",
+						Range: ast.Range{
+							StartPos: ast.Position{Offset: 20, Line: 3, Column: 10},
+							EndPos:   ast.Position{Offset: 23, Line: 3, Column: 13},
+						},
+						BlankLineAfter: true,
+					},
+				},
+			},
+			declarations[0].(*ast.VariableDeclaration).Comments,
+		)
+
+		assert.Equal(t,
+			&ast.Comments{
+				Leading: []*ast.Comment{
+					{
+						Text: "/* y */",
+						Range: ast.Range{
+							StartPos: ast.Position{Offset: 26, Line: 5, Column: 0},
+							EndPos:   ast.Position{Offset: 32, Line: 5, Column: 6},
+						},
+						BlankLineBefore: true,
+					},
+				},
+				BlankLineBefore: true,
+			},
+			declarations[1].(*ast.VariableDeclaration).Comments,
+		)
+	})
+
+	t.Run("block comments sharing lines with code", func(t *testing.T) {
+
+		t.Parallel()
+
+		program := testParseProgramWithComments(t, `
+fun test(): Int {
+
+    /* block */ let y = [1, /* inner */ 2]
+
+    return y[0] /* end */
+
+}
+`)
+
+		block := program.FunctionDeclarations()[0].FunctionBlock.Block
+		require.Len(t, block.Statements, 2)
+
+		// Comments are only separated by blank lines from the code they do not share a line with
+
+		declarationComments := block.Statements[0].(*ast.VariableDeclaration).Comments
+		require.NotNil(t, declarationComments)
+		require.Len(t, declarationComments.Leading, 2)
+
+		blockComment := declarationComments.Leading[0]
+		assert.Equal(t, "/* block */", blockComment.Text)
+		assert.True(t, blockComment.BlankLineBefore)
+		assert.False(t, blockComment.BlankLineAfter)
+
+		innerComment := declarationComments.Leading[1]
+		assert.Equal(t, "/* inner */", innerComment.Text)
+		assert.False(t, innerComment.BlankLineBefore)
+		assert.False(t, innerComment.BlankLineAfter)
+
+		assert.True(t, declarationComments.BlankLineBefore)
+
+		returnComments := block.Statements[1].(*ast.ReturnStatement).Comments
+		require.NotNil(t, returnComments)
+		require.Len(t, returnComments.Trailing, 1)
+
+		endComment := returnComments.Trailing[0]
+		assert.Equal(t, "/* end */", endComment.Text)
+		assert.False(t, endComment.BlankLineBefore)
+		assert.True(t, endComment.BlankLineAfter)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		program := testParseProgramWithComments(t, `
+struct S {
+    fun test() {
+        // a
+        foo()
+        // b
+    }
+    // c
+}
+`)
+
+		compositeDeclaration := program.CompositeDeclarations()[0]
+		assert.Nil(t, compositeDeclaration.Comments)
+
+		members := compositeDeclaration.Members
+		require.NotNil(t, members.Comments)
+		require.Len(t, members.Comments.Inner, 1)
+		assert.Equal(t, "// c", members.Comments.Inner[0].Text)
+
+		functionBlock := members.Functions()[0].FunctionBlock
+
+		block := functionBlock.Block
+		require.NotNil(t, block.Comments)
+		require.Len(t, block.Comments.Inner, 1)
+		assert.Equal(t, "// b", block.Comments.Inner[0].Text)
+
+		statement := block.Statements[0].(*ast.ExpressionStatement)
+		require.NotNil(t, statement.Comments)
+		require.Len(t, statement.Comments.Leading, 1)
+		assert.Equal(t, "// a", statement.Comments.Leading[0].Text)
+	})
+
+	t.Run("conditions", func(t *testing.T) {
+
+		t.Parallel()
+
+		program := testParseProgramWithComments(t, `
+fun test(x: Int) {
+    pre {
+        // a
+        x > 0 // b
+    }
+}
+`)
+
+		functionBlock := program.FunctionDeclarations()[0].FunctionBlock
+
+		conditions := functionBlock.PreConditions.Conditions
+		require.Len(t, conditions, 1)
+
+		comments := conditions[0].(*ast.TestCondition).Comments
+		require.NotNil(t, comments)
+		require.Len(t, comments.Leading, 1)
+		assert.Equal(t, "// a", comments.Leading[0].Text)
+		require.Len(t, comments.Trailing, 1)
+		assert.Equal(t, "// b", comments.Trailing[0].Text)
+	})
+}
+
+func TestParseCommentsRoundTrip(t *testing.T) {
+
+	t.Parallel()
+
+	// The pretty printer indents blank lines
+	trailingWhitespace := regexp.MustCompile(`(?m)[ \t]+$`)
+
+	test := func(t *testing.T, code string) {
+		program := testParseProgramWithComments(t, code)
+		assert.Equal(t,
+			code,
+			trailingWhitespace.ReplaceAllString(ast.Prettier(program), ""),
+		)
+	}
+
+	t.Run("declarations", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, `// License
+
+import "Foo" // Foo
+
+/// S is a struct
+struct S {
+    // x
+    let x: Int /* x */
+
+    /*
+     * Initializes S
+     */
+    init() {
+        // first
+        self.x = 1
+
+        // second
+        let y = 2
+        // end of init
+    }
+    // end of S
+}
+
+// end of program`)
+	})
+
+	t.Run("statements", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, `fun test(x: Int): Int {
+    pre {
+        // positive
+        x > 0
+    }
+    if x > 1 {
+        // one
+        return 1
+    } else if x > 2 {
+        return 2 // two
+    } else {
+        // three
+    }
+    switch x {
+        // zero
+        case 0:
+            return 0
+        // other
+        default:
+            while true {
+                break // break
+            }
+    }
+    return x
+}`)
+	})
+
+	t.Run("transaction", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, `transaction {
+    // prepare
+    prepare(signer: &Account) {}
+
+    // execute
+    execute {}
+    // end
+}`)
+	})
+}
@@ -166,6 +166,16 @@ func parseDeclaration(p *parser, docString string) (ast.Declaration, error) {
 				}
 				return parseEntitlementOrMappingDeclaration(p, access, accessPos, docString)
 
+			case KeywordTypealias:
+				err := rejectStaticAndNativeModifiers(p, staticPos, nativePos, common.DeclarationKindTypeAlias)
+				if err != nil {
+					return nil, err
+				}
+				if purity != ast.FunctionPurityUnspecified {
+					return nil, NewSyntaxError(*purityPos, "invalid view modifier for type alias")
+				}
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
 			case KeywordAttachment:
 				err := rejectStaticAndNativeModifiers(p, staticPos, nativePos, common.DeclarationKindAttachment)
 				if err != nil {
@@ -647,6 +657,7 @@ func parsePragmaDeclaration(p *parser) (*ast.PragmaDeclaration, error) {
 //	    'import'
 //	    ( identifier (',' identifier)* 'from' )?
 //	    ( string | hexadecimalLiteral | identifier )
+//	    ( 'hash' string )?
 func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 
 	startPosition := p.current.StartPos
@@ -654,6 +665,7 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 	var identifiers []ast.Identifier
 
 	var location common.Location
+	var codeHash []byte
 	var locationPos ast.Position
 	var endPos ast.Position
 
@@ -851,10 +863,23 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 		)
 	}
 
+	if isNextTokenImportCodeHash(p) {
+		// Skip the `hash` keyword
+		p.skipSpaceAndComments()
+		p.nextSemanticToken()
+
+		codeHash = parseImportCodeHash(p)
+		endPos = p.current.EndPos
+
+		// Skip the hash
+		p.next()
+	}
+
 	return ast.NewImportDeclaration(
 		p.memoryGauge,
 		identifiers,
 		location,
+		codeHash,
 		ast.NewRange(
 			p.memoryGauge,
 			startPosition,
@@ -888,6 +913,45 @@ func isNextTokenCommaOrFrom(p *parser) bool {
 	return false
 }
 
+// isNextTokenImportCodeHash checks whether the tokens to follow are the `hash` keyword and a string,
+// i.e. the code hash an import is pinned to.
+func isNextTokenImportCodeHash(p *parser) bool {
+	current := p.current
+	cursor := p.tokens.Cursor()
+	defer func() {
+		p.current = current
+		p.tokens.Revert(cursor)
+	}()
+
+	p.skipSpaceAndComments()
+
+	if !p.isToken(p.current, lexer.TokenIdentifier, KeywordHash) {
+		return false
+	}
+
+	// Skip the `hash` keyword
+	p.nextSemanticToken()
+
+	return p.current.Is(lexer.TokenString)
+}
+
+// parseImportCodeHash parses the current string token as a hex-encoded code hash
+func parseImportCodeHash(p *parser) []byte {
+	literal := p.currentTokenSource()
+	parsedString := parseStringLiteral(p, literal)
+
+	codeHash, err := hex.DecodeString(parsedString)
+	if err != nil || len(codeHash) != ast.ImportCodeHashLength {
+		p.reportSyntaxError(
+			"invalid import hash: expected hex-encoded SHA3-256 hash (%d bytes)",
+			ast.ImportCodeHashLength,
+		)
+		return nil
+	}
+
+	return codeHash
+}
+
 func parseHexadecimalLocation(p *parser) common.AddressLocation {
 	// TODO: improve
 	literal := string(p.currentTokenSource())
@@ -945,8 +1009,11 @@ func parseEventDeclaration(
 	p.next()
 
 	// if this is a `ResourceDestroyed` event (i.e., a default event declaration), parse default arguments
-	parseDefaultArguments := ast.IsResourceDestructionDefaultEvent(identifier.Identifier)
-	parameterList, err := parseParameterList(p, parseDefaultArguments)
+	defaultArguments := defaultArgumentsForbidden
+	if ast.IsResourceDestructionDefaultEvent(identifier.Identifier) {
+		defaultArguments = defaultArgumentsRequired
+	}
+	parameterList, err := parseParameterList(p, defaultArguments)
 	if err != nil {
 		return nil, err
 	}
@@ -983,6 +1050,7 @@ func parseEventDeclaration(
 		common.CompositeKindEvent,
 		identifier,
 		nil,
+		nil,
 		members,
 		docString,
 		ast.NewRange(
@@ -1017,11 +1085,13 @@ func parseCompositeKind(p *parser) common.CompositeKind {
 	return common.CompositeKindUnknown
 }
 
-// parseFieldWithVariableKind parses a field which has a variable kind.
+// parseFieldWithVariableKind parses a field which has a variable kind,
+// and optionally a getter, if the field is computed.
+// Computed fields are only allowed if computedFieldAllowed is true, i.e. in enums.
 //
 //	variableKind : 'var' | 'let'
 //
-//	field : variableKind identifier ':' typeAnnotation
+//	field : variableKind identifier ':' typeAnnotation functionBlock?
 func parseFieldWithVariableKind(
 	p *parser,
 	access ast.Access,
@@ -1029,6 +1099,7 @@ func parseFieldWithVariableKind(
 	staticPos *ast.Position,
 	nativePos *ast.Position,
 	docString string,
+	computedFieldAllowed bool,
 ) (*ast.FieldDeclaration, error) {
 
 	startPos := ast.EarliestPosition(p.current.StartPos, accessPos, staticPos, nativePos)
@@ -1067,7 +1138,36 @@ func parseFieldWithVariableKind(
 		return nil, err
 	}
 
-	return ast.NewFieldDeclaration(
+	endPos := typeAnnotation.EndPosition(p.memoryGauge)
+
+	// Optional getter of a computed field
+
+	var getter *ast.FunctionBlock
+
+	current := p.current
+	cursor := p.tokens.Cursor()
+	p.skipSpaceAndComments()
+	if p.current.Is(lexer.TokenBraceOpen) {
+		if !computedFieldAllowed {
+			return nil, p.syntaxError("computed fields are only supported in enums")
+		}
+
+		if variableKind != ast.VariableKindConstant {
+			return nil, p.syntaxError("computed fields must be declared with `%s`", KeywordLet)
+		}
+
+		getter, err = parseFunctionBlock(p)
+		if err != nil {
+			return nil, err
+		}
+
+		endPos = getter.EndPosition(p.memoryGauge)
+	} else {
+		p.tokens.Revert(cursor)
+		p.current = current
+	}
+
+	field := ast.NewFieldDeclaration(
 		p.memoryGauge,
 		access,
 		staticPos != nil,
@@ -1079,9 +1179,12 @@ func parseFieldWithVariableKind(
 		ast.NewRange(
 			p.memoryGauge,
 			startPos,
-			typeAnnotation.EndPosition(p.memoryGauge),
+			endPos,
 		),
-	), nil
+	)
+	field.Getter = getter
+
+	return field, nil
 }
 
 // parseEntitlementMapping parses an entitlement mapping
@@ -1267,6 +1370,58 @@ func parseEntitlementOrMappingDeclaration(
 	}
 }
 
+// parseTypeAliasDeclaration parses a type alias declaration.
+//
+//	typeAliasDeclaration : 'typealias' identifier '=' type
+func parseTypeAliasDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) (ast.Declaration, error) {
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `typealias` keyword
+	p.nextSemanticToken()
+
+	identifier, err := p.nonReservedIdentifier("following type alias declaration")
+	if err != nil {
+		return nil, err
+	}
+
+	p.nextSemanticToken()
+
+	_, err = p.mustOne(lexer.TokenEqual)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments()
+
+	aliasedType, err := parseType(p, lowestBindingPower)
+	if err != nil {
+		return nil, err
+	}
+
+	declarationRange := ast.NewRange(
+		p.memoryGauge,
+		startPos,
+		aliasedType.EndPosition(p.memoryGauge),
+	)
+
+	return ast.NewTypeAliasDeclaration(
+		p.memoryGauge,
+		access,
+		identifier,
+		aliasedType,
+		docString,
+		declarationRange,
+	), nil
+}
+
 func parseConformances(p *parser) ([]*ast.NominalType, error) {
 	var conformances []*ast.NominalType
 	var err error
@@ -1296,7 +1451,7 @@ func parseConformances(p *parser) ([]*ast.NominalType, error) {
 //
 //	conformances : ':' nominalType ( ',' nominalType )*
 //
-//	compositeDeclaration : compositeKind identifier conformances?
+//	compositeDeclaration : compositeKind identifier typeParameterList? conformances?
 //	                       '{' membersAndNestedDeclarations '}'
 //
 //	interfaceDeclaration : compositeKind 'interface' identifier conformances?
@@ -1358,6 +1513,18 @@ func parseCompositeOrInterfaceDeclaration(
 		}
 	}
 
+	typeParameterList, err := parseTypeParameterList(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if isInterface && !typeParameterList.IsEmpty() {
+		p.report(NewSyntaxError(
+			typeParameterList.StartPos,
+			"interface declarations cannot have type parameters",
+		))
+	}
+
 	p.skipSpaceAndComments()
 
 	conformances, err := parseConformances(p)
@@ -1370,7 +1537,10 @@ func parseCompositeOrInterfaceDeclaration(
 		return nil, err
 	}
 
-	members, err := parseMembersAndNestedDeclarations(p, lexer.TokenBraceClose)
+	// Only enums may declare computed fields
+	computedFieldsAllowed := compositeKind == common.CompositeKindEnum && !isInterface
+
+	members, err := parseMembersAndNestedDeclarations(p, lexer.TokenBraceClose, computedFieldsAllowed)
 	if err != nil {
 		return nil, err
 	}
@@ -1405,6 +1575,7 @@ func parseCompositeOrInterfaceDeclaration(
 			access,
 			compositeKind,
 			identifier,
+			typeParameterList,
 			conformances,
 			members,
 			docString,
@@ -1478,7 +1649,9 @@ func parseAttachmentDeclaration(
 
 	p.skipSpaceAndComments()
 
-	members, err := parseMembersAndNestedDeclarations(p, lexer.TokenBraceClose)
+	const computedFieldsAllowed = false
+
+	members, err := parseMembersAndNestedDeclarations(p, lexer.TokenBraceClose, computedFieldsAllowed)
 	if err != nil {
 		return nil, err
 	}
@@ -1510,9 +1683,14 @@ func parseAttachmentDeclaration(
 
 // parseMembersAndNestedDeclarations parses composite or interface members,
 // and nested declarations.
+// Computed fields are only allowed if computedFieldsAllowed is true, i.e. in enums.
 //
 //	membersAndNestedDeclarations : ( memberOrNestedDeclaration ';'* )*
-func parseMembersAndNestedDeclarations(p *parser, endTokenType lexer.TokenType) (*ast.Members, error) {
+func parseMembersAndNestedDeclarations(
+	p *parser,
+	endTokenType lexer.TokenType,
+	computedFieldsAllowed bool,
+) (*ast.Members, error) {
 
 	var declarations []ast.Declaration
 
@@ -1532,7 +1710,7 @@ func parseMembersAndNestedDeclarations(p *parser, endTokenType lexer.TokenType)
 			return ast.NewMembers(p.memoryGauge, declarations), nil
 
 		default:
-			memberOrNestedDeclaration, err := parseMemberOrNestedDeclaration(p, docString)
+			memberOrNestedDeclaration, err := parseMemberOrNestedDeclaration(p, docString, computedFieldsAllowed)
 			if err != nil {
 				return nil, err
 			}
@@ -1556,8 +1734,13 @@ func parseMembersAndNestedDeclarations(p *parser, endTokenType lexer.TokenType)
 //	                          | compositeDeclaration
 //	                          | eventDeclaration
 //	                          | enumCase
+//	                          | typeAliasDeclaration
 //	                          | pragmaDeclaration
-func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaration, error) {
+func parseMemberOrNestedDeclaration(
+	p *parser,
+	docString string,
+	computedFieldsAllowed bool,
+) (ast.Declaration, error) {
 
 	const functionBlockIsOptional = true
 
@@ -1603,6 +1786,7 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaratio
 					staticPos,
 					nativePos,
 					docString,
+					computedFieldsAllowed,
 				)
 
 			case KeywordCase:
@@ -1678,6 +1862,16 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaratio
 				}
 				return parseEntitlementOrMappingDeclaration(p, access, accessPos, docString)
 
+			case KeywordTypealias:
+				err := rejectStaticAndNativeModifiers(p, staticPos, nativePos, common.DeclarationKindTypeAlias)
+				if err != nil {
+					return nil, err
+				}
+				if purity != ast.FunctionPurityUnspecified {
+					return nil, NewSyntaxError(*purityPos, "invalid view modifier for type alias")
+				}
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
 			case KeywordEnum:
 				if purity != ast.FunctionPurityUnspecified {
 					return nil, NewSyntaxError(*purityPos, "invalid view modifier for enum")
@@ -1914,7 +2108,7 @@ func parseSpecialFunctionDeclaration(
 	startPos := ast.EarliestPosition(identifier.Pos, accessPos, purityPos, staticPos, nativePos)
 
 	parameterList, returnTypeAnnotation, functionBlock, err :=
-		parseFunctionParameterListAndRest(p, functionBlockIsOptional)
+		parseFunctionParameterListAndRest(p, functionBlockIsOptional, defaultArgumentsAllowed)
 	if err != nil {
 		return nil, err
 	}
@@ -19,6 +19,8 @@
 package parser
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strings"
@@ -389,7 +391,7 @@ func TestParseParameterList(t *testing.T) {
 			nil,
 			[]byte(input),
 			func(p *parser) (*ast.ParameterList, error) {
-				return parseParameterList(p, false)
+				return parseParameterList(p, defaultArgumentsForbidden)
 			},
 			Config{},
 		)
@@ -1423,17 +1425,11 @@ func TestParseFunctionDeclaration(t *testing.T) {
 		)
 	})
 
-	t.Run("with empty type parameters, enabled", func(t *testing.T) {
+	t.Run("with empty type parameters", func(t *testing.T) {
 
 		t.Parallel()
 
-		result, errs := ParseDeclarations(
-			nil,
-			[]byte("fun foo  < > () {}"),
-			Config{
-				TypeParametersEnabled: true,
-			},
-		)
+		result, errs := testParseDeclarations("fun foo  < > () {}")
 		require.Empty(t, errs)
 
 		AssertEqualWithDiff(t,
@@ -1473,17 +1469,11 @@ func TestParseFunctionDeclaration(t *testing.T) {
 		)
 	})
 
-	t.Run("with type parameters, single type parameter, enabled", func(t *testing.T) {
+	t.Run("with type parameters, single type parameter", func(t *testing.T) {
 
 		t.Parallel()
 
-		result, errs := ParseDeclarations(
-			nil,
-			[]byte("fun foo  < A  > () {}"),
-			Config{
-				TypeParametersEnabled: true,
-			},
-		)
+		result, errs := testParseDeclarations("fun foo  < A  > () {}")
 		require.Empty(t, errs)
 
 		AssertEqualWithDiff(t,
@@ -1530,17 +1520,11 @@ func TestParseFunctionDeclaration(t *testing.T) {
 		)
 	})
 
-	t.Run("with type parameters, multiple parameters, type bound, enabled", func(t *testing.T) {
+	t.Run("with type parameters, multiple parameters, type bound", func(t *testing.T) {
 
 		t.Parallel()
 
-		result, errs := ParseDeclarations(
-			nil,
-			[]byte("fun foo  < A  , B : C > () {}"),
-			Config{
-				TypeParametersEnabled: true,
-			},
-		)
+		result, errs := testParseDeclarations("fun foo  < A  , B : C > () {}")
 		require.Empty(t, errs)
 
 		AssertEqualWithDiff(t,
@@ -1602,34 +1586,11 @@ func TestParseFunctionDeclaration(t *testing.T) {
 		)
 	})
 
-	t.Run("with type parameters, disabled", func(t *testing.T) {
+	t.Run("missing type parameter list end", func(t *testing.T) {
 
 		t.Parallel()
 
-		_, errs := testParseDeclarations("fun foo<A>() {}")
-
-		AssertEqualWithDiff(t,
-			[]error{
-				&SyntaxError{
-					Message: "expected '(' as start of parameter list, got '<'",
-					Pos:     ast.Position{Offset: 7, Line: 1, Column: 7},
-				},
-			},
-			errs,
-		)
-	})
-
-	t.Run("missing type parameter list end, enabled", func(t *testing.T) {
-
-		t.Parallel()
-
-		_, errs := ParseDeclarations(
-			nil,
-			[]byte("fun foo  < "),
-			Config{
-				TypeParametersEnabled: true,
-			},
-		)
+		_, errs := testParseDeclarations("fun foo  < ")
 
 		AssertEqualWithDiff(t,
 			[]error{
@@ -1642,17 +1603,11 @@ func TestParseFunctionDeclaration(t *testing.T) {
 		)
 	})
 
-	t.Run("missing type parameter list separator, enabled", func(t *testing.T) {
+	t.Run("missing type parameter list separator", func(t *testing.T) {
 
 		t.Parallel()
 
-		_, errs := ParseDeclarations(
-			nil,
-			[]byte("fun foo  < A B > () { } "),
-			Config{
-				TypeParametersEnabled: true,
-			},
-		)
+		_, errs := testParseDeclarations("fun foo  < A B > () { } ")
 
 		AssertEqualWithDiff(t,
 			[]error{
@@ -2778,6 +2733,8 @@ func TestParseFieldWithVariableKind(t *testing.T) {
 			nil,
 			[]byte(input),
 			func(p *parser) (*ast.FieldDeclaration, error) {
+				const computedFieldAllowed = true
+
 				return parseFieldWithVariableKind(
 					p,
 					ast.AccessNotSpecified,
@@ -2785,6 +2742,7 @@ func TestParseFieldWithVariableKind(t *testing.T) {
 					nil,
 					nil,
 					"",
+					computedFieldAllowed,
 				)
 			},
 			Config{},
@@ -2858,6 +2816,171 @@ func TestParseFieldWithVariableKind(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("computed", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := parse("let x : Int { return 1 }")
+		require.Empty(t, errs)
+
+		AssertEqualWithDiff(t,
+			&ast.FieldDeclaration{
+				Access:       ast.AccessNotSpecified,
+				VariableKind: ast.VariableKindConstant,
+				Identifier: ast.Identifier{
+					Identifier: "x",
+					Pos:        ast.Position{Line: 1, Column: 4, Offset: 4},
+				},
+				TypeAnnotation: &ast.TypeAnnotation{
+					IsResource: false,
+					Type: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "Int",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+				},
+				Getter: &ast.FunctionBlock{
+					Block: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.ReturnStatement{
+								Expression: &ast.IntegerExpression{
+									PositiveLiteral: []byte("1"),
+									Value:           big.NewInt(1),
+									Base:            10,
+									Range: ast.Range{
+										StartPos: ast.Position{Line: 1, Column: 21, Offset: 21},
+										EndPos:   ast.Position{Line: 1, Column: 21, Offset: 21},
+									},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+									EndPos:   ast.Position{Line: 1, Column: 21, Offset: 21},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+							EndPos:   ast.Position{Line: 1, Column: 23, Offset: 23},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 23, Offset: 23},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("computed, variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := parse("var x : Int { return 1 }")
+
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "computed fields must be declared with `let`",
+					Pos:     ast.Position{Offset: 12, Line: 1, Column: 12},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("computed, in enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(`
+          enum E: UInt8 {
+              let x: Int { return 1 }
+          }
+        `)
+		require.Empty(t, errs)
+	})
+
+	t.Run("computed, not in enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, declarationKind := range []string{
+			"struct",
+			"resource",
+			"contract",
+			"struct interface",
+			"resource interface",
+			"contract interface",
+			"enum interface",
+		} {
+			t.Run(declarationKind, func(t *testing.T) {
+
+				t.Parallel()
+
+				_, errs := testParseDeclarations(fmt.Sprintf(
+					`%s T { let x: Int { return 1 } }`,
+					declarationKind,
+				))
+
+				offset := len(declarationKind) + 16
+
+				AssertEqualWithDiff(t,
+					[]error{
+						&SyntaxError{
+							Message: "computed fields are only supported in enums",
+							Pos:     ast.Position{Offset: offset, Line: 1, Column: offset},
+						},
+					},
+					errs,
+				)
+			})
+		}
+	})
+
+	t.Run("computed, in nested composite of enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(`
+          enum E: UInt8 {
+              struct S {
+                  let x: Int { return 1 }
+              }
+          }
+        `)
+
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "computed fields are only supported in enums",
+					Pos:     ast.Position{Offset: 81, Line: 4, Column: 29},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("computed, in attachment", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(`attachment A for S { let x: Int { return 1 } }`)
+
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "computed fields are only supported in enums",
+					Pos:     ast.Position{Offset: 32, Line: 1, Column: 32},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseField(t *testing.T) {
@@ -2869,9 +2992,12 @@ func TestParseField(t *testing.T) {
 			nil,
 			[]byte(input),
 			func(p *parser) (ast.Declaration, error) {
+				const computedFieldsAllowed = false
+
 				return parseMemberOrNestedDeclaration(
 					p,
 					"",
+					computedFieldsAllowed,
 				)
 			},
 			config,
@@ -3231,6 +3357,56 @@ func TestParseCompositeDeclaration(t *testing.T) {
 		)
 	})
 
+	t.Run("struct, type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations("struct Box<T: AnyStruct> {}")
+		require.Empty(t, errs)
+
+		AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessNotSpecified,
+					CompositeKind: common.CompositeKindStructure,
+					Identifier: ast.Identifier{
+						Identifier: "Box",
+						Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+					TypeParameterList: &ast.TypeParameterList{
+						TypeParameters: []*ast.TypeParameter{
+							{
+								Identifier: ast.Identifier{
+									Identifier: "T",
+									Pos:        ast.Position{Line: 1, Column: 11, Offset: 11},
+								},
+								TypeBound: &ast.TypeAnnotation{
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "AnyStruct",
+											Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 10, Offset: 10},
+							EndPos:   ast.Position{Line: 1, Column: 23, Offset: 23},
+						},
+					},
+					Members: &ast.Members{},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 26, Offset: 26},
+					},
+				},
+			},
+			result,
+		)
+	})
+
 	t.Run("struct, with fields, functions, and special functions", func(t *testing.T) {
 
 		t.Parallel()
@@ -4316,6 +4492,23 @@ func TestParseInterfaceDeclaration(t *testing.T) {
 
 	t.Parallel()
 
+	t.Run("type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations("struct interface I<T> {}")
+
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "interface declarations cannot have type parameters",
+					Pos:     ast.Position{Offset: 18, Line: 1, Column: 18},
+				},
+			},
+			errs,
+		)
+	})
+
 	t.Run("struct, no conformances", func(t *testing.T) {
 
 		t.Parallel()
@@ -7197,6 +7390,126 @@ func TestParseImportWithFromIdentifier(t *testing.T) {
 	)
 }
 
+func TestParseImportWithCodeHash(t *testing.T) {
+
+	t.Parallel()
+
+	codeHash := bytes.Repeat([]byte{0xab}, ast.ImportCodeHashLength)
+	hexCodeHash := hex.EncodeToString(codeHash)
+
+	t.Run("address location", func(t *testing.T) {
+
+		t.Parallel()
+
+		code := `
+        import Foo from 0x1 hash "` + hexCodeHash + `"
+	`
+		result, errs := testParseProgram(code)
+		require.Empty(t, errs)
+
+		AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "Foo",
+							Pos:        ast.Position{Offset: 16, Line: 2, Column: 15},
+						},
+					},
+					Location: common.AddressLocation{
+						Address: common.MustBytesToAddress([]byte{0x1}),
+					},
+					CodeHash: codeHash,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 9, Line: 2, Column: 8},
+						EndPos:   ast.Position{Offset: 99, Line: 2, Column: 98},
+					},
+					LocationPos: ast.Position{Offset: 25, Line: 2, Column: 24},
+				},
+			},
+			result.Declarations(),
+		)
+	})
+
+	t.Run("identifier location", func(t *testing.T) {
+
+		t.Parallel()
+
+		code := `
+        import Foo hash "` + hexCodeHash + `"
+
+        /// bar
+        fun bar() {}
+	`
+		result, errs := testParseProgram(code)
+		require.Empty(t, errs)
+
+		declarations := result.Declarations()
+		require.Len(t, declarations, 2)
+
+		AssertEqualWithDiff(t,
+			&ast.ImportDeclaration{
+				Location: common.IdentifierLocation("Foo"),
+				CodeHash: codeHash,
+				Range: ast.Range{
+					StartPos: ast.Position{Offset: 9, Line: 2, Column: 8},
+					EndPos:   ast.Position{Offset: 90, Line: 2, Column: 89},
+				},
+				LocationPos: ast.Position{Offset: 16, Line: 2, Column: 15},
+			},
+			declarations[0],
+		)
+
+		// The doc string of the following declaration is not affected
+
+		require.IsType(t, &ast.FunctionDeclaration{}, declarations[1])
+		assert.Equal(t,
+			" bar",
+			declarations[1].(*ast.FunctionDeclaration).DocString,
+		)
+	})
+
+	t.Run("invalid hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+        import 0x1 hash "xyz"
+	`
+		_, errs := testParseDeclarations(code)
+
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: expected hex-encoded SHA3-256 hash (32 bytes)",
+					Pos:     ast.Position{Offset: 25, Line: 2, Column: 24},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("invalid hash length", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+        import 0x1 hash "abcd"
+	`
+		_, errs := testParseDeclarations(code)
+
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: expected hex-encoded SHA3-256 hash (32 bytes)",
+					Pos:     ast.Position{Offset: 25, Line: 2, Column: 24},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestParseInvalidImportWithPurity(t *testing.T) {
 
 	t.Parallel()
@@ -7217,33 +7530,129 @@ func TestParseInvalidImportWithPurity(t *testing.T) {
 	)
 }
 
+func TestParseDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	expectedParameterList := func(offset int) *ast.ParameterList {
+		position := func(n int) ast.Position {
+			return ast.Position{Line: 1, Column: offset + n, Offset: offset + n}
+		}
+
+		return &ast.ParameterList{
+			Parameters: []*ast.Parameter{
+				{
+					Identifier: ast.Identifier{
+						Identifier: "a",
+						Pos:        position(1),
+					},
+					TypeAnnotation: &ast.TypeAnnotation{
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "Int",
+								Pos:        position(4),
+							},
+						},
+						StartPos: position(4),
+					},
+					StartPos: position(1),
+				},
+				{
+					Identifier: ast.Identifier{
+						Identifier: "b",
+						Pos:        position(9),
+					},
+					TypeAnnotation: &ast.TypeAnnotation{
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "Int",
+								Pos:        position(12),
+							},
+						},
+						StartPos: position(12),
+					},
+					DefaultArgument: &ast.IntegerExpression{
+						PositiveLiteral: []byte("1"),
+						Value:           big.NewInt(1),
+						Base:            10,
+						Range: ast.Range{
+							StartPos: position(18),
+							EndPos:   position(18),
+						},
+					},
+					StartPos: position(9),
+				},
+			},
+			Range: ast.Range{
+				StartPos: position(0),
+				EndPos:   position(19),
+			},
+		}
+	}
+
+	t.Run("function declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations("fun foo(a: Int, b: Int = 1) {}")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.FunctionDeclaration{}, result[0])
+
+		AssertEqualWithDiff(t,
+			expectedParameterList(7),
+			result[0].(*ast.FunctionDeclaration).ParameterList,
+		)
+	})
+
+	t.Run("initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations("struct S { init(a: Int, b: Int = 1) {} }")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.CompositeDeclaration{}, result[0])
+
+		initializers := result[0].(*ast.CompositeDeclaration).Members.Initializers()
+		require.Len(t, initializers, 1)
+
+		AssertEqualWithDiff(t,
+			expectedParameterList(15),
+			initializers[0].FunctionDeclaration.ParameterList,
+		)
+	})
+}
+
 func TestParseInvalidDefaultArgument(t *testing.T) {
 
 	t.Parallel()
 
-	t.Run("function declaration ", func(t *testing.T) {
+	t.Run("function expression ", func(t *testing.T) {
 
 		t.Parallel()
 
-		_, errs := testParseDeclarations(" access(all) fun foo ( a : Int = 3) { } ")
+		_, errs := testParseDeclarations(" let foo = fun ( a : Int = 3) { } ")
 
 		AssertEqualWithDiff(t, []error{
 			&SyntaxError{
-				Pos:     ast.Position{Line: 1, Column: 31, Offset: 31},
+				Pos:     ast.Position{Line: 1, Column: 25, Offset: 25},
 				Message: "cannot use a default argument for this function",
 			},
 		}, errs)
 	})
 
-	t.Run("function expression ", func(t *testing.T) {
+	t.Run("transaction parameter", func(t *testing.T) {
 
 		t.Parallel()
 
-		_, errs := testParseDeclarations(" let foo = fun ( a : Int = 3) { } ")
+		_, errs := testParseDeclarations("transaction(a: Int = 1) {}")
 
 		AssertEqualWithDiff(t, []error{
 			&SyntaxError{
-				Pos:     ast.Position{Line: 1, Column: 25, Offset: 25},
+				Pos:     ast.Position{Line: 1, Column: 19, Offset: 19},
 				Message: "cannot use a default argument for this function",
 			},
 		}, errs)
@@ -8583,9 +8992,12 @@ func TestParseNestedPragma(t *testing.T) {
 			nil,
 			[]byte(input),
 			func(p *parser) (ast.Declaration, error) {
+				const computedFieldsAllowed = false
+
 				return parseMemberOrNestedDeclaration(
 					p,
 					"",
+					computedFieldsAllowed,
 				)
 			},
 			config,
@@ -9804,3 +10216,158 @@ func TestParseKeywordsAsFieldNames(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTypeAliasDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("basic", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(" access(all) typealias Balances = {Address: UFix64}")
+		require.Empty(t, errs)
+
+		AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.TypeAliasDeclaration{
+					Access: ast.AccessAll,
+					Identifier: ast.Identifier{
+						Identifier: "Balances",
+						Pos:        ast.Position{Line: 1, Column: 23, Offset: 23},
+					},
+					AliasedType: &ast.DictionaryType{
+						KeyType: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "Address",
+								Pos:        ast.Position{Line: 1, Column: 35, Offset: 35},
+							},
+						},
+						ValueType: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "UFix64",
+								Pos:        ast.Position{Line: 1, Column: 44, Offset: 44},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 34, Offset: 34},
+							EndPos:   ast.Position{Line: 1, Column: 50, Offset: 50},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 50, Offset: 50},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`
+            access(all) contract C {
+                /// Doc
+                access(all) typealias IDs = [UInt64]
+            }
+        `)
+		require.Empty(t, errs)
+
+		AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessAll,
+					CompositeKind: common.CompositeKindContract,
+					Identifier: ast.Identifier{
+						Identifier: "C",
+						Pos:        ast.Position{Offset: 34, Line: 2, Column: 33},
+					},
+					Members: ast.NewUnmeteredMembers(
+						[]ast.Declaration{
+							&ast.TypeAliasDeclaration{
+								Access:    ast.AccessAll,
+								DocString: " Doc",
+								Identifier: ast.Identifier{
+									Identifier: "IDs",
+									Pos:        ast.Position{Offset: 100, Line: 4, Column: 38},
+								},
+								AliasedType: &ast.VariableSizedType{
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "UInt64",
+											Pos:        ast.Position{Offset: 107, Line: 4, Column: 45},
+										},
+									},
+									Range: ast.Range{
+										StartPos: ast.Position{Offset: 106, Line: 4, Column: 44},
+										EndPos:   ast.Position{Offset: 113, Line: 4, Column: 51},
+									},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Offset: 78, Line: 4, Column: 16},
+									EndPos:   ast.Position{Offset: 113, Line: 4, Column: 51},
+								},
+							},
+						},
+					),
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 13, Line: 2, Column: 12},
+						EndPos:   ast.Position{Offset: 127, Line: 5, Column: 12},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("no identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(" access(all) typealias")
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected identifier following type alias declaration, got EOF",
+					Pos:     ast.Position{Offset: 22, Line: 1, Column: 22},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("no equal sign", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(" access(all) typealias T Int")
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token '='",
+					Pos:     ast.Position{Offset: 25, Line: 1, Column: 25},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("view modifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(" access(all) view typealias T = Int")
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid view modifier for type alias",
+					Pos:     ast.Position{Offset: 13, Line: 1, Column: 13},
+				},
+			},
+			errs,
+		)
+	})
+}
@@ -847,7 +847,7 @@ func defineIdentifierExpression() {
 
 func parseFunctionExpression(p *parser, token lexer.Token, purity ast.FunctionPurity) (*ast.FunctionExpression, error) {
 	parameterList, returnTypeAnnotation, functionBlock, err :=
-		parseFunctionParameterListAndRest(p, false)
+		parseFunctionParameterListAndRest(p, false, defaultArgumentsForbidden)
 	if err != nil {
 		return nil, err
 	}
@@ -2890,6 +2890,42 @@ func TestParseCreate(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("type arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseExpression("create R<Int>()")
+		require.Empty(t, errs)
+
+		AssertEqualWithDiff(t,
+			&ast.CreateExpression{
+				InvocationExpression: &ast.InvocationExpression{
+					InvokedExpression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "R",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					TypeArguments: []*ast.TypeAnnotation{
+						{
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "Int",
+									Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+								},
+							},
+							StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+					ArgumentsStartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+					EndPos:            ast.Position{Line: 1, Column: 14, Offset: 14},
+				},
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+			},
+			result,
+		)
+	})
 }
 
 func TestParseNil(t *testing.T) {
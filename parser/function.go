@@ -32,7 +32,17 @@ func parsePurityAnnotation(p *parser) ast.FunctionPurity {
 	return ast.FunctionPurityUnspecified
 }
 
-func parseParameterList(p *parser, expectDefaultArguments bool) (*ast.ParameterList, error) {
+// defaultArgumentsMode specifies if the parameters of a parameter list
+// must, may, or may not have default arguments
+type defaultArgumentsMode uint8
+
+const (
+	defaultArgumentsForbidden defaultArgumentsMode = iota
+	defaultArgumentsAllowed
+	defaultArgumentsRequired
+)
+
+func parseParameterList(p *parser, defaultArguments defaultArgumentsMode) (*ast.ParameterList, error) {
 	var parameters []*ast.Parameter
 
 	p.skipSpaceAndComments()
@@ -63,7 +73,7 @@ func parseParameterList(p *parser, expectDefaultArguments bool) (*ast.ParameterL
 					Pos: p.current.StartPos,
 				})
 			}
-			parameter, err := parseParameter(p, expectDefaultArguments)
+			parameter, err := parseParameter(p, defaultArguments)
 			if err != nil {
 				return nil, err
 			}
@@ -120,7 +130,7 @@ func parseParameterList(p *parser, expectDefaultArguments bool) (*ast.ParameterL
 	), nil
 }
 
-func parseParameter(p *parser, expectDefaultArgument bool) (*ast.Parameter, error) {
+func parseParameter(p *parser, defaultArguments defaultArgumentsMode) (*ast.Parameter, error) {
 	p.skipSpaceAndComments()
 
 	startPos := p.current.StartPos
@@ -171,7 +181,8 @@ func parseParameter(p *parser, expectDefaultArgument bool) (*ast.Parameter, erro
 
 	var defaultArgument ast.Expression
 
-	if expectDefaultArgument {
+	switch defaultArguments {
+	case defaultArgumentsRequired:
 		if !p.current.Is(lexer.TokenEqual) {
 			return nil, p.syntaxError(
 				"expected a default argument after type annotation, got %s",
@@ -179,6 +190,13 @@ func parseParameter(p *parser, expectDefaultArgument bool) (*ast.Parameter, erro
 			)
 		}
 
+	case defaultArgumentsForbidden:
+		if p.current.Is(lexer.TokenEqual) {
+			return nil, p.syntaxError("cannot use a default argument for this function")
+		}
+	}
+
+	if p.current.Is(lexer.TokenEqual) {
 		// Skip the =
 		p.nextSemanticToken()
 
@@ -186,9 +204,6 @@ func parseParameter(p *parser, expectDefaultArgument bool) (*ast.Parameter, erro
 		if err != nil {
 			return nil, err
 		}
-
-	} else if p.current.Is(lexer.TokenEqual) {
-		return nil, p.syntaxError("cannot use a default argument for this function")
 	}
 
 	return ast.NewParameter(
@@ -343,18 +358,13 @@ func parseFunctionDeclaration(
 	// Skip the identifier
 	p.next()
 
-	var typeParameterList *ast.TypeParameterList
-
-	if p.config.TypeParametersEnabled {
-		var err error
-		typeParameterList, err = parseTypeParameterList(p)
-		if err != nil {
-			return nil, err
-		}
+	typeParameterList, err := parseTypeParameterList(p)
+	if err != nil {
+		return nil, err
 	}
 
 	parameterList, returnTypeAnnotation, functionBlock, err :=
-		parseFunctionParameterListAndRest(p, functionBlockIsOptional)
+		parseFunctionParameterListAndRest(p, functionBlockIsOptional, defaultArgumentsAllowed)
 
 	if err != nil {
 		return nil, err
@@ -379,6 +389,7 @@ func parseFunctionDeclaration(
 func parseFunctionParameterListAndRest(
 	p *parser,
 	functionBlockIsOptional bool,
+	defaultArguments defaultArgumentsMode,
 ) (
 	parameterList *ast.ParameterList,
 	returnTypeAnnotation *ast.TypeAnnotation,
@@ -387,7 +398,7 @@ func parseFunctionParameterListAndRest(
 ) {
 	// Parameter list
 
-	parameterList, err = parseParameterList(p, false)
+	parameterList, err = parseParameterList(p, defaultArguments)
 	if err != nil {
 		return
 	}
@@ -92,6 +92,7 @@ const (
 	KeywordRepeat      = "repeat"
 	KeywordGuard       = "guard"
 	KeywordIs          = "is"
+	KeywordHash        = "hash"
 	// NOTE: ensure to update allKeywords when adding a new keyword
 )
 
@@ -166,6 +167,7 @@ var allKeywords = []string{
 	KeywordRepeat,
 	KeywordGuard,
 	KeywordIs,
+	KeywordHash,
 }
 
 // SoftKeywords are keywords that can be used as identifiers anywhere,
@@ -179,6 +181,7 @@ var SoftKeywords = []string{
 	KeywordRemove,
 	KeywordTo,
 	KeywordType,
+	KeywordHash,
 }
 
 var softKeywordsTable = mph.Build(SoftKeywords)
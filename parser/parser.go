@@ -55,8 +55,12 @@ type Config struct {
 	//
 	// This option exists so the old behaviour can be enabled to allow developers to update their code.
 	IgnoreLeadingIdentifierEnabled bool
-	// TypeParametersEnabled determines if type parameters are enabled
-	TypeParametersEnabled bool
+	// CommentsEnabled determines if comments and blank lines are recorded
+	// and attached to the elements of parsed programs (see ast.Comments).
+	//
+	// This option is intended for source-to-source tools, like formatters.
+	// Recording comments is not metered
+	CommentsEnabled bool
 }
 
 type parser struct {
@@ -721,6 +725,10 @@ func ParseProgramFromTokenStream(
 
 	program = ast.NewProgram(memoryGauge, declarations)
 
+	if config.CommentsEnabled {
+		attachProgramComments(program, input)
+	}
+
 	return
 }
 
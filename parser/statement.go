@@ -92,9 +92,9 @@ func parseStatement(p *parser) (ast.Statement, error) {
 		case KeywordSwitch:
 			return parseSwitchStatement(p)
 		case KeywordWhile:
-			return parseWhileStatement(p)
+			return parseWhileStatement(p, nil)
 		case KeywordFor:
-			return parseForStatement(p)
+			return parseForStatement(p, nil)
 		case KeywordEmit:
 			return parseEmitStatement(p)
 		case KeywordRemove:
@@ -121,6 +121,12 @@ func parseStatement(p *parser) (ast.Statement, error) {
 			// The `fun` keyword is ambiguous: it either introduces a function expression
 			// or a function declaration, depending on if an identifier follows, or not.
 			return parseFunctionDeclarationOrFunctionExpressionStatement(p, ast.FunctionPurityUnspecified, nil)
+
+		default:
+			// The identifier might be the label of a loop, e.g. `outer: while ...`
+			if !IsHardKeyword(string(p.currentTokenSource())) && isLoopLabel(p) {
+				return parseLabeledLoopStatement(p)
+			}
 		}
 	}
 
@@ -194,18 +200,13 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(
 
 		p.next()
 
-		var typeParameterList *ast.TypeParameterList
-
-		if p.config.TypeParametersEnabled {
-			var err error
-			typeParameterList, err = parseTypeParameterList(p)
-			if err != nil {
-				return nil, err
-			}
+		typeParameterList, err := parseTypeParameterList(p)
+		if err != nil {
+			return nil, err
 		}
 
 		parameterList, returnTypeAnnotation, functionBlock, err :=
-			parseFunctionParameterListAndRest(p, false)
+			parseFunctionParameterListAndRest(p, false, defaultArgumentsAllowed)
 
 		if err != nil {
 			return nil, err
@@ -227,7 +228,7 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(
 		), nil
 	} else {
 		parameterList, returnTypeAnnotation, functionBlock, err :=
-			parseFunctionParameterListAndRest(p, false)
+			parseFunctionParameterListAndRest(p, false, defaultArgumentsForbidden)
 		if err != nil {
 			return nil, err
 		}
@@ -286,14 +287,90 @@ func parseBreakStatement(p *parser) *ast.BreakStatement {
 	tokenRange := p.current.Range
 	p.next()
 
-	return ast.NewBreakStatement(p.memoryGauge, tokenRange)
+	label := parseControlStatementLabel(p)
+	if label != nil {
+		tokenRange.EndPos = label.EndPosition(p.memoryGauge)
+	}
+
+	return ast.NewBreakStatement(p.memoryGauge, label, tokenRange)
 }
 
 func parseContinueStatement(p *parser) *ast.ContinueStatement {
 	tokenRange := p.current.Range
 	p.next()
 
-	return ast.NewContinueStatement(p.memoryGauge, tokenRange)
+	label := parseControlStatementLabel(p)
+	if label != nil {
+		tokenRange.EndPos = label.EndPosition(p.memoryGauge)
+	}
+
+	return ast.NewContinueStatement(p.memoryGauge, label, tokenRange)
+}
+
+// parseControlStatementLabel parses the optional label
+// of a `break` or `continue` statement, e.g. `outer` in `break outer`.
+// The label must be on the same line as the keyword.
+func parseControlStatementLabel(p *parser) *ast.Identifier {
+	sawNewLine, _ := p.parseTrivia(triviaOptions{
+		skipNewlines: false,
+	})
+
+	if sawNewLine ||
+		!p.current.Is(lexer.TokenIdentifier) ||
+		IsHardKeyword(string(p.currentTokenSource())) {
+
+		return nil
+	}
+
+	label := p.tokenToIdentifier(p.current)
+	p.next()
+
+	return &label
+}
+
+// isLoopLabel returns true if the current identifier token is followed by a colon,
+// i.e. if it is the label of a loop statement.
+// The parser state is not changed.
+func isLoopLabel(p *parser) bool {
+	// save current stream state before looking ahead for the colon
+	cursor := p.tokens.Cursor()
+	current := p.current
+
+	p.nextSemanticToken()
+	isLabel := p.current.Is(lexer.TokenColon)
+
+	p.tokens.Revert(cursor)
+	p.current = current
+
+	return isLabel
+}
+
+// parseLabeledLoopStatement parses a loop statement with a label.
+//
+//	labeledLoopStatement : identifier ':' ( whileStatement | forStatement )
+func parseLabeledLoopStatement(p *parser) (ast.Statement, error) {
+	label := p.tokenToIdentifier(p.current)
+
+	// Skip the label and the colon
+	p.nextSemanticToken()
+	p.nextSemanticToken()
+
+	switch {
+	case p.isToken(p.current, lexer.TokenIdentifier, KeywordWhile):
+		return parseWhileStatement(p, &label)
+
+	case p.isToken(p.current, lexer.TokenIdentifier, KeywordFor):
+		return parseForStatement(p, &label)
+
+	default:
+		return nil, p.syntaxError(
+			"expected keyword %q or %q after label %q, got %s",
+			KeywordWhile,
+			KeywordFor,
+			label.Identifier,
+			p.current.Type,
+		)
+	}
 }
 
 func parseIfStatement(p *parser) (*ast.IfStatement, error) {
@@ -395,9 +472,12 @@ func parseIfStatement(p *parser) (*ast.IfStatement, error) {
 	return result, nil
 }
 
-func parseWhileStatement(p *parser) (*ast.WhileStatement, error) {
+func parseWhileStatement(p *parser, label *ast.Identifier) (*ast.WhileStatement, error) {
 
 	startPos := p.current.StartPos
+	if label != nil {
+		startPos = label.Pos
+	}
 	p.next()
 
 	expression, err := parseExpression(p, lowestBindingPower)
@@ -410,12 +490,15 @@ func parseWhileStatement(p *parser) (*ast.WhileStatement, error) {
 		return nil, err
 	}
 
-	return ast.NewWhileStatement(p.memoryGauge, expression, block, startPos), nil
+	return ast.NewWhileStatement(p.memoryGauge, label, expression, block, startPos), nil
 }
 
-func parseForStatement(p *parser) (*ast.ForStatement, error) {
+func parseForStatement(p *parser, label *ast.Identifier) (*ast.ForStatement, error) {
 
 	startPos := p.current.StartPos
+	if label != nil {
+		startPos = label.Pos
+	}
 	p.nextSemanticToken()
 
 	if p.isToken(p.current, lexer.TokenIdentifier, KeywordIn) {
@@ -426,27 +509,39 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 		p.next()
 	}
 
-	firstValue, err := p.mustIdentifier()
-	if err != nil {
-		return nil, err
-	}
-
-	p.skipSpaceAndComments()
-
 	var index *ast.Identifier
+	var key *ast.Identifier
 	var identifier ast.Identifier
 
-	if p.current.Is(lexer.TokenComma) {
-		p.nextSemanticToken()
-		index = &firstValue
-		identifier, err = p.mustIdentifier()
+	if p.current.Is(lexer.TokenParenOpen) {
+		keyIdentifier, valueIdentifier, err := parseForStatementKeyValuePair(p)
 		if err != nil {
 			return nil, err
 		}
 
-		p.skipSpaceAndComments()
+		key = &keyIdentifier
+		identifier = valueIdentifier
+
 	} else {
-		identifier = firstValue
+		firstValue, err := p.mustIdentifier()
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments()
+
+		if p.current.Is(lexer.TokenComma) {
+			p.nextSemanticToken()
+			index = &firstValue
+			identifier, err = p.mustIdentifier()
+			if err != nil {
+				return nil, err
+			}
+
+			p.skipSpaceAndComments()
+		} else {
+			identifier = firstValue
+		}
 	}
 
 	if !p.isToken(p.current, lexer.TokenIdentifier, KeywordIn) {
@@ -471,14 +566,54 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 
 	return ast.NewForStatement(
 		p.memoryGauge,
+		label,
 		identifier,
 		index,
+		key,
 		block,
 		expression,
 		startPos,
 	), nil
 }
 
+// parseForStatementKeyValuePair parses the key-value pair variables
+// of a for-loop over a dictionary, i.e. `(key, value)`
+func parseForStatementKeyValuePair(p *parser) (key ast.Identifier, value ast.Identifier, err error) {
+
+	// Skip the opening paren
+	p.nextSemanticToken()
+
+	key, err = p.mustIdentifier()
+	if err != nil {
+		return
+	}
+
+	p.skipSpaceAndComments()
+
+	_, err = p.mustOne(lexer.TokenComma)
+	if err != nil {
+		return
+	}
+
+	p.skipSpaceAndComments()
+
+	value, err = p.mustIdentifier()
+	if err != nil {
+		return
+	}
+
+	p.skipSpaceAndComments()
+
+	_, err = p.mustOne(lexer.TokenParenClose)
+	if err != nil {
+		return
+	}
+
+	p.skipSpaceAndComments()
+
+	return
+}
+
 func parseBlock(p *parser) (*ast.Block, error) {
 	startToken, err := p.mustOne(lexer.TokenBraceOpen)
 	if err != nil {
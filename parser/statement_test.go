@@ -912,6 +912,243 @@ func TestParseForStatementIndexBinding(t *testing.T) {
 	})
 }
 
+func TestParseForStatementKeyValueBinding(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("empty block", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("for (k, v) in y { }")
+		require.Empty(t, errs)
+
+		AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.ForStatement{
+					Identifier: ast.Identifier{
+						Identifier: "v",
+						Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+					},
+					Key: &ast.Identifier{
+						Identifier: "k",
+						Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "y",
+							Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+					Block: &ast.Block{
+						Statements: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+							EndPos:   ast.Position{Line: 1, Column: 18, Offset: 18},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseStatements("for (k) in y { }")
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token ','",
+					Pos:     ast.Position{Offset: 6, Line: 1, Column: 6},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing closing paren", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseStatements("for (k, v in y { }")
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token ')'",
+					Pos:     ast.Position{Offset: 10, Line: 1, Column: 10},
+				},
+			},
+			errs,
+		)
+	})
+}
+
+func TestParseLabeledLoops(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("while, break", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("outer: while true { break outer }")
+		require.Empty(t, errs)
+
+		AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.WhileStatement{
+					Label: &ast.Identifier{
+						Identifier: "outer",
+						Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+					Test: &ast.BoolExpression{
+						Value: true,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+							EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					Block: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.BreakStatement{
+								Label: &ast.Identifier{
+									Identifier: "outer",
+									Pos:        ast.Position{Line: 1, Column: 26, Offset: 26},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 20, Offset: 20},
+									EndPos:   ast.Position{Line: 1, Column: 30, Offset: 30},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 18, Offset: 18},
+							EndPos:   ast.Position{Line: 1, Column: 32, Offset: 32},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("for, continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("outer: for x in xs { continue outer }")
+		require.Empty(t, errs)
+
+		AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.ForStatement{
+					Label: &ast.Identifier{
+						Identifier: "outer",
+						Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+					},
+					Identifier: ast.Identifier{
+						Identifier: "x",
+						Pos:        ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "xs",
+							Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					Block: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.ContinueStatement{
+								Label: &ast.Identifier{
+									Identifier: "outer",
+									Pos:        ast.Position{Line: 1, Column: 30, Offset: 30},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 21, Offset: 21},
+									EndPos:   ast.Position{Line: 1, Column: 34, Offset: 34},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 19, Offset: 19},
+							EndPos:   ast.Position{Line: 1, Column: 36, Offset: 36},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("break, identifier on next line", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("while true {\nbreak\nx\n}")
+		require.Empty(t, errs)
+
+		AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.WhileStatement{
+					Test: &ast.BoolExpression{
+						Value: true,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 6, Offset: 6},
+							EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+					Block: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.BreakStatement{
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 2, Column: 0, Offset: 13},
+									EndPos:   ast.Position{Line: 2, Column: 4, Offset: 17},
+								},
+							},
+							&ast.ExpressionStatement{
+								Expression: &ast.IdentifierExpression{
+									Identifier: ast.Identifier{
+										Identifier: "x",
+										Pos:        ast.Position{Line: 3, Column: 0, Offset: 19},
+									},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+							EndPos:   ast.Position{Line: 4, Column: 0, Offset: 21},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("label without loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseStatements("outer: x")
+		AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected keyword \"while\" or \"for\" after label \"outer\", got identifier",
+					Pos:     ast.Position{Offset: 7, Line: 1, Column: 7},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestParseEmit(t *testing.T) {
 
 	t.Parallel()
@@ -52,7 +52,7 @@ func parseTransactionDeclaration(p *parser, docString string) (*ast.TransactionD
 	var err error
 
 	if p.current.Is(lexer.TokenParenOpen) {
-		parameterList, err = parseParameterList(p, false)
+		parameterList, err = parseParameterList(p, defaultArgumentsForbidden)
 		if err != nil {
 			return nil, err
 		}
@@ -230,6 +230,8 @@ func parseTransactionFields(p *parser) (fields []*ast.FieldDeclaration, err erro
 		case lexer.TokenIdentifier:
 			switch string(p.currentTokenSource()) {
 			case KeywordLet, KeywordVar:
+				const computedFieldAllowed = false
+
 				field, err := parseFieldWithVariableKind(
 					p,
 					ast.AccessNotSpecified,
@@ -237,6 +239,7 @@ func parseTransactionFields(p *parser) (fields []*ast.FieldDeclaration, err erro
 					nil,
 					nil,
 					docString,
+					computedFieldAllowed,
 				)
 				if err != nil {
 					return nil, err
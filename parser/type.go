@@ -783,6 +783,27 @@ func parseNominalTypeInvocationRemainder(p *parser) (*ast.InvocationExpression,
 	}
 
 	p.skipSpaceAndComments()
+
+	// Parse the optional type arguments of a generic composite type
+
+	var typeArguments []*ast.TypeAnnotation
+	if p.current.Is(lexer.TokenLess) {
+		// Skip the `<` token.
+		p.nextSemanticToken()
+
+		typeArguments, err = parseCommaSeparatedTypeAnnotations(p, lexer.TokenGreater)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = p.mustOne(lexer.TokenGreater)
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments()
+	}
+
 	parenOpenToken, err := p.mustOne(lexer.TokenParenOpen)
 	if err != nil {
 		return nil, err
@@ -812,7 +833,7 @@ func parseNominalTypeInvocationRemainder(p *parser) (*ast.InvocationExpression,
 	return ast.NewInvocationExpression(
 		p.memoryGauge,
 		invokedExpression,
-		nil,
+		typeArguments,
 		arguments,
 		argumentsStartPos,
 		endPos,
@@ -39,4 +39,7 @@ type Config struct {
 	LegacyContractUpgradeEnabled bool
 	// StorageFormatV2Enabled specifies whether storage format V2 is enabled
 	StorageFormatV2Enabled bool
+	// EnumSwitchExhaustivenessCheckEnabled specifies whether switch statements over enums,
+	// which have no default case and do not cover all enum cases, are reported
+	EnumSwitchExhaustivenessCheckEnabled bool
 }
@@ -2307,6 +2307,73 @@ func TestRuntimeContractUpdateValidation(t *testing.T) {
 		assertEnumCaseMismatchError(t, childErrors[2], "left", "up")
 	})
 
+	testWithValidators(t, "enum add functions, computed fields, and conformances", func(t *testing.T, config Config) {
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) enum Foo: UInt8 {
+                    access(all) case up
+                    access(all) case down
+                }
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct interface Named {
+                    access(all) view fun name(): String
+                }
+
+                access(all) enum Foo: UInt8, Named {
+                    access(all) case up
+                    access(all) case down
+
+                    access(all) let isUp: Bool {
+                        return self == Foo.up
+                    }
+
+                    access(all) view fun name(): String {
+                        return self.isUp ? "up" : "down"
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		require.NoError(t, err)
+	})
+
+	testWithValidators(t, "enum remove conformance", func(t *testing.T, config Config) {
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) struct interface Named {}
+
+                access(all) enum Foo: UInt8, Named {
+                    access(all) case up
+                    access(all) case down
+                }
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct interface Named {}
+
+                access(all) enum Foo: UInt8 {
+                    access(all) case up
+                    access(all) case down
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		RequireError(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+		assertConformanceMismatchError(t, cause, "Foo", "Named")
+	})
+
 	testWithValidators(t, "Remove and add struct", func(t *testing.T, config Config) {
 
 		const oldCode = `
@@ -2522,6 +2589,150 @@ func TestRuntimeContractUpdateValidation(t *testing.T) {
 		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
 		require.NoError(t, err)
 	})
+
+	testWithValidators(t, "Add overloads", func(t *testing.T, config Config) {
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) resource R {
+                    access(all) let a: Int
+
+                    init(a: Int) {
+                        self.a = a
+                    }
+
+                    access(all) fun foo(a: Int): Int {
+                        return a
+                    }
+                }
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) resource R {
+                    access(all) let a: Int
+
+                    init(a: Int) {
+                        self.a = a
+                    }
+
+                    init() {
+                        self.a = 0
+                    }
+
+                    access(all) fun foo(a: Int): Int {
+                        return a
+                    }
+
+                    access(all) fun foo(b: Int): Int {
+                        return b
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		require.NoError(t, err)
+	})
+
+	testWithValidators(t, "Remove and reorder overloads", func(t *testing.T, config Config) {
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) struct S {
+                    access(all) let a: Int
+
+                    init(a: Int) {
+                        self.a = a
+                    }
+
+                    init() {
+                        self.a = 0
+                    }
+
+                    access(all) fun foo(a: Int): Int {
+                        return a
+                    }
+
+                    access(all) fun foo(b: Int): Int {
+                        return b
+                    }
+
+                    access(all) fun foo(c: Int): Int {
+                        return c
+                    }
+                }
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct S {
+                    access(all) let a: Int
+
+                    init() {
+                        self.a = 0
+                    }
+
+                    access(all) fun foo(c: Int): Int {
+                        return c
+                    }
+
+                    access(all) fun foo(a: Int): Int {
+                        return a
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		require.NoError(t, err)
+	})
+
+	testWithValidators(t, "Add field in overloaded initializers", func(t *testing.T, config Config) {
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) struct S {
+                    access(all) let a: Int
+
+                    init(a: Int) {
+                        self.a = a
+                    }
+
+                    init() {
+                        self.a = 0
+                    }
+                }
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct S {
+                    access(all) let a: Int
+                    access(all) let b: Int
+
+                    init(a: Int) {
+                        self.a = a
+                        self.b = 0
+                    }
+
+                    init() {
+                        self.a = 0
+                        self.b = 0
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		RequireError(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+		assertExtraneousFieldError(t, cause, "S", "b")
+	})
 }
 
 func assertContractRemovalError(t *testing.T, err error, name string) {
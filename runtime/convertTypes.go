@@ -108,6 +108,10 @@ func ExportMeteredType(
 			return cadence.Fix64Type
 		case sema.UFix64Type:
 			return cadence.UFix64Type
+		case sema.Fix128Type:
+			return cadence.Fix128Type
+		case sema.UFix128Type:
+			return cadence.UFix128Type
 		case sema.PathType:
 			return cadence.PathType
 		case sema.StoragePathType:
@@ -260,6 +264,8 @@ func ExportMeteredType(
 			return exportCapabilityType(gauge, t, results)
 		case *sema.InclusiveRangeType:
 			return exportInclusiveRangeType(gauge, t, results)
+		case *sema.SetType:
+			return exportSetType(gauge, t, results)
 		}
 
 		panic(fmt.Sprintf("cannot export type %s", t))
@@ -496,6 +502,19 @@ func exportInclusiveRangeType(
 	)
 }
 
+func exportSetType(
+	gauge common.MemoryGauge,
+	t *sema.SetType,
+	results map[sema.TypeID]cadence.Type,
+) *cadence.SetType {
+	convertedElementType := ExportMeteredType(gauge, t.ElementType, results)
+
+	return cadence.NewMeteredSetType(
+		gauge,
+		convertedElementType,
+	)
+}
+
 func exportFunctionType(
 	gauge common.MemoryGauge,
 	t *sema.FunctionType,
@@ -731,6 +750,11 @@ func ImportType(memoryGauge common.MemoryGauge, t cadence.Type) interpreter.Stat
 			memoryGauge,
 			ImportType(memoryGauge, t.ElementType),
 		)
+	case *cadence.SetType:
+		return interpreter.NewSetStaticType(
+			memoryGauge,
+			ImportType(memoryGauge, t.ElementType),
+		)
 	case *cadence.StructType,
 		*cadence.ResourceType,
 		*cadence.EventType,
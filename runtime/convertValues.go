@@ -205,6 +205,16 @@ func exportValueWithInterpreter(
 		return cadence.Fix64(v), nil
 	case interpreter.UFix64Value:
 		return cadence.UFix64(v.UFix64Value), nil
+	case interpreter.Fix128Value:
+		return cadence.NewMeteredFix128FromRawFixedPointNumber(
+			inter,
+			new(big.Int).Set(v.BigInt),
+		)
+	case interpreter.UFix128Value:
+		return cadence.NewMeteredUFix128FromRawFixedPointNumber(
+			inter,
+			new(big.Int).Set(v.BigInt),
+		)
 	case *interpreter.CompositeValue:
 		return exportCompositeValue(
 			v,
@@ -226,6 +236,13 @@ func exportValueWithInterpreter(
 			locationRange,
 			seenReferences,
 		)
+	case *interpreter.SetValue:
+		return exportSetValue(
+			v,
+			inter,
+			locationRange,
+			seenReferences,
+		)
 	case interpreter.AddressValue:
 		return cadence.NewMeteredAddress(inter, v), nil
 	case interpreter.PathValue:
@@ -626,6 +643,59 @@ func exportDictionaryValue(
 	return dictionary.WithType(exportType), err
 }
 
+func exportSetValue(
+	v *interpreter.SetValue,
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	seenReferences seenReferences,
+) (
+	cadence.Set,
+	error,
+) {
+	set, err := cadence.NewMeteredSet(
+		inter,
+		v.Count(),
+		func() ([]cadence.Value, error) {
+			var err error
+			values := make([]cadence.Value, 0, v.Count())
+
+			v.Iterate(
+				inter,
+				func(element interpreter.Value) (resume bool) {
+
+					var convertedElement cadence.Value
+					convertedElement, err = exportValueWithInterpreter(
+						element,
+						inter,
+						locationRange,
+						seenReferences,
+					)
+					if err != nil {
+						return false
+					}
+
+					values = append(values, convertedElement)
+
+					return true
+				},
+			)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return values, nil
+		},
+	)
+	if err != nil {
+		return cadence.Set{}, err
+	}
+
+	exportType := ExportType(v.SemaType(inter), map[sema.TypeID]cadence.Type{}).(*cadence.SetType)
+
+	return set.WithType(exportType), err
+}
+
 func exportCompositeValueAsInclusiveRange(
 	v interpreter.Value,
 	inclusiveRangeType *sema.InclusiveRangeType,
@@ -886,12 +956,18 @@ func (i valueImporter) importValue(value cadence.Value, expectedType sema.Type)
 		return i.importFix64(v), nil
 	case cadence.UFix64:
 		return i.importUFix64(v), nil
+	case cadence.Fix128:
+		return i.importFix128(v), nil
+	case cadence.UFix128:
+		return i.importUFix128(v), nil
 	case cadence.Path:
 		return i.importPathValue(v), nil
 	case cadence.Array:
 		return i.importArrayValue(v, expectedType)
 	case cadence.Dictionary:
 		return i.importDictionaryValue(v, expectedType)
+	case cadence.Set:
+		return i.importSetValue(v, expectedType)
 	case cadence.Struct:
 		return i.importCompositeValue(
 			common.CompositeKindStructure,
@@ -1150,6 +1226,24 @@ func (i valueImporter) importUFix64(v cadence.UFix64) interpreter.UFix64Value {
 	)
 }
 
+func (i valueImporter) importFix128(v cadence.Fix128) interpreter.Fix128Value {
+	return interpreter.NewFix128Value(
+		i.inter,
+		func() *big.Int {
+			return v.Value
+		},
+	)
+}
+
+func (i valueImporter) importUFix128(v cadence.UFix128) interpreter.UFix128Value {
+	return interpreter.NewUFix128Value(
+		i.inter,
+		func() *big.Int {
+			return v.Value
+		},
+	)
+}
+
 func (i valueImporter) importString(v cadence.String) *interpreter.StringValue {
 	memoryUsage := common.NewStringMemoryUsage(len(v))
 	return interpreter.NewStringValue(
@@ -1416,6 +1510,68 @@ func (i valueImporter) importDictionaryValue(
 	), nil
 }
 
+func (i valueImporter) importSetValue(
+	v cadence.Set,
+	expectedType sema.Type,
+) (
+	*interpreter.SetValue,
+	error,
+) {
+	elements := make([]interpreter.Value, len(v.Values))
+
+	var elementType sema.Type
+
+	setType, ok := expectedType.(*sema.SetType)
+	if ok {
+		elementType = setType.ElementType
+	}
+
+	inter := i.inter
+	locationRange := i.locationRange
+
+	for elementIndex, element := range v.Values {
+		value, err := i.importValue(element, elementType)
+		if err != nil {
+			return nil, err
+		}
+		elements[elementIndex] = value
+	}
+
+	if setType == nil {
+		elementTypes := make([]sema.Type, len(elements))
+
+		for i, element := range elements {
+			elementType, err := inter.ConvertStaticToSemaType(element.StaticType(inter))
+			if err != nil {
+				return nil, err
+			}
+			elementTypes[i] = elementType
+		}
+
+		elementSuperType := sema.LeastCommonSuperType(elementTypes...)
+
+		if !sema.IsSubType(elementSuperType, sema.HashableStructType) {
+			return nil, errors.NewDefaultUserError(
+				"cannot import set: elements do not belong to the same type",
+			)
+		}
+
+		setType = sema.NewSetType(inter, elementSuperType)
+	}
+
+	setStaticType := interpreter.NewSetStaticType(
+		inter,
+		interpreter.ConvertSemaToStaticType(inter, setType.ElementType),
+	)
+
+	return interpreter.NewSetValue(
+		inter,
+		locationRange,
+		setStaticType,
+		elements...,
+	), nil
+}
+
 func (i valueImporter) importInclusiveRangeValue(
 	v *cadence.InclusiveRange,
 	expectedType sema.Type,
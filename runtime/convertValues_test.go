@@ -299,6 +299,21 @@ func TestRuntimeExportValue(t *testing.T) {
 					ElementType: cadence.AnyStructType,
 				}),
 		},
+		{
+			label: "Set",
+			valueFactory: func(inter *interpreter.Interpreter) interpreter.Value {
+				return interpreter.NewSetValue(
+					inter,
+					interpreter.EmptyLocationRange,
+					interpreter.NewSetStaticType(nil, interpreter.PrimitiveStaticTypeString),
+					interpreter.NewUnmeteredStringValue("a"),
+				)
+			},
+			expected: cadence.NewSet([]cadence.Value{
+				cadence.String("a"),
+			}).
+				WithType(cadence.NewSetType(cadence.StringType)),
+		},
 		{
 			label:    "Address",
 			value:    interpreter.NewUnmeteredAddressValueFromBytes([]byte{0x1}),
@@ -765,6 +780,23 @@ func TestRuntimeImportValue(t *testing.T) {
 				ValueType: sema.AnyStructType,
 			},
 		},
+		{
+			label: "Set",
+			expected: interpreter.NewSetValue(
+				NewTestInterpreter(t),
+				interpreter.EmptyLocationRange,
+				interpreter.NewSetStaticType(nil, interpreter.PrimitiveStaticTypeString),
+				interpreter.NewUnmeteredStringValue("a"),
+				interpreter.NewUnmeteredStringValue("b"),
+			),
+			value: cadence.NewSet([]cadence.Value{
+				cadence.String("a"),
+				cadence.String("b"),
+			}),
+			expectedType: &sema.SetType{
+				ElementType: sema.StringType,
+			},
+		},
 		{
 			label:    "Address",
 			expected: interpreter.NewUnmeteredAddressValueFromBytes([]byte{0x1}),
@@ -1015,6 +1047,14 @@ func TestRuntimeImportRuntimeType(t *testing.T) {
 				ValueType: interpreter.PrimitiveStaticTypeInt,
 			},
 		},
+		{
+			label: "Set",
+			input: cadence.NewSetType(cadence.IntType),
+			expected: interpreter.NewSetStaticType(
+				nil,
+				interpreter.PrimitiveStaticTypeInt,
+			),
+		},
 		{
 			label: "Unauthorized Reference",
 			input: &cadence.ReferenceType{
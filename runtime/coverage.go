@@ -23,11 +23,34 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/common"
 )
 
+// BranchCoverage records coverage information for the branches
+// of a conditional element, e.g. an if-statement.
+type BranchCoverage struct {
+	// The line of the conditional element.
+	Line int
+	// Contains the hit count for each branch of the element.
+	// A hit count of 0 means the branch was not taken.
+	Hits []int
+}
+
+// FunctionCoverage records coverage information for a function declaration.
+type FunctionCoverage struct {
+	// The name of the function, qualified with the names
+	// of the enclosing composite and interface declarations, if any.
+	Name string
+	// The line of the function declaration.
+	Line int
+	// The invocation count of the function.
+	// A hit count of 0 means the function was not invoked.
+	Hits int
+}
+
 // LocationCoverage records coverage information for a location.
 type LocationCoverage struct {
 	// Contains hit count for each line on a given location.
@@ -35,6 +58,12 @@ type LocationCoverage struct {
 	LineHits map[int]int
 	// Total number of statements on a given location.
 	Statements int
+	// Contains the branch coverage for each conditional element
+	// on a given location, keyed by the range of the element.
+	BranchHits map[ast.Range]*BranchCoverage
+	// Contains the function coverage for each function declaration
+	// on a given location, keyed by the function's key (see functionKey).
+	FunctionHits map[int]*FunctionCoverage
 }
 
 // AddLineHit increments the hit count for the given line.
@@ -46,6 +75,90 @@ func (c *LocationCoverage) AddLineHit(line int) {
 	c.LineHits[line]++
 }
 
+// AddBranchHit increments the hit count for the given branch
+// of the conditional element with the given range.
+// Unknown elements and branches are dropped.
+func (c *LocationCoverage) AddBranchHit(elementRange ast.Range, branch int) {
+	branchCoverage, ok := c.BranchHits[elementRange]
+	if !ok || branch < 0 || branch >= len(branchCoverage.Hits) {
+		return
+	}
+	branchCoverage.Hits[branch]++
+}
+
+// AddFunctionHit increments the invocation count for the function
+// with the given key. Unknown functions, e.g. function expressions, are dropped.
+func (c *LocationCoverage) AddFunctionHit(key int) {
+	functionCoverage, ok := c.FunctionHits[key]
+	if !ok {
+		return
+	}
+	functionCoverage.Hits++
+}
+
+// Branches returns the count of branches for a given location.
+func (c *LocationCoverage) Branches() int {
+	branches := 0
+	for _, branchCoverage := range c.BranchHits { // nolint:maprange
+		branches += len(branchCoverage.Hits)
+	}
+	return branches
+}
+
+// CoveredBranches returns the count of taken branches for a given location.
+// This is the number of branches with a hit count > 0.
+func (c *LocationCoverage) CoveredBranches() int {
+	coveredBranches := 0
+	for _, branchCoverage := range c.BranchHits { // nolint:maprange
+		for _, hits := range branchCoverage.Hits {
+			if hits > 0 {
+				coveredBranches += 1
+			}
+		}
+	}
+	return coveredBranches
+}
+
+// LineBranches returns the count of branches and the count of
+// taken branches for each line with conditional elements.
+func (c *LocationCoverage) LineBranches() map[int]LineBranchCoverage {
+	lineBranches := make(map[int]LineBranchCoverage)
+	for _, branchCoverage := range c.BranchHits { // nolint:maprange
+		lineBranchCoverage := lineBranches[branchCoverage.Line]
+		for _, hits := range branchCoverage.Hits {
+			lineBranchCoverage.Branches++
+			if hits > 0 {
+				lineBranchCoverage.CoveredBranches++
+			}
+		}
+		lineBranches[branchCoverage.Line] = lineBranchCoverage
+	}
+	return lineBranches
+}
+
+// LineBranchCoverage summarizes the branch coverage of a line.
+type LineBranchCoverage struct {
+	Branches        int
+	CoveredBranches int
+}
+
+// Functions returns the count of functions for a given location.
+func (c *LocationCoverage) Functions() int {
+	return len(c.FunctionHits)
+}
+
+// CoveredFunctions returns the count of invoked functions for a given location.
+// This is the number of functions with a hit count > 0.
+func (c *LocationCoverage) CoveredFunctions() int {
+	coveredFunctions := 0
+	for _, functionCoverage := range c.FunctionHits { // nolint:maprange
+		if functionCoverage.Hits > 0 {
+			coveredFunctions += 1
+		}
+	}
+	return coveredFunctions
+}
+
 // Percentage returns a string representation of the covered
 // statements percentage. It is defined as the ratio of covered
 // lines over the total statements for a given location.
@@ -96,6 +209,19 @@ func (c *LocationCoverage) MissedLines() []int {
 	return missedLines
 }
 
+// sortedLines returns the lines with statements for a given location,
+// sorted in ascending order.
+func (c *LocationCoverage) sortedLines() []int {
+	i := 0
+	lines := make([]int, len(c.LineHits))
+	for line := range c.LineHits { // nolint:maprange
+		lines[i] = line
+		i++
+	}
+	sort.Ints(lines)
+	return lines
+}
+
 // NewLocationCoverage creates and returns a *LocationCoverage with the
 // given lineHits map.
 func NewLocationCoverage(lineHits map[int]int) *LocationCoverage {
@@ -171,8 +297,50 @@ func (r *CoverageReport) AddLineHit(location Location, line int) {
 	locationCoverage.AddLineHit(line)
 }
 
+// AddBranchHit increments the hit count for the given branch of the
+// conditional element with the given range, on the given location.
+// The method call is a NO-OP in the same cases as AddLineHit.
+func (r *CoverageReport) AddBranchHit(location Location, elementRange ast.Range, branch int) {
+	if r.IsLocationExcluded(location) {
+		return
+	}
+
+	if !r.IsLocationInspected(location) {
+		return
+	}
+
+	locationCoverage := r.Coverage[location]
+	locationCoverage.AddBranchHit(elementRange, branch)
+}
+
+// AddFunctionHit increments the invocation count for the function with
+// the given key (see functionKey), on the given location.
+// The method call is a NO-OP in the same cases as AddLineHit.
+func (r *CoverageReport) AddFunctionHit(location Location, key int) {
+	if r.IsLocationExcluded(location) {
+		return
+	}
+
+	if !r.IsLocationInspected(location) {
+		return
+	}
+
+	locationCoverage := r.Coverage[location]
+	locationCoverage.AddFunctionHit(key)
+}
+
+// functionKey returns the key of a function, which is the offset
+// of its parameter list. Functions without a parameter list,
+// i.e. the execute block of a transaction, have the key -1.
+func functionKey(parameterList *ast.ParameterList) int {
+	if parameterList == nil {
+		return -1
+	}
+	return parameterList.StartPos.Offset
+}
+
 // InspectProgram inspects the elements of the given *ast.Program, and counts its
-// statements. If inspection is successful, the location is marked as inspected.
+// statements, the branches of its conditional elements, and its functions. If inspection is successful, the location is marked as inspected.
 // If the given location is excluded from coverage collection, the method call
 // results in a NO-OP.
 // If the CoverageReport.LocationFilter is present, and calling it with the given
@@ -190,14 +358,82 @@ func (r *CoverageReport) InspectProgram(location Location, program *ast.Program)
 		line := hasPosition.StartPosition().Line
 		lineHits[line] = 0
 	}
+	branchHits := make(map[ast.Range]*BranchCoverage)
+	recordBranches := func(element ast.Element, branches int) {
+		branchHits[ast.NewRangeFromPositioned(nil, element)] = &BranchCoverage{
+			Line: element.StartPosition().Line,
+			Hits: make([]int, branches),
+		}
+	}
+	functionHits := make(map[int]*FunctionCoverage)
+	var typeNames []string
+	recordFunction := func(declaration *ast.FunctionDeclaration, name string) {
+		if declaration.FunctionBlock == nil {
+			return
+		}
+		qualifiedName := strings.Join(append(typeNames[:len(typeNames):len(typeNames)], name), ".")
+		functionHits[functionKey(declaration.ParameterList)] = &FunctionCoverage{
+			Name: qualifiedName,
+			Line: declaration.StartPosition().Line,
+		}
+	}
 	var depth int
 
 	inspector := ast.NewInspector(program)
 	inspector.Elements(
 		nil, func(element ast.Element, push bool) bool {
+			switch element := element.(type) {
+			case *ast.CompositeDeclaration:
+				if push {
+					typeNames = append(typeNames, element.Identifier.Identifier)
+				} else {
+					typeNames = typeNames[:len(typeNames)-1]
+				}
+			case *ast.InterfaceDeclaration:
+				if push {
+					typeNames = append(typeNames, element.Identifier.Identifier)
+				} else {
+					typeNames = typeNames[:len(typeNames)-1]
+				}
+			}
+
 			if push {
 				depth++
 
+				// Track the branches of conditional elements.
+				// A switch-statement without a default case
+				// has an implicit default branch, which follows the cases.
+				switch element := element.(type) {
+				case *ast.IfStatement:
+					recordBranches(element, 2)
+				case *ast.SwitchStatement:
+					branches := len(element.Cases)
+					hasDefault := false
+					for _, switchCase := range element.Cases {
+						if switchCase.Expression == nil {
+							hasDefault = true
+						}
+					}
+					if !hasDefault {
+						branches++
+					}
+					recordBranches(element, branches)
+				case *ast.ConditionalExpression:
+					recordBranches(element, 2)
+				case *ast.BinaryExpression:
+					if element.Operation == ast.OperationNilCoalesce {
+						recordBranches(element, 2)
+					}
+				case *ast.FunctionDeclaration:
+					recordFunction(element, element.Identifier.Identifier)
+				case *ast.SpecialFunctionDeclaration:
+					name := element.FunctionDeclaration.Identifier.Identifier
+					if name == "" {
+						name = element.Kind.Keywords()
+					}
+					recordFunction(element.FunctionDeclaration, name)
+				}
+
 				_, isStatement := element.(ast.Statement)
 				_, isDeclaration := element.(ast.Declaration)
 				_, isVariableDeclaration := element.(*ast.VariableDeclaration)
@@ -233,7 +469,10 @@ func (r *CoverageReport) InspectProgram(location Location, program *ast.Program)
 			return true
 		})
 
-	r.Coverage[location] = NewLocationCoverage(lineHits)
+	locationCoverage := NewLocationCoverage(lineHits)
+	locationCoverage.BranchHits = branchHits
+	locationCoverage.FunctionHits = functionHits
+	r.Coverage[location] = locationCoverage
 }
 
 // IsLocationInspected checks whether the given location,
@@ -492,23 +731,14 @@ func (r *CoverageReport) UnmarshalJSON(data []byte) error {
 
 // MarshalLCOV serializes each common.Location/*LocationCoverage
 // key/value pair on the *CoverageReport.Coverage map, to the
-// LCOV format. Currently supports only line coverage, function
-// and branch coverage are not yet available.
+// LCOV format. Line coverage is reported for all locations,
+// function and branch coverage for locations which have
+// function declarations and conditional elements, respectively.
 // Description for the LCOV file format, can be found here
 // https://github.com/linux-test-project/lcov/blob/master/man/geninfo.1#L948.
 func (r *CoverageReport) MarshalLCOV() ([]byte, error) {
-	i := 0
-	locations := make([]common.Location, len(r.Coverage))
-	for location := range r.Coverage { // nolint:maprange
-		locations[i] = location
-		i++
-	}
-	sort.Slice(locations, func(i, j int) bool {
-		return locations[i].ID() < locations[j].ID()
-	})
-
 	buf := new(bytes.Buffer)
-	for _, location := range locations {
+	for _, location := range r.sortedLocations() {
 		coverage := r.Coverage[location]
 		locationSource := r.sourcePathForLocation(location)
 		_, err := fmt.Fprintf(buf, "TN:\nSF:%s\n", locationSource)
@@ -516,15 +746,17 @@ func (r *CoverageReport) MarshalLCOV() ([]byte, error) {
 			return nil, err
 		}
 
-		i := 0
-		lines := make([]int, len(coverage.LineHits))
-		for line := range coverage.LineHits { // nolint:maprange
-			lines[i] = line
-			i++
+		err = writeLCOVFunctions(buf, coverage)
+		if err != nil {
+			return nil, err
 		}
-		sort.Ints(lines)
 
-		for _, line := range lines {
+		err = writeLCOVBranches(buf, coverage)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, line := range coverage.sortedLines() {
 			hits := coverage.LineHits[line]
 			_, err = fmt.Fprintf(buf, "DA:%v,%v\n", line, hits)
 			if err != nil {
@@ -546,6 +778,120 @@ func (r *CoverageReport) MarshalLCOV() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// writeLCOVFunctions writes the FN, FNDA, FNF, and FNH records
+// for the functions of the given location coverage, if any.
+func writeLCOVFunctions(buf *bytes.Buffer, coverage *LocationCoverage) error {
+	if len(coverage.FunctionHits) == 0 {
+		return nil
+	}
+
+	functions := make([]*FunctionCoverage, 0, len(coverage.FunctionHits))
+	for _, functionCoverage := range coverage.FunctionHits { // nolint:maprange
+		functions = append(functions, functionCoverage)
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		a := functions[i]
+		b := functions[j]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Name < b.Name
+	})
+
+	for _, function := range functions {
+		_, err := fmt.Fprintf(buf, "FN:%v,%s\n", function.Line, function.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, function := range functions {
+		_, err := fmt.Fprintf(buf, "FNDA:%v,%s\n", function.Hits, function.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(
+		buf,
+		"FNF:%v\nFNH:%v\n",
+		coverage.Functions(),
+		coverage.CoveredFunctions(),
+	)
+	return err
+}
+
+// writeLCOVBranches writes the BRDA, BRF, and BRH records
+// for the conditional elements of the given location coverage, if any.
+// The block number of an element is its index in source order.
+// Branches of elements on lines which were never executed
+// are reported as not taken ("-").
+func writeLCOVBranches(buf *bytes.Buffer, coverage *LocationCoverage) error {
+	if len(coverage.BranchHits) == 0 {
+		return nil
+	}
+
+	elementRanges := make([]ast.Range, 0, len(coverage.BranchHits))
+	for elementRange := range coverage.BranchHits { // nolint:maprange
+		elementRanges = append(elementRanges, elementRange)
+	}
+	sort.Slice(elementRanges, func(i, j int) bool {
+		a := elementRanges[i]
+		b := elementRanges[j]
+		if a.StartPos.Offset != b.StartPos.Offset {
+			return a.StartPos.Offset < b.StartPos.Offset
+		}
+		return a.EndPos.Offset < b.EndPos.Offset
+	})
+
+	for block, elementRange := range elementRanges {
+		branchCoverage := coverage.BranchHits[elementRange]
+		lineHits := coverage.LineHits[branchCoverage.Line]
+
+		for branch, hits := range branchCoverage.Hits {
+			taken := "-"
+			if lineHits > 0 || hits > 0 {
+				taken = fmt.Sprint(hits)
+			}
+
+			_, err := fmt.Fprintf(
+				buf,
+				"BRDA:%v,%v,%v,%s\n",
+				branchCoverage.Line,
+				block,
+				branch,
+				taken,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := fmt.Fprintf(
+		buf,
+		"BRF:%v\nBRH:%v\n",
+		coverage.Branches(),
+		coverage.CoveredBranches(),
+	)
+	return err
+}
+
+// sortedLocations returns the locations included in the
+// CoverageReport, sorted by their ID.
+func (r *CoverageReport) sortedLocations() []common.Location {
+	i := 0
+	locations := make([]common.Location, len(r.Coverage))
+	for location := range r.Coverage { // nolint:maprange
+		locations[i] = location
+		i++
+	}
+	sort.Slice(locations, func(i, j int) bool {
+		return locations[i].ID() < locations[j].ID()
+	})
+	return locations
+}
+
 // Given a common.Location, returns its mapped source, if any.
 // Defaults to the location's ID().
 func (r *CoverageReport) sourcePathForLocation(location common.Location) string {
@@ -0,0 +1,214 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+)
+
+const coberturaDocType = `<!DOCTYPE coverage SYSTEM "http://cobertura.sourceforge.net/xml/coverage-04.dtd">`
+
+// The following types describe the Cobertura XML format,
+// see http://cobertura.sourceforge.net/xml/coverage-04.dtd.
+
+type coberturaCoverage struct {
+	XMLName         xml.Name           `xml:"coverage"`
+	LineRate        string             `xml:"line-rate,attr"`
+	BranchRate      string             `xml:"branch-rate,attr"`
+	LinesCovered    int                `xml:"lines-covered,attr"`
+	LinesValid      int                `xml:"lines-valid,attr"`
+	BranchesCovered int                `xml:"branches-covered,attr"`
+	BranchesValid   int                `xml:"branches-valid,attr"`
+	Complexity      int                `xml:"complexity,attr"`
+	Version         string             `xml:"version,attr"`
+	Timestamp       int64              `xml:"timestamp,attr"`
+	Sources         []string           `xml:"sources>source"`
+	Packages        []coberturaPackage `xml:"packages>package"`
+}
+
+type coberturaPackage struct {
+	Name       string           `xml:"name,attr"`
+	LineRate   string           `xml:"line-rate,attr"`
+	BranchRate string           `xml:"branch-rate,attr"`
+	Complexity int              `xml:"complexity,attr"`
+	Classes    []coberturaClass `xml:"classes>class"`
+}
+
+type coberturaClass struct {
+	Name       string            `xml:"name,attr"`
+	Filename   string            `xml:"filename,attr"`
+	LineRate   string            `xml:"line-rate,attr"`
+	BranchRate string            `xml:"branch-rate,attr"`
+	Complexity int               `xml:"complexity,attr"`
+	Methods    []coberturaMethod `xml:"methods>method"`
+	Lines      []coberturaLine   `xml:"lines>line"`
+}
+
+type coberturaMethod struct {
+	Name       string          `xml:"name,attr"`
+	Signature  string          `xml:"signature,attr"`
+	LineRate   string          `xml:"line-rate,attr"`
+	BranchRate string          `xml:"branch-rate,attr"`
+	Complexity int             `xml:"complexity,attr"`
+	Lines      []coberturaLine `xml:"lines>line"`
+}
+
+type coberturaLine struct {
+	Number            int    `xml:"number,attr"`
+	Hits              int    `xml:"hits,attr"`
+	Branch            bool   `xml:"branch,attr"`
+	ConditionCoverage string `xml:"condition-coverage,attr,omitempty"`
+}
+
+// coberturaRate returns the ratio of covered over valid items,
+// formatted as a Cobertura rate. The rate is 1 if there are no items.
+func coberturaRate(covered int, valid int) string {
+	if valid == 0 {
+		return "1"
+	}
+	return fmt.Sprintf("%.4g", float64(covered)/float64(valid))
+}
+
+// MarshalCobertura serializes the CoverageReport to the Cobertura XML format.
+// All locations are reported as classes of a single package,
+// and the functions of each location are reported as its methods.
+// The timestamp of the report is always 0, so that the output is deterministic.
+func (r *CoverageReport) MarshalCobertura() ([]byte, error) {
+
+	var linesCovered, linesValid, branchesCovered, branchesValid int
+
+	locations := r.sortedLocations()
+	classes := make([]coberturaClass, 0, len(locations))
+
+	for _, location := range locations {
+		coverage := r.Coverage[location]
+
+		lineBranches := coverage.LineBranches()
+
+		lines := make([]coberturaLine, 0, len(coverage.LineHits))
+		for _, line := range coverage.sortedLines() {
+			coberturaLine := coberturaLine{
+				Number: line,
+				Hits:   coverage.LineHits[line],
+			}
+
+			lineBranchCoverage, ok := lineBranches[line]
+			if ok {
+				coberturaLine.Branch = true
+				coberturaLine.ConditionCoverage = fmt.Sprintf(
+					"%.0f%% (%d/%d)",
+					100*float64(lineBranchCoverage.CoveredBranches)/float64(lineBranchCoverage.Branches),
+					lineBranchCoverage.CoveredBranches,
+					lineBranchCoverage.Branches,
+				)
+			}
+
+			lines = append(lines, coberturaLine)
+		}
+
+		functions := make([]*FunctionCoverage, 0, len(coverage.FunctionHits))
+		for _, functionCoverage := range coverage.FunctionHits { // nolint:maprange
+			functions = append(functions, functionCoverage)
+		}
+		sort.Slice(functions, func(i, j int) bool {
+			a := functions[i]
+			b := functions[j]
+			if a.Line != b.Line {
+				return a.Line < b.Line
+			}
+			return a.Name < b.Name
+		})
+
+		methods := make([]coberturaMethod, 0, len(functions))
+		for _, function := range functions {
+			covered := 0
+			if function.Hits > 0 {
+				covered = 1
+			}
+			methods = append(methods, coberturaMethod{
+				Name:       function.Name,
+				LineRate:   coberturaRate(covered, 1),
+				BranchRate: "1",
+				Lines: []coberturaLine{
+					{
+						Number: function.Line,
+						Hits:   function.Hits,
+					},
+				},
+			})
+		}
+
+		locationLinesCovered := coverage.CoveredLines()
+		locationBranches := coverage.Branches()
+		locationBranchesCovered := coverage.CoveredBranches()
+
+		classes = append(classes, coberturaClass{
+			Name:       location.ID(),
+			Filename:   r.sourcePathForLocation(location),
+			LineRate:   coberturaRate(locationLinesCovered, coverage.Statements),
+			BranchRate: coberturaRate(locationBranchesCovered, locationBranches),
+			Methods:    methods,
+			Lines:      lines,
+		})
+
+		linesCovered += locationLinesCovered
+		linesValid += coverage.Statements
+		branchesCovered += locationBranchesCovered
+		branchesValid += locationBranches
+	}
+
+	lineRate := coberturaRate(linesCovered, linesValid)
+	branchRate := coberturaRate(branchesCovered, branchesValid)
+
+	coverage := coberturaCoverage{
+		LineRate:        lineRate,
+		BranchRate:      branchRate,
+		LinesCovered:    linesCovered,
+		LinesValid:      linesValid,
+		BranchesCovered: branchesCovered,
+		BranchesValid:   branchesValid,
+		Sources:         []string{"."},
+		Packages: []coberturaPackage{
+			{
+				Name:       "cadence",
+				LineRate:   lineRate,
+				BranchRate: branchRate,
+				Classes:    classes,
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString(xml.Header)
+	buf.WriteString(coberturaDocType)
+	buf.WriteString("\n")
+
+	encoder := xml.NewEncoder(buf)
+	encoder.Indent("", "  ")
+	err := encoder.Encode(coverage)
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,237 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"os"
+	"strings"
+)
+
+// CoverageSourceReader returns the source code for the given source path,
+// i.e. the path a location is mapped to (see CoverageReport.WithLocationMappings),
+// or the location's ID, if the location is not mapped.
+type CoverageSourceReader func(sourcePath string) ([]byte, error)
+
+type htmlCoverageReport struct {
+	Summary   CoverageReportSummary
+	Branches  string
+	Functions string
+	Locations []htmlLocationCoverage
+}
+
+type htmlLocationCoverage struct {
+	ID         string
+	SourcePath string
+	Statements int
+	Hits       int
+	Coverage   string
+	Branches   string
+	Functions  string
+	// HasSource is false if the source code of the location
+	// could not be read. Only lines with statements are listed then.
+	HasSource bool
+	Lines     []htmlLine
+}
+
+type htmlLine struct {
+	Number int
+	Code   string
+	// Hits is empty for lines without statements
+	Hits string
+	// Class is "hit" for covered lines, "miss" for missed lines,
+	// "partial" for covered lines with branches that were not taken,
+	// and empty for lines without statements
+	Class    string
+	Branches string
+}
+
+var coverageHTMLTemplate = template.Must(template.New("coverage").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Coverage Report</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #24292f; }
+table { border-collapse: collapse; }
+th, td { padding: 0.2em 0.8em; text-align: left; }
+.summary th, .summary td { border-bottom: 1px solid #d0d7de; }
+.source { font-family: ui-monospace, SFMono-Regular, Menlo, Consolas, monospace; font-size: 0.9em; width: 100%; }
+.source td { padding: 0 0.8em; }
+.source .number, .source .hits { text-align: right; color: #57606a; user-select: none; }
+.source .code { white-space: pre; }
+.hit { background-color: #dafbe1; }
+.miss { background-color: #ffebe9; }
+.partial { background-color: #fff8c5; }
+</style>
+</head>
+<body>
+<h1>Coverage Report</h1>
+<table class="summary">
+<tr><th>Locations</th><td>{{.Summary.Locations}}</td></tr>
+<tr><th>Statements</th><td>{{.Summary.Statements}}</td></tr>
+<tr><th>Hits</th><td>{{.Summary.Hits}}</td></tr>
+<tr><th>Misses</th><td>{{.Summary.Misses}}</td></tr>
+<tr><th>Coverage</th><td>{{.Summary.Coverage}}</td></tr>
+<tr><th>Branches</th><td>{{.Branches}}</td></tr>
+<tr><th>Functions</th><td>{{.Functions}}</td></tr>
+</table>
+<h2>Locations</h2>
+<table class="summary">
+<tr><th>Source</th><th>Statements</th><th>Hits</th><th>Coverage</th><th>Branches</th><th>Functions</th></tr>
+{{- range $index, $location := .Locations}}
+<tr><td><a href="#location-{{$index}}">{{$location.SourcePath}}</a></td><td>{{$location.Statements}}</td><td>{{$location.Hits}}</td><td>{{$location.Coverage}}</td><td>{{$location.Branches}}</td><td>{{$location.Functions}}</td></tr>
+{{- end}}
+</table>
+{{- range $index, $location := .Locations}}
+<h2 id="location-{{$index}}">{{$location.SourcePath}}</h2>
+<p>{{$location.ID}}: {{$location.Coverage}} of statements</p>
+{{- if not $location.HasSource}}
+<p>Source not available</p>
+{{- end}}
+<table class="source">
+{{- range $location.Lines}}
+<tr{{if .Class}} class="{{.Class}}"{{end}}><td class="number">{{.Number}}</td><td class="hits">{{.Hits}}</td><td class="code"{{if .Branches}} title="{{.Branches}}"{{end}}>{{.Code}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+// MarshalHTML renders the CoverageReport as a self-contained HTML document.
+// The source code of each location is annotated with hit counts,
+// and missed lines are highlighted.
+//
+// The source code of a location is read using the given source reader,
+// for the source path of the location (see CoverageSourceReader).
+// If no source reader is given, the source code is read from the file system.
+// If the source code of a location cannot be read,
+// only the lines with statements are listed.
+func (r *CoverageReport) MarshalHTML(readSource CoverageSourceReader) ([]byte, error) {
+	if readSource == nil {
+		readSource = os.ReadFile
+	}
+
+	report := htmlCoverageReport{
+		Summary: r.Summary(),
+	}
+
+	var branches, coveredBranches, functions, coveredFunctions int
+
+	for _, location := range r.sortedLocations() {
+		coverage := r.Coverage[location]
+		sourcePath := r.sourcePathForLocation(location)
+
+		htmlLocation := htmlLocationCoverage{
+			ID:         location.ID(),
+			SourcePath: sourcePath,
+			Statements: coverage.Statements,
+			Hits:       coverage.CoveredLines(),
+			Branches:   formatCoverageRatio(coverage.CoveredBranches(), coverage.Branches()),
+			Functions:  formatCoverageRatio(coverage.CoveredFunctions(), coverage.Functions()),
+		}
+
+		if coverage.Statements > 0 {
+			htmlLocation.Coverage = coverage.Percentage()
+		} else {
+			htmlLocation.Coverage = "100.0%"
+		}
+
+		lineBranches := coverage.LineBranches()
+
+		newLine := func(number int, code string) htmlLine {
+			line := htmlLine{
+				Number: number,
+				Code:   code,
+			}
+
+			hits, ok := coverage.LineHits[number]
+			if !ok {
+				return line
+			}
+
+			line.Hits = fmt.Sprint(hits)
+
+			lineBranchCoverage, hasBranches := lineBranches[number]
+			if hasBranches {
+				line.Branches = fmt.Sprintf(
+					"%d of %d branches taken",
+					lineBranchCoverage.CoveredBranches,
+					lineBranchCoverage.Branches,
+				)
+			}
+
+			switch {
+			case hits == 0:
+				line.Class = "miss"
+			case hasBranches &&
+				lineBranchCoverage.CoveredBranches < lineBranchCoverage.Branches:
+
+				line.Class = "partial"
+			default:
+				line.Class = "hit"
+			}
+
+			return line
+		}
+
+		source, err := readSource(sourcePath)
+		if err == nil {
+			htmlLocation.HasSource = true
+			code := strings.ReplaceAll(string(source), "\r\n", "\n")
+			for i, lineCode := range strings.Split(code, "\n") {
+				htmlLocation.Lines = append(htmlLocation.Lines, newLine(i+1, lineCode))
+			}
+		} else {
+			for _, line := range coverage.sortedLines() {
+				htmlLocation.Lines = append(htmlLocation.Lines, newLine(line, ""))
+			}
+		}
+
+		report.Locations = append(report.Locations, htmlLocation)
+
+		branches += coverage.Branches()
+		coveredBranches += coverage.CoveredBranches()
+		functions += coverage.Functions()
+		coveredFunctions += coverage.CoveredFunctions()
+	}
+
+	report.Branches = formatCoverageRatio(coveredBranches, branches)
+	report.Functions = formatCoverageRatio(coveredFunctions, functions)
+
+	buf := new(bytes.Buffer)
+	err := coverageHTMLTemplate.Execute(buf, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// formatCoverageRatio returns a human-friendly representation
+// of the count of covered items over the total count of items.
+func formatCoverageRatio(covered int, total int) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d", covered, total)
+}
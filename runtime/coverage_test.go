@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/parser"
 	. "github.com/onflow/cadence/runtime"
@@ -1972,6 +1973,26 @@ func TestRuntimeCoverageReportLCOVFormat(t *testing.T) {
 
 		expected := `TN:
 SF:S.IntegerTraits
+FN:8,addSpecialNumber
+FN:12,getIntegerTrait
+FNDA:1,addSpecialNumber
+FNDA:10,getIntegerTrait
+FNF:2
+FNH:2
+BRDA:13,0,0,1
+BRDA:13,0,1,9
+BRDA:15,1,0,1
+BRDA:15,1,1,8
+BRDA:17,2,0,1
+BRDA:17,2,1,7
+BRDA:19,3,0,1
+BRDA:19,3,1,6
+BRDA:21,4,0,1
+BRDA:21,4,1,5
+BRDA:25,5,0,4
+BRDA:25,5,1,1
+BRF:12
+BRH:12
 DA:9,1
 DA:13,10
 DA:14,1
@@ -2043,6 +2064,26 @@ end_of_record
 
 		expected := `TN:
 SF:cadence/contracts/IntegerTraits.cdc
+FN:8,addSpecialNumber
+FN:12,getIntegerTrait
+FNDA:1,addSpecialNumber
+FNDA:10,getIntegerTrait
+FNF:2
+FNH:2
+BRDA:13,0,0,1
+BRDA:13,0,1,9
+BRDA:15,1,0,1
+BRDA:15,1,1,8
+BRDA:17,2,0,1
+BRDA:17,2,1,7
+BRDA:19,3,0,1
+BRDA:19,3,1,6
+BRDA:21,4,0,1
+BRDA:21,4,1,5
+BRDA:25,5,0,4
+BRDA:25,5,1,1
+BRF:12
+BRH:12
 DA:9,1
 DA:13,10
 DA:14,1
@@ -2071,3 +2112,336 @@ end_of_record
 	})
 
 }
+
+func TestRuntimeCoverageReportInspectProgramBranchesAndFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`
+	  access(all) struct Counter {
+	    access(all) var count: Int
+
+	    init() {
+	      self.count = 0
+	    }
+
+	    access(all) fun increment(_ by: Int?) {
+	      self.count = self.count + (by ?? 1)
+	    }
+	  }
+
+	  access(all) fun sign(_ n: Int): Int {
+	    switch n {
+	    case 0:
+	      return 0
+	    }
+	    return n > 0 ? 1 : -1
+	  }
+	`)
+
+	program, err := parser.ParseProgram(nil, script, parser.Config{})
+	require.NoError(t, err)
+
+	coverageReport := NewCoverageReport()
+
+	location := common.StringLocation("CounterScript")
+	coverageReport.InspectProgram(location, program)
+
+	locationCoverage := coverageReport.Coverage[location]
+
+	functionNames := map[int]string{}
+	for _, functionCoverage := range locationCoverage.FunctionHits {
+		functionNames[functionCoverage.Line] = functionCoverage.Name
+	}
+	assert.Equal(
+		t,
+		map[int]string{
+			5:  "Counter.init",
+			9:  "Counter.increment",
+			14: "sign",
+		},
+		functionNames,
+	)
+	assert.Equal(t, 3, locationCoverage.Functions())
+	assert.Equal(t, 0, locationCoverage.CoveredFunctions())
+
+	branchCounts := map[int]int{}
+	for _, branchCoverage := range locationCoverage.BranchHits {
+		branchCounts[branchCoverage.Line] = len(branchCoverage.Hits)
+	}
+	assert.Equal(
+		t,
+		map[int]int{
+			// nil-coalescing
+			10: 2,
+			// switch with one case and an implicit default
+			15: 2,
+			// conditional expression
+			19: 2,
+		},
+		branchCounts,
+	)
+	assert.Equal(t, 6, locationCoverage.Branches())
+	assert.Equal(t, 0, locationCoverage.CoveredBranches())
+}
+
+func TestRuntimeCoverageBranchesAndFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`
+	  access(all) fun sign(_ n: Int): Int {
+	    switch n {
+	    case 0:
+	      return 0
+	    }
+	    return n > 0 ? 1 : -1
+	  }
+
+	  access(all) fun unused(_ n: Int?): Int {
+	    return n ?? 0
+	  }
+
+	  access(all) fun main(): Int {
+	    let results = [sign(0), sign(5), sign(7)]
+	    return results.length
+	  }
+	`)
+
+	coverageReport := NewCoverageReport()
+
+	runtimeInterface := &TestRuntimeInterface{}
+
+	config := DefaultTestInterpreterConfig
+	config.CoverageReport = coverageReport
+	runtime := NewTestInterpreterRuntimeWithConfig(config)
+
+	value, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface:      runtimeInterface,
+			Location:       common.ScriptLocation{},
+			CoverageReport: coverageReport,
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, cadence.NewInt(3), value)
+
+	actual, err := coverageReport.MarshalLCOV()
+	require.NoError(t, err)
+
+	expected := `TN:
+SF:s.0000000000000000000000000000000000000000000000000000000000000000
+FN:2,sign
+FN:10,unused
+FN:14,main
+FNDA:3,sign
+FNDA:0,unused
+FNDA:1,main
+FNF:3
+FNH:2
+BRDA:3,0,0,1
+BRDA:3,0,1,2
+BRDA:7,1,0,2
+BRDA:7,1,1,0
+BRDA:11,2,0,-
+BRDA:11,2,1,-
+BRF:6
+BRH:3
+DA:3,3
+DA:5,1
+DA:7,2
+DA:11,0
+DA:15,1
+DA:16,1
+LF:6
+LH:5
+end_of_record
+`
+
+	require.Equal(t, expected, string(actual))
+}
+
+func TestRuntimeCoverageReportCoberturaFormat(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`
+	  access(all) fun sign(_ n: Int): Int {
+	    if n < 0 {
+	      return -1
+	    }
+	    return 1
+	  }
+
+	  access(all) fun unused(): Int {
+	    return 0
+	  }
+	`)
+
+	program, err := parser.ParseProgram(nil, script, parser.Config{})
+	require.NoError(t, err)
+
+	coverageReport := NewCoverageReport()
+	coverageReport.WithLocationMappings(map[string]string{
+		"Sign": "cadence/contracts/Sign.cdc",
+	})
+
+	location := common.StringLocation("Sign")
+	coverageReport.InspectProgram(location, program)
+
+	ifStatement := program.FunctionDeclarations()[0].FunctionBlock.Block.Statements[0]
+
+	for key, functionCoverage := range coverageReport.Coverage[location].FunctionHits {
+		if functionCoverage.Name == "sign" {
+			coverageReport.AddFunctionHit(location, key)
+		}
+	}
+	coverageReport.AddLineHit(location, 3)
+	coverageReport.AddBranchHit(location, ast.NewRangeFromPositioned(nil, ifStatement), 1)
+	coverageReport.AddLineHit(location, 6)
+
+	actual, err := coverageReport.MarshalCobertura()
+	require.NoError(t, err)
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE coverage SYSTEM "http://cobertura.sourceforge.net/xml/coverage-04.dtd">
+<coverage line-rate="0.5" branch-rate="0.5" lines-covered="2" lines-valid="4" branches-covered="1" branches-valid="2" complexity="0" version="" timestamp="0">
+  <sources>
+    <source>.</source>
+  </sources>
+  <packages>
+    <package name="cadence" line-rate="0.5" branch-rate="0.5" complexity="0">
+      <classes>
+        <class name="S.Sign" filename="cadence/contracts/Sign.cdc" line-rate="0.5" branch-rate="0.5" complexity="0">
+          <methods>
+            <method name="sign" signature="" line-rate="1" branch-rate="1" complexity="0">
+              <lines>
+                <line number="2" hits="1" branch="false"></line>
+              </lines>
+            </method>
+            <method name="unused" signature="" line-rate="0" branch-rate="1" complexity="0">
+              <lines>
+                <line number="9" hits="0" branch="false"></line>
+              </lines>
+            </method>
+          </methods>
+          <lines>
+            <line number="3" hits="1" branch="true" condition-coverage="50% (1/2)"></line>
+            <line number="4" hits="0" branch="false"></line>
+            <line number="6" hits="1" branch="false"></line>
+            <line number="10" hits="0" branch="false"></line>
+          </lines>
+        </class>
+      </classes>
+    </package>
+  </packages>
+</coverage>
+`
+
+	require.Equal(t, expected, string(actual))
+}
+
+func TestRuntimeCoverageReportHTMLFormat(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`access(all) fun sign(_ n: Int): Int {
+  if n < 0 {
+    return -1
+  }
+  return 1 // <positive>
+}
+`)
+
+	program, err := parser.ParseProgram(nil, script, parser.Config{})
+	require.NoError(t, err)
+
+	newCoverageReport := func() *CoverageReport {
+		coverageReport := NewCoverageReport()
+		coverageReport.WithLocationMappings(map[string]string{
+			"Sign": "cadence/contracts/Sign.cdc",
+		})
+
+		location := common.StringLocation("Sign")
+		coverageReport.InspectProgram(location, program)
+
+		ifStatement := program.FunctionDeclarations()[0].FunctionBlock.Block.Statements[0]
+
+		for key, functionCoverage := range coverageReport.Coverage[location].FunctionHits {
+			if functionCoverage.Name == "sign" {
+				coverageReport.AddFunctionHit(location, key)
+			}
+		}
+		coverageReport.AddLineHit(location, 2)
+		coverageReport.AddBranchHit(location, ast.NewRangeFromPositioned(nil, ifStatement), 1)
+		coverageReport.AddLineHit(location, 5)
+
+		return coverageReport
+	}
+
+	t.Run("with source", func(t *testing.T) {
+
+		t.Parallel()
+
+		var readPaths []string
+
+		actual, err := newCoverageReport().MarshalHTML(func(sourcePath string) ([]byte, error) {
+			readPaths = append(readPaths, sourcePath)
+			return script, nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"cadence/contracts/Sign.cdc"}, readPaths)
+
+		html := string(actual)
+
+		assert.Contains(t, html, `<tr><th>Coverage</th><td>66.7%</td></tr>`)
+		assert.Contains(t, html, `<tr><th>Branches</th><td>1/2</td></tr>`)
+		assert.Contains(t, html, `<tr><th>Functions</th><td>1/1</td></tr>`)
+		assert.Contains(
+			t,
+			html,
+			`<tr><td class="number">1</td><td class="hits"></td><td class="code">access(all) fun sign(_ n: Int): Int {</td></tr>`,
+		)
+		assert.Contains(
+			t,
+			html,
+			`<tr class="partial"><td class="number">2</td><td class="hits">1</td><td class="code" title="1 of 2 branches taken">  if n &lt; 0 {</td></tr>`,
+		)
+		assert.Contains(
+			t,
+			html,
+			`<tr class="miss"><td class="number">3</td><td class="hits">0</td><td class="code">    return -1</td></tr>`,
+		)
+		assert.Contains(
+			t,
+			html,
+			`<tr class="hit"><td class="number">5</td><td class="hits">1</td><td class="code">  return 1 // &lt;positive&gt;</td></tr>`,
+		)
+		assert.NotContains(t, html, "Source not available")
+	})
+
+	t.Run("without source", func(t *testing.T) {
+
+		t.Parallel()
+
+		actual, err := newCoverageReport().MarshalHTML(func(sourcePath string) ([]byte, error) {
+			return nil, fmt.Errorf("unknown source: %s", sourcePath)
+		})
+		require.NoError(t, err)
+
+		html := string(actual)
+
+		assert.Contains(t, html, "Source not available")
+		assert.Contains(
+			t,
+			html,
+			`<tr class="miss"><td class="number">3</td><td class="hits">0</td><td class="code"></td></tr>`,
+		)
+		assert.NotContains(t, html, `<td class="number">1</td>`)
+	})
+}
@@ -19,15 +19,21 @@
 package runtime_test
 
 import (
+	"errors"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/cadence/interpreter"
 	. "github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/sema"
+	. "github.com/onflow/cadence/test_utils/common_utils"
 	. "github.com/onflow/cadence/test_utils/runtime_utils"
 )
 
@@ -206,3 +212,937 @@ func TestRuntimeDebuggerBreakpoints(t *testing.T) {
 
 	require.True(t, logged)
 }
+
+func TestRuntimeDebuggerConcurrentBreakpoints(t *testing.T) {
+
+	t.Parallel()
+
+	location := common.ScriptLocation{0x1}
+	otherLocation := common.ScriptLocation{0x2}
+
+	debugger := interpreter.NewDebugger()
+
+	// The script loops until the test finished modifying the breakpoints
+
+	var done atomic.Bool
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		config := DefaultTestInterpreterConfig
+		config.Debugger = debugger
+		runtime := NewTestInterpreterRuntimeWithConfig(config)
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, nil),
+			OnReadRandom: func(buffer []byte) error {
+				if done.Load() {
+					buffer[0] = 1
+				} else {
+					buffer[0] = 0
+				}
+				return nil
+			},
+		}
+
+		value, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(`
+                  access(all) fun main(): Bool {
+                      var i = 0
+                      while revertibleRandom<UInt8>() == 0 {
+                          i = i + 1
+                      }
+                      return i >= 0
+                  }
+                `),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  location,
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewBool(true), value)
+	}()
+
+	// Modify the breakpoints while the program is running
+
+	for i := 0; i < 1000; i++ {
+		debugger.SetBreakpoint(interpreter.Breakpoint{
+			Location:  location,
+			Line:      5,
+			Condition: "i < 0",
+		})
+		debugger.AddBreakpoint(otherLocation, uint(i))
+		debugger.ClearBreakpointsForLocation(otherLocation)
+		debugger.AddWatch("i")
+		require.True(t, debugger.RemoveWatch(0))
+	}
+
+	debugger.AddBreakpoint(location, 7)
+
+	done.Store(true)
+
+	stop := <-debugger.Stops()
+
+	require.IsType(t, &ast.ReturnStatement{}, stop.Statement)
+
+	debugger.ClearBreakpoints()
+	debugger.Continue()
+
+	wg.Wait()
+}
+
+const debuggerSteppingTestScript = `
+  access(all) fun inner(): Int {
+      let a = 1
+      return a + 1
+  }
+
+  access(all) fun outer(): Int {
+      let b = inner()
+      return b
+  }
+
+  access(all) fun main(): Int {
+      let c = outer()
+      return c
+  }
+`
+
+func executeDebuggerTestScript(
+	t *testing.T,
+	debugger *interpreter.Debugger,
+	location common.Location,
+	wg *sync.WaitGroup,
+	expectedResult int,
+) {
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		config := DefaultTestInterpreterConfig
+		config.Debugger = debugger
+		runtime := NewTestInterpreterRuntimeWithConfig(config)
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, nil),
+		}
+
+		value, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(debuggerSteppingTestScript),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  location,
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(expectedResult), value)
+	}()
+}
+
+type testStackFrame struct {
+	functionName string
+	line         int
+}
+
+func requireStackFrames(t *testing.T, expected []testStackFrame, stop interpreter.Stop) {
+	actual := make([]testStackFrame, 0, len(stop.Frames))
+	for _, frame := range stop.Frames {
+		actual = append(
+			actual,
+			testStackFrame{
+				functionName: frame.FunctionName,
+				line:         frame.Position.Line,
+			},
+		)
+	}
+	require.Equal(t, expected, actual)
+	require.Equal(t, expected[len(expected)-1].line, stop.Statement.StartPosition().Line)
+}
+
+func TestRuntimeDebuggerStepping(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("step into, step over", func(t *testing.T) {
+
+		t.Parallel()
+
+		debugger := interpreter.NewDebugger()
+		debugger.RequestPause()
+
+		var wg sync.WaitGroup
+
+		executeDebuggerTestScript(t, debugger, NewScriptLocationGenerator()(), &wg, 2)
+
+		stop := debugger.Pause()
+		requireStackFrames(t,
+			[]testStackFrame{
+				{functionName: "main", line: 13},
+			},
+			stop,
+		)
+
+		stop = debugger.StepInto()
+		requireStackFrames(t,
+			[]testStackFrame{
+				{functionName: "main", line: 13},
+				{functionName: "outer", line: 8},
+			},
+			stop,
+		)
+
+		// Stepping over the statement does not enter the function `inner`
+
+		stop = debugger.StepOver()
+		requireStackFrames(t,
+			[]testStackFrame{
+				{functionName: "main", line: 13},
+				{functionName: "outer", line: 9},
+			},
+			stop,
+		)
+
+		// Stepping over the last statement continues in the caller
+
+		stop = debugger.StepOver()
+		requireStackFrames(t,
+			[]testStackFrame{
+				{functionName: "main", line: 14},
+			},
+			stop,
+		)
+
+		debugger.Continue()
+
+		wg.Wait()
+	})
+
+	t.Run("step out", func(t *testing.T) {
+
+		t.Parallel()
+
+		location := NewScriptLocationGenerator()()
+
+		debugger := interpreter.NewDebugger()
+		debugger.AddBreakpoint(location, 3)
+
+		var wg sync.WaitGroup
+
+		executeDebuggerTestScript(t, debugger, location, &wg, 2)
+
+		stop := <-debugger.Stops()
+		requireStackFrames(t,
+			[]testStackFrame{
+				{functionName: "main", line: 13},
+				{functionName: "outer", line: 8},
+				{functionName: "inner", line: 3},
+			},
+			stop,
+		)
+
+		// The activation of each frame is available
+
+		innerFrame := stop.Frames[2]
+		require.Nil(t, innerFrame.Activation.Find("a"))
+		require.NotNil(t, innerFrame.Activation.Find("inner"))
+
+		stop = debugger.StepInto()
+		requireStackFrames(t,
+			[]testStackFrame{
+				{functionName: "main", line: 13},
+				{functionName: "outer", line: 8},
+				{functionName: "inner", line: 4},
+			},
+			stop,
+		)
+
+		variable := stop.Frames[2].Activation.Find("a")
+		require.NotNil(t, variable)
+		require.Equal(
+			t,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			variable.GetValue(stop.Interpreter),
+		)
+
+		stop = debugger.StepOut()
+		requireStackFrames(t,
+			[]testStackFrame{
+				{functionName: "main", line: 13},
+				{functionName: "outer", line: 9},
+			},
+			stop,
+		)
+
+		stop = debugger.StepOut()
+		requireStackFrames(t,
+			[]testStackFrame{
+				{functionName: "main", line: 14},
+			},
+			stop,
+		)
+
+		debugger.Continue()
+
+		wg.Wait()
+	})
+}
+
+func TestRuntimeDebuggerConditionalBreakpoints(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("condition, logpoint", func(t *testing.T) {
+
+		t.Parallel()
+
+		location := NewScriptLocationGenerator()()
+
+		debugger := interpreter.NewDebugger()
+
+		var messages []string
+		debugger.SetLogHandler(func(breakpoint interpreter.Breakpoint, message string) {
+			messages = append(messages, message)
+		})
+
+		// The condition never holds, so the program does not stop
+		debugger.SetBreakpoint(interpreter.Breakpoint{
+			Location:  location,
+			Line:      4,
+			Condition: "a == 2",
+		})
+
+		debugger.SetBreakpoint(interpreter.Breakpoint{
+			Location:   location,
+			Line:       14,
+			LogMessage: "c = {c}, in {\"main\"}",
+		})
+
+		var wg sync.WaitGroup
+
+		executeDebuggerTestScript(t, debugger, location, &wg, 2)
+
+		wg.Wait()
+
+		require.Equal(t, []string{"c = 2, in main"}, messages)
+
+		breakpoints := debugger.Breakpoints(location)
+		require.Len(t, breakpoints, 2)
+		require.Equal(t, uint(0), breakpoints[0].Hits)
+		require.Equal(t, uint(1), breakpoints[1].Hits)
+	})
+
+	t.Run("hit count", func(t *testing.T) {
+
+		t.Parallel()
+
+		location := NewScriptLocationGenerator()()
+
+		debugger := interpreter.NewDebugger()
+
+		// The statement is only executed once
+		debugger.SetBreakpoint(interpreter.Breakpoint{
+			Location: location,
+			Line:     3,
+			HitCount: 2,
+		})
+
+		var wg sync.WaitGroup
+
+		executeDebuggerTestScript(t, debugger, location, &wg, 2)
+
+		wg.Wait()
+	})
+
+	t.Run("invalid condition", func(t *testing.T) {
+
+		t.Parallel()
+
+		location := NewScriptLocationGenerator()()
+
+		debugger := interpreter.NewDebugger()
+
+		var messages []string
+		debugger.SetLogHandler(func(breakpoint interpreter.Breakpoint, message string) {
+			messages = append(messages, message)
+		})
+
+		debugger.SetBreakpoint(interpreter.Breakpoint{
+			Location:  location,
+			Line:      4,
+			Condition: "a",
+		})
+
+		var wg sync.WaitGroup
+
+		executeDebuggerTestScript(t, debugger, location, &wg, 2)
+
+		// The program stops at the breakpoint, and the error is logged
+
+		stop := <-debugger.Stops()
+		require.Equal(t, 4, stop.Statement.StartPosition().Line)
+
+		require.Len(t, messages, 1)
+		require.Contains(t, messages[0], "mismatched types")
+
+		debugger.Continue()
+
+		wg.Wait()
+	})
+}
+
+func TestRuntimeDebuggerBreakOnError(t *testing.T) {
+
+	t.Parallel()
+
+	const script = `
+      access(all) fun divide(_ a: Int, _ b: Int): Int {
+          pre {
+              a >= 0: "negative dividend"
+          }
+          let quotient = a / b
+          return quotient
+      }
+
+      access(all) fun main(dividend: Int, divisor: Int): Int {
+          let x = 1
+          return divide(dividend, divisor) + x
+      }
+    `
+
+	execute := func(
+		debugger *interpreter.Debugger,
+		dividend int,
+		divisor int,
+		errs chan<- error,
+	) {
+		go func() {
+			config := DefaultTestInterpreterConfig
+			config.Debugger = debugger
+			runtime := NewTestInterpreterRuntimeWithConfig(config)
+
+			runtimeInterface := &TestRuntimeInterface{
+				Storage: NewTestLedger(nil, nil),
+				OnDecodeArgument: func(b []byte, t cadence.Type) (value cadence.Value, err error) {
+					return json.Decode(nil, b)
+				},
+			}
+
+			_, err := runtime.ExecuteScript(
+				Script{
+					Source: []byte(script),
+					Arguments: encodeArgs([]cadence.Value{
+						cadence.NewInt(dividend),
+						cadence.NewInt(divisor),
+					}),
+				},
+				Context{
+					Interface: runtimeInterface,
+					Location:  common.ScriptLocation{},
+				},
+			)
+			errs <- err
+		}()
+	}
+
+	t.Run("error", func(t *testing.T) {
+
+		t.Parallel()
+
+		debugger := interpreter.NewDebugger()
+		debugger.SetBreakOnError(true)
+
+		errs := make(chan error, 1)
+		execute(debugger, 1, 0, errs)
+
+		// The program stops at the statement raising the error
+
+		stop := <-debugger.Stops()
+		require.ErrorContains(t, stop.Err, "division by zero")
+
+		requireStackFrames(t,
+			[]testStackFrame{
+				{functionName: "main", line: 12},
+				{functionName: "divide", line: 6},
+			},
+			stop,
+		)
+
+		// The activation is still intact
+
+		activation := stop.Frames[1].Activation
+		require.Equal(t,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			activation.Find("a").GetValue(stop.Interpreter),
+		)
+
+		// Continuing the program aborts it with the error
+
+		debugger.Continue()
+
+		err := <-errs
+		RequireError(t, err)
+		require.ErrorContains(t, err, "division by zero")
+	})
+
+	t.Run("failed condition", func(t *testing.T) {
+
+		t.Parallel()
+
+		debugger := interpreter.NewDebugger()
+		debugger.SetBreakOnError(true)
+
+		errs := make(chan error, 1)
+		execute(debugger, -1, 1, errs)
+
+		// The program stops at the failed condition
+
+		stop := <-debugger.Stops()
+		require.ErrorAs(t, stop.Err, &interpreter.ConditionError{})
+
+		requireStackFrames(t,
+			[]testStackFrame{
+				{functionName: "main", line: 12},
+				{functionName: "divide", line: 4},
+			},
+			stop,
+		)
+
+		debugger.Continue()
+
+		err := <-errs
+		RequireError(t, err)
+		require.ErrorAs(t, err, &interpreter.ConditionError{})
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		debugger := interpreter.NewDebugger()
+
+		errs := make(chan error, 1)
+		execute(debugger, 1, 0, errs)
+
+		err := <-errs
+		RequireError(t, err)
+		require.ErrorContains(t, err, "division by zero")
+	})
+
+	t.Run("external error", func(t *testing.T) {
+
+		t.Parallel()
+
+		debugger := interpreter.NewDebugger()
+		debugger.SetBreakOnError(true)
+
+		errs := make(chan error, 1)
+
+		go func() {
+			config := DefaultTestInterpreterConfig
+			config.Debugger = debugger
+			runtime := NewTestInterpreterRuntimeWithConfig(config)
+
+			runtimeInterface := &TestRuntimeInterface{
+				Storage: NewTestLedger(nil, nil),
+				OnProgramLog: func(_ string) {
+					panic(errors.New("log failed"))
+				},
+			}
+
+			_, err := runtime.ExecuteScript(
+				Script{
+					Source: []byte(`
+                      access(all) fun main() {
+                          log("x")
+                      }
+                    `),
+				},
+				Context{
+					Interface: runtimeInterface,
+					Location:  common.ScriptLocation{},
+				},
+			)
+			errs <- err
+		}()
+
+		// Errors which are not raised by the program do not stop it
+
+		select {
+		case stop := <-debugger.Stops():
+			debugger.Continue()
+			require.Failf(t, "unexpected stop", "stopped on error: %s", stop.Err)
+
+		case err := <-errs:
+			RequireError(t, err)
+			require.ErrorContains(t, err, "log failed")
+		}
+	})
+}
+
+func TestRuntimeDebuggerREPL(t *testing.T) {
+
+	t.Parallel()
+
+	debugger := interpreter.NewDebugger()
+
+	repl, err := NewREPL(debugger)
+	require.NoError(t, err)
+
+	var results []interpreter.Value
+	repl.OnResult = func(value interpreter.Value) {
+		results = append(results, value)
+	}
+	repl.OnError = func(err error, _ Location, _ map[Location][]byte) {
+		require.NoError(t, err)
+	}
+
+	accept := func(code string) {
+		inputIsComplete, err := repl.Accept([]byte(code), true)
+		require.NoError(t, err)
+		require.True(t, inputIsComplete)
+	}
+
+	// Define a function, on lines 1-4
+
+	accept(`
+      fun double(_ x: Int): Int {
+          let result = x * 2
+          return result
+      }
+    `)
+
+	// Break in the function
+
+	debugger.AddBreakpoint(common.REPLLocation{}, 4)
+
+	// Invoke the function in a later line.
+	// It will stop at the breakpoint,
+	// so run it in a goroutine
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		accept("double(21)\n")
+	}()
+
+	stop := <-debugger.Stops()
+
+	requireStackFrames(t,
+		[]testStackFrame{
+			{functionName: "double", line: 4},
+		},
+		stop,
+	)
+
+	variable := debugger.CurrentActivation(stop.Interpreter).Find("result")
+	require.NotNil(t, variable)
+	require.Equal(t,
+		interpreter.NewUnmeteredIntValueFromInt64(42),
+		variable.GetValue(stop.Interpreter),
+	)
+
+	debugger.Continue()
+
+	wg.Wait()
+
+	require.Len(t, results, 1)
+	require.Equal(t, "42", results[0].String())
+}
+
+func TestRuntimeDebuggerEvaluation(t *testing.T) {
+
+	t.Parallel()
+
+	location := NewScriptLocationGenerator()()
+
+	debugger := interpreter.NewDebugger()
+	debugger.AddBreakpoint(location, 4)
+
+	var wg sync.WaitGroup
+
+	executeDebuggerTestScript(t, debugger, location, &wg, 2)
+
+	stop := <-debugger.Stops()
+	require.Equal(t, 4, stop.Statement.StartPosition().Line)
+
+	inter := stop.Interpreter
+	activation := debugger.CurrentActivation(inter)
+
+	evaluate := func(code string) interpreter.Value {
+		value, err := debugger.EvaluateExpression(inter, activation, code, nil)
+		require.NoError(t, err)
+		return value
+	}
+
+	require.Equal(t,
+		interpreter.NewUnmeteredIntValueFromInt64(11),
+		evaluate("a + 10"),
+	)
+
+	// Functions can be invoked.
+	// The program does not stop at the breakpoint in the function
+
+	require.Equal(t,
+		interpreter.NewUnmeteredIntValueFromInt64(2),
+		evaluate("inner()"),
+	)
+
+	// Invalid expressions are rejected
+
+	_, err := debugger.EvaluateExpression(inter, activation, "a +", nil)
+	RequireError(t, err)
+
+	_, err = debugger.EvaluateExpression(inter, activation, `a + "x"`, nil)
+	RequireError(t, err)
+
+	_, err = debugger.EvaluateExpression(inter, activation, "a", sema.BoolType)
+	RequireError(t, err)
+
+	// Constants cannot be set
+
+	err = debugger.SetVariable(inter, activation, "a", "a + 4")
+	RequireError(t, err)
+
+	var assignmentToConstantErr *sema.AssignmentToConstantError
+	require.ErrorAs(t, err, &assignmentToConstantErr)
+
+	require.Equal(t,
+		interpreter.NewUnmeteredIntValueFromInt64(1),
+		evaluate("a"),
+	)
+
+	err = debugger.SetVariable(inter, activation, "unknown", "1")
+	RequireError(t, err)
+
+	// Watches are kept
+
+	debugger.AddWatch("a")
+	debugger.AddWatch("a * 2")
+	require.Equal(t, []string{"a", "a * 2"}, debugger.Watches())
+
+	require.True(t, debugger.RemoveWatch(0))
+	require.False(t, debugger.RemoveWatch(1))
+	require.Equal(t, []string{"a * 2"}, debugger.Watches())
+
+	debugger.Continue()
+
+	wg.Wait()
+}
+
+func TestRuntimeDebuggerEvaluationProgramTypes(t *testing.T) {
+
+	t.Parallel()
+
+	const script = `
+      access(all) struct S {
+          access(all) let n: Int
+
+          init(n: Int) {
+              self.n = n
+          }
+      }
+
+      access(all) enum E: UInt8 {
+          access(all) case a
+          access(all) case b
+      }
+
+      access(all) struct interface I {}
+
+      access(all) typealias T = S
+
+      access(all) fun main(): Int {
+          let x: AnyStruct = S(n: 42)
+          let e = E.b
+          return 1
+      }
+    `
+
+	location := NewScriptLocationGenerator()()
+
+	debugger := interpreter.NewDebugger()
+	debugger.AddBreakpoint(location, 22)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		config := DefaultTestInterpreterConfig
+		config.Debugger = debugger
+		runtime := NewTestInterpreterRuntimeWithConfig(config)
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, nil),
+		}
+
+		_, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(script),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  location,
+			},
+		)
+		require.NoError(t, err)
+	}()
+
+	stop := <-debugger.Stops()
+	require.Equal(t, 22, stop.Statement.StartPosition().Line)
+
+	inter := stop.Interpreter
+	activation := debugger.CurrentActivation(inter)
+
+	evaluate := func(code string) interpreter.Value {
+		value, err := debugger.EvaluateExpression(inter, activation, code, nil)
+		require.NoError(t, err)
+		return value
+	}
+
+	// Types declared in the program can be used in expressions
+
+	require.Equal(t,
+		interpreter.NewUnmeteredIntValueFromInt64(42),
+		evaluate("(x as? S)!.n"),
+	)
+
+	require.Equal(t,
+		interpreter.NewUnmeteredIntValueFromInt64(42),
+		evaluate("(x as? T)!.n"),
+	)
+
+	require.Equal(t,
+		interpreter.TrueValue,
+		evaluate("(x as? {I}) == nil"),
+	)
+
+	require.Equal(t,
+		interpreter.NewUnmeteredIntValueFromInt64(1),
+		evaluate("S(n: 1).n"),
+	)
+
+	require.Equal(t,
+		interpreter.NewUnmeteredUInt8Value(0),
+		evaluate("E.a.rawValue"),
+	)
+
+	require.Equal(t,
+		interpreter.TrueValue,
+		evaluate("e == E.b"),
+	)
+
+	debugger.Continue()
+
+	wg.Wait()
+}
+
+func TestRuntimeDebuggerSetVariable(t *testing.T) {
+
+	t.Parallel()
+
+	const script = `
+      access(all) fun main(_ p: Int): Int? {
+          var x: Int? = nil
+          let y = 1
+          for i in [1, 2, 3, 4, 5, 6, 7, 8, 9, 10] {
+              let a = i
+              let b = i
+              let c = i
+              let d = i
+              let e = i
+              let f = i
+              let g = i
+              let h = i
+          }
+          return x
+      }
+    `
+
+	location := NewScriptLocationGenerator()()
+
+	debugger := interpreter.NewDebugger()
+	debugger.AddBreakpoint(location, 15)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		config := DefaultTestInterpreterConfig
+		config.Debugger = debugger
+		runtime := NewTestInterpreterRuntimeWithConfig(config)
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, nil),
+			OnDecodeArgument: func(b []byte, t cadence.Type) (cadence.Value, error) {
+				return json.Decode(nil, b)
+			},
+		}
+
+		value, err := runtime.ExecuteScript(
+			Script{
+				Source: []byte(script),
+				Arguments: encodeArgs([]cadence.Value{
+					cadence.NewInt(2),
+				}),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  location,
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewOptional(cadence.NewInt(3)), value)
+	}()
+
+	stop := <-debugger.Stops()
+	require.Equal(t, 15, stop.Statement.StartPosition().Line)
+
+	inter := stop.Interpreter
+	activation := debugger.CurrentActivation(inter)
+
+	// Variables are checked against their declared type,
+	// not against the type of their current value.
+	// The declarations of the variables are kept,
+	// even after many variables were declared in the loop
+
+	err := debugger.SetVariable(inter, activation, "x", "p + y")
+	require.NoError(t, err)
+
+	err = debugger.SetVariable(inter, activation, "x", `"x"`)
+	RequireError(t, err)
+
+	var typeMismatchErr *sema.TypeMismatchError
+	require.ErrorAs(t, err, &typeMismatchErr)
+
+	// Constants and parameters cannot be set
+
+	var assignmentToConstantErr *sema.AssignmentToConstantError
+
+	err = debugger.SetVariable(inter, activation, "y", "2")
+	RequireError(t, err)
+	require.ErrorAs(t, err, &assignmentToConstantErr)
+
+	err = debugger.SetVariable(inter, activation, "p", "2")
+	RequireError(t, err)
+	require.ErrorAs(t, err, &assignmentToConstantErr)
+
+	debugger.Continue()
+
+	wg.Wait()
+}
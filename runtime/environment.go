@@ -19,9 +19,11 @@
 package runtime
 
 import (
+	"bytes"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/activations"
@@ -190,6 +192,8 @@ func (e *interpreterEnvironment) newInterpreterConfig() *interpreter.Config {
 		AtreeStorageValidationEnabled:             false,
 		Debugger:                                  e.config.Debugger,
 		OnStatement:                               e.newOnStatementHandler(),
+		OnBranch:                                  e.newOnBranchHandler(),
+		OnInterpretedFunctionInvocation:           e.newOnInterpretedFunctionInvocationHandler(),
 		OnMeterComputation:                        e.newOnMeterComputation(),
 		OnFunctionInvocation:                      e.newOnFunctionInvocationHandler(),
 		OnInvokedFunctionReturn:                   e.newOnInvokedFunctionReturnHandler(),
@@ -203,13 +207,14 @@ func (e *interpreterEnvironment) newInterpreterConfig() *interpreter.Config {
 
 func (e *interpreterEnvironment) newCheckerConfig() *sema.Config {
 	return &sema.Config{
-		AccessCheckMode:                  sema.AccessCheckModeStrict,
-		BaseValueActivationHandler:       e.getBaseValueActivation,
-		BaseTypeActivationHandler:        e.getBaseTypeActivation,
-		ValidTopLevelDeclarationsHandler: validTopLevelDeclarations,
-		LocationHandler:                  e.ResolveLocation,
-		ImportHandler:                    e.resolveImport,
-		CheckHandler:                     e.newCheckHandler(),
+		AccessCheckMode:                      sema.AccessCheckModeStrict,
+		BaseValueActivationHandler:           e.getBaseValueActivation,
+		BaseTypeActivationHandler:            e.getBaseTypeActivation,
+		ValidTopLevelDeclarationsHandler:     validTopLevelDeclarations,
+		LocationHandler:                      e.ResolveLocation,
+		ImportHandler:                        e.resolveImport,
+		CheckHandler:                         e.newCheckHandler(),
+		EnumSwitchExhaustivenessCheckEnabled: e.config.EnumSwitchExhaustivenessCheckEnabled,
 	}
 }
 
@@ -467,6 +472,7 @@ func (e *interpreterEnvironment) ParseAndCheckProgram(
 			// So mark it also as 'already seen'.
 			location: true,
 		},
+		nil,
 	)
 }
 
@@ -675,7 +681,7 @@ func (e *interpreterEnvironment) newCheckHandler() sema.CheckHandlerFunc {
 }
 
 func (e *interpreterEnvironment) resolveImport(
-	_ *sema.Checker,
+	checker *sema.Checker,
 	importedLocation common.Location,
 	importRange ast.Range,
 ) (sema.Import, error) {
@@ -696,6 +702,7 @@ func (e *interpreterEnvironment) resolveImport(
 		importedLocation,
 		getAndSetProgram,
 		e.checkedImports,
+		checker.Elaboration.ImportCodeHash(importedLocation),
 	)
 	if err != nil {
 		return nil, err
@@ -710,6 +717,7 @@ func (e *interpreterEnvironment) GetProgram(
 	location Location,
 	storeProgram bool,
 	checkedImports importResolutionResults,
+	expectedCodeHash []byte,
 ) (
 	*interpreter.Program,
 	error,
@@ -721,20 +729,49 @@ func (e *interpreterEnvironment) GetProgram(
 		},
 		storeProgram,
 		checkedImports,
+		expectedCodeHash,
 	)
 }
 
 // getProgram returns the existing program at the given location, if available.
 // If it is not available, it loads the code, and then parses and checks it.
+//
+// If an expected code hash is given, the code is always loaded,
+// and the program is only returned if the hash of the code matches.
 func (e *interpreterEnvironment) getProgram(
 	location Location,
 	getCode func() ([]byte, error),
 	getAndSetProgram bool,
 	checkedImports importResolutionResults,
+	expectedCodeHash []byte,
 ) (
 	program *interpreter.Program,
 	err error,
 ) {
+	if expectedCodeHash != nil {
+		// The program might already be available,
+		// but its code might have changed since, e.g. when the contract was updated.
+		// Load the code and check its hash before getting the program
+
+		code, err := getCode()
+		if err != nil {
+			return nil, err
+		}
+
+		actualCodeHash := sha3.Sum256(code)
+		if !bytes.Equal(actualCodeHash[:], expectedCodeHash) {
+			return nil, &ImportCodeHashMismatchError{
+				Location:     location,
+				ExpectedHash: expectedCodeHash,
+				ActualHash:   actualCodeHash[:],
+			}
+		}
+
+		getCode = func() ([]byte, error) {
+			return code, nil
+		}
+	}
+
 	load := func() (*interpreter.Program, error) {
 		code, err := getCode()
 		if err != nil {
@@ -845,17 +882,50 @@ func (e *interpreterEnvironment) newOnStatementHandler() interpreter.OnStatement
 	}
 
 	return func(inter *interpreter.Interpreter, statement ast.Statement) {
-		location := inter.Location
-		if !e.coverageReport.IsLocationInspected(location) {
-			program := inter.Program.Program
-			e.coverageReport.InspectProgram(location, program)
-		}
+		location := e.inspectCoverageLocation(inter)
 
 		line := statement.StartPosition().Line
 		e.coverageReport.AddLineHit(location, line)
 	}
 }
 
+func (e *interpreterEnvironment) newOnBranchHandler() interpreter.OnBranchFunc {
+	if e.config.CoverageReport == nil {
+		return nil
+	}
+
+	return func(inter *interpreter.Interpreter, element ast.Element, branch int) {
+		location := e.inspectCoverageLocation(inter)
+
+		elementRange := ast.NewRangeFromPositioned(nil, element)
+		e.coverageReport.AddBranchHit(location, elementRange, branch)
+	}
+}
+
+func (e *interpreterEnvironment) newOnInterpretedFunctionInvocationHandler() interpreter.OnInterpretedFunctionInvocationFunc {
+	if e.config.CoverageReport == nil {
+		return nil
+	}
+
+	return func(inter *interpreter.Interpreter, function *interpreter.InterpretedFunctionValue) {
+		location := e.inspectCoverageLocation(inter)
+
+		e.coverageReport.AddFunctionHit(location, functionKey(function.ParameterList))
+	}
+}
+
+// inspectCoverageLocation inspects the program of the given interpreter
+// for coverage collection, if it was not inspected yet,
+// and returns the location of the program
+func (e *interpreterEnvironment) inspectCoverageLocation(inter *interpreter.Interpreter) common.Location {
+	location := inter.Location
+	if !e.coverageReport.IsLocationInspected(location) {
+		program := inter.Program.Program
+		e.coverageReport.InspectProgram(location, program)
+	}
+	return location
+}
+
 func (e *interpreterEnvironment) newOnRecordTraceHandler() interpreter.OnRecordTraceFunc {
 	return func(
 		interpreter *interpreter.Interpreter,
@@ -1040,6 +1110,7 @@ func (e *interpreterEnvironment) newImportLocationHandler() interpreter.ImportLo
 			location,
 			getAndSetProgram,
 			importResolutionResults{},
+			nil,
 		)
 		if err != nil {
 			panic(err)
@@ -285,6 +285,28 @@ func (e *ArgumentNotImportableError) Error() string {
 	)
 }
 
+// ImportCodeHashMismatchError is an error that is reported
+// when the code of an imported program does not match
+// the code hash the import is pinned to
+type ImportCodeHashMismatchError struct {
+	Location     Location
+	ExpectedHash []byte
+	ActualHash   []byte
+}
+
+var _ errors.UserError = &ImportCodeHashMismatchError{}
+
+func (*ImportCodeHashMismatchError) IsUserError() {}
+
+func (e *ImportCodeHashMismatchError) Error() string {
+	return fmt.Sprintf(
+		"code hash mismatch for import of `%s`: expected %x, got %x",
+		e.Location,
+		e.ExpectedHash,
+		e.ActualHash,
+	)
+}
+
 // ParsingCheckingError is an error wrapper
 // for a parsing or a checking error at a specific location
 type ParsingCheckingError struct {
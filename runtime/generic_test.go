@@ -0,0 +1,139 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	. "github.com/onflow/cadence/runtime"
+	. "github.com/onflow/cadence/test_utils/runtime_utils"
+)
+
+func TestRuntimeGenericCompositeStorage(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	contract := []byte(`
+      access(all) contract Test {
+
+          access(all) resource Vault<T> {
+
+              access(all) var items: [T]
+
+              init() {
+                  self.items = []
+              }
+
+              access(all) fun deposit(_ item: T) {
+                  self.items.append(item)
+              }
+          }
+
+          access(all) fun createVault<T>(): @Vault<T> {
+              return <-create Vault<T>()
+          }
+      }
+    `)
+
+	deploy := DeploymentTransaction("Test", contract)
+
+	setupTx := []byte(`
+      import Test from 0x1
+
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              let vault <- Test.createVault<Int>()
+              vault.deposit(1)
+              signer.storage.save(<-vault, to: /storage/vault)
+          }
+      }
+    `)
+
+	useTx := []byte(`
+      import Test from 0x1
+
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              assert(signer.storage.type(at: /storage/vault) == Type<@Test.Vault<Int>>())
+              assert(signer.storage.check<@Test.Vault<Int>>(from: /storage/vault))
+              assert(!signer.storage.check<@Test.Vault<String>>(from: /storage/vault))
+
+              let vault = signer.storage.borrow<&Test.Vault<Int>>(from: /storage/vault)!
+              vault.deposit(2)
+              log(vault.items)
+              log(vault.getType().identifier)
+          }
+      }
+    `)
+
+	var accountCode []byte
+	var loggedMessages []string
+
+	runtimeInterface := &TestRuntimeInterface{
+		OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+		OnGetAccountContractCode: func(_ common.AddressLocation) ([]byte, error) {
+			return accountCode, nil
+		},
+		Storage: NewTestLedger(nil, nil),
+		OnGetSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		OnUpdateAccountContractCode: func(_ common.AddressLocation, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		OnEmitEvent: func(_ cadence.Event) error {
+			return nil
+		},
+		OnProgramLog: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := NewTransactionLocationGenerator()
+
+	for _, tx := range [][]byte{deploy, setupTx, useTx} {
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: tx,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t,
+		[]string{
+			"[1, 2]",
+			`"A.0000000000000001.Test.Vault<Int>"`,
+		},
+		loggedMessages,
+	)
+}
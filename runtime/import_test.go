@@ -23,7 +23,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/common"
@@ -116,6 +118,94 @@ func TestRuntimeCyclicImport(t *testing.T) {
 	require.IsType(t, &sema.CyclicImportsError{}, errs[0])
 }
 
+func TestRuntimeImportCodeHash(t *testing.T) {
+
+	t.Parallel()
+
+	imported := []byte(`
+      access(all) fun answer(): Int {
+          return 42
+      }
+    `)
+
+	importedCodeHash := sha3.Sum256(imported)
+
+	executeScript := func(codeHash []byte) (cadence.Value, error) {
+		runtime := NewTestInterpreterRuntime()
+
+		runtimeInterface := &TestRuntimeInterface{
+			OnGetCode: func(location Location) (bytes []byte, err error) {
+				switch location {
+				case common.IdentifierLocation("imported"):
+					return imported, nil
+				default:
+					return nil, fmt.Errorf("unknown import location: %s", location)
+				}
+			},
+		}
+
+		script := []byte(fmt.Sprintf(
+			`
+              import answer from imported hash "%x"
+
+              access(all) fun main(): Int {
+                  return answer()
+              }
+            `,
+			codeHash,
+		))
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+	}
+
+	t.Run("matching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := executeScript(importedCodeHash[:])
+		require.NoError(t, err)
+
+		require.Equal(t, cadence.NewInt(42), result)
+	})
+
+	t.Run("mismatching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		otherCodeHash := sha3.Sum256([]byte("access(all) fun answer(): Int { return 0 }"))
+
+		_, err := executeScript(otherCodeHash[:])
+		RequireError(t, err)
+
+		var checkerErr *sema.CheckerError
+		require.ErrorAs(t, err, &checkerErr)
+
+		// The import fails, so the imported function is not declared
+
+		errs := RequireCheckerErrors(t, checkerErr, 2)
+
+		var importedProgramErr *sema.ImportedProgramError
+		require.ErrorAs(t, errs[0], &importedProgramErr)
+
+		require.IsType(t, &sema.NotDeclaredError{}, errs[1])
+
+		var mismatchErr *ImportCodeHashMismatchError
+		require.ErrorAs(t, importedProgramErr.Err, &mismatchErr)
+
+		assert.Equal(t, common.IdentifierLocation("imported"), mismatchErr.Location)
+		assert.Equal(t, otherCodeHash[:], mismatchErr.ExpectedHash)
+		assert.Equal(t, importedCodeHash[:], mismatchErr.ActualHash)
+	})
+}
+
 func TestRuntimeCheckCyclicImportsAfterUpdate(t *testing.T) {
 
 	runtime := NewTestInterpreterRuntime()
@@ -286,15 +286,24 @@ func fixedPointLiteralValue(memoryGauge common.MemoryGauge, expression ast.Expre
 		return nil, InvalidLiteralError
 	}
 
-	// TODO: adjust once/if we support more fixed point types
-
-	value := fixedpoint.ConvertToFixedPointBigInt(
-		fixedPointExpression.Negative,
-		fixedPointExpression.UnsignedInteger,
-		fixedPointExpression.Fractional,
-		fixedPointExpression.Scale,
-		sema.Fix64Scale,
-	)
+	convert := func(targetScale uint) *big.Int {
+		return fixedpoint.ConvertToFixedPointBigInt(
+			fixedPointExpression.Negative,
+			fixedPointExpression.UnsignedInteger,
+			fixedPointExpression.Fractional,
+			fixedPointExpression.Scale,
+			targetScale,
+		)
+	}
+
+	switch ty {
+	case sema.Fix128Type:
+		return cadence.NewFix128FromBig(convert(sema.Fix128Scale))
+	case sema.UFix128Type:
+		return cadence.NewUFix128FromBig(convert(sema.Fix128Scale))
+	}
+
+	value := convert(sema.Fix64Scale)
 
 	switch ty {
 	case sema.Fix64Type, sema.FixedPointType, sema.SignedFixedPointType:
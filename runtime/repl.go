@@ -47,7 +47,9 @@ type REPL struct {
 	parserConfig     parser.Config
 }
 
-func NewREPL() (*REPL, error) {
+// NewREPL returns a new REPL.
+// If a debugger is given, functions invoked by the entered code can be debugged
+func NewREPL(debugger *interpreter.Debugger) (*REPL, error) {
 
 	// Prepare checkers
 
@@ -94,6 +96,7 @@ func NewREPL() (*REPL, error) {
 		ImportLocationHandler: func(inter *interpreter.Interpreter, location common.Location) interpreter.Import {
 			panic(fmt.Errorf("cannot import %s: Importing programs is not supported yet", location.ID()))
 		},
+		Debugger: debugger,
 	}
 
 	inter, err := interpreter.NewInterpreter(
@@ -376,7 +376,18 @@ func validateArgumentParams(
 	argumentCount := len(arguments)
 	parameterCount := len(parameters)
 
-	if argumentCount != parameterCount {
+	// Arguments for trailing parameters with default arguments may be omitted
+
+	requiredArgumentCount := sema.RequiredArgumentCount(parameters)
+
+	if argumentCount < requiredArgumentCount {
+		return nil, InvalidEntryPointParameterCountError{
+			Expected: requiredArgumentCount,
+			Actual:   argumentCount,
+		}
+	}
+
+	if argumentCount > parameterCount {
 		return nil, InvalidEntryPointParameterCountError{
 			Expected: parameterCount,
 			Actual:   argumentCount,
@@ -386,9 +397,8 @@ func validateArgumentParams(
 	argumentValues := make([]interpreter.Value, len(arguments))
 
 	// Decode arguments against parameter types
-	for parameterIndex, parameter := range parameters {
-		parameterType := parameter.TypeAnnotation.Type
-		argument := arguments[parameterIndex]
+	for parameterIndex, argument := range arguments {
+		parameterType := parameters[parameterIndex].TypeAnnotation.Type
 
 		exportedParameterType := ExportMeteredType(inter, parameterType, map[sema.TypeID]cadence.Type{})
 		var value cadence.Value
@@ -499,6 +509,8 @@ func hasValidStaticType(inter *interpreter.Interpreter, value interpreter.Value)
 	case *interpreter.DictionaryValue:
 		return value.Type.KeyType != nil &&
 			value.Type.ValueType != nil
+	case *interpreter.SetValue:
+		return value.Type.ElementType != nil
 	default:
 		// For other values, static type is NOT inferred.
 		// Hence no need to validate it here.
@@ -7122,6 +7122,106 @@ func TestRuntimeExecuteScriptArguments(t *testing.T) {
 	}
 }
 
+func TestRuntimeExecuteScriptDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	script := []byte(`
+      access(all) fun main(a: Int, b: Int = 2, c: Int = 3): Int {
+          return a + b * c
+      }
+    `)
+
+	type testCase struct {
+		name      string
+		arguments [][]byte
+		expected  cadence.Value
+	}
+
+	test := func(tc testCase) {
+		t.Run(tc.name, func(t *testing.T) {
+
+			// NOTE: to parallelize this sub-test,
+			// access to `programs` must be made thread-safe first
+
+			storage := NewTestLedger(nil, nil)
+
+			runtimeInterface := &TestRuntimeInterface{
+				Storage: storage,
+				OnDecodeArgument: func(b []byte, t cadence.Type) (cadence.Value, error) {
+					return json.Decode(nil, b)
+				},
+			}
+
+			result, err := runtime.ExecuteScript(
+				Script{
+					Source:    script,
+					Arguments: tc.arguments,
+				},
+				Context{
+					Interface: runtimeInterface,
+					Location:  common.ScriptLocation{0x1},
+				},
+			)
+
+			if tc.expected != nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			} else {
+				RequireError(t, err)
+
+				assertRuntimeErrorIsUserError(t, err)
+
+				require.ErrorAs(t, err, &InvalidEntryPointParameterCountError{})
+			}
+		})
+	}
+
+	for _, testCase := range []testCase{
+		{
+			name:      "missing required argument",
+			arguments: [][]byte{},
+		},
+		{
+			name: "required argument only",
+			arguments: encodeArgs([]cadence.Value{
+				cadence.NewInt(1),
+			}),
+			expected: cadence.NewInt(7),
+		},
+		{
+			name: "some default arguments",
+			arguments: encodeArgs([]cadence.Value{
+				cadence.NewInt(1),
+				cadence.NewInt(4),
+			}),
+			expected: cadence.NewInt(13),
+		},
+		{
+			name: "all arguments",
+			arguments: encodeArgs([]cadence.Value{
+				cadence.NewInt(1),
+				cadence.NewInt(4),
+				cadence.NewInt(5),
+			}),
+			expected: cadence.NewInt(21),
+		},
+		{
+			name: "too many arguments",
+			arguments: encodeArgs([]cadence.Value{
+				cadence.NewInt(1),
+				cadence.NewInt(2),
+				cadence.NewInt(3),
+				cadence.NewInt(4),
+			}),
+		},
+	} {
+		test(testCase)
+	}
+}
+
 func TestRuntimePanics(t *testing.T) {
 
 	t.Parallel()
@@ -9252,6 +9352,10 @@ func TestRuntimeTypesAndConversions(t *testing.T) {
 		if _, isInclusiveRange := ty.(*sema.InclusiveRangeType); isInclusiveRange {
 			continue
 		}
+		// Set is a dynamically created type.
+		if _, isSet := ty.(*sema.SetType); isSet {
+			continue
+		}
 		test(name, ty)
 	}
 }
@@ -12403,3 +12507,60 @@ func TestRuntimeInterfaceConditionDeduplication(t *testing.T) {
 		events,
 	)
 }
+
+func TestRuntimeEnumSwitchExhaustiveness(t *testing.T) {
+
+	t.Parallel()
+
+	script := []byte(`
+      access(all) enum E: UInt8 {
+          access(all) case a
+          access(all) case b
+      }
+
+      access(all) fun main(): Int {
+          switch E.b {
+              case E.a:
+                  return 1
+          }
+          return 0
+      }
+    `)
+
+	execute := func(config Config) (cadence.Value, error) {
+		runtime := NewTestInterpreterRuntimeWithConfig(config)
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: &TestRuntimeInterface{},
+				Location:  common.ScriptLocation{},
+			},
+		)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := execute(Config{})
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.NewInt(0), result)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := execute(Config{
+			EnumSwitchExhaustivenessCheckEnabled: true,
+		})
+		RequireError(t, err)
+
+		var missingSwitchCasesErr *sema.MissingSwitchCasesError
+		require.ErrorAs(t, err, &missingSwitchCasesErr)
+	})
+}
@@ -1231,6 +1231,86 @@ func TestRuntimeStorageSaveCapability(t *testing.T) {
 	require.Equal(t, expected, value)
 }
 
+func TestRuntimeStorageSet(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	storage := NewTestLedger(nil, nil)
+
+	signer := common.MustBytesToAddress([]byte{0x42})
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: storage,
+		OnGetSigningAccounts: func() ([]Address, error) {
+			return []Address{signer}, nil
+		},
+	}
+
+	nextTransactionLocation := NewTransactionLocationGenerator()
+
+	executeTransaction := func(code string) {
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: []byte(code),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	executeTransaction(`
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              signer.storage.save(Set([1, 2]), to: /storage/set)
+          }
+      }
+    `)
+
+	executeTransaction(`
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              let set = signer.storage.borrow<auth(Mutate) &Set<Int>>(from: /storage/set)!
+              set.insert(3)
+              set.remove(1)
+          }
+      }
+    `)
+
+	result, err := runtime.ExecuteScript(
+		Script{
+			Source: []byte(`
+              access(all) fun main(): [AnyStruct] {
+                  let account = getAuthAccount<auth(Storage) &Account>(0x42)
+                  let set = account.storage.load<Set<Int>>(from: /storage/set)!
+                  return [set.length, set.contains(1), set.contains(2), set.contains(3)]
+              }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewInt(2),
+			cadence.NewBool(false),
+			cadence.NewBool(true),
+			cadence.NewBool(true),
+		}).WithType(&cadence.VariableSizedArrayType{
+			ElementType: cadence.AnyStructType,
+		}),
+		result,
+	)
+}
+
 func TestRuntimeStorageReferenceCast(t *testing.T) {
 
 	t.Parallel()
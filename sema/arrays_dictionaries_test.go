@@ -1329,6 +1329,477 @@ func TestCheckResourceArrayMapInvalid(t *testing.T) {
 	assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
 }
 
+func TestCheckArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		fun test() {
+			let x = [3, 1, 2]
+			let ascending =
+				view fun (_ a: Int, _ b: Int): Bool {
+					return a < b
+				}
+
+			let y: [Int] = x.sort(by: ascending)
+		}
+
+		fun testFixedSize() {
+			let x: [Int; 3] = [3, 1, 2]
+			let y: [Int; 3] = x.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+				return a > b
+			})
+		}
+	`)
+
+	require.NoError(t, err)
+}
+
+func TestCheckArraySortInvalidArgs(t *testing.T) {
+
+	t.Parallel()
+
+	testInvalidArgs := func(code string, expectedErrors []sema.SemanticError) {
+		_, err := ParseAndCheck(t, code)
+
+		errs := RequireCheckerErrors(t, err, len(expectedErrors))
+
+		for i, e := range expectedErrors {
+			assert.IsType(t, e, errs[i])
+		}
+	}
+
+	testInvalidArgs(`
+		fun test() {
+			let x = [1, 2, 3]
+			let y = x.sort(by: 100)
+		}
+	`,
+		[]sema.SemanticError{
+			&sema.TypeMismatchError{},
+		},
+	)
+
+	testInvalidArgs(`
+		fun test() {
+			let x = [1, 2, 3]
+			let y = x.sort(view fun (_ a: Int, _ b: Int): Bool {
+				return a < b
+			})
+		}
+	`,
+		[]sema.SemanticError{
+			&sema.MissingArgumentLabelError{},
+		},
+	)
+
+	// the comparator must be a view function
+	testInvalidArgs(`
+		fun test() {
+			let x = [1, 2, 3]
+			let y = x.sort(by: fun (_ a: Int, _ b: Int): Bool {
+				return a < b
+			})
+		}
+	`,
+		[]sema.SemanticError{
+			&sema.TypeMismatchError{},
+		},
+	)
+}
+
+func TestCheckResourceArraySortInvalid(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		resource X {}
+
+		fun test() {
+			let xs <- [<-create X()]
+			let ys <- xs.sort(by: view fun (_ a: &X, _ b: &X): Bool {
+				return true
+			})
+			destroy xs
+			destroy ys
+		}
+	`)
+
+	errs := RequireCheckerErrors(t, err, 2)
+
+	assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+}
+
+func TestCheckArrayReduce(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		fun test() {
+			let x = [1, 2, 3]
+			let sum: Int = x.reduce(initial: 0, view fun (_ acc: Int, _ x: Int): Int {
+				return acc + x
+			})
+
+			let description: String = x.reduce(initial: "", view fun (_ acc: String, _ x: Int): String {
+				return acc.concat(x.toString())
+			})
+		}
+
+		fun testFixedSize() {
+			let x: [Int; 3] = [1, 2, 3]
+			let product = x.reduce(initial: 1, view fun (_ acc: Int, _ x: Int): Int {
+				return acc * x
+			})
+		}
+
+		fun testImpure() {
+			let x = [1, 2, 3]
+			var count = 0
+			let sum = x.reduce(initial: 0, fun (_ acc: Int, _ x: Int): Int {
+				count = count + 1
+				return acc + x
+			})
+		}
+	`)
+
+	require.NoError(t, err)
+}
+
+func TestCheckArrayReduceInvalidArgs(t *testing.T) {
+
+	t.Parallel()
+
+	testInvalidArgs := func(code string, expectedErrors []sema.SemanticError) {
+		_, err := ParseAndCheck(t, code)
+
+		errs := RequireCheckerErrors(t, err, len(expectedErrors))
+
+		for i, e := range expectedErrors {
+			assert.IsType(t, e, errs[i])
+		}
+	}
+
+	testInvalidArgs(`
+		fun test() {
+			let x = [1, 2, 3]
+			let y = x.reduce(initial: "", view fun (_ acc: Int, _ x: Int): Int {
+				return acc + x
+			})
+		}
+	`,
+		[]sema.SemanticError{
+			&sema.TypeMismatchError{},
+		},
+	)
+
+	testInvalidArgs(`
+		fun test() {
+			let x = [1, 2, 3]
+			let y = x.reduce(initial: 0, view fun (_ acc: Int, _ x: String): Int {
+				return acc
+			})
+		}
+	`,
+		[]sema.SemanticError{
+			&sema.TypeMismatchError{},
+		},
+	)
+
+	// Like map, reduce may invoke an impure function,
+	// so it cannot be used in a view context
+
+	testInvalidArgs(`
+		view fun test(): Int {
+			let x = [1, 2, 3]
+			return x.reduce(initial: 0, view fun (_ acc: Int, _ x: Int): Int {
+				return acc + x
+			})
+		}
+	`,
+		[]sema.SemanticError{
+			&sema.PurityError{},
+		},
+	)
+}
+
+func TestCheckArrayAnyAll(t *testing.T) {
+
+	t.Parallel()
+
+	for _, name := range []string{"any", "all"} {
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      fun test() {
+                          let x = [1, 2, 3]
+                          let y: Bool = x.%[1]s(view fun (_ x: Int): Bool {
+                              return x > 1
+                          })
+                      }
+
+                      fun testFixedSize() {
+                          let x: [Int; 3] = [1, 2, 3]
+                          let y: Bool = x.%[1]s(view fun (_ x: Int): Bool {
+                              return x > 1
+                          })
+                      }
+                    `,
+					name,
+				),
+			)
+
+			require.NoError(t, err)
+		})
+
+		t.Run(name+", invalid argument", func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      fun test() {
+                          let x = [1, 2, 3]
+                          let y = x.%s(view fun (_ x: String): Bool {
+                              return true
+                          })
+                      }
+                    `,
+					name,
+				),
+			)
+
+			errs := RequireCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		})
+
+		t.Run(name+", resource array", func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      resource X {}
+
+                      fun test() {
+                          let xs <- [<-create X()]
+                          let y = xs.%s(view fun (_ x: &X): Bool {
+                              return true
+                          })
+                          destroy xs
+                      }
+                    `,
+					name,
+				),
+			)
+
+			errs := RequireCheckerErrors(t, err, 2)
+
+			assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+			assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+		})
+	}
+}
+
+func TestCheckArrayFirstIndexWhere(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			fun test() {
+				let x = [1, 2, 3]
+				let index: Int? = x.firstIndex(where: view fun (_ x: Int): Bool {
+					return x > 1
+				})
+				let otherIndex: Int? = x.firstIndex(of: 2)
+			}
+		`)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-equatable element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			struct S {
+				let x: Int
+
+				init(x: Int) {
+					self.x = x
+				}
+			}
+
+			fun test() {
+				let xs = [S(x: 1), S(x: 2)]
+				let index: Int? = xs.firstIndex(where: view fun (_ s: S): Bool {
+					return s.x == 2
+				})
+			}
+		`)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-view predicate", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			fun test() {
+				let x = [1, 2, 3]
+				let index = x.firstIndex(where: fun (_ x: Int): Bool {
+					return x > 1
+				})
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource array", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The predicate must be a view function,
+		// so it cannot take ownership of the resource elements
+
+		_, err := ParseAndCheck(t, `
+			resource X {}
+
+			fun test() {
+				let xs <- [<-create X()]
+				let index = xs.firstIndex(where: view fun (_ x: @X): Bool {
+					destroy x
+					return true
+				})
+				destroy xs
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+}
+
+func TestCheckArrayForEach(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			fun test(): Int {
+				var sum = 0
+				let x = [1, 2, 3]
+				x.forEach(fun (_ x: Int): Bool {
+					sum = sum + x
+					return sum < 3
+				})
+				return sum
+			}
+		`)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("function without result", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			fun test() {
+				let x = [1, 2, 3]
+				x.forEach(fun (_ x: Int) {})
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("impure in view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			view fun test() {
+				let x = [1, 2, 3]
+				x.forEach(fun (_ x: Int): Bool {
+					return true
+				})
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("resource array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			resource X {}
+
+			fun test() {
+				let xs <- [<-create X()]
+				xs.forEach(fun (_ x: &X): Bool {
+					return true
+				})
+				destroy xs
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+}
+
+func TestCheckArrayViewFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+		view fun test(): Bool {
+			let x = [3, 1, 2]
+			let sorted = x.sort(by: view fun (_ a: Int, _ b: Int): Bool {
+				return a < b
+			})
+			let index = x.firstIndex(where: view fun (_ x: Int): Bool {
+				return x == 2
+			})
+			return x.any(view fun (_ x: Int): Bool { return x > 2 })
+				&& x.all(view fun (_ x: Int): Bool { return x > 0 })
+		}
+	`)
+
+	require.NoError(t, err)
+}
+
 func TestCheckArrayContains(t *testing.T) {
 
 	t.Parallel()
@@ -1429,6 +1900,271 @@ func TestCheckInvalidDictionaryContainsKey(t *testing.T) {
 	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
 }
 
+func TestCheckDictionaryFilter(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+			let x = {"a": 1, "b": 2, "c": 3}
+			let y = x.filter(view fun (key: String, value: Int): Bool {
+				return value > 1 && key != "c"
+			})
+		`)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.DictionaryType{
+				KeyType:   sema.StringType,
+				ValueType: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("invalid predicate", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			let x = {"a": 1}
+			let y = x.filter(view fun (key: String, value: String): Bool {
+				return true
+			})
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("impure predicate", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			let x = {"a": 1}
+			let y = x.filter(fun (key: String, value: Int): Bool {
+				return true
+			})
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			resource R {}
+
+			fun test() {
+				let x <- {"a": <-create R()}
+				let y <- x.filter(view fun (key: String, value: &R): Bool {
+					return true
+				})
+				destroy x
+				destroy y
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidResourceDictionaryMemberError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+}
+
+func TestCheckDictionaryMap(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("map", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+			let x = {"a": 1, "b": 2}
+			let y = x.map(fun (key: String, value: Int): String {
+				return key.concat(value.toString())
+			})
+		`)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.DictionaryType{
+				KeyType:   sema.StringType,
+				ValueType: sema.StringType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("mapValues", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+			let x = {"a": 1, "b": 2}
+			let y = x.mapValues(fun (_ value: Int): Bool {
+				return value > 1
+			})
+		`)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.DictionaryType{
+				KeyType:   sema.StringType,
+				ValueType: sema.BoolType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("invalid transform", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			let x = {"a": 1}
+			let y = x.mapValues(fun (key: String, value: Int): Int {
+				return value
+			})
+		`)
+
+		errs := RequireCheckerErrors(t, err, 3)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.InvocationTypeInferenceError{}, errs[1])
+		assert.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[2])
+	})
+
+	t.Run("in view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			view fun test() {
+				let x = {"a": 1}
+				let y = x.mapValues(view fun (_ value: Int): Int {
+					return value
+				})
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("resource dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			resource R {}
+
+			fun test() {
+				let x <- {"a": <-create R()}
+				let y = x.map(fun (key: String, value: &R): Int {
+					return 1
+				})
+				destroy x
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidResourceDictionaryMemberError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+}
+
+func TestCheckDictionaryForEach(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			fun test(): Int {
+				var sum = 0
+				let x = {"a": 1, "b": 2}
+				x.forEach(fun (key: String, value: Int): Bool {
+					sum = sum + value
+					return true
+				})
+				return sum
+			}
+		`)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("function without result", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			fun test() {
+				let x = {"a": 1}
+				x.forEach(fun (key: String, value: Int) {})
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid procedure", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			fun test() {
+				let x = {"a": 1}
+				x.forEach(fun (_ value: Int): Bool {
+					return true
+				})
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("impure in view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+			view fun test() {
+				let x = {"a": 1}
+				x.forEach(fun (key: String, value: Int): Bool {
+					return true
+				})
+			}
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+}
+
 func TestCheckEmptyDictionary(t *testing.T) {
 
 	t.Parallel()
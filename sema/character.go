@@ -22,6 +22,8 @@ package sema
 
 import "github.com/rivo/uniseg"
 
+var CharacterTypeAnnotation = NewTypeAnnotation(CharacterType)
+
 func IsValidCharacter(s string) bool {
 	graphemes := uniseg.NewGraphemes(s)
 	// a valid character must have exactly one grapheme cluster
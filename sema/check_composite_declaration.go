@@ -187,7 +187,7 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 	declarationKind := declaration.Kind()
 	checker.checkFieldsAccessModifier(members.Fields(), compositeType.Members, &declarationKind)
 
-	checker.checkNestedIdentifiers(members)
+	checker.checkNestedIdentifiers(members, true)
 
 	// Activate new scopes for nested types
 
@@ -197,13 +197,16 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 	checker.enterValueScope()
 	defer checker.leaveValueScope(declaration.EndPosition, false)
 
+	checker.declareCompositeTypeParameterTypes(declaration, compositeType)
+
 	checker.declareCompositeLikeNestedTypes(declaration, true)
 
 	var initializationInfo *InitializationInfo
-	// The initializer must initialize all members that are fields,
-	// e.g. not composite functions (which are by definition constant and "initialized")
+	// The initializer must initialize all members that are stored fields,
+	// e.g. not composite functions (which are by definition constant and "initialized"),
+	// or computed fields
 
-	fields := members.Fields()
+	fields := members.StoredFields()
 	fieldMembers := orderedmap.New[MemberFieldDeclarationOrderedMap](len(fields))
 
 	for _, field := range fields {
@@ -220,7 +223,7 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 
 	checker.checkInitializers(
 		members.Initializers(),
-		members.Fields(),
+		fields,
 		compositeType,
 		declaration.DeclarationDocString(),
 		compositeType.ConstructorPurity,
@@ -237,6 +240,12 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 		declaration.DeclarationDocString(),
 	)
 
+	checker.checkComputedFields(
+		members.ComputedFields(),
+		compositeType,
+		declaration.DeclarationDocString(),
+	)
+
 	fieldPositionGetter := func(name string) ast.Position {
 		return compositeType.FieldPosition(name, declaration)
 	}
@@ -275,7 +284,7 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 		})
 	}
 
-	// NOTE: visit entitlements, then interfaces, then composites
+	// NOTE: visit entitlements, then type aliases, then interfaces, then composites
 	// DON'T use `nestedDeclarations`, because of non-deterministic order
 
 	for _, nestedEntitlement := range members.Entitlements() {
@@ -286,6 +295,10 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 		ast.AcceptDeclaration[struct{}](nestedEntitlement, checker)
 	}
 
+	for _, nestedTypeAlias := range members.TypeAliases() {
+		ast.AcceptDeclaration[struct{}](nestedTypeAlias, checker)
+	}
+
 	for _, nestedInterface := range members.Interfaces() {
 		ast.AcceptDeclaration[struct{}](nestedInterface, checker)
 	}
@@ -404,6 +417,8 @@ func (checker *Checker) declareCompositeLikeNestedTypes(
 			}
 		}
 	})
+
+	checker.declareContainedTypeAliases(compositeType)
 }
 
 func (checker *Checker) declareNestedDeclarations(
@@ -655,6 +670,8 @@ func (checker *Checker) declareCompositeType(declaration ast.CompositeLikeDeclar
 		Members:     &StringMemberOrderedMap{},
 	}
 
+	compositeType.typeParameters = checker.compositeTypeParameters(declaration)
+
 	variable, err := checker.typeActivations.declareType(typeDeclaration{
 		identifier:               identifier,
 		ty:                       compositeType,
@@ -676,7 +693,22 @@ func (checker *Checker) declareCompositeType(declaration ast.CompositeLikeDeclar
 
 	switch declaration.Kind() {
 	case common.CompositeKindEnum:
-		compositeType.EnumRawType = checker.enumRawType(declaration.(*ast.CompositeDeclaration))
+		compositeDeclaration := declaration.(*ast.CompositeDeclaration)
+		compositeType.EnumRawType = checker.enumRawType(compositeDeclaration)
+
+		// The first conformance is the raw type,
+		// all other conformances must be struct interfaces
+
+		if len(compositeDeclaration.Conformances) > 1 {
+			compositeType.ExplicitInterfaceConformances =
+				checker.interfaceConformances(compositeDeclaration.Conformances[1:], compositeType)
+		}
+
+		enumCases := compositeDeclaration.Members.EnumCases()
+		compositeType.EnumCases = make([]string, 0, len(enumCases))
+		for _, enumCase := range enumCases {
+			compositeType.EnumCases = append(compositeType.EnumCases, enumCase.Identifier.Identifier)
+		}
 
 	case common.CompositeKindAttachment:
 
@@ -765,6 +797,59 @@ func (checker *Checker) declareCompositeType(declaration ast.CompositeLikeDeclar
 	return compositeType
 }
 
+// compositeTypeParameters converts the type parameters of the given composite declaration, if any.
+// Only structures and resources may have type parameters.
+// Type parameters without a type bound are implicitly bound to non-resource types
+func (checker *Checker) compositeTypeParameters(declaration ast.CompositeLikeDeclaration) []*TypeParameter {
+	compositeDeclaration, ok := declaration.(*ast.CompositeDeclaration)
+	if !ok || compositeDeclaration.TypeParameterList.IsEmpty() {
+		return nil
+	}
+
+	typeParameterList := compositeDeclaration.TypeParameterList
+
+	switch compositeDeclaration.CompositeKind {
+	case common.CompositeKindStructure,
+		common.CompositeKindResource:
+		break
+
+	default:
+		checker.report(
+			&InvalidTypeParameterizedCompositeError{
+				CompositeKind: compositeDeclaration.CompositeKind,
+				Range: ast.NewRangeFromPositioned(
+					checker.memoryGauge,
+					typeParameterList,
+				),
+			},
+		)
+		return nil
+	}
+
+	return checker.typeParameters(typeParameterList, AnyStructType)
+}
+
+// declareCompositeTypeParameterTypes declares the type parameters of a generic composite type
+// in the current type scope, so they can be used in the members of the composite
+func (checker *Checker) declareCompositeTypeParameterTypes(
+	declaration ast.CompositeLikeDeclaration,
+	compositeType *CompositeType,
+) {
+	if len(compositeType.typeParameters) == 0 {
+		return
+	}
+
+	compositeDeclaration, ok := declaration.(*ast.CompositeDeclaration)
+	if !ok {
+		return
+	}
+
+	checker.declareTypeParameterTypes(
+		compositeDeclaration.TypeParameterList,
+		compositeType.typeParameters,
+	)
+}
+
 func (checker *Checker) declareAttachmentMembersAndValue(declaration *ast.AttachmentDeclaration) {
 	checker.declareCompositeLikeMembersAndValue(declaration)
 }
@@ -800,6 +885,8 @@ func (checker *Checker) declareCompositeLikeMembersAndValue(
 		checker.enterValueScope()
 		defer checker.leaveValueScope(declaration.EndPosition, false)
 
+		checker.declareCompositeTypeParameterTypes(declaration, compositeType)
+
 		// Declare nested types
 
 		checker.declareCompositeLikeNestedTypes(declaration, false)
@@ -817,7 +904,12 @@ func (checker *Checker) declareCompositeLikeMembersAndValue(
 		// and after declaring nested types as the initializer may use nested type in parameters
 
 		initializers := members.Initializers()
-		compositeType.ConstructorParameters = checker.initializerParameters(initializers)
+		if len(initializers) > 1 && supportsInitializerOverloading(compositeKind) {
+			compositeType.InitializerOverloads = checker.initializerOverloads(initializers)
+			compositeType.ConstructorParameters = compositeType.InitializerOverloads[0].Parameters
+		} else {
+			compositeType.ConstructorParameters = checker.initializerParameters(initializers)
+		}
 		compositeType.ConstructorPurity = checker.initializerPurity(compositeKind, initializers)
 
 		// Declare nested declarations' members
@@ -1203,15 +1295,109 @@ func (checker *Checker) initializerParameters(initializers []*ast.SpecialFunctio
 	return parameters
 }
 
+// initializerOverloads determines the function types of overloaded initializers,
+// and reports initializers which have the same argument labels as a previous initializer
+func (checker *Checker) initializerOverloads(initializers []*ast.SpecialFunctionDeclaration) []*FunctionType {
+	overloads := make([]*FunctionType, 0, len(initializers))
+	positions := make(map[string]ast.Position, len(initializers))
+
+	for _, initializer := range initializers {
+		functionDeclaration := initializer.FunctionDeclaration
+
+		functionType := &FunctionType{
+			IsConstructor:        true,
+			Purity:               PurityFromAnnotation(functionDeclaration.Purity),
+			Parameters:           checker.parameters(functionDeclaration.ParameterList),
+			ReturnTypeAnnotation: VoidTypeAnnotation,
+		}
+
+		checker.Elaboration.SetConstructorFunctionType(initializer, functionType)
+
+		pos := initializer.StartPosition()
+		overloadName := OverloadName(InitializerOverloadNamePrefix, functionType.ArgumentLabels())
+
+		if previousPos, ok := positions[overloadName]; ok {
+			checker.report(
+				&RedeclarationError{
+					Kind:        common.DeclarationKindInitializer,
+					Name:        common.DeclarationKindInitializer.Keywords(),
+					PreviousPos: &previousPos,
+					Pos:         pos,
+				},
+			)
+			continue
+		}
+		positions[overloadName] = pos
+
+		if checker.checkInitializerOverloadAmbiguity(overloadName, functionType, overloads, pos) {
+			continue
+		}
+
+		overloads = append(overloads, functionType)
+	}
+
+	return overloads
+}
+
+// checkInitializerOverloadAmbiguity reports if the initializer with the given overload name and function type
+// could be invoked with the same arguments as one of the given previously declared initializers.
+// It returns true if the initializer is ambiguous
+func (checker *Checker) checkInitializerOverloadAmbiguity(
+	overloadName string,
+	functionType *FunctionType,
+	previousOverloads []*FunctionType,
+	pos ast.Position,
+) bool {
+	argumentLabels := functionType.ArgumentLabels()
+	requiredArgumentCount := RequiredArgumentCount(functionType.Parameters)
+
+	for _, previousOverload := range previousOverloads {
+		previousArgumentLabels := previousOverload.ArgumentLabels()
+		if !argumentLabelsOverlap(
+			argumentLabels,
+			requiredArgumentCount,
+			previousArgumentLabels,
+			RequiredArgumentCount(previousOverload.Parameters),
+		) {
+			continue
+		}
+
+		checker.report(
+			&AmbiguousOverloadDeclarationError{
+				Name:      overloadName,
+				OtherName: OverloadName(InitializerOverloadNamePrefix, previousArgumentLabels),
+				Range: ast.NewRange(
+					checker.memoryGauge,
+					pos,
+					pos.Shifted(checker.memoryGauge, len(InitializerOverloadNamePrefix)-1),
+				),
+			},
+		)
+		return true
+	}
+
+	return false
+}
+
 func (checker *Checker) explicitInterfaceConformances(
 	conformingDeclaration ast.ConformingDeclaration,
 	compositeKindedType CompositeKindedType,
 ) []*InterfaceType {
+	return checker.interfaceConformances(
+		conformingDeclaration.ConformanceList(),
+		compositeKindedType,
+	)
+}
+
+func (checker *Checker) interfaceConformances(
+	conformances []*ast.NominalType,
+	compositeKindedType CompositeKindedType,
+) []*InterfaceType {
 
 	var interfaceTypes []*InterfaceType
 	seenConformances := map[*InterfaceType]bool{}
 
-	for _, conformance := range conformingDeclaration.ConformanceList() {
+	for _, conformance := range conformances {
 		convertedType := checker.ConvertType(conformance)
 
 		if interfaceType, ok := convertedType.(*InterfaceType); ok {
@@ -1246,7 +1432,7 @@ func (checker *Checker) enumRawType(declaration *ast.CompositeDeclaration) Type
 
 	conformanceCount := len(declaration.Conformances)
 
-	// Enums must have exactly one conformance, the raw type
+	// Enums must have at least one conformance, the raw type
 
 	if conformanceCount == 0 {
 		checker.report(
@@ -1258,28 +1444,7 @@ func (checker *Checker) enumRawType(declaration *ast.CompositeDeclaration) Type
 		return InvalidType
 	}
 
-	// Enums may not conform to interfaces,
-	// i.e. only have one conformance, the raw type
-
-	if conformanceCount > 1 {
-		secondConformance := declaration.Conformances[1]
-		lastConformance := declaration.Conformances[conformanceCount-1]
-
-		checker.report(
-			&InvalidEnumConformancesError{
-				Range: ast.NewRange(
-					checker.memoryGauge,
-					secondConformance.StartPosition(),
-					lastConformance.EndPosition(checker.memoryGauge),
-				),
-			},
-		)
-
-		// NOTE: do not return, the first conformance should
-		// still be considered as a raw type
-	}
-
-	// The single conformance is considered the raw type.
+	// The first conformance is considered the raw type.
 	// It must be an `Integer`-subtype for now.
 
 	conformance := declaration.Conformances[0]
@@ -1330,23 +1495,43 @@ func (checker *Checker) checkCompositeLikeConformance(
 
 	// Check initializer requirement
 
-	// TODO: add support for overloaded initializers
-
 	if conformance.InitializerParameters != nil {
 
-		initializerType := NewSimpleFunctionType(
-			compositeType.ConstructorPurity,
-			compositeType.ConstructorParameters,
-			VoidTypeAnnotation,
-		)
 		interfaceInitializerType := NewSimpleFunctionType(
 			conformance.InitializerPurity,
 			conformance.InitializerParameters,
 			VoidTypeAnnotation,
 		)
 
-		// TODO: subtype?
-		if !initializerType.Equal(interfaceInitializerType) {
+		// If the composite declares overloaded initializers,
+		// the overload with the same argument labels must satisfy the requirement
+
+		satisfied := false
+
+		if len(compositeType.InitializerOverloads) > 0 {
+			for _, overload := range compositeType.InitializerOverloads {
+				initializerType := NewSimpleFunctionType(
+					overload.Purity,
+					overload.Parameters,
+					VoidTypeAnnotation,
+				)
+				if initializerType.HasSameArgumentLabels(interfaceInitializerType) {
+					satisfied = initializerType.Equal(interfaceInitializerType)
+					break
+				}
+			}
+		} else {
+			initializerType := NewSimpleFunctionType(
+				compositeType.ConstructorPurity,
+				compositeType.ConstructorParameters,
+				VoidTypeAnnotation,
+			)
+
+			// TODO: subtype?
+			satisfied = initializerType.Equal(interfaceInitializerType)
+		}
+
+		if !satisfied {
 			initializerMismatch = &InitializerMismatch{
 				CompositePurity:     compositeType.ConstructorPurity,
 				InterfacePurity:     conformance.InitializerPurity,
@@ -1370,6 +1555,16 @@ func (checker *Checker) checkCompositeLikeConformance(
 		compositeMember, ok := compositeType.Members.Get(name)
 		if ok {
 
+			// If the composite member is an overloaded function,
+			// the overload with the same argument labels must satisfy the interface member
+
+			if len(compositeMember.Overloads) > 0 {
+				overload := compositeMember.Overload(interfaceMember.ArgumentLabels)
+				if overload != nil {
+					compositeMember = overload
+				}
+			}
+
 			// If the composite member exists, check if it satisfies the mem
 
 			if !checker.memberSatisfied(compositeType, compositeMember, interfaceMember) {
@@ -1474,7 +1669,7 @@ func (checker *Checker) checkConformanceKindMatch(
 ) {
 
 	// Check if the conformance kind matches the declaration type's kind.
-	if interfaceConformance.CompositeKind == compositeKindedType.GetCompositeKind() {
+	if compositeKindedType.GetCompositeKind().CanConformTo(interfaceConformance.CompositeKind) {
 		return
 	}
 
@@ -1638,11 +1833,10 @@ func CompositeLikeConstructorType(
 	constructorFunctionType = &FunctionType{
 		Purity:               compositeType.ConstructorPurity,
 		IsConstructor:        true,
+		TypeParameters:       compositeType.typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(compositeType),
 	}
 
-	// TODO: support multiple overloaded initializers
-
 	initializers := compositeDeclaration.DeclarationMembers().Initializers()
 	if len(initializers) > 0 {
 		firstInitializer := initializers[0]
@@ -1653,6 +1847,7 @@ func CompositeLikeConstructorType(
 			EffectiveArgumentLabels()
 
 		constructorFunctionType.Parameters = compositeType.ConstructorParameters
+		constructorFunctionType.Overloads = compositeType.ConstructorOverloadFunctionTypes()
 
 		// NOTE: Don't use `constructorFunctionType`, as it has a return type.
 		//   The initializer itself has a `Void` return type.
@@ -1787,7 +1982,21 @@ func (checker *Checker) defaultMembersAndOrigins(
 
 		identifier := field.Identifier.Identifier
 
-		fieldNames = append(fieldNames, identifier)
+		// Computed fields are not stored,
+		// and are only supported in enums
+
+		if field.IsComputed() {
+			if containerDeclarationKind != common.DeclarationKindEnum {
+				checker.report(
+					&InvalidComputedFieldError{
+						ContainerDeclarationKind: containerDeclarationKind,
+						Range:                    ast.NewRangeFromPositioned(checker.memoryGauge, field.Identifier),
+					},
+				)
+			}
+		} else {
+			fieldNames = append(fieldNames, identifier)
+		}
 
 		fieldAccess := checker.accessFromAstAccess(field.Access)
 
@@ -1818,6 +2027,16 @@ func (checker *Checker) defaultMembersAndOrigins(
 		checker.checkStaticModifier(field.IsStatic(), field.Identifier)
 		checker.checkNativeModifier(field.IsNative(), field.Identifier)
 
+		if field.IsComputed() {
+			checker.Elaboration.SetFieldDeclarationGetterType(
+				field,
+				&FunctionType{
+					Purity:               FunctionPurityView,
+					ReturnTypeAnnotation: fieldTypeAnnotation,
+				},
+			)
+		}
+
 		members.Set(
 			identifier,
 			&Member{
@@ -1851,6 +2070,13 @@ func (checker *Checker) defaultMembersAndOrigins(
 		}
 	}
 
+	// Functions of composites may be overloaded,
+	// keep track of the first function declared for each identifier
+	var firstFunctions map[string]*Member
+	if containerKind == ContainerKindComposite {
+		firstFunctions = make(map[string]*Member, len(functions))
+	}
+
 	// declare a member for each function
 	for _, function := range functions {
 
@@ -1901,23 +2127,58 @@ func (checker *Checker) defaultMembersAndOrigins(
 		hasImplementation := function.FunctionBlock.HasStatements()
 		hasConditions := function.FunctionBlock.HasConditions()
 
-		members.Set(
-			identifier,
-			&Member{
-				ContainerType:     containerType,
-				Access:            functionAccess,
-				Identifier:        function.Identifier,
-				DeclarationKind:   declarationKind,
-				TypeAnnotation:    fieldTypeAnnotation,
-				VariableKind:      ast.VariableKindConstant,
-				ArgumentLabels:    argumentLabels,
-				DocString:         function.DocString,
-				HasImplementation: hasImplementation,
-				HasConditions:     hasConditions,
-			})
+		// Functions of composites may be overloaded by argument labels.
+		// The first function of an overload set is declared under its identifier,
+		// the other overloads are declared under their overload name
+
+		memberName := identifier
+		var overloadName string
+
+		firstOverload, isOverload := firstFunctions[identifier]
+		if isOverload {
+			if firstOverload.Overload(argumentLabels) != nil {
+				// Redeclaration, already reported in `checkNestedIdentifiers`
+				continue
+			}
+
+			overloadName = OverloadName(identifier, argumentLabels)
+			memberName = overloadName
+
+			if checker.checkOverloadAmbiguity(
+				overloadName,
+				argumentLabels,
+				RequiredArgumentCount(functionType.Parameters),
+				firstOverload,
+				function.Identifier,
+			) {
+				continue
+			}
+		}
+
+		member := &Member{
+			ContainerType:     containerType,
+			Access:            functionAccess,
+			Identifier:        function.Identifier,
+			DeclarationKind:   declarationKind,
+			TypeAnnotation:    fieldTypeAnnotation,
+			VariableKind:      ast.VariableKindConstant,
+			ArgumentLabels:    argumentLabels,
+			DocString:         function.DocString,
+			HasImplementation: hasImplementation,
+			HasConditions:     hasConditions,
+			OverloadName:      overloadName,
+		}
+
+		if isOverload {
+			firstOverload.Overloads = append(firstOverload.Overloads, member)
+		} else if firstFunctions != nil && !members.Contains(identifier) {
+			firstFunctions[identifier] = member
+		}
+
+		members.Set(memberName, member)
 
 		if checker.PositionInfo != nil && origins != nil {
-			origins[identifier] = checker.recordFunctionDeclarationOrigin(function, functionType)
+			origins[memberName] = checker.recordFunctionDeclarationOrigin(function, functionType)
 		}
 	}
 
@@ -1985,39 +2246,69 @@ func (checker *Checker) enumMembersAndOrigins(
 	fieldNames []string,
 	origins map[string]*Origin,
 ) {
+	// Besides the enum cases, enum declarations may contain
+	// view functions and computed fields
+
+	var declarations []ast.Declaration
+
 	for _, declaration := range allMembers.Declarations() {
 
-		// Enum declarations may only contain enum cases
+		switch declaration := declaration.(type) {
+		case *ast.EnumCaseDeclaration:
+
+			// Enum cases must be effectively public
+			enumAccess := checker.accessFromAstAccess(declaration.Access)
+
+			if !checker.EffectiveCompositeMemberAccess(enumAccess).Equal(PrimitiveAccess(ast.AccessAll)) {
+				checker.report(
+					&InvalidAccessModifierError{
+						DeclarationKind: declaration.DeclarationKind(),
+						Access:          enumAccess,
+						Explanation:     "enum cases must be public",
+						Pos:             declaration.StartPos,
+					},
+				)
+			}
 
-		enumCase, ok := declaration.(*ast.EnumCaseDeclaration)
-		if !ok {
-			checker.report(
-				&InvalidNonEnumCaseError{
-					ContainerDeclarationKind: containerDeclarationKind,
-					Range:                    ast.NewRangeFromPositioned(checker.memoryGauge, declaration),
-				},
-			)
 			continue
-		}
 
-		// Enum cases must be effectively public
-		enumAccess := checker.accessFromAstAccess(enumCase.Access)
+		case *ast.FunctionDeclaration:
 
-		if !checker.EffectiveCompositeMemberAccess(enumAccess).Equal(PrimitiveAccess(ast.AccessAll)) {
-			checker.report(
-				&InvalidAccessModifierError{
-					DeclarationKind: enumCase.DeclarationKind(),
-					Access:          enumAccess,
-					Explanation:     "enum cases must be public",
-					Pos:             enumCase.StartPos,
-				},
-			)
+			// Enum cases are shared constants,
+			// so functions must not have side effects
+
+			if declaration.Purity != ast.FunctionPurityView {
+				checker.report(
+					&InvalidEnumFunctionPurityError{
+						Range: ast.NewRangeFromPositioned(checker.memoryGauge, declaration.Identifier),
+					},
+				)
+			}
+
+			declarations = append(declarations, declaration)
+			continue
+
+		case *ast.FieldDeclaration:
+
+			// Enum cases only have the raw value as a stored field
+
+			if declaration.IsComputed() {
+				declarations = append(declarations, declaration)
+				continue
+			}
 		}
+
+		checker.report(
+			&InvalidNonEnumCaseError{
+				ContainerDeclarationKind: containerDeclarationKind,
+				Range:                    ast.NewRangeFromPositioned(checker.memoryGauge, declaration),
+			},
+		)
 	}
 
 	// Members of the enum type are *not* the enum cases!
 	// Each individual enum case is an instance of the enum type,
-	// so only has a single member, the raw value field
+	// so only has the raw value field, the functions, and the computed fields
 
 	members = &StringMemberOrderedMap{}
 	members.Set(
@@ -2036,16 +2327,32 @@ func (checker *Checker) enumMembersAndOrigins(
 			DocString:       enumRawValueFieldDocString,
 		})
 
-	// No origins available for the only member which was declared above
+	fieldNames = []string{EnumRawValueFieldName}
 
-	origins = map[string]*Origin{}
+	if len(declarations) == 0 {
+		return
+	}
 
-	// Gather the field names from the members declared above
+	declaredMembers, _, origins := checker.defaultMembersAndOrigins(
+		ast.NewMembers(checker.memoryGauge, declarations),
+		containerType,
+		ContainerKindComposite,
+		containerDeclarationKind,
+	)
 
-	members.Foreach(func(name string, member *Member) {
-		if member.DeclarationKind == common.DeclarationKindField {
-			fieldNames = append(fieldNames, name)
+	declaredMembers.Foreach(func(name string, member *Member) {
+		if name == EnumRawValueFieldName {
+			checker.report(
+				&InvalidDeclarationError{
+					Identifier: name,
+					Kind:       member.DeclarationKind,
+					Range:      ast.NewRangeFromPositioned(checker.memoryGauge, member.Identifier),
+				},
+			)
+			return
 		}
+
+		members.Set(name, member)
 	})
 
 	return
@@ -2241,9 +2548,6 @@ func (checker *Checker) checkInitializers(
 		return
 	}
 
-	// TODO: check all initializers:
-	//  parameter initializerParameterTypeAnnotations needs to be a slice
-
 	initializer := initializers[0]
 	checker.checkSpecialFunction(
 		initializer,
@@ -2266,6 +2570,30 @@ func (checker *Checker) checkInitializers(
 			initializerParameters,
 		)
 	}
+
+	// Check the other initializers of a composite with overloaded initializers.
+	// Each initializer must initialize all fields on its own
+
+	if compositeType, ok := containerType.(*CompositeType); ok &&
+		len(compositeType.InitializerOverloads) > 0 {
+
+		for _, initializer := range initializers[1:] {
+			functionType := checker.Elaboration.ConstructorFunctionType(initializer)
+
+			checker.checkSpecialFunction(
+				initializer,
+				containerType,
+				containerDocString,
+				functionType.Purity,
+				functionType.Parameters,
+				containerKind,
+				NewInitializationInfo(
+					initializationInfo.ContainerType,
+					initializationInfo.FieldMembers,
+				),
+			)
+		}
+	}
 }
 
 // checkNoInitializerNoFields checks that if there are no initializers,
@@ -2339,6 +2667,17 @@ func (checker *Checker) checkSpecialFunction(
 		VoidTypeAnnotation,
 	)
 
+	// The default arguments of default destroy events are checked separately,
+	// see checkDefaultDestroyEvent
+
+	if containerType.GetCompositeKind() != common.CompositeKindEvent {
+		checker.checkDefaultArguments(
+			specialFunction.FunctionDeclaration.ParameterList,
+			functionType,
+			containerKind != ContainerKindInterface,
+		)
+	}
+
 	checker.checkFunction(
 		specialFunction.FunctionDeclaration.ParameterList,
 		nil,
@@ -2403,9 +2742,10 @@ func (checker *Checker) checkCompositeFunctions(
 			checker.visitFunctionDeclaration(
 				function,
 				functionDeclarationOptions{
-					mustExit:          true,
-					declareFunction:   false,
-					checkResourceLoss: true,
+					mustExit:              true,
+					declareFunction:       false,
+					checkResourceLoss:     true,
+					allowDefaultArguments: true,
 				},
 				&selfType.Kind,
 			)
@@ -2421,6 +2761,45 @@ func (checker *Checker) checkCompositeFunctions(
 	}
 }
 
+// checkComputedFields checks the getters of the given computed fields.
+// Getters are view functions without parameters,
+// which return a value of the field's type
+func (checker *Checker) checkComputedFields(
+	fields []*ast.FieldDeclaration,
+	selfType *CompositeType,
+	selfDocString string,
+) {
+	for _, field := range fields {
+		functionType := checker.Elaboration.FieldDeclarationGetterType(field)
+		if functionType == nil {
+			continue
+		}
+
+		func() {
+			checker.enterValueScope()
+			defer checker.leaveValueScope(field.EndPosition, true)
+
+			fieldAccess := checker.effectiveMemberAccess(checker.accessFromAstAccess(field.Access), ContainerKindComposite)
+			if fieldAccess.IsPrimitiveAccess() {
+				fieldAccess = UnauthorizedAccess
+			}
+
+			checker.declareSelfValue(fieldAccess, selfType, selfDocString)
+
+			checker.checkFunction(
+				&ast.ParameterList{},
+				field.TypeAnnotation,
+				fieldAccess,
+				functionType,
+				field.Getter,
+				true,
+				nil,
+				true,
+			)
+		}()
+	}
+}
+
 // declares a value one scope lower than the current.
 // This is useful particularly in the cases of creating `self`
 // and `base` parameters to composite/attachment functions.
@@ -2473,9 +2852,19 @@ func (checker *Checker) declareBaseValue(fnAccess Access, baseType Type, attachm
 
 // checkNestedIdentifiers checks that nested identifiers, i.e. fields, functions,
 // and nested interfaces and composites, are unique and aren't named `init`
-func (checker *Checker) checkNestedIdentifiers(members *ast.Members) {
+//
+// If function overloading is allowed, functions may have the same identifier
+// as other functions, as long as their argument labels are different
+func (checker *Checker) checkNestedIdentifiers(members *ast.Members, allowFunctionOverloading bool) {
 	positions := map[string]ast.Position{}
 
+	var functionNames map[string]struct{}
+	var overloadPositions map[string]ast.Position
+	if allowFunctionOverloading {
+		functionNames = map[string]struct{}{}
+		overloadPositions = map[string]ast.Position{}
+	}
+
 	for _, declaration := range members.Declarations() {
 
 		if _, ok := declaration.(*ast.SpecialFunctionDeclaration); ok {
@@ -2487,6 +2876,37 @@ func (checker *Checker) checkNestedIdentifiers(members *ast.Members) {
 			continue
 		}
 
+		if function, ok := declaration.(*ast.FunctionDeclaration); ok && allowFunctionOverloading {
+			name := identifier.Identifier
+			pos := identifier.Pos
+
+			overloadName := OverloadName(
+				name,
+				function.ParameterList.EffectiveArgumentLabels(),
+			)
+
+			if _, ok := functionNames[name]; ok {
+				if previousPos, ok := overloadPositions[overloadName]; ok {
+					checker.report(
+						&RedeclarationError{
+							Name:        name,
+							Pos:         pos,
+							Kind:        declaration.DeclarationKind(),
+							PreviousPos: &previousPos,
+						},
+					)
+				} else {
+					overloadPositions[overloadName] = pos
+				}
+				continue
+			}
+
+			if _, ok := positions[name]; !ok {
+				functionNames[name] = struct{}{}
+				overloadPositions[overloadName] = pos
+			}
+		}
+
 		checker.checkNestedIdentifier(
 			*identifier,
 			declaration.DeclarationKind(),
@@ -43,11 +43,36 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) (_ struct
 
 	valueType := checker.VisitExpression(valueExpression, statement, expectedType)
 
-	// Only get the element type if the array is not a resource array.
-	// Otherwise, in addition to the `UnsupportedResourceForLoopError`,
-	// the loop variable will be declared with the resource-typed element type,
-	// leading to an additional `ResourceLossError`.
-	loopVariableType := checker.loopVariableType(valueType, valueExpression)
+	var keyType Type
+	var loopVariableType Type
+
+	if statement.Key != nil {
+		// Iteration over the key-value pairs of a dictionary,
+		// i.e. `for (key, value) in dictionary`
+		keyType, loopVariableType = checker.keyValueLoopVariableTypes(valueType, valueExpression)
+
+		key := statement.Key.Identifier
+		keyVariable, err := checker.valueActivations.declare(variableDeclaration{
+			identifier:               key,
+			ty:                       keyType,
+			kind:                     common.DeclarationKindConstant,
+			pos:                      statement.Key.Pos,
+			isConstant:               true,
+			argumentLabels:           nil,
+			allowOuterScopeShadowing: false,
+			access:                   PrimitiveAccess(ast.AccessNotSpecified),
+		})
+		checker.report(err)
+		if checker.PositionInfo != nil && keyVariable != nil {
+			checker.recordVariableDeclarationOccurrence(key, keyVariable)
+		}
+	} else {
+		// Only get the element type if the array is not a resource array.
+		// Otherwise, in addition to the `UnsupportedResourceForLoopError`,
+		// the loop variable will be declared with the resource-typed element type,
+		// leading to an additional `ResourceLossError`.
+		loopVariableType = checker.loopVariableType(valueType, valueExpression)
+	}
 
 	identifier := statement.Identifier.Identifier
 
@@ -89,6 +114,7 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) (_ struct
 
 	checker.Elaboration.SetForStatementType(statement, ForStatementTypes{
 		IndexVariableType: indexType,
+		KeyVariableType:   keyType,
 		ValueVariableType: loopVariableType,
 	})
 
@@ -97,7 +123,7 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) (_ struct
 	// returns are not definite, but only potential.
 
 	_ = checker.checkPotentiallyUnevaluated(func() Type {
-		checker.functionActivations.Current().WithLoop(func() {
+		checker.functionActivations.Current().WithLoop(checker.loopLabel(statement.Label), func() {
 			checker.checkBlock(statement.Block)
 		})
 
@@ -175,3 +201,70 @@ func (checker *Checker) iterableElementType(valueType Type, hasPosition ast.HasP
 
 	return InvalidType
 }
+
+// keyValueLoopVariableTypes returns the types of the key and value variables
+// of a loop over the key-value pairs of a dictionary
+func (checker *Checker) keyValueLoopVariableTypes(
+	valueType Type,
+	hasPosition ast.HasPosition,
+) (
+	keyType Type,
+	loopVariableType Type,
+) {
+	if valueType.IsInvalidType() {
+		return InvalidType, InvalidType
+	}
+
+	// Resources cannot be looped.
+	if valueType.IsResourceType() {
+		checker.report(
+			&UnsupportedResourceForLoopError{
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, hasPosition),
+			},
+		)
+		return InvalidType, InvalidType
+	}
+
+	// If it's a reference, the value variable is a reference
+	// if the referenced dictionary's value type is a container type,
+	// like for loops over references to arrays
+
+	if referenceType, ok := valueType.(*ReferenceType); ok {
+		dictionaryType := checker.iterableDictionaryType(referenceType.Type, hasPosition)
+		if dictionaryType == nil {
+			return InvalidType, InvalidType
+		}
+
+		loopVariableType = dictionaryType.ValueType
+		if loopVariableType.ContainFieldsOrElements() {
+			loopVariableType = checker.getReferenceType(loopVariableType, false, UnauthorizedAccess)
+		}
+
+		return dictionaryType.KeyType, loopVariableType
+	}
+
+	dictionaryType := checker.iterableDictionaryType(valueType, hasPosition)
+	if dictionaryType == nil {
+		return InvalidType, InvalidType
+	}
+
+	return dictionaryType.KeyType, dictionaryType.ValueType
+}
+
+func (checker *Checker) iterableDictionaryType(valueType Type, hasPosition ast.HasPosition) *DictionaryType {
+	if dictionaryType, ok := valueType.(*DictionaryType); ok {
+		return dictionaryType
+	}
+
+	if !valueType.IsInvalidType() {
+		checker.report(
+			&TypeMismatchWithDescriptionError{
+				ExpectedTypeDescription: "dictionary",
+				ActualType:              valueType,
+				Range:                   ast.NewRangeFromPositioned(checker.memoryGauge, hasPosition),
+			},
+		)
+	}
+
+	return nil
+}
@@ -35,9 +35,10 @@ func (checker *Checker) VisitFunctionDeclaration(declaration *ast.FunctionDeclar
 	checker.visitFunctionDeclaration(
 		declaration,
 		functionDeclarationOptions{
-			mustExit:          true,
-			declareFunction:   true,
-			checkResourceLoss: true,
+			mustExit:              true,
+			declareFunction:       true,
+			checkResourceLoss:     true,
+			allowDefaultArguments: true,
 		},
 		nil,
 	)
@@ -61,6 +62,9 @@ type functionDeclarationOptions struct {
 	// checkResourceLoss if the function should be checked for resource loss.
 	// For example, function declarations in interfaces should not be checked.
 	checkResourceLoss bool
+	// allowDefaultArguments specifies if the function's parameters may have default arguments.
+	// For example, function declarations in interfaces may not have default arguments.
+	allowDefaultArguments bool
 }
 
 func (checker *Checker) visitFunctionDeclaration(
@@ -126,6 +130,24 @@ func (checker *Checker) visitFunctionDeclaration(
 
 	checker.Elaboration.SetFunctionDeclarationFunctionType(declaration, functionType)
 
+	checker.checkDefaultArguments(
+		declaration.ParameterList,
+		functionType,
+		options.allowDefaultArguments,
+	)
+
+	// The type parameters of generic functions are in scope in the function body
+
+	if len(functionType.TypeParameters) > 0 {
+		checker.typeActivations.Enter()
+		defer checker.typeActivations.Leave(declaration.EndPosition)
+
+		checker.declareTypeParameterTypes(
+			declaration.TypeParameterList,
+			functionType.TypeParameters,
+		)
+	}
+
 	checker.checkFunction(
 		declaration.ParameterList,
 		declaration.ReturnTypeAnnotation,
@@ -138,6 +160,27 @@ func (checker *Checker) visitFunctionDeclaration(
 	)
 }
 
+// declareTypeParameterTypes declares the generic types of the given converted type parameters
+// in the current type scope.
+//
+// Errors, like redeclarations, are not reported,
+// as they were already reported when the type parameters were converted
+func (checker *Checker) declareTypeParameterTypes(
+	typeParameterList *ast.TypeParameterList,
+	typeParameters []*TypeParameter,
+) {
+	for i, typeParameter := range typeParameterList.TypeParameters {
+		_, _ = checker.typeActivations.declareType(typeDeclaration{
+			identifier: typeParameter.Identifier,
+			ty: &GenericType{
+				TypeParameter: typeParameters[i],
+			},
+			declarationKind:          common.DeclarationKindTypeParameter,
+			allowOuterScopeShadowing: true,
+		})
+	}
+}
+
 func (checker *Checker) declareFunctionDeclaration(
 	declaration *ast.FunctionDeclaration,
 	functionType *FunctionType,
@@ -286,6 +329,93 @@ func (checker *Checker) checkParameters(parameterList *ast.ParameterList, parame
 	}
 }
 
+// checkDefaultArguments checks the default arguments of the parameters of the given function, if any.
+//
+// Only trailing parameters may have default arguments.
+// Default arguments are checked in the scope the function is declared in,
+// so they cannot refer to other parameters, and they also may not refer to `self` or `base`.
+func (checker *Checker) checkDefaultArguments(
+	parameterList *ast.ParameterList,
+	functionType *FunctionType,
+	allowed bool,
+) {
+	hasDefaultArgument := false
+
+	for i, parameter := range parameterList.Parameters {
+		defaultArgument := parameter.DefaultArgument
+		if defaultArgument == nil {
+			if hasDefaultArgument {
+				checker.report(
+					&InvalidDefaultArgumentError{
+						Kind:  MissingTrailingDefaultArgument,
+						Range: ast.NewRangeFromPositioned(checker.memoryGauge, parameter),
+					},
+				)
+			}
+			continue
+		}
+
+		hasDefaultArgument = true
+
+		if !allowed {
+			checker.report(
+				&InvalidDefaultArgumentError{
+					Kind:  UnsupportedDefaultArgument,
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, defaultArgument),
+				},
+			)
+			continue
+		}
+
+		parameterType := functionType.Parameters[i].TypeAnnotation.Type
+
+		if parameterType.IsResourceType() {
+			checker.report(
+				&InvalidDefaultArgumentError{
+					Kind:  ResourceDefaultArgument,
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, defaultArgument),
+				},
+			)
+			continue
+		}
+
+		checker.checkDefaultArgumentSelfReferences(defaultArgument)
+
+		// Default arguments are evaluated as part of the invocation of the function,
+		// so they must satisfy the function's purity
+
+		var defaultArgumentType Type
+		checker.InNewPurityScope(functionType.Purity == FunctionPurityView, func() {
+			defaultArgumentType = checker.VisitExpression(defaultArgument, nil, parameterType)
+		})
+
+		functionType.Parameters[i].DefaultArgument = defaultArgumentType
+	}
+}
+
+// checkDefaultArgumentSelfReferences reports references to `self` and `base`
+// in the given default argument
+func (checker *Checker) checkDefaultArgumentSelfReferences(defaultArgument ast.Expression) {
+	ast.Inspect(defaultArgument, func(element ast.Element) bool {
+		identifierExpression, ok := element.(*ast.IdentifierExpression)
+		if !ok {
+			return true
+		}
+
+		switch identifierExpression.Identifier.Identifier {
+		case SelfIdentifier, BaseIdentifier:
+			checker.report(
+				&InvalidDefaultArgumentError{
+					Kind:  SelfReferencingDefaultArgument,
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, identifierExpression),
+				},
+			)
+		}
+
+		return true
+	})
+}
+
 // checkArgumentLabels checks that all argument labels (if any) are unique
 func (checker *Checker) checkArgumentLabels(parameterList *ast.ParameterList) {
 
@@ -19,6 +19,8 @@
 package sema
 
 import (
+	"bytes"
+
 	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/common"
 )
@@ -88,11 +90,46 @@ func (checker *Checker) declareImportDeclaration(declaration *ast.ImportDeclarat
 
 	checker.Elaboration.SetImportDeclarationsResolvedLocations(declaration, resolvedLocations)
 
+	// If the import is pinned to a code hash, it must resolve to a single program.
+	// The expected code hash is recorded, so the import handler can verify it
+
+	codeHash := declaration.CodeHash
+	if codeHash != nil && len(resolvedLocations) != 1 {
+		checker.report(
+			&AmbiguousImportCodeHashError{
+				Location: declaration.Location,
+				Range:    locationRange,
+			},
+		)
+		return
+	}
+
 	for _, resolvedLocation := range resolvedLocations {
+		checker.recordImportCodeHash(resolvedLocation.Location, codeHash, locationRange)
 		checker.importResolvedLocation(resolvedLocation, locationRange)
 	}
 }
 
+// recordImportCodeHash records the code hash the import of the given location is pinned to, if any.
+// All imports of a location must be pinned to the same code hash, or none,
+// as only one program is imported for the location
+func (checker *Checker) recordImportCodeHash(location common.Location, codeHash []byte, locationRange ast.Range) {
+	previousCodeHash, imported := checker.Elaboration.importCodeHash(location)
+	if imported {
+		if !bytes.Equal(previousCodeHash, codeHash) {
+			checker.report(
+				&ConflictingImportCodeHashError{
+					Location: location,
+					Range:    locationRange,
+				},
+			)
+		}
+		return
+	}
+
+	checker.Elaboration.SetImportCodeHash(location, codeHash)
+}
+
 func (checker *Checker) resolveLocation(identifiers []ast.Identifier, location common.Location) ([]ResolvedLocation, error) {
 
 	// If no location handler is available,
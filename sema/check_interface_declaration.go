@@ -84,7 +84,7 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 	// NOTE: functions are checked separately
 	checker.checkFieldsAccessModifier(declaration.Members.Fields(), interfaceType.Members, &declaration.CompositeKind)
 
-	checker.checkNestedIdentifiers(declaration.Members)
+	checker.checkNestedIdentifiers(declaration.Members, false)
 
 	// Activate new scope for nested types and values
 
@@ -147,13 +147,17 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 		})
 	}
 
-	// NOTE: visit entitlements, then interfaces, then composites
+	// NOTE: visit entitlements, then type aliases, then interfaces, then composites
 	// DON'T use `nestedDeclarations`, because of non-deterministic order
 
 	for _, nestedEntitlement := range declaration.Members.Entitlements() {
 		ast.AcceptDeclaration[struct{}](nestedEntitlement, checker)
 	}
 
+	for _, nestedTypeAlias := range declaration.Members.TypeAliases() {
+		ast.AcceptDeclaration[struct{}](nestedTypeAlias, checker)
+	}
+
 	for _, nestedInterface := range declaration.Members.Interfaces() {
 		ast.AcceptDeclaration[struct{}](nestedInterface, checker)
 	}
@@ -206,6 +210,8 @@ func (checker *Checker) declareInterfaceNestedTypes(
 		})
 		checker.report(err)
 	})
+
+	checker.declareContainedTypeAliases(interfaceType)
 }
 
 func (checker *Checker) checkInterfaceFunctions(
@@ -74,6 +74,10 @@ func (checker *Checker) checkInvokedExpression(ty Type, pos ast.HasPosition) boo
 }
 
 func (checker *Checker) checkInvocationExpression(invocationExpression *ast.InvocationExpression) Type {
+	// The contextually expected type of the invocation,
+	// used to infer type arguments of generic functions, if necessary
+	expectedType := checker.expectedType
+
 	inCreate := checker.inCreate
 	checker.inCreate = false
 	defer func() {
@@ -116,6 +120,19 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 	var argumentTypes []Type
 
 	functionType, ok := expressionType.(*FunctionType)
+
+	// If the invoked function is an overloaded constructor,
+	// select the overload based on the argument labels
+
+	var overloadName string
+	isOverloaded := ok && len(functionType.Overloads) > 0
+	if isOverloaded {
+		functionType, overloadName, ok = checker.selectConstructorOverload(invocationExpression, functionType)
+		if !ok {
+			expressionType = InvalidType
+		}
+	}
+
 	if !ok {
 		if !expressionType.IsInvalidType() {
 			checker.report(
@@ -157,9 +174,16 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 
 	var returnType Type
 
+	// The result of an optional chaining invocation is optional,
+	// so the expected type cannot be used for the inference of type arguments
+
+	if isOptionalChainingResult {
+		expectedType = nil
+	}
+
 	checkInvocation := func() {
 		argumentTypes, returnType =
-			checker.checkInvocation(invocationExpression, functionType)
+			checker.checkInvocation(invocationExpression, functionType, overloadName, expectedType)
 	}
 
 	if isOptionalChainingResult {
@@ -183,20 +207,25 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 
 	// If the invocation refers directly to the name of the function as stated in the declaration,
 	// or the invocation refers to a function of a composite (member),
-	// check that the correct argument labels are supplied in the invocation
+	// check that the correct argument labels are supplied in the invocation.
+	//
+	// The argument labels of invocations of overloaded constructors
+	// were already checked when the overload was selected
 
-	switch typedInvokedExpression := invokedExpression.(type) {
-	case *ast.IdentifierExpression:
-		checker.checkIdentifierInvocationArgumentLabels(
-			invocationExpression,
-			typedInvokedExpression,
-		)
+	if !isOverloaded {
+		switch typedInvokedExpression := invokedExpression.(type) {
+		case *ast.IdentifierExpression:
+			checker.checkIdentifierInvocationArgumentLabels(
+				invocationExpression,
+				typedInvokedExpression,
+			)
 
-	case *ast.MemberExpression:
-		checker.checkMemberInvocationArgumentLabels(
-			invocationExpression,
-			typedInvokedExpression,
-		)
+		case *ast.MemberExpression:
+			checker.checkMemberInvocationArgumentLabels(
+				invocationExpression,
+				typedInvokedExpression,
+			)
+		}
 	}
 
 	checker.checkConstructorInvocationWithResourceResult(
@@ -330,6 +359,13 @@ func (checker *Checker) checkMemberInvocationArgumentLabels(
 		return
 	}
 
+	// The argument labels of invocations of overloaded functions
+	// were already checked when the overload was selected
+
+	if len(member.Overloads) > 0 {
+		return
+	}
+
 	checker.checkInvocationArgumentLabels(
 		invocationExpression.Arguments,
 		member.ArgumentLabels,
@@ -396,12 +432,14 @@ func (checker *Checker) checkInvocationArgumentLabels(
 func (checker *Checker) checkInvocation(
 	invocationExpression *ast.InvocationExpression,
 	functionType *FunctionType,
+	overloadName string,
+	expectedType Type,
 ) (
 	argumentTypes []Type,
 	returnType Type,
 ) {
 	parameterCount := len(functionType.Parameters)
-	arity := functionType.Arity
+	arity := functionType.EffectiveArity()
 	typeParameterCount := len(functionType.TypeParameters)
 
 	// Check the type arguments and bind them to type parameters
@@ -501,7 +539,25 @@ func (checker *Checker) checkInvocation(
 		)
 	}
 
-	returnType = functionType.ReturnTypeAnnotation.Type.Resolve(typeArguments)
+	// If not all type parameters could be inferred from the type arguments and arguments,
+	// try to infer them from the expected type of the invocation.
+	//
+	// Like for arguments, reference types are never inferred from the expected type,
+	// see checkInvocationRequiredArgument
+
+	if typeArguments.Len() < typeParameterCount &&
+		expectedType != nil &&
+		!expectedType.IsOrContainsReferenceType() {
+
+		checker.inferTypeArgumentsFromExpectedType(
+			functionType.ReturnTypeAnnotation.Type,
+			expectedType,
+			typeArguments,
+			invocationExpression,
+		)
+	}
+
+	returnType = checker.resolveInvocationType(functionType.ReturnTypeAnnotation.Type, typeArguments)
 	if returnType == nil {
 		checker.report(&InvocationTypeInferenceError{
 			Range: ast.NewRangeFromPositioned(
@@ -542,12 +598,85 @@ func (checker *Checker) checkInvocation(
 			TypeParameterTypes: parameterTypes,
 			ReturnType:         returnType,
 			ArgumentTypes:      argumentTypes,
+			OverloadName:       overloadName,
 		},
 	)
 
 	return argumentTypes, returnType
 }
 
+// inferTypeArgumentsFromExpectedType infers type arguments for the type parameters
+// which have not been bound to a type yet, by unifying the given return type
+// with the contextually expected type of the invocation.
+//
+// The inference is best-effort: If the unification fails,
+// e.g. because the expected type does not satisfy a type bound,
+// no type arguments are inferred, and the type parameters remain unbound
+func (checker *Checker) inferTypeArgumentsFromExpectedType(
+	returnType Type,
+	expectedType Type,
+	typeArguments *TypeParameterTypeOrderedMap,
+	invocationExpression *ast.InvocationExpression,
+) {
+	inferredTypeArguments := &TypeParameterTypeOrderedMap{}
+	inferredTypeArguments.SetAll(typeArguments)
+
+	failed := false
+	report := func(err error) {
+		if err != nil {
+			failed = true
+		}
+	}
+
+	if !returnType.Unify(
+		expectedType,
+		inferredTypeArguments,
+		report,
+		checker.memoryGauge,
+		invocationExpression,
+	) || failed {
+		return
+	}
+
+	typeArguments.SetAll(inferredTypeArguments)
+}
+
+// resolveInvocationType resolves the given parameter or return type of an invoked function
+// with the type arguments of the invocation.
+//
+// The type may also refer to the type parameters of the enclosing generic functions and composites,
+// e.g. when a function-typed parameter of a generic function is invoked.
+// These type parameters are not resolved
+func (checker *Checker) resolveInvocationType(ty Type, typeArguments *TypeParameterTypeOrderedMap) Type {
+	resolvedType := ty.Resolve(typeArguments)
+	if resolvedType != nil {
+		return resolvedType
+	}
+
+	var enclosingTypeArguments *TypeParameterTypeOrderedMap
+
+	_ = checker.typeActivations.Current().ForEach(func(_ string, variable *Variable) error {
+		genericType, ok := variable.Type.(*GenericType)
+		if !ok || typeArguments.Contains(genericType.TypeParameter) {
+			return nil
+		}
+
+		if enclosingTypeArguments == nil {
+			enclosingTypeArguments = &TypeParameterTypeOrderedMap{}
+			enclosingTypeArguments.SetAll(typeArguments)
+		}
+		enclosingTypeArguments.Set(genericType.TypeParameter, genericType)
+
+		return nil
+	})
+
+	if enclosingTypeArguments == nil {
+		return nil
+	}
+
+	return ty.Resolve(enclosingTypeArguments)
+}
+
 // checkTypeParameterInference checks that all type parameters
 // of the given generic function type have been assigned a type.
 func (checker *Checker) checkTypeParameterInference(
@@ -602,7 +731,7 @@ func (checker *Checker) checkInvocationRequiredArgument(
 		// Optimization: only resolve if there are type parameters.
 		// This avoids unnecessary work for non-generic functions.
 		if typeParameterCount > 0 {
-			parameterType = parameterType.Resolve(typeParameters)
+			parameterType = checker.resolveInvocationType(parameterType, typeParameters)
 			// If the type parameter could not be resolved, use the invalid type.
 			if parameterType == nil {
 				checker.report(&InvocationTypeInferenceError{
@@ -683,7 +812,7 @@ func (checker *Checker) checkInvocationRequiredArgument(
 			checker.memoryGauge,
 			argument.Expression,
 		) {
-			parameterType = parameterType.Resolve(typeParameters)
+			parameterType = checker.resolveInvocationType(parameterType, typeParameters)
 			// If the type parameter could not be resolved, use the invalid type.
 			if parameterType == nil {
 				checker.report(&InvocationTypeInferenceError{
@@ -816,9 +945,10 @@ func (checker *Checker) checkInvocationArgumentParameterTypeCompatibility(
 
 		checker.report(
 			&TypeMismatchError{
-				ExpectedType: parameterType,
-				ActualType:   argumentType,
-				Range:        ast.NewRangeFromPositioned(checker.memoryGauge, argument),
+				ExpectedType:      parameterType,
+				ActualType:        argumentType,
+				ExpectedTypeAlias: checker.Elaboration.TypeAlias(parameterType),
+				Range:             ast.NewRangeFromPositioned(checker.memoryGauge, argument),
 			},
 		)
 	}
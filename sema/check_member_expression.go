@@ -151,6 +151,7 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression, isAssignme
 				AccessedType:    accessedType,
 				ResultingType:   resultingType,
 				Member:          member,
+				OverloadName:    MemberOverloadName(member),
 				IsOptional:      isOptional,
 				ReturnReference: returnReference,
 			},
@@ -208,6 +209,12 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression, isAssignme
 	// i.e. a Go type switch would be sufficient.
 	// However, for some types (e.g. reference types) this depends on what type is referenced
 
+	// Errors reported when resolving a member are only reported
+	// after the overload of an overloaded member is selected,
+	// as they only apply to the first overload
+
+	var resolveErrors []error
+
 	getMemberForType := func(expressionType Type) {
 		resolver, ok := expressionType.GetMembers()[identifier]
 		if !ok {
@@ -218,7 +225,9 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression, isAssignme
 			checker.memoryGauge,
 			identifier,
 			expression.Expression,
-			checker.report,
+			func(err error) {
+				resolveErrors = append(resolveErrors, err)
+			},
 		)
 		resultingType = member.TypeAnnotation.Type
 	}
@@ -290,6 +299,27 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression, isAssignme
 		return
 	}
 
+	// If the member is an overloaded function which is invoked,
+	// select the overload based on the argument labels of the invocation
+
+	firstOverload := member
+
+	if len(member.Overloads) > 0 {
+		var ok bool
+		member, ok = checker.selectMemberOverload(expression, member)
+		if ok {
+			resultingType = member.TypeAnnotation.Type
+		} else {
+			resultingType = InvalidType
+		}
+	}
+
+	if member == firstOverload {
+		for _, err := range resolveErrors {
+			checker.report(err)
+		}
+	}
+
 	if checker.PositionInfo != nil {
 		checker.PositionInfo.recordMemberOccurrence(
 			accessedType,
@@ -20,6 +20,7 @@ package sema
 
 import (
 	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
 )
 
 func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) (_ struct{}) {
@@ -50,9 +51,103 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) (_
 		)
 	})
 
+	if testTypeIsValid && checker.Config.EnumSwitchExhaustivenessCheckEnabled {
+		checker.checkEnumSwitchExhaustiveness(statement, testType)
+	}
+
 	return
 }
 
+// checkEnumSwitchExhaustiveness reports an error if the switch statement
+// tests an enum value, has no default case, and does not cover all enum cases.
+// The check is opt-in, see Config.EnumSwitchExhaustivenessCheckEnabled.
+//
+// Only case expressions which directly refer to an enum case,
+// e.g. `E.a`, are considered to cover the case.
+func (checker *Checker) checkEnumSwitchExhaustiveness(statement *ast.SwitchStatement, testType Type) {
+
+	enumType, ok := testType.(*CompositeType)
+	if !ok ||
+		enumType.Kind != common.CompositeKindEnum ||
+		len(enumType.EnumCases) == 0 {
+
+		return
+	}
+
+	coveredCases := make(map[string]struct{}, len(statement.Cases))
+
+	for _, switchCase := range statement.Cases {
+		caseExpression := switchCase.Expression
+
+		// A default case covers all remaining enum cases
+		if caseExpression == nil {
+			return
+		}
+
+		caseName, ok := checker.enumCaseName(caseExpression, enumType)
+		if !ok {
+			continue
+		}
+
+		coveredCases[caseName] = struct{}{}
+	}
+
+	var missingCases []string
+
+	for _, caseName := range enumType.EnumCases {
+		if _, ok := coveredCases[caseName]; ok {
+			continue
+		}
+		missingCases = append(missingCases, caseName)
+	}
+
+	if len(missingCases) == 0 {
+		return
+	}
+
+	checker.report(
+		&MissingSwitchCasesError{
+			EnumType:     enumType,
+			MissingCases: missingCases,
+			Statement:    statement,
+			Range: ast.NewRange(
+				checker.memoryGauge,
+				statement.StartPos,
+				statement.Expression.EndPosition(checker.memoryGauge),
+			),
+		},
+	)
+}
+
+// enumCaseName returns the name of the enum case the given expression refers to,
+// if the expression is a member access of an enum case of the given enum type
+func (checker *Checker) enumCaseName(expression ast.Expression, enumType *CompositeType) (string, bool) {
+	memberExpression, ok := expression.(*ast.MemberExpression)
+	if !ok {
+		return "", false
+	}
+
+	memberInfo, ok := checker.Elaboration.MemberExpressionMemberAccessInfo(memberExpression)
+	if !ok || memberInfo.Member == nil {
+		return "", false
+	}
+
+	member := memberInfo.Member
+
+	// Enum cases are members of the enum's constructor function
+
+	constructorType, ok := member.ContainerType.(*FunctionType)
+	if !ok || !constructorType.IsConstructor {
+		return "", false
+	}
+
+	if !member.TypeAnnotation.Type.Equal(enumType) {
+		return "", false
+	}
+
+	return member.Identifier.Identifier, true
+}
+
 func (checker *Checker) checkSwitchCaseExpression(
 	statement *ast.SwitchStatement,
 	caseExpression ast.Expression,
@@ -184,6 +184,12 @@ func (checker *Checker) visitTransactionPrepareFunction(
 
 	prepareFunctionType := transactionType.PrepareFunctionType()
 
+	checker.checkDefaultArguments(
+		prepareFunction.FunctionDeclaration.ParameterList,
+		prepareFunctionType,
+		false,
+	)
+
 	checker.checkFunction(
 		prepareFunction.FunctionDeclaration.ParameterList,
 		nil,
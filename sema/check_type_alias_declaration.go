@@ -0,0 +1,327 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+)
+
+// declareTypeAliases resolves the aliased types of the given type alias declarations,
+// in order, and declares the type aliases in the current type scope.
+//
+// If the type aliases are nested in a container type, they are also added to the container type.
+// Only contracts and contract interfaces support nested type aliases.
+//
+// As the type aliases are declared in order, a type alias may only refer to previously declared type aliases.
+// This also ensures that type aliases are never cyclic.
+// Type aliases which refer to themselves, directly or indirectly, are reported as cyclic.
+func (checker *Checker) declareTypeAliases(
+	declarations []*ast.TypeAliasDeclaration,
+	containerType TypeAliasContainerType,
+	containerCompositeKind common.CompositeKind,
+	containerDeclarationKind common.DeclarationKind,
+) {
+	cyclic := cyclicTypeAliasDeclarations(declarations)
+
+	for i, declaration := range declarations {
+
+		if containerType != nil && containerCompositeKind != common.CompositeKindContract {
+			checker.report(
+				&InvalidNestedDeclarationError{
+					NestedDeclarationKind:    declaration.DeclarationKind(),
+					ContainerDeclarationKind: containerDeclarationKind,
+					Range:                    ast.NewRangeFromPositioned(checker.memoryGauge, declaration.Identifier),
+				},
+			)
+		}
+
+		var aliasedType Type
+		if cyclic[i] {
+			checker.report(
+				&CyclicTypeAliasError{
+					Name:  declaration.Identifier.Identifier,
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, declaration.AliasedType),
+				},
+			)
+			aliasedType = InvalidType
+		} else {
+			aliasedType = checker.ConvertType(declaration.AliasedType)
+		}
+
+		alias := &TypeAlias{
+			Location:      checker.Location,
+			ContainerType: containerType,
+			Type:          aliasedType,
+			Identifier:    declaration.Identifier,
+			DocString:     declaration.DocString,
+			Access:        checker.accessFromAstAccess(declaration.Access),
+			Range:         ast.NewRangeFromPositioned(checker.memoryGauge, declaration),
+		}
+
+		checker.Elaboration.SetTypeAliasDeclarationAlias(declaration, alias)
+
+		// Record the alias of the aliased type, so errors can refer to the type alias.
+		// Nominal types are not recorded, as they are shared by all uses of the type.
+
+		if _, ok := declaration.AliasedType.(*ast.NominalType); !ok {
+			checker.Elaboration.SetTypeAlias(aliasedType, alias)
+		}
+
+		variable, err := checker.typeActivations.declareType(typeDeclaration{
+			identifier:               declaration.Identifier,
+			ty:                       aliasedType,
+			declarationKind:          declaration.DeclarationKind(),
+			access:                   alias.Access,
+			docString:                declaration.DocString,
+			allowOuterScopeShadowing: false,
+		})
+		checker.report(err)
+
+		if checker.PositionInfo != nil && variable != nil {
+			checker.recordVariableDeclarationOccurrence(
+				declaration.Identifier.Identifier,
+				variable,
+			)
+		}
+
+		// Only add the type alias to the container type if it could be declared,
+		// i.e. does not conflict with a nested type or another type alias
+
+		if containerType != nil && err == nil {
+			containerType.SetTypeAlias(alias)
+		}
+	}
+}
+
+// cyclicTypeAliasDeclarations returns which of the given type alias declarations
+// refer to themselves, directly or indirectly through other type aliases of the declarations
+func cyclicTypeAliasDeclarations(declarations []*ast.TypeAliasDeclaration) []bool {
+	if len(declarations) == 0 {
+		return nil
+	}
+
+	indices := make(map[string]int, len(declarations))
+	for i, declaration := range declarations {
+		indices[declaration.Identifier.Identifier] = i
+	}
+
+	references := make([][]int, len(declarations))
+	for i, declaration := range declarations {
+		walkNominalTypes(declaration.AliasedType, func(nominalType *ast.NominalType) {
+			index, ok := indices[nominalType.Identifier.Identifier]
+			if ok {
+				references[i] = append(references[i], index)
+			}
+		})
+	}
+
+	cyclic := make([]bool, len(declarations))
+
+	for i := range declarations {
+		visited := make([]bool, len(declarations))
+		stack := append([]int(nil), references[i]...)
+
+		for len(stack) > 0 {
+			index := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if index == i {
+				cyclic[i] = true
+				break
+			}
+
+			if visited[index] {
+				continue
+			}
+			visited[index] = true
+
+			stack = append(stack, references[index]...)
+		}
+	}
+
+	return cyclic
+}
+
+// walkNominalTypes calls the given function for each nominal type in the given type
+func walkNominalTypes(ty ast.Type, f func(*ast.NominalType)) {
+	switch ty := ty.(type) {
+	case *ast.NominalType:
+		f(ty)
+
+	case *ast.OptionalType:
+		walkNominalTypes(ty.Type, f)
+
+	case *ast.VariableSizedType:
+		walkNominalTypes(ty.Type, f)
+
+	case *ast.ConstantSizedType:
+		walkNominalTypes(ty.Type, f)
+
+	case *ast.DictionaryType:
+		walkNominalTypes(ty.KeyType, f)
+		walkNominalTypes(ty.ValueType, f)
+
+	case *ast.FunctionType:
+		for _, parameterTypeAnnotation := range ty.ParameterTypeAnnotations {
+			walkNominalTypes(parameterTypeAnnotation.Type, f)
+		}
+		if ty.ReturnTypeAnnotation != nil {
+			walkNominalTypes(ty.ReturnTypeAnnotation.Type, f)
+		}
+
+	case *ast.ReferenceType:
+		walkNominalTypes(ty.Type, f)
+
+	case *ast.IntersectionType:
+		if ty.LegacyRestrictedType != nil {
+			walkNominalTypes(ty.LegacyRestrictedType, f)
+		}
+		for _, nominalType := range ty.Types {
+			f(nominalType)
+		}
+
+	case *ast.InstantiationType:
+		walkNominalTypes(ty.Type, f)
+		for _, typeArgument := range ty.TypeArguments {
+			walkNominalTypes(typeArgument.Type, f)
+		}
+	}
+}
+
+// declareCompositeLikeTypeAliases declares the type aliases nested in the given composite declaration,
+// and recursively the type aliases of all nested declarations.
+//
+// It assumes the types of the declaration and all nested declarations were previously declared,
+// see `declareCompositeType`.
+func (checker *Checker) declareCompositeLikeTypeAliases(declaration ast.CompositeLikeDeclaration) {
+
+	compositeType := checker.Elaboration.CompositeDeclarationType(declaration)
+	if compositeType == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	members := declaration.DeclarationMembers()
+
+	// Activate new scope for nested types
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareCompositeLikeNestedTypes(declaration, false)
+
+	checker.declareTypeAliases(
+		members.TypeAliases(),
+		compositeType,
+		declaration.Kind(),
+		declaration.DeclarationKind(),
+	)
+
+	checker.declareNestedTypeAliases(members)
+}
+
+// declareInterfaceTypeAliases declares the type aliases nested in the given interface declaration,
+// and recursively the type aliases of all nested declarations.
+//
+// It assumes the types of the declaration and all nested declarations were previously declared,
+// see `declareInterfaceType`.
+func (checker *Checker) declareInterfaceTypeAliases(declaration *ast.InterfaceDeclaration) {
+
+	interfaceType := checker.Elaboration.InterfaceDeclarationType(declaration)
+	if interfaceType == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	// Activate new scope for nested types
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareInterfaceNestedTypes(declaration)
+
+	checker.declareTypeAliases(
+		declaration.Members.TypeAliases(),
+		interfaceType,
+		declaration.CompositeKind,
+		declaration.DeclarationKind(),
+	)
+
+	checker.declareNestedTypeAliases(declaration.Members)
+}
+
+func (checker *Checker) declareNestedTypeAliases(members *ast.Members) {
+	for _, nestedInterface := range members.Interfaces() {
+		checker.declareInterfaceTypeAliases(nestedInterface)
+	}
+
+	for _, nestedComposite := range members.Composites() {
+		checker.declareCompositeLikeTypeAliases(nestedComposite)
+	}
+
+	for _, nestedAttachment := range members.Attachments() {
+		checker.declareCompositeLikeTypeAliases(nestedAttachment)
+	}
+}
+
+// declareContainedTypeAliases re-declares the previously declared type aliases
+// nested in the given container type in the current type scope.
+// See `declareCompositeLikeNestedTypes` and `declareInterfaceNestedTypes`.
+func (checker *Checker) declareContainedTypeAliases(containerType TypeAliasContainerType) {
+	typeAliases := containerType.GetTypeAliases()
+	if typeAliases == nil {
+		return
+	}
+
+	typeAliases.Foreach(func(_ string, alias *TypeAlias) {
+
+		// NOTE: We allow the shadowing of types here, because the type alias was already previously
+		// declared without allowing shadowing before. This avoids a duplicate error message.
+
+		_, err := checker.typeActivations.declareType(typeDeclaration{
+			identifier:               alias.Identifier,
+			ty:                       alias.Type,
+			declarationKind:          common.DeclarationKindTypeAlias,
+			access:                   alias.Access,
+			docString:                alias.DocString,
+			allowOuterScopeShadowing: true,
+		})
+		checker.report(err)
+	})
+}
+
+func (checker *Checker) VisitTypeAliasDeclaration(declaration *ast.TypeAliasDeclaration) (_ struct{}) {
+
+	alias := checker.Elaboration.TypeAliasDeclarationAlias(declaration)
+	// all type alias declarations were previously declared in `declareTypeAliases`
+	if alias == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	checker.checkDeclarationAccessModifier(
+		alias.Access,
+		declaration.DeclarationKind(),
+		alias.Type,
+		nil,
+		declaration.StartPos,
+		true,
+	)
+
+	return
+}
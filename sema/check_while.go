@@ -32,7 +32,7 @@ func (checker *Checker) VisitWhileStatement(statement *ast.WhileStatement) (_ st
 	// returns are not definite, but only potential.
 
 	_ = checker.checkPotentiallyUnevaluated(func() Type {
-		checker.functionActivations.Current().WithLoop(func() {
+		checker.functionActivations.Current().WithLoop(checker.loopLabel(statement.Label), func() {
 			checker.checkBlock(statement.Block)
 		})
 
@@ -45,6 +45,11 @@ func (checker *Checker) VisitWhileStatement(statement *ast.WhileStatement) (_ st
 
 func (checker *Checker) VisitBreakStatement(statement *ast.BreakStatement) (_ struct{}) {
 
+	if statement.Label != nil {
+		checker.checkLabeledJump(statement.Label, statement.StartPos)
+		return
+	}
+
 	// Ensure that the `break` statement is inside a loop or switch statement
 
 	if !(checker.inLoop() || checker.inSwitch()) {
@@ -66,6 +71,11 @@ func (checker *Checker) VisitBreakStatement(statement *ast.BreakStatement) (_ st
 
 func (checker *Checker) VisitContinueStatement(statement *ast.ContinueStatement) (_ struct{}) {
 
+	if statement.Label != nil {
+		checker.checkLabeledJump(statement.Label, statement.StartPos)
+		return
+	}
+
 	// Ensure that the `continue` statement is inside a loop statement
 
 	if !checker.inLoop() {
@@ -84,3 +94,47 @@ func (checker *Checker) VisitContinueStatement(statement *ast.ContinueStatement)
 
 	return
 }
+
+// loopLabel returns the name of the given loop label, if any.
+// Labels of nested loops must be unique
+func (checker *Checker) loopLabel(label *ast.Identifier) string {
+	if label == nil {
+		return ""
+	}
+
+	name := label.Identifier
+
+	if checker.functionActivations.Current().LoopLabel(name) != nil {
+		checker.report(
+			&LabelRedeclarationError{
+				Name:  name,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, label),
+			},
+		)
+	}
+
+	return name
+}
+
+// checkLabeledJump checks a `break` or `continue` statement with a label.
+// The label must refer to an enclosing loop of the current function
+func (checker *Checker) checkLabeledJump(label *ast.Identifier, startPos ast.Position) {
+
+	functionActivation := checker.functionActivations.Current()
+
+	loopLabel := functionActivation.LoopLabel(label.Identifier)
+	if loopLabel == nil {
+		checker.report(
+			&NotDeclaredLabelError{
+				Name:  label.Identifier,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, label),
+			},
+		)
+		return
+	}
+
+	// The jump leaves all loops up to the labeled loop
+
+	functionActivation.ReturnInfo.AddJumpOffsetToTarget(startPos.Offset, loopLabel.JumpOffsets)
+	functionActivation.ReturnInfo.DefinitelyJumped = true
+}
@@ -421,6 +421,29 @@ func (checker *Checker) CheckProgram(program *ast.Program) {
 		VisitThisAndNested(compositeType, registerInElaboration)
 	}
 
+	// Declare type aliases.
+	// NOTE: type aliases are declared *after* all interface and composite types,
+	// so they may refer to them, and *before* their members, so the members may refer to the type aliases.
+
+	checker.declareTypeAliases(
+		program.TypeAliasDeclarations(),
+		nil,
+		common.CompositeKindUnknown,
+		common.DeclarationKindUnknown,
+	)
+
+	for _, declaration := range program.InterfaceDeclarations() {
+		checker.declareInterfaceTypeAliases(declaration)
+	}
+
+	for _, declaration := range program.CompositeDeclarations() {
+		checker.declareCompositeLikeTypeAliases(declaration)
+	}
+
+	for _, declaration := range program.AttachmentDeclarations() {
+		checker.declareCompositeLikeTypeAliases(declaration)
+	}
+
 	// Declare interfaces' and composites' members
 
 	for _, declaration := range program.InterfaceDeclarations() {
@@ -860,7 +883,9 @@ func (checker *Checker) findAndCheckValueVariable(identifierExpression *ast.Iden
 func (checker *Checker) ConvertType(t ast.Type) Type {
 	switch t := t.(type) {
 	case *ast.NominalType:
-		return checker.convertNominalType(t)
+		ty := checker.convertNominalType(t)
+		checker.checkGenericCompositeTypeInstantiated(ty, t)
+		return ty
 
 	case *ast.VariableSizedType:
 		return checker.convertVariableSizedType(t)
@@ -1233,7 +1258,15 @@ func (checker *Checker) convertNominalType(t *ast.NominalType) Type {
 
 	for _, identifier := range t.NestedIdentifiers {
 		if containerType, ok := ty.(ContainerType); ok && containerType.IsContainerType() {
-			ty, _ = containerType.GetNestedTypes().Get(identifier.Identifier)
+			var found bool
+			ty, found = containerType.GetNestedTypes().Get(identifier.Identifier)
+			if !found {
+				if typeAliasContainerType, ok := containerType.(TypeAliasContainerType); ok {
+					if alias, ok := typeAliasContainerType.GetTypeAlias(identifier.Identifier); ok {
+						ty = alias.Type
+					}
+				}
+			}
 		} else {
 			if !ty.IsInvalidType() {
 				checker.report(
@@ -1306,16 +1339,6 @@ func (checker *Checker) functionType(
 	var convertedTypeParameters []*TypeParameter
 	if typeParameterList != nil {
 
-		// Only native functions may have type parameters at the moment
-		if !isNative && !typeParameterList.IsEmpty() {
-			checker.report(&InvalidTypeParameterizedNonNativeFunctionError{
-				Range: ast.NewRangeFromPositioned(
-					checker.memoryGauge,
-					typeParameterList,
-				),
-			})
-		}
-
 		checker.typeActivations.Enter()
 		defer checker.typeActivations.Leave(func(gauge common.MemoryGauge) ast.Position {
 			if returnTypeAnnotation != nil {
@@ -1328,7 +1351,15 @@ func (checker *Checker) functionType(
 		// All type parameters are converted at once,
 		// so type bounds may currently not refer to previous type parameters
 
-		convertedTypeParameters = checker.typeParameters(typeParameterList)
+		// Type parameters of non-native functions without a type bound
+		// are implicitly bound to non-resource types
+
+		var defaultTypeBound Type
+		if !isNative {
+			defaultTypeBound = AnyStructType
+		}
+
+		convertedTypeParameters = checker.typeParameters(typeParameterList, defaultTypeBound)
 
 		for typeParameterIndex, typeParameter := range typeParameterList.TypeParameters {
 			convertedTypeParameter := convertedTypeParameters[typeParameterIndex]
@@ -1368,7 +1399,12 @@ func (checker *Checker) functionType(
 	}
 }
 
-func (checker *Checker) typeParameters(typeParameterList *ast.TypeParameterList) []*TypeParameter {
+// typeParameters converts the given type parameters.
+// Type parameters without a type bound are bound to the given default type bound, if any
+func (checker *Checker) typeParameters(
+	typeParameterList *ast.TypeParameterList,
+	defaultTypeBound Type,
+) []*TypeParameter {
 
 	var typeParameters []*TypeParameter
 
@@ -1379,7 +1415,7 @@ func (checker *Checker) typeParameters(typeParameterList *ast.TypeParameterList)
 		for i, typeParameter := range typeParameterList.TypeParameters {
 
 			typeBoundAnnotation := typeParameter.TypeBound
-			var convertedTypeBound Type
+			convertedTypeBound := defaultTypeBound
 			if typeBoundAnnotation != nil {
 				convertedTypeBoundAnnotation := checker.ConvertTypeAnnotation(typeBoundAnnotation)
 				checker.checkTypeAnnotation(convertedTypeBoundAnnotation, typeBoundAnnotation)
@@ -1417,6 +1453,15 @@ func (checker *Checker) parameters(parameterList *ast.ParameterList) []Parameter
 					Type:       convertedParameterType,
 				},
 			}
+
+			// NOTE: the default argument can only be checked when the function is checked,
+			// as it may refer to declarations which are not declared yet.
+			// Until then, assume the default argument has the parameter's type,
+			// see checkDefaultArguments
+
+			if parameter.DefaultArgument != nil {
+				parameters[i].DefaultArgument = convertedParameterType
+			}
 		}
 	}
 
@@ -2398,9 +2443,35 @@ func (checker *Checker) EffectiveCompositeMemberAccess(access Access) Access {
 	}
 }
 
+// checkGenericCompositeTypeInstantiated reports an error if the given type
+// is a generic composite type, which is used without type arguments
+func (checker *Checker) checkGenericCompositeTypeInstantiated(ty Type, t *ast.NominalType) {
+	compositeType, ok := ty.(*CompositeType)
+	if !ok || compositeType.genericType != nil {
+		return
+	}
+
+	for _, typeParameter := range compositeType.typeParameters {
+		checker.report(
+			&MissingTypeArgumentError{
+				TypeArgumentName: typeParameter.Name,
+				Range:            ast.NewRangeFromPositioned(checker.memoryGauge, t),
+			},
+		)
+	}
+}
+
 func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type {
 
-	ty := checker.ConvertType(t.Type)
+	// The instantiated type may be a generic composite type,
+	// so do not require it to be instantiated, like other nominal types
+
+	var ty Type
+	if nominalType, ok := t.Type.(*ast.NominalType); ok {
+		ty = checker.convertNominalType(nominalType)
+	} else {
+		ty = checker.ConvertType(t.Type)
+	}
 
 	// Always convert (check) the type arguments,
 	// even if the instantiated type is invalid
@@ -2418,7 +2489,7 @@ func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type
 	}
 
 	parameterizedType, ok := ty.(ParameterizedType)
-	if !ok {
+	if !ok || len(parameterizedType.TypeParameters()) == 0 {
 
 		// The type is not parameterized,
 		// report an error for all type arguments
@@ -2631,10 +2702,11 @@ func (checker *Checker) visitExpressionWithForceType(
 
 		checker.report(
 			&TypeMismatchError{
-				ExpectedType: expectedType,
-				ActualType:   actualType,
-				Expression:   expr,
-				Range:        checker.expressionRange(expr),
+				ExpectedType:      expectedType,
+				ActualType:        actualType,
+				ExpectedTypeAlias: checker.Elaboration.TypeAlias(expectedType),
+				Expression:        expr,
+				Range:             checker.expressionRange(expr),
 			},
 		)
 
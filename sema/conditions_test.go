@@ -286,11 +286,12 @@ func TestCheckInvalidFunctionPostConditionWithBeforeAndNoArgument(t *testing.T)
           }
         `)
 
-		errs := RequireCheckerErrors(t, err, 3)
+		// The type argument of the invocation of `before`
+		// is inferred from the parameter type of the event
+
+		errs := RequireCheckerErrors(t, err, 1)
 
 		assert.IsType(t, &sema.InsufficientArgumentsError{}, errs[0])
-		assert.IsType(t, &sema.InvocationTypeInferenceError{}, errs[1])
-		assert.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[2])
 	})
 }
 
@@ -53,4 +53,7 @@ type Config struct {
 	AllowNativeDeclarations bool
 	// AllowStaticDeclarations determines if declarations may be static
 	AllowStaticDeclarations bool
+	// EnumSwitchExhaustivenessCheckEnabled determines if switch statements over enums,
+	// which have no default case and do not cover all enum cases, are reported
+	EnumSwitchExhaustivenessCheckEnabled bool
 }
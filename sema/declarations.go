@@ -31,6 +31,7 @@ type ValueDeclaration interface {
 	ValueDeclarationPosition() *ast.Position
 	ValueDeclarationIsConstant() bool
 	ValueDeclarationArgumentLabels() []string
+	ValueDeclarationAllowShadowing() bool
 }
 
 type TypeDeclaration interface {
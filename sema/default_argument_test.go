@@ -0,0 +1,342 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/sema"
+	. "github.com/onflow/cadence/test_utils/sema_utils"
+)
+
+func TestCheckDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun mint(amount: UFix64, recipient: Address = 0x1, memo: String? = nil): Address {
+              return recipient
+          }
+
+          let a = mint(amount: 1.0)
+          let b = mint(amount: 1.0, recipient: 0x2)
+          let c = mint(amount: 1.0, recipient: 0x2, memo: "test")
+        `)
+		require.NoError(t, err)
+
+		mintType := RequireGlobalValue(t, checker.Elaboration, "mint").(*sema.FunctionType)
+		assert.Equal(t, 1, sema.RequiredArgumentCount(mintType.Parameters))
+		assert.Equal(t,
+			&sema.Arity{Min: 1, Max: 3},
+			mintType.EffectiveArity(),
+		)
+	})
+
+	t.Run("composite function and initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let defaultValue = 42
+
+          struct S {
+              let x: Int
+
+              init(x: Int = defaultValue) {
+                  self.x = x
+              }
+
+              fun add(_ a: Int, _ b: Int = 1): Int {
+                  return a + b
+              }
+          }
+
+          let s = S()
+          let t = S(x: 2)
+          let a = s.add(1)
+          let b = s.add(1, 2)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("too few arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun foo(a: Int, b: Int = 1) {}
+
+          let x = foo()
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InsufficientArgumentsError{}, errs[0])
+	})
+
+	t.Run("too many arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun foo(a: Int, b: Int = 1) {}
+
+          let x = foo(a: 1, b: 2, 3)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ExcessiveArgumentsError{}, errs[0])
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun foo(a: Int = "1") {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("subtype", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun foo(a: Int? = 1, b: AnyStruct = "b") {}
+
+          let x = foo()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing trailing default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun foo(a: Int = 1, b: Int) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var defaultArgumentErr *sema.InvalidDefaultArgumentError
+		require.ErrorAs(t, errs[0], &defaultArgumentErr)
+		assert.Equal(t, sema.MissingTrailingDefaultArgument, defaultArgumentErr.Kind)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun foo(r: @R? = nil) {
+              destroy r
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var defaultArgumentErr *sema.InvalidDefaultArgumentError
+		require.ErrorAs(t, errs[0], &defaultArgumentErr)
+		assert.Equal(t, sema.ResourceDefaultArgument, defaultArgumentErr.Kind)
+	})
+
+	t.Run("self", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let x: Int
+
+              init() {
+                  self.x = 1
+              }
+
+              fun foo(a: Int = self.x) {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var defaultArgumentErr *sema.InvalidDefaultArgumentError
+		require.ErrorAs(t, errs[0], &defaultArgumentErr)
+		assert.Equal(t, sema.SelfReferencingDefaultArgument, defaultArgumentErr.Kind)
+	})
+
+	t.Run("other parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun foo(a: Int, b: Int = a) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("interface function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun foo(a: Int = 1)
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var defaultArgumentErr *sema.InvalidDefaultArgumentError
+		require.ErrorAs(t, errs[0], &defaultArgumentErr)
+		assert.Equal(t, sema.UnsupportedDefaultArgument, defaultArgumentErr.Kind)
+	})
+
+	t.Run("interface initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              init(a: Int = 1)
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var defaultArgumentErr *sema.InvalidDefaultArgumentError
+		require.ErrorAs(t, errs[0], &defaultArgumentErr)
+		assert.Equal(t, sema.UnsupportedDefaultArgument, defaultArgumentErr.Kind)
+	})
+
+	t.Run("view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var x = 0
+
+          fun impure(): Int {
+              x = x + 1
+              return x
+          }
+
+          view fun foo(a: Int = impure()) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+}
+
+func TestCheckDefaultArgumentsOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("omitted argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun foo(a: Int, b: Int = 1): Int { return a + b }
+              fun foo(b: Int): String { return "b" }
+          }
+
+          let s = S()
+          let x: Int = s.foo(a: 1)
+          let y: Int = s.foo(a: 1, b: 2)
+          let z: String = s.foo(b: 2)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun foo(a: Int) {}
+              fun foo(a: Int, b: Int = 1) {}
+          }
+
+          let s = S()
+          let x = s.foo(a: 1)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var ambiguousErr *sema.AmbiguousOverloadDeclarationError
+		require.ErrorAs(t, errs[0], &ambiguousErr)
+		assert.Equal(t, "foo(a:b:)", ambiguousErr.Name)
+		assert.Equal(t, "foo(a:)", ambiguousErr.OtherName)
+	})
+
+	t.Run("ambiguous initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              init() {}
+              init(a: Int = 1) {}
+          }
+
+          let s = S()
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var ambiguousErr *sema.AmbiguousOverloadDeclarationError
+		require.ErrorAs(t, errs[0], &ambiguousErr)
+		assert.Equal(t, "init(a:)", ambiguousErr.Name)
+		assert.Equal(t, "init()", ambiguousErr.OtherName)
+	})
+
+	t.Run("not ambiguous, different labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun foo(a: Int, b: Int = 1) {}
+              fun foo(a: Int, c: Int) {}
+          }
+        `)
+		require.NoError(t, err)
+	})
+}
@@ -27,9 +27,12 @@ import (
 )
 
 type MemberAccessInfo struct {
-	AccessedType    Type
-	ResultingType   Type
-	Member          *Member
+	AccessedType  Type
+	ResultingType Type
+	Member        *Member
+	// OverloadName is the name by which the member is looked up at run-time,
+	// if it differs from the member's identifier, see MemberOverloadName
+	OverloadName    string
 	IsOptional      bool
 	ReturnReference bool
 }
@@ -72,6 +75,9 @@ type InvocationExpressionTypes struct {
 	TypeArguments      *TypeParameterTypeOrderedMap
 	ArgumentTypes      []Type
 	TypeParameterTypes []Type
+	// OverloadName is the overload name of the selected initializer,
+	// if an overloaded constructor is invoked and the overload is not the first initializer
+	OverloadName string
 }
 
 type ArrayExpressionTypes struct {
@@ -113,6 +119,7 @@ type ExpressionTypes struct {
 
 type ForStatementTypes struct {
 	IndexVariableType Type
+	KeyVariableType   Type
 	ValueVariableType Type
 }
 
@@ -142,6 +149,7 @@ type Elaboration struct {
 	stringExpressionTypes             map[*ast.StringExpression]Type
 	returnStatementTypes              map[*ast.ReturnStatement]ReturnStatementTypes
 	functionDeclarationFunctionTypes  map[*ast.FunctionDeclaration]*FunctionType
+	fieldDeclarationGetterTypes       map[*ast.FieldDeclaration]*FunctionType
 	variableDeclarationTypes          map[*ast.VariableDeclaration]VariableDeclarationTypes
 	// nestedResourceMoveExpressions indicates the index or member expression
 	// is implicitly moving a resource out of the container, e.g. in a shift or swap statement.
@@ -151,12 +159,15 @@ type Elaboration struct {
 	defaultDestroyDeclarations          map[ast.Declaration]ast.CompositeLikeDeclaration
 	postConditionsRewrites              map[*ast.Conditions]PostConditionsRewrite
 	emitStatementEventTypes             map[*ast.EmitStatement]*CompositeType
+	typeAliasDeclarationAliases         map[*ast.TypeAliasDeclaration]*TypeAlias
+	typeAliases                         map[Type]*TypeAlias
 	compositeTypes                      map[TypeID]*CompositeType
 	interfaceTypes                      map[TypeID]*InterfaceType
 	entitlementTypes                    map[TypeID]*EntitlementType
 	entitlementMapTypes                 map[TypeID]*EntitlementMapType
 	identifierInInvocationTypes         map[*ast.IdentifierExpression]Type
 	importDeclarationsResolvedLocations map[*ast.ImportDeclaration][]ResolvedLocation
+	importCodeHashes                    map[common.Location][]byte
 	globalValues                        *StringVariableOrderedMap
 	globalTypes                         *StringVariableOrderedMap
 	numberConversionArgumentTypes       map[ast.Expression]NumberConversionArgumentTypes
@@ -235,6 +246,23 @@ func (e *Elaboration) SetFunctionDeclarationFunctionType(
 	e.functionDeclarationFunctionTypes[declaration] = functionType
 }
 
+func (e *Elaboration) FieldDeclarationGetterType(declaration *ast.FieldDeclaration) *FunctionType {
+	if e.fieldDeclarationGetterTypes == nil {
+		return nil
+	}
+	return e.fieldDeclarationGetterTypes[declaration]
+}
+
+func (e *Elaboration) SetFieldDeclarationGetterType(
+	declaration *ast.FieldDeclaration,
+	functionType *FunctionType,
+) {
+	if e.fieldDeclarationGetterTypes == nil {
+		e.fieldDeclarationGetterTypes = map[*ast.FieldDeclaration]*FunctionType{}
+	}
+	e.fieldDeclarationGetterTypes[declaration] = functionType
+}
+
 func (e *Elaboration) VariableDeclarationTypes(declaration *ast.VariableDeclaration) (types VariableDeclarationTypes) {
 	if e.variableDeclarationTypes == nil {
 		return
@@ -783,6 +811,38 @@ func (e *Elaboration) SetEmitStatementEventType(statement *ast.EmitStatement, co
 	e.emitStatementEventTypes[statement] = compositeType
 }
 
+func (e *Elaboration) TypeAliasDeclarationAlias(declaration *ast.TypeAliasDeclaration) *TypeAlias {
+	if e.typeAliasDeclarationAliases == nil {
+		return nil
+	}
+	return e.typeAliasDeclarationAliases[declaration]
+}
+
+func (e *Elaboration) SetTypeAliasDeclarationAlias(declaration *ast.TypeAliasDeclaration, alias *TypeAlias) {
+	if e.typeAliasDeclarationAliases == nil {
+		e.typeAliasDeclarationAliases = map[*ast.TypeAliasDeclaration]*TypeAlias{}
+	}
+	e.typeAliasDeclarationAliases[declaration] = alias
+}
+
+// TypeAlias returns the type alias which declared the given type, if any.
+// Only types declared by the aliased type of a type alias declaration are recorded,
+// e.g. the dictionary type `{Address: UFix64}` of `typealias Balances = {Address: UFix64}`,
+// but not the nominal types aliased by type alias declarations
+func (e *Elaboration) TypeAlias(ty Type) *TypeAlias {
+	if e.typeAliases == nil {
+		return nil
+	}
+	return e.typeAliases[ty]
+}
+
+func (e *Elaboration) SetTypeAlias(ty Type, alias *TypeAlias) {
+	if e.typeAliases == nil {
+		e.typeAliases = map[Type]*TypeAlias{}
+	}
+	e.typeAliases[ty] = alias
+}
+
 func (e *Elaboration) CompositeType(typeID common.TypeID) *CompositeType {
 	if e.compositeTypes == nil {
 		return nil
@@ -870,6 +930,30 @@ func (e *Elaboration) SetImportDeclarationsResolvedLocations(
 	e.importDeclarationsResolvedLocations[declaration] = locations
 }
 
+// ImportCodeHash returns the code hash the import of the given location is pinned to, if any
+func (e *Elaboration) ImportCodeHash(location common.Location) []byte {
+	codeHash, _ := e.importCodeHash(location)
+	return codeHash
+}
+
+// importCodeHash returns the code hash the import of the given location is pinned to, if any,
+// and whether the location was imported.
+// Imports which are not pinned are recorded with a nil code hash
+func (e *Elaboration) importCodeHash(location common.Location) (codeHash []byte, imported bool) {
+	if e.importCodeHashes == nil {
+		return nil, false
+	}
+	codeHash, imported = e.importCodeHashes[location]
+	return
+}
+
+func (e *Elaboration) SetImportCodeHash(location common.Location, codeHash []byte) {
+	if e.importCodeHashes == nil {
+		e.importCodeHashes = map[common.Location][]byte{}
+	}
+	e.importCodeHashes[location] = codeHash
+}
+
 func (e *Elaboration) ReferenceExpressionBorrowType(expression *ast.ReferenceExpression) Type {
 	if e.referenceExpressionBorrowTypes == nil {
 		return nil
@@ -131,7 +131,7 @@ func TestCheckEnumRawType(t *testing.T) {
 		require.NoError(t, err)
 	})
 
-	t.Run("more than one conformance", func(t *testing.T) {
+	t.Run("raw type and struct interface", func(t *testing.T) {
 
 		t.Parallel()
 
@@ -141,9 +141,35 @@ func TestCheckEnumRawType(t *testing.T) {
           enum E: Int, S {}
         `)
 
+		require.NoError(t, err)
+	})
+
+	t.Run("raw type and resource interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface R {}
+
+          enum E: Int, R {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.CompositeKindMismatchError{}, errs[0])
+	})
+
+	t.Run("raw type and non-interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: Int, UInt8 {}
+        `)
+
 		errs := RequireCheckerErrors(t, err, 1)
 
-		assert.IsType(t, &sema.InvalidEnumConformancesError{}, errs[0])
+		assert.IsType(t, &sema.InvalidConformanceError{}, errs[0])
 	})
 }
 
@@ -247,3 +273,326 @@ func TestCheckEnumInContract(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckEnumFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum Status: UInt8 {
+              case active
+              case inactive
+
+              access(all) view fun displayName(): String {
+                  switch self {
+                      case Status.active:
+                          return "Active"
+                      case Status.inactive:
+                          return "Inactive"
+                  }
+                  return ""
+              }
+
+              access(all) view fun isActive(): Bool {
+                  return self.rawValue == Status.active.rawValue
+              }
+          }
+
+          let name: String = Status.active.displayName()
+          let active: Bool = Status.inactive.isActive()
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+
+              access(all) fun foo(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidEnumFunctionPurityError{}, errs[0])
+	})
+
+	t.Run("impure function body", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var x = 0
+
+          enum E: UInt8 {
+              case a
+
+              access(all) view fun foo() {
+                  x = 1
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("raw value redeclaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+
+              access(all) view fun rawValue(): UInt8 {
+                  return 0
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDeclarationError{}, errs[0])
+	})
+
+	t.Run("initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+
+              init() {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidNonEnumCaseError{}, errs[0])
+	})
+}
+
+func TestCheckEnumComputedFields(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum Status: UInt8 {
+              case active
+              case inactive
+
+              access(all) let isActive: Bool {
+                  return self == Status.active
+              }
+
+              access(all) let code: String {
+                  return self.isActive ? "A" : "I"
+              }
+          }
+
+          let isActive: Bool = Status.active.isActive
+          let code: String = Status.inactive.code
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+
+              access(all) let foo: Int {
+                  return "foo"
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing return", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+
+              access(all) let foo: Int {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnStatementError{}, errs[0])
+	})
+
+	t.Run("impure getter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var x = 0
+
+          enum E: UInt8 {
+              case a
+
+              access(all) let foo: Int {
+                  x = x + 1
+                  return x
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("assignment", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+
+              access(all) let foo: Int {
+                  return 1
+              }
+          }
+
+          fun test() {
+              E.a.foo = 2
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidAssignmentAccessError{}, errs[0])
+		assert.IsType(t, &sema.AssignmentToConstantMemberError{}, errs[1])
+	})
+
+	t.Run("stored field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+
+              access(all) let foo: Int
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidNonEnumCaseError{}, errs[0])
+		assert.IsType(t, &sema.MissingInitializerError{}, errs[1])
+	})
+}
+
+func TestCheckEnumInterfaceConformance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("function and field requirements", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface Named {
+              access(all) let name: String
+
+              access(all) view fun displayName(): String
+          }
+
+          enum Status: UInt8, Named {
+              case active
+
+              access(all) let name: String {
+                  return "active"
+              }
+
+              access(all) view fun displayName(): String {
+                  return "Active"
+              }
+          }
+
+          let named: {Named} = Status.active
+          let names: [{Named}] = [Status.active]
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("default function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface Named {
+              access(all) let name: String
+
+              access(all) view fun displayName(): String {
+                  return "<".concat(self.name).concat(">")
+              }
+          }
+
+          enum Status: UInt8, Named {
+              case active
+
+              access(all) let name: String {
+                  return "active"
+              }
+          }
+
+          let name: String = Status.active.displayName()
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing member", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface Named {
+              access(all) view fun displayName(): String
+          }
+
+          enum Status: UInt8, Named {
+              case active
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+}
@@ -266,13 +266,16 @@ func (e *AssignmentToConstantError) SecondaryError() string {
 type TypeMismatchError struct {
 	ExpectedType Type
 	ActualType   Type
-	Expression   ast.Expression
+	// ExpectedTypeAlias is the type alias of the expected type, if any
+	ExpectedTypeAlias *TypeAlias
+	Expression        ast.Expression
 	ast.Range
 }
 
 var _ SemanticError = &TypeMismatchError{}
 var _ errors.UserError = &TypeMismatchError{}
 var _ errors.SecondaryError = &TypeMismatchError{}
+var _ errors.ErrorNotes = &TypeMismatchError{}
 
 func (*TypeMismatchError) isSemanticError() {}
 
@@ -295,6 +298,40 @@ func (e *TypeMismatchError) SecondaryError() string {
 	)
 }
 
+func (e *TypeMismatchError) ErrorNotes() []errors.ErrorNote {
+	if e.ExpectedTypeAlias == nil {
+		return nil
+	}
+
+	return []errors.ErrorNote{
+		TypeAliasNote{
+			TypeAlias: e.ExpectedTypeAlias,
+		},
+	}
+}
+
+// TypeAliasNote
+
+type TypeAliasNote struct {
+	TypeAlias *TypeAlias
+}
+
+func (n TypeAliasNote) Message() string {
+	return fmt.Sprintf(
+		"`%s` is an alias for `%s`",
+		n.TypeAlias.QualifiedIdentifier(),
+		n.TypeAlias.Type.QualifiedString(),
+	)
+}
+
+func (n TypeAliasNote) StartPosition() ast.Position {
+	return n.TypeAlias.Range.StartPos
+}
+
+func (n TypeAliasNote) EndPosition(_ common.MemoryGauge) ast.Position {
+	return n.TypeAlias.Range.EndPos
+}
+
 // TypeMismatchWithDescriptionError
 
 type TypeMismatchWithDescriptionError struct {
@@ -745,6 +782,58 @@ func (e *ControlStatementError) SecondaryError() string {
 	)
 }
 
+// NotDeclaredLabelError
+
+type NotDeclaredLabelError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &NotDeclaredLabelError{}
+var _ errors.UserError = &NotDeclaredLabelError{}
+var _ errors.SecondaryError = &NotDeclaredLabelError{}
+
+func (*NotDeclaredLabelError) isSemanticError() {}
+
+func (*NotDeclaredLabelError) IsUserError() {}
+
+func (e *NotDeclaredLabelError) Error() string {
+	return fmt.Sprintf(
+		"cannot find label in this scope: `%s`",
+		e.Name,
+	)
+}
+
+func (e *NotDeclaredLabelError) SecondaryError() string {
+	return "labels of `break` and `continue` statements must refer to an enclosing loop"
+}
+
+// LabelRedeclarationError
+
+type LabelRedeclarationError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &LabelRedeclarationError{}
+var _ errors.UserError = &LabelRedeclarationError{}
+var _ errors.SecondaryError = &LabelRedeclarationError{}
+
+func (*LabelRedeclarationError) isSemanticError() {}
+
+func (*LabelRedeclarationError) IsUserError() {}
+
+func (e *LabelRedeclarationError) Error() string {
+	return fmt.Sprintf(
+		"cannot redeclare label: `%s` is already declared",
+		e.Name,
+	)
+}
+
+func (e *LabelRedeclarationError) SecondaryError() string {
+	return "an enclosing loop already has this label"
+}
+
 // InvalidAccessModifierError
 
 type InvalidAccessModifierError struct {
@@ -1413,23 +1502,6 @@ func (e *MissingEnumRawTypeError) EndPosition(common.MemoryGauge) ast.Position {
 	return e.Pos
 }
 
-// InvalidEnumConformancesError
-
-type InvalidEnumConformancesError struct {
-	ast.Range
-}
-
-var _ SemanticError = &InvalidEnumConformancesError{}
-var _ errors.UserError = &InvalidEnumConformancesError{}
-
-func (*InvalidEnumConformancesError) isSemanticError() {}
-
-func (*InvalidEnumConformancesError) IsUserError() {}
-
-func (e *InvalidEnumConformancesError) Error() string {
-	return "enums cannot conform to interfaces"
-}
-
 // InvalidAttachmentConformancesError
 
 type InvalidAttachmentConformancesError struct {
@@ -1612,6 +1684,31 @@ func (e CyclicConformanceError) Error() string {
 	)
 }
 
+// CyclicTypeAliasError
+type CyclicTypeAliasError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &CyclicTypeAliasError{}
+var _ errors.UserError = &CyclicTypeAliasError{}
+var _ errors.SecondaryError = &CyclicTypeAliasError{}
+
+func (*CyclicTypeAliasError) isSemanticError() {}
+
+func (*CyclicTypeAliasError) IsUserError() {}
+
+func (e *CyclicTypeAliasError) Error() string {
+	return fmt.Sprintf(
+		"type alias `%s` refers to itself",
+		e.Name,
+	)
+}
+
+func (*CyclicTypeAliasError) SecondaryError() string {
+	return "a type alias may only refer to previously declared type aliases"
+}
+
 // MultipleInterfaceDefaultImplementationsError
 type MultipleInterfaceDefaultImplementationsError struct {
 	CompositeKindedType CompositeKindedType
@@ -1883,6 +1980,129 @@ func (e *UnsupportedOverloadingError) Error() string {
 	)
 }
 
+// NoMatchingOverloadError
+
+type NoMatchingOverloadError struct {
+	Name       string
+	Candidates []string
+	ast.Range
+}
+
+var _ SemanticError = &NoMatchingOverloadError{}
+var _ errors.UserError = &NoMatchingOverloadError{}
+var _ errors.SecondaryError = &NoMatchingOverloadError{}
+
+func (*NoMatchingOverloadError) isSemanticError() {}
+
+func (*NoMatchingOverloadError) IsUserError() {}
+
+func (e *NoMatchingOverloadError) Error() string {
+	return fmt.Sprintf(
+		"no overload matches the argument labels of the invocation `%s`",
+		e.Name,
+	)
+}
+
+func (e *NoMatchingOverloadError) SecondaryError() string {
+	return formatOverloadCandidates(e.Candidates)
+}
+
+func formatOverloadCandidates(candidates []string) string {
+	var builder strings.Builder
+	builder.WriteString("candidates are ")
+	for i, candidate := range candidates {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteByte('`')
+		builder.WriteString(candidate)
+		builder.WriteByte('`')
+	}
+	return builder.String()
+}
+
+// AmbiguousOverloadError
+
+type AmbiguousOverloadError struct {
+	Name       string
+	Candidates []string
+	ast.Range
+}
+
+var _ SemanticError = &AmbiguousOverloadError{}
+var _ errors.UserError = &AmbiguousOverloadError{}
+var _ errors.SecondaryError = &AmbiguousOverloadError{}
+
+func (*AmbiguousOverloadError) isSemanticError() {}
+
+func (*AmbiguousOverloadError) IsUserError() {}
+
+func (e *AmbiguousOverloadError) Error() string {
+	return fmt.Sprintf(
+		"the argument labels of the invocation `%s` match multiple overloads",
+		e.Name,
+	)
+}
+
+func (e *AmbiguousOverloadError) SecondaryError() string {
+	return formatOverloadCandidates(e.Candidates)
+}
+
+// AmbiguousOverloadReferenceError
+
+type AmbiguousOverloadReferenceError struct {
+	Name       string
+	Candidates []string
+	ast.Range
+}
+
+var _ SemanticError = &AmbiguousOverloadReferenceError{}
+var _ errors.UserError = &AmbiguousOverloadReferenceError{}
+var _ errors.SecondaryError = &AmbiguousOverloadReferenceError{}
+
+func (*AmbiguousOverloadReferenceError) isSemanticError() {}
+
+func (*AmbiguousOverloadReferenceError) IsUserError() {}
+
+func (e *AmbiguousOverloadReferenceError) Error() string {
+	return fmt.Sprintf(
+		"reference to overloaded function `%s` is ambiguous",
+		e.Name,
+	)
+}
+
+func (e *AmbiguousOverloadReferenceError) SecondaryError() string {
+	return "overloaded functions must be invoked; " + formatOverloadCandidates(e.Candidates)
+}
+
+// AmbiguousOverloadDeclarationError
+
+type AmbiguousOverloadDeclarationError struct {
+	Name      string
+	OtherName string
+	ast.Range
+}
+
+var _ SemanticError = &AmbiguousOverloadDeclarationError{}
+var _ errors.UserError = &AmbiguousOverloadDeclarationError{}
+var _ errors.SecondaryError = &AmbiguousOverloadDeclarationError{}
+
+func (*AmbiguousOverloadDeclarationError) isSemanticError() {}
+
+func (*AmbiguousOverloadDeclarationError) IsUserError() {}
+
+func (e *AmbiguousOverloadDeclarationError) Error() string {
+	return fmt.Sprintf(
+		"overload `%s` is ambiguous with overload `%s`",
+		e.Name,
+		e.OtherName,
+	)
+}
+
+func (*AmbiguousOverloadDeclarationError) SecondaryError() string {
+	return "invocations which omit arguments for parameters with default arguments match both overloads"
+}
+
 // CompositeKindMismatchError
 
 type CompositeKindMismatchError struct {
@@ -3499,7 +3719,50 @@ func (*InvalidNonEnumCaseError) IsUserError() {}
 
 func (e *InvalidNonEnumCaseError) Error() string {
 	return fmt.Sprintf(
-		"%s declaration only allows enum cases",
+		"%s declaration only allows enum cases, functions, and computed fields",
+		e.ContainerDeclarationKind.Name(),
+	)
+}
+
+// InvalidEnumFunctionPurityError
+
+type InvalidEnumFunctionPurityError struct {
+	ast.Range
+}
+
+var _ SemanticError = &InvalidEnumFunctionPurityError{}
+var _ errors.UserError = &InvalidEnumFunctionPurityError{}
+var _ errors.SecondaryError = &InvalidEnumFunctionPurityError{}
+
+func (*InvalidEnumFunctionPurityError) isSemanticError() {}
+
+func (*InvalidEnumFunctionPurityError) IsUserError() {}
+
+func (e *InvalidEnumFunctionPurityError) Error() string {
+	return "enum functions must be view functions"
+}
+
+func (e *InvalidEnumFunctionPurityError) SecondaryError() string {
+	return "enum cases are constant, add the `view` modifier"
+}
+
+// InvalidComputedFieldError
+
+type InvalidComputedFieldError struct {
+	ContainerDeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+var _ SemanticError = &InvalidComputedFieldError{}
+var _ errors.UserError = &InvalidComputedFieldError{}
+
+func (*InvalidComputedFieldError) isSemanticError() {}
+
+func (*InvalidComputedFieldError) IsUserError() {}
+
+func (e *InvalidComputedFieldError) Error() string {
+	return fmt.Sprintf(
+		"%s declaration does not allow computed fields",
 		e.ContainerDeclarationKind.Name(),
 	)
 }
@@ -4075,6 +4338,58 @@ func (e *CyclicImportsError) Error() string {
 	return fmt.Sprintf("cyclic import of `%s`", e.Location)
 }
 
+// AmbiguousImportCodeHashError
+
+type AmbiguousImportCodeHashError struct {
+	Location common.Location
+	ast.Range
+}
+
+var _ SemanticError = &AmbiguousImportCodeHashError{}
+var _ errors.UserError = &AmbiguousImportCodeHashError{}
+var _ errors.SecondaryError = &AmbiguousImportCodeHashError{}
+
+func (*AmbiguousImportCodeHashError) isSemanticError() {}
+
+func (*AmbiguousImportCodeHashError) IsUserError() {}
+
+func (e *AmbiguousImportCodeHashError) Error() string {
+	return fmt.Sprintf(
+		"cannot pin import of `%s` to a code hash: import resolves to multiple programs",
+		e.Location,
+	)
+}
+
+func (*AmbiguousImportCodeHashError) SecondaryError() string {
+	return "import a single program when specifying a hash"
+}
+
+// ConflictingImportCodeHashError
+
+type ConflictingImportCodeHashError struct {
+	Location common.Location
+	ast.Range
+}
+
+var _ SemanticError = &ConflictingImportCodeHashError{}
+var _ errors.UserError = &ConflictingImportCodeHashError{}
+var _ errors.SecondaryError = &ConflictingImportCodeHashError{}
+
+func (*ConflictingImportCodeHashError) isSemanticError() {}
+
+func (*ConflictingImportCodeHashError) IsUserError() {}
+
+func (e *ConflictingImportCodeHashError) Error() string {
+	return fmt.Sprintf(
+		"conflicting code hashes for imports of `%s`",
+		e.Location,
+	)
+}
+
+func (*ConflictingImportCodeHashError) SecondaryError() string {
+	return "pin all imports of a program to the same hash, or none"
+}
+
 // SwitchDefaultPositionError
 
 type SwitchDefaultPositionError struct {
@@ -4117,6 +4432,101 @@ func (e *MissingSwitchCaseStatementsError) EndPosition(common.MemoryGauge) ast.P
 	return e.Pos
 }
 
+// MissingSwitchCasesError
+
+type MissingSwitchCasesError struct {
+	EnumType     *CompositeType
+	Statement    *ast.SwitchStatement
+	MissingCases []string
+	ast.Range
+}
+
+var _ SemanticError = &MissingSwitchCasesError{}
+var _ errors.UserError = &MissingSwitchCasesError{}
+var _ errors.SecondaryError = &MissingSwitchCasesError{}
+var _ errors.HasSuggestedFixes[ast.TextEdit] = &MissingSwitchCasesError{}
+
+func (*MissingSwitchCasesError) isSemanticError() {}
+
+func (*MissingSwitchCasesError) IsUserError() {}
+
+func (e *MissingSwitchCasesError) Error() string {
+	return fmt.Sprintf(
+		"switch over enum `%s` is not exhaustive",
+		e.EnumType.QualifiedString(),
+	)
+}
+
+func (e *MissingSwitchCasesError) missingCaseExpressions() []string {
+	enumTypeIdentifier := e.EnumType.QualifiedIdentifier()
+
+	expressions := make([]string, 0, len(e.MissingCases))
+	for _, caseName := range e.MissingCases {
+		expressions = append(
+			expressions,
+			fmt.Sprintf("%s.%s", enumTypeIdentifier, caseName),
+		)
+	}
+	return expressions
+}
+
+func (e *MissingSwitchCasesError) SecondaryError() string {
+	var builder strings.Builder
+	builder.WriteString("missing cases: ")
+	for i, expression := range e.missingCaseExpressions() {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteByte('`')
+		builder.WriteString(expression)
+		builder.WriteByte('`')
+	}
+	builder.WriteString("; add the missing cases or a `default` case")
+	return builder.String()
+}
+
+func (e *MissingSwitchCasesError) SuggestFixes(_ string) []errors.SuggestedFix[ast.TextEdit] {
+
+	// Insert the missing cases before the closing brace of the switch statement,
+	// indented like the existing cases
+
+	closingBracePos := e.Statement.EndPos
+	closingBraceIndentation := strings.Repeat(" ", closingBracePos.Column)
+
+	caseIndentation := closingBraceIndentation + "    "
+	if len(e.Statement.Cases) > 0 {
+		caseIndentation = strings.Repeat(" ", e.Statement.Cases[0].StartPos.Column)
+	}
+
+	var builder strings.Builder
+	for _, expression := range e.missingCaseExpressions() {
+		builder.WriteString(caseIndentation)
+		builder.WriteString("case ")
+		builder.WriteString(expression)
+		builder.WriteString(":\n")
+		builder.WriteString(caseIndentation)
+		builder.WriteString("    break\n")
+	}
+
+	insertion := strings.TrimPrefix(builder.String(), closingBraceIndentation) +
+		closingBraceIndentation
+
+	return []errors.SuggestedFix[ast.TextEdit]{
+		{
+			Message: "add missing cases",
+			TextEdits: []ast.TextEdit{
+				{
+					Insertion: insertion,
+					Range: ast.Range{
+						StartPos: closingBracePos,
+						EndPos:   closingBracePos,
+					},
+				},
+			},
+		},
+	}
+}
+
 // MissingEntryPointError
 
 type MissingEntryPointError struct {
@@ -4767,21 +5177,76 @@ func (e *DefaultDestroyInvalidParameterError) Error() string {
 	return fmt.Sprintf("`%s` is not a valid parameter type for a default destroy event", e.ParamType.QualifiedString())
 }
 
-// InvalidTypeParameterizedNonNativeFunctionError
+// InvalidDefaultArgumentError
+
+type InvalidDefaultArgumentKind int
+
+const (
+	UnsupportedDefaultArgument InvalidDefaultArgumentKind = iota
+	MissingTrailingDefaultArgument
+	ResourceDefaultArgument
+	SelfReferencingDefaultArgument
+)
+
+type InvalidDefaultArgumentError struct {
+	ast.Range
+	Kind InvalidDefaultArgumentKind
+}
+
+var _ SemanticError = &InvalidDefaultArgumentError{}
+var _ errors.UserError = &InvalidDefaultArgumentError{}
+var _ errors.SecondaryError = &InvalidDefaultArgumentError{}
+
+func (*InvalidDefaultArgumentError) isSemanticError() {}
+
+func (*InvalidDefaultArgumentError) IsUserError() {}
+
+func (e *InvalidDefaultArgumentError) Error() string {
+	switch e.Kind {
+	case MissingTrailingDefaultArgument:
+		return "missing default argument"
+	}
+	return "invalid default argument"
+}
+
+func (e *InvalidDefaultArgumentError) SecondaryError() string {
+	switch e.Kind {
+	case UnsupportedDefaultArgument:
+		return "default arguments are only supported by function declarations and initializers of non-interface types"
+	case MissingTrailingDefaultArgument:
+		return "parameters following a parameter with a default argument must have default arguments"
+	case ResourceDefaultArgument:
+		return "resource-typed parameters cannot have default arguments"
+	case SelfReferencingDefaultArgument:
+		return "default arguments cannot refer to `self` or `base`"
+	}
+	return ""
+}
+
+// InvalidTypeParameterizedCompositeError
 
-type InvalidTypeParameterizedNonNativeFunctionError struct {
+type InvalidTypeParameterizedCompositeError struct {
+	CompositeKind common.CompositeKind
 	ast.Range
 }
 
-var _ SemanticError = &InvalidTypeParameterizedNonNativeFunctionError{}
-var _ errors.UserError = &InvalidTypeParameterizedNonNativeFunctionError{}
+var _ SemanticError = &InvalidTypeParameterizedCompositeError{}
+var _ errors.UserError = &InvalidTypeParameterizedCompositeError{}
+var _ errors.SecondaryError = &InvalidTypeParameterizedCompositeError{}
+
+func (*InvalidTypeParameterizedCompositeError) isSemanticError() {}
 
-func (*InvalidTypeParameterizedNonNativeFunctionError) isSemanticError() {}
+func (*InvalidTypeParameterizedCompositeError) IsUserError() {}
 
-func (*InvalidTypeParameterizedNonNativeFunctionError) IsUserError() {}
+func (e *InvalidTypeParameterizedCompositeError) Error() string {
+	return fmt.Sprintf(
+		"invalid type parameters in %s declaration",
+		e.CompositeKind.Name(),
+	)
+}
 
-func (e *InvalidTypeParameterizedNonNativeFunctionError) Error() string {
-	return "invalid type parameters in non-native function"
+func (*InvalidTypeParameterizedCompositeError) SecondaryError() string {
+	return "only structures and resources may have type parameters"
 }
 
 // NestedReferenceError
@@ -531,3 +531,128 @@ func TestCheckReferencesInForLoop(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestCheckForKeyValueBinding(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               for (key, value) in {"a": 1, "b": 2} {
+                   let k: String = key
+                   let v: Int = value
+               }
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("reference to dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               let xs = {"a": [1], "b": [2]}
+               let ref = &xs as &{String: [Int]}
+               for (key, value) in ref {
+                   let k: String = key
+                   let v: &[Int] = value
+               }
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource dictionary reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           resource R {}
+
+           fun test() {
+               let xs <- {"a": <-create R()}
+               for (key, value) in &xs as &{String: R} {
+                   let r: &R = value
+               }
+               destroy xs
+           }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("key type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               for (key, value) in {"a": 1} {
+                   let k: Int = key
+               }
+           }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               for (key, value) in [1, 2, 3] {}
+           }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+	})
+
+	t.Run("resource dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           resource R {}
+
+           fun test() {
+               let xs <- {"a": <-create R()}
+               for (key, value) in xs {}
+               destroy xs
+           }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnsupportedResourceForLoopError{}, errs[0])
+	})
+
+	t.Run("not declared after loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+           fun test() {
+               for (key, value) in {"a": 1} {}
+               let k = key
+           }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
@@ -18,15 +18,29 @@
 
 package sema
 
+import (
+	"github.com/onflow/cadence/common/persistent"
+)
+
 type FunctionActivation struct {
-	ReturnType           Type
-	ReturnInfo           *ReturnInfo
-	InitializationInfo   *InitializationInfo
+	ReturnType         Type
+	ReturnInfo         *ReturnInfo
+	InitializationInfo *InitializationInfo
+	// LoopLabels are the labels of the enclosing labeled loops,
+	// from the outermost to the innermost loop
+	LoopLabels           []LoopLabel
 	Loops                int
 	Switches             int
 	ValueActivationDepth int
 }
 
+// LoopLabel is the label of a loop,
+// together with the jump offsets of the loop body
+type LoopLabel struct {
+	JumpOffsets *persistent.OrderedSet[int]
+	Name        string
+}
+
 func (a FunctionActivation) InLoop() bool {
 	return a.Loops > 0
 }
@@ -35,12 +49,40 @@ func (a FunctionActivation) InSwitch() bool {
 	return a.Switches > 0
 }
 
-func (a *FunctionActivation) WithLoop(f func()) {
+// WithLoop calls the given function for the body of a loop.
+// If the label is not empty, the loop can be targeted by labeled jumps
+func (a *FunctionActivation) WithLoop(label string, f func()) {
 	a.Loops++
-	a.ReturnInfo.WithNewJumpTarget(f)
+	a.ReturnInfo.WithNewJumpTarget(func() {
+		if label == "" {
+			f()
+			return
+		}
+
+		a.LoopLabels = append(
+			a.LoopLabels,
+			LoopLabel{
+				Name:        label,
+				JumpOffsets: a.ReturnInfo.JumpOffsets,
+			},
+		)
+		f()
+		a.LoopLabels = a.LoopLabels[:len(a.LoopLabels)-1]
+	})
 	a.Loops--
 }
 
+// LoopLabel returns the label of the innermost enclosing loop with the given name,
+// or nil if there is no such loop
+func (a *FunctionActivation) LoopLabel(name string) *LoopLabel {
+	for i := len(a.LoopLabels) - 1; i >= 0; i-- {
+		if a.LoopLabels[i].Name == name {
+			return &a.LoopLabels[i]
+		}
+	}
+	return nil
+}
+
 func (a *FunctionActivation) WithSwitch(f func()) {
 	// NOTE: new jump-offsets child-set for each case instead of whole switch
 	a.Switches++
@@ -72,7 +72,6 @@ var parsedHeaderTemplate = template.Must(template.New("header").Parse(headerTemp
 var parserConfig = parser.Config{
 	StaticModifierEnabled: true,
 	NativeModifierEnabled: true,
-	TypeParametersEnabled: true,
 }
 
 func initialUpper(s string) string {
@@ -782,6 +781,10 @@ func (*generator) VisitTransactionDeclaration(_ *ast.TransactionDeclaration) str
 	panic("transaction declarations are not supported")
 }
 
+func (*generator) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) struct{} {
+	panic("type alias declarations are not supported")
+}
+
 func (g *generator) VisitEntitlementDeclaration(decl *ast.EntitlementDeclaration) (_ struct{}) {
 	entitlementName := decl.Identifier.Identifier
 	typeVarName := typeVarName(entitlementName)
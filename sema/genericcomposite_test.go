@@ -0,0 +1,305 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/sema"
+	. "github.com/onflow/cadence/test_utils/sema_utils"
+)
+
+func TestCheckGenericCompositeDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun get(): T {
+                  return self.value
+              }
+
+              fun clone(): Box<T> {
+                  return Box(value: self.value)
+              }
+
+              fun map<U>(_ f: fun(T): U): Box<U> {
+                  return Box<U>(value: f(self.value))
+              }
+          }
+
+          let box = Box(value: 1)
+          let value = box.get()
+          let clone = box.clone()
+          let mapped = box.map(fun (x: Int): String { return x.toString() })
+          let explicit = Box<Int?>(value: 1)
+        `)
+		require.NoError(t, err)
+
+		boxType := RequireGlobalType(t, checker.Elaboration, "Box").(*sema.CompositeType)
+		require.Len(t, boxType.TypeParameters(), 1)
+
+		intBoxType := RequireGlobalValue(t, checker.Elaboration, "box")
+		assert.Equal(t, "Box<Int>", intBoxType.String())
+		assert.Equal(t, sema.TypeID("S.test.Box<Int>"), intBoxType.ID())
+		assert.Equal(t, boxType, intBoxType.(*sema.CompositeType).BaseType())
+
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "value"))
+		assert.True(t, intBoxType.Equal(RequireGlobalValue(t, checker.Elaboration, "clone")))
+		assert.Equal(t, "Box<String>", RequireGlobalValue(t, checker.Elaboration, "mapped").String())
+		assert.Equal(t, "Box<Int?>", RequireGlobalValue(t, checker.Elaboration, "explicit").String())
+	})
+
+	t.Run("resource, bounded", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          resource Holder<T: @AnyResource> {
+              var value: @T?
+
+              init(_ value: @T) {
+                  self.value <- value
+              }
+
+              fun take(): @T {
+                  let value <- self.value <- nil
+                  return <-value!
+              }
+          }
+
+          fun test() {
+              let holder <- create Holder(<-create R())
+              let r: @R <- holder.take()
+              destroy r
+              destroy holder
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("multiple type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface Named {
+              fun name(): String
+          }
+
+          struct S: Named {
+              fun name(): String { return "S" }
+          }
+
+          struct Pair<A, B: {Named}> {
+              let first: A
+              let second: B
+
+              init(first: A, second: B) {
+                  self.first = first
+                  self.second = second
+              }
+
+              fun name(): String {
+                  return self.second.name()
+              }
+          }
+
+          let pair: Pair<Int, S> = Pair(first: 1, second: S())
+          let name = pair.name()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("generic function with generic composite parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          fun unwrap<T>(_ box: Box<T>): T {
+              return box.value
+          }
+
+          let x = unwrap(Box(value: "a"))
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t, sema.StringType, RequireGlobalValue(t, checker.Elaboration, "x"))
+	})
+
+	t.Run("type value", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {}
+
+          let type: Type = Type<Box<Int>>()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {}
+
+          let box: Box? = nil
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingTypeArgumentError{}, errs[0])
+	})
+
+	t.Run("too many type arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {}
+
+          let box: Box<Int, Int>? = nil
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTypeArgumentCountError{}, errs[0])
+	})
+
+	t.Run("mismatched argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let box = Box<Int>(value: "a")
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("bound violated", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface Named {}
+
+          struct Box<T: {Named}> {}
+
+          let box: Box<Int>? = nil
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invariant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let box: Box<AnyStruct> = Box<Int>(value: 1)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource field in struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S<R: @AnyResource> {
+              let r: @R
+
+              init(r: @R) {
+                  self.r <- r
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceFieldError{}, errs[0])
+	})
+
+	t.Run("enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E<T>: UInt8 {
+              case a
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var typeParameterizedErr *sema.InvalidTypeParameterizedCompositeError
+		require.ErrorAs(t, errs[0], &typeParameterizedErr)
+		assert.Equal(t, "invalid type parameters in enum declaration", typeParameterizedErr.Error())
+	})
+}
@@ -933,14 +933,11 @@ func TestCheckGenericFunctionDeclaration(t *testing.T) {
 				},
 				ParseOptions: parser.Config{
 					NativeModifierEnabled: false,
-					TypeParametersEnabled: true,
 				},
 			},
 		)
 
-		errs := RequireCheckerErrors(t, err, 1)
-
-		assert.IsType(t, &sema.InvalidTypeParameterizedNonNativeFunctionError{}, errs[0])
+		require.NoError(t, err)
 	})
 
 	t.Run("global, native", func(t *testing.T) {
@@ -958,7 +955,6 @@ func TestCheckGenericFunctionDeclaration(t *testing.T) {
 				},
 				ParseOptions: parser.Config{
 					NativeModifierEnabled: true,
-					TypeParametersEnabled: true,
 				},
 			},
 		)
@@ -983,14 +979,11 @@ func TestCheckGenericFunctionDeclaration(t *testing.T) {
 				},
 				ParseOptions: parser.Config{
 					NativeModifierEnabled: false,
-					TypeParametersEnabled: true,
 				},
 			},
 		)
 
-		errs := RequireCheckerErrors(t, err, 1)
-
-		assert.IsType(t, &sema.InvalidTypeParameterizedNonNativeFunctionError{}, errs[0])
+		require.NoError(t, err)
 	})
 
 	t.Run("composite function, non-native", func(t *testing.T) {
@@ -1010,7 +1003,6 @@ func TestCheckGenericFunctionDeclaration(t *testing.T) {
 				},
 				ParseOptions: parser.Config{
 					NativeModifierEnabled: true,
-					TypeParametersEnabled: true,
 				},
 			},
 		)
@@ -1034,7 +1026,6 @@ func TestCheckGenericFunctionDeclaration(t *testing.T) {
 				},
 				ParseOptions: parser.Config{
 					NativeModifierEnabled: true,
-					TypeParametersEnabled: true,
 				},
 			},
 		)
@@ -1060,7 +1051,6 @@ func TestCheckGenericFunctionDeclaration(t *testing.T) {
 				},
 				ParseOptions: parser.Config{
 					NativeModifierEnabled: true,
-					TypeParametersEnabled: true,
 				},
 			},
 		)
@@ -1084,7 +1074,6 @@ func TestCheckGenericFunctionDeclaration(t *testing.T) {
 				},
 				ParseOptions: parser.Config{
 					NativeModifierEnabled: true,
-					TypeParametersEnabled: true,
 				},
 			},
 		)
@@ -1110,7 +1099,6 @@ func TestCheckGenericFunctionDeclaration(t *testing.T) {
 				},
 				ParseOptions: parser.Config{
 					NativeModifierEnabled: true,
-					TypeParametersEnabled: true,
 				},
 			},
 		)
@@ -1120,3 +1108,208 @@ func TestCheckGenericFunctionDeclaration(t *testing.T) {
 		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
 	})
 }
+
+func TestCheckUserDefinedGenericFunction(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred from arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun identity<T>(_ value: T): T {
+              return value
+          }
+
+          let x = identity(1)
+          let y = identity("a")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "x"))
+		assert.Equal(t, sema.StringType, RequireGlobalValue(t, checker.Elaboration, "y"))
+	})
+
+	t.Run("explicit type arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun wrap<T>(_ value: T): [T] {
+              return [value]
+          }
+
+          let x = wrap<Int?>(1)
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: &sema.OptionalType{
+					Type: sema.IntType,
+				},
+			},
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("inferred from expected type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun empty<T>(): [T] {
+              return []
+          }
+
+          let x: [Int] = empty()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("not inferrable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun empty<T>(): [T] {
+              return []
+          }
+
+          let x = empty()
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvocationTypeInferenceError{}, errs[0])
+		assert.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[1])
+	})
+
+	t.Run("bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface Named {
+              fun name(): String
+          }
+
+          struct S: Named {
+              fun name(): String { return "S" }
+          }
+
+          fun names<T: {Named}>(_ values: [T]): [String] {
+              let names: [String] = []
+              for value in values {
+                  names.append(value.name())
+              }
+              return names
+          }
+
+          let x = names([S()])
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("bound, invalid argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface Named {}
+
+          fun test<T: {Named}>(_ value: T) {}
+
+          let x = test(1)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun move<T: @AnyResource>(_ value: @T): @T {
+              return <-value
+          }
+
+          fun test() {
+              let r <- move(<-create R())
+              destroy r
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource argument, default bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun identity<T>(_ value: T): T {
+              return value
+          }
+
+          fun test() {
+              let r <- identity(<-create R())
+              destroy r
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("function-typed parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun apply<T, U>(_ value: T, _ f: fun(T): U): U {
+              return f(value)
+          }
+
+          let x: String = apply(1, fun (x: Int): String { return x.toString() })
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("type parameter in body", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T>(_ value: AnyStruct): T? {
+              let type: Type = Type<T>()
+              let values: [T] = []
+              return value as? T
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("type parameter not usable as value of other type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test<T>(_ value: T): Int {
+              return value
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
@@ -19,7 +19,9 @@
 package sema_test
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -277,6 +279,191 @@ func TestCheckImportResolutionSplit(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCheckImportCodeHash(t *testing.T) {
+
+	t.Parallel()
+
+	importedAddress := common.MustBytesToAddress([]byte{0x1})
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          access(all) let x = 1
+          access(all) let y = 2
+        `,
+		ParseAndCheckOptions{
+			Location: common.AddressLocation{
+				Address: importedAddress,
+				Name:    "x",
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	codeHash := strings.Repeat("ab", ast.ImportCodeHashLength)
+
+	resolveLocation := func(identifiers []ast.Identifier, _ common.Location) (result []sema.ResolvedLocation, err error) {
+		for _, identifier := range identifiers {
+			result = append(result, sema.ResolvedLocation{
+				Location: common.AddressLocation{
+					Address: importedAddress,
+					Name:    identifier.Identifier,
+				},
+				Identifiers: []ast.Identifier{
+					identifier,
+				},
+			})
+		}
+		return
+	}
+
+	t.Run("single location", func(t *testing.T) {
+
+		t.Parallel()
+
+		var importCodeHash []byte
+
+		_, err := ParseAndCheckWithOptions(t,
+			fmt.Sprintf(
+				`
+                  import x from 0x1 hash "%s"
+                `,
+				codeHash,
+			),
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					LocationHandler: resolveLocation,
+					ImportHandler: func(checker *sema.Checker, importedLocation common.Location, _ ast.Range) (sema.Import, error) {
+						importCodeHash = checker.Elaboration.ImportCodeHash(importedLocation)
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			bytes.Repeat([]byte{0xab}, ast.ImportCodeHashLength),
+			importCodeHash,
+		)
+	})
+
+	t.Run("multiple locations", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithOptions(t,
+			fmt.Sprintf(
+				`
+                  import x, y from 0x1 hash "%s"
+                `,
+				codeHash,
+			),
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					LocationHandler: resolveLocation,
+					ImportHandler: func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				},
+			},
+		)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousImportCodeHashError{}, errs[0])
+	})
+
+	// All identifiers are declared by the same program
+
+	resolveSingleLocation := func(identifiers []ast.Identifier, _ common.Location) ([]sema.ResolvedLocation, error) {
+		return []sema.ResolvedLocation{
+			{
+				Location: common.AddressLocation{
+					Address: importedAddress,
+					Name:    "x",
+				},
+				Identifiers: identifiers,
+			},
+		}, nil
+	}
+
+	checkImports := func(t *testing.T, code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					LocationHandler: resolveSingleLocation,
+					ImportHandler: func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("same hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := checkImports(t,
+			fmt.Sprintf(
+				`
+                  import x from 0x1 hash "%[1]s"
+                  import y from 0x1 hash "%[1]s"
+                `,
+				codeHash,
+			),
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("different hashes", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := checkImports(t,
+			fmt.Sprintf(
+				`
+                  import x from 0x1 hash "%s"
+                  import y from 0x1 hash "%s"
+                `,
+				codeHash,
+				strings.Repeat("cd", ast.ImportCodeHashLength),
+			),
+		)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConflictingImportCodeHashError{}, errs[0])
+	})
+
+	t.Run("pinned and not pinned", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := checkImports(t,
+			fmt.Sprintf(
+				`
+                  import x from 0x1
+                  import y from 0x1 hash "%s"
+                `,
+				codeHash,
+			),
+		)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConflictingImportCodeHashError{}, errs[0])
+	})
+}
+
 func TestCheckImportAll(t *testing.T) {
 
 	t.Parallel()
@@ -26,6 +26,7 @@ import (
 
 type StringTypeOrderedMap = orderedmap.OrderedMap[string, Type]
 type StringMemberOrderedMap = orderedmap.OrderedMap[string, *Member]
+type StringTypeAliasOrderedMap = orderedmap.OrderedMap[string, *TypeAlias]
 type StringVariableOrderedMap = orderedmap.OrderedMap[string, *Variable]
 type TypeParameterTypeOrderedMap = orderedmap.OrderedMap[*TypeParameter, Type]
 type StringImportElementOrderedMap = orderedmap.OrderedMap[string, ImportElement]
@@ -0,0 +1,385 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+)
+
+// Functions and initializers of composites may be overloaded by argument labels.
+//
+// The first declaration of an overload set is declared under its identifier,
+// all other overloads are declared under their overload name (see OverloadName).
+// Overload names are not valid identifiers, so overloads can only be referred to
+// by invoking the overloaded function or constructor with the overload's argument labels.
+
+// InitializerOverloadNamePrefix is the identifier used to build
+// the overload names of overloaded initializers
+var InitializerOverloadNamePrefix = common.DeclarationKindInitializer.Keywords()
+
+// supportsInitializerOverloading returns true if composites of the given kind
+// may declare overloaded initializers.
+//
+// Contract initializers are invoked by the host environment when the contract is deployed,
+// and event initializers are synthesized from the event's parameters,
+// so they cannot be overloaded.
+func supportsInitializerOverloading(kind common.CompositeKind) bool {
+	switch kind {
+	case common.CompositeKindStructure,
+		common.CompositeKindResource,
+		common.CompositeKindAttachment:
+		return true
+	}
+	return false
+}
+
+// OverloadName returns the name under which an overload with the given identifier
+// and argument labels is declared, e.g. `foo(a:_:)`
+func OverloadName(identifier string, argumentLabels []string) string {
+	var builder strings.Builder
+	builder.WriteString(identifier)
+	builder.WriteByte('(')
+	for _, argumentLabel := range argumentLabels {
+		builder.WriteString(argumentLabel)
+		builder.WriteByte(':')
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// argumentLabelsEqual returns true if the given argument label lists are equal
+func argumentLabelsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, label := range a {
+		if b[i] != label {
+			return false
+		}
+	}
+	return true
+}
+
+// OverloadIdentifier returns the identifier of the given overload name,
+// e.g. `foo` for `foo(a:_:)`.
+// It returns false if the given name is not an overload name
+func OverloadIdentifier(name string) (string, bool) {
+	index := strings.IndexByte(name, '(')
+	if index < 0 {
+		return "", false
+	}
+	return name[:index], true
+}
+
+// argumentLabelsOverlap returns true if an invocation could match both of the given overloads,
+// when arguments for trailing parameters with default arguments are omitted.
+// This is the case if the overloads accept a common number of arguments,
+// and the argument labels for the smallest common number of arguments are equal
+func argumentLabelsOverlap(
+	labels []string,
+	requiredArgumentCount int,
+	otherLabels []string,
+	otherRequiredArgumentCount int,
+) bool {
+	minCount := max(requiredArgumentCount, otherRequiredArgumentCount)
+	maxCount := min(len(labels), len(otherLabels))
+	if minCount > maxCount {
+		return false
+	}
+	return argumentLabelsEqual(labels[:minCount], otherLabels[:minCount])
+}
+
+// MemberOverloadName returns the name by which the given accessed member is looked up at run-time,
+// if it differs from the member's identifier.
+//
+// A selected overload is looked up by its overload name.
+// A function of an interface may be overloaded in the composite implementing the interface,
+// so it is looked up by the overload name for the function's argument labels.
+// The run-time falls back to the identifier if the composite's function is not overloaded
+func MemberOverloadName(member *Member) string {
+	if member == nil {
+		return ""
+	}
+
+	if member.OverloadName != "" {
+		return member.OverloadName
+	}
+
+	if member.DeclarationKind == common.DeclarationKindFunction {
+		if _, ok := member.ContainerType.(*InterfaceType); ok {
+			return OverloadName(member.Identifier.Identifier, member.ArgumentLabels)
+		}
+	}
+
+	return ""
+}
+
+// checkOverloadAmbiguity reports if the overload with the given overload name and argument labels,
+// which is declared in the overload set of the given first overload,
+// could be invoked with the same arguments as a previously declared overload.
+// It returns true if the overload is ambiguous
+func (checker *Checker) checkOverloadAmbiguity(
+	overloadName string,
+	argumentLabels []string,
+	requiredArgumentCount int,
+	firstOverload *Member,
+	identifier ast.Identifier,
+) bool {
+	previousOverloads := make([]*Member, 0, len(firstOverload.Overloads)+1)
+	previousOverloads = append(previousOverloads, firstOverload)
+	previousOverloads = append(previousOverloads, firstOverload.Overloads...)
+
+	for _, previousOverload := range previousOverloads {
+		if !argumentLabelsOverlap(
+			argumentLabels,
+			requiredArgumentCount,
+			previousOverload.ArgumentLabels,
+			memberRequiredArgumentCount(previousOverload),
+		) {
+			continue
+		}
+
+		checker.report(
+			&AmbiguousOverloadDeclarationError{
+				Name: overloadName,
+				OtherName: OverloadName(
+					previousOverload.Identifier.Identifier,
+					previousOverload.ArgumentLabels,
+				),
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, identifier),
+			},
+		)
+		return true
+	}
+
+	return false
+}
+
+// argumentsMatchLabels returns true if the given invocation arguments
+// are labeled exactly as required by the given argument labels.
+// Arguments for trailing parameters with default arguments may be omitted,
+// i.e. at least the given required argument count of arguments must be provided
+func argumentsMatchLabels(arguments ast.Arguments, argumentLabels []string, requiredArgumentCount int) bool {
+	if len(arguments) < requiredArgumentCount ||
+		len(arguments) > len(argumentLabels) {
+
+		return false
+	}
+	for i, argument := range arguments {
+		argumentLabel := argumentLabels[i]
+		providedLabel := argument.Label
+		if argumentLabel == ArgumentLabelNotRequired {
+			if providedLabel != "" {
+				return false
+			}
+		} else if providedLabel != argumentLabel {
+			return false
+		}
+	}
+	return true
+}
+
+// memberRequiredArgumentCount returns the number of arguments
+// which must be provided when invoking the given function member
+func memberRequiredArgumentCount(member *Member) int {
+	functionType, ok := member.TypeAnnotation.Type.(*FunctionType)
+	if !ok {
+		return len(member.ArgumentLabels)
+	}
+	return RequiredArgumentCount(functionType.Parameters)
+}
+
+// argumentsOverloadName returns the overload name
+// for the labels of the given invocation arguments
+func argumentsOverloadName(identifier string, arguments ast.Arguments) string {
+	argumentLabels := make([]string, len(arguments))
+	for i, argument := range arguments {
+		label := argument.Label
+		if label == "" {
+			label = ArgumentLabelNotRequired
+		}
+		argumentLabels[i] = label
+	}
+	return OverloadName(identifier, argumentLabels)
+}
+
+// invokedOverloadArguments returns the arguments of the invocation
+// which invokes the given expression directly, if any.
+// Overloads are only selected for directly invoked expressions.
+func (checker *Checker) invokedOverloadArguments(expression ast.Expression) (ast.Arguments, bool) {
+	invocationExpression, ok := checker.parent.(*ast.InvocationExpression)
+	if !ok || invocationExpression.InvokedExpression != expression {
+		return nil, false
+	}
+	return invocationExpression.Arguments, true
+}
+
+// selectMemberOverload returns the member of the overload set of the given member
+// which matches the argument labels of the invocation of the given member expression.
+// An overloaded member which is not invoked is ambiguous.
+func (checker *Checker) selectMemberOverload(
+	expression *ast.MemberExpression,
+	member *Member,
+) (
+	selected *Member,
+	ok bool,
+) {
+	overloads := make([]*Member, 0, len(member.Overloads)+1)
+	overloads = append(overloads, member)
+	overloads = append(overloads, member.Overloads...)
+
+	arguments, ok := checker.invokedOverloadArguments(expression)
+	if !ok {
+		candidates := make([]string, 0, len(overloads))
+		for _, overload := range overloads {
+			candidates = append(
+				candidates,
+				OverloadName(overload.Identifier.Identifier, overload.ArgumentLabels),
+			)
+		}
+
+		checker.report(
+			&AmbiguousOverloadReferenceError{
+				Name:       member.Identifier.Identifier,
+				Candidates: candidates,
+				Range:      ast.NewRangeFromPositioned(checker.memoryGauge, expression.Identifier),
+			},
+		)
+
+		return member, false
+	}
+
+	var matches []*Member
+	for _, overload := range overloads {
+		if argumentsMatchLabels(
+			arguments,
+			overload.ArgumentLabels,
+			memberRequiredArgumentCount(overload),
+		) {
+			matches = append(matches, overload)
+		}
+	}
+
+	if len(matches) == 1 {
+		return matches[0], true
+	}
+
+	candidates := make([]string, 0, len(overloads))
+	for _, overload := range overloads {
+		candidates = append(
+			candidates,
+			OverloadName(overload.Identifier.Identifier, overload.ArgumentLabels),
+		)
+	}
+
+	checker.reportOverloadSelectionError(
+		len(matches),
+		argumentsOverloadName(member.Identifier.Identifier, arguments),
+		candidates,
+		ast.NewRangeFromPositioned(checker.memoryGauge, expression.Identifier),
+	)
+
+	return member, false
+}
+
+// selectConstructorOverload returns the function type of the overload of the given
+// overloaded constructor type which matches the argument labels of the given invocation,
+// and the overload name of the selected overload.
+// The overload name is empty if the first overload is selected.
+func (checker *Checker) selectConstructorOverload(
+	invocationExpression *ast.InvocationExpression,
+	constructorType *FunctionType,
+) (
+	selected *FunctionType,
+	overloadName string,
+	ok bool,
+) {
+	arguments := invocationExpression.Arguments
+
+	overloads := make([]*FunctionType, 0, len(constructorType.Overloads)+1)
+	overloads = append(overloads, constructorType)
+	overloads = append(overloads, constructorType.Overloads...)
+
+	var matches []int
+	for i, overload := range overloads {
+		if argumentsMatchLabels(
+			arguments,
+			overload.ArgumentLabels(),
+			RequiredArgumentCount(overload.Parameters),
+		) {
+			matches = append(matches, i)
+		}
+	}
+
+	if len(matches) == 1 {
+		index := matches[0]
+		if index == 0 {
+			return constructorType, "", true
+		}
+		overload := overloads[index]
+		return overload, OverloadName(InitializerOverloadNamePrefix, overload.ArgumentLabels()), true
+	}
+
+	candidates := make([]string, 0, len(overloads))
+	for _, overload := range overloads {
+		candidates = append(
+			candidates,
+			OverloadName(InitializerOverloadNamePrefix, overload.ArgumentLabels()),
+		)
+	}
+
+	checker.reportOverloadSelectionError(
+		len(matches),
+		argumentsOverloadName(InitializerOverloadNamePrefix, arguments),
+		candidates,
+		ast.NewRangeFromPositioned(checker.memoryGauge, invocationExpression.InvokedExpression),
+	)
+
+	return nil, "", false
+}
+
+// reportOverloadSelectionError reports that no overload, or that multiple overloads,
+// match the argument labels of an invocation
+func (checker *Checker) reportOverloadSelectionError(
+	matchCount int,
+	name string,
+	candidates []string,
+	errorRange ast.Range,
+) {
+	if matchCount == 0 {
+		checker.report(
+			&NoMatchingOverloadError{
+				Name:       name,
+				Candidates: candidates,
+				Range:      errorRange,
+			},
+		)
+	} else {
+		checker.report(
+			&AmbiguousOverloadError{
+				Name:       name,
+				Candidates: candidates,
+				Range:      errorRange,
+			},
+		)
+	}
+}
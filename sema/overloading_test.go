@@ -23,13 +23,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/sema"
 	. "github.com/onflow/cadence/test_utils/sema_utils"
 )
 
-func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
+func TestCheckCompositeInitializerOverloading(t *testing.T) {
 
 	t.Parallel()
 
@@ -77,6 +78,17 @@ func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
 					),
 				)
 
+				switch kind {
+				case common.CompositeKindStructure,
+					common.CompositeKindResource,
+					common.CompositeKindAttachment:
+
+					if !isInterface {
+						require.NoError(t, err)
+						return
+					}
+				}
+
 				errs := RequireCheckerErrors(t, err, 1)
 
 				assert.IsType(t, &sema.RedeclarationError{}, errs[0])
@@ -84,3 +96,288 @@ func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckCompositeFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              fun foo(a: Int): Int { return a }
+              fun foo(b: Int): String { return "b" }
+              fun foo(_ x: Int, _ y: Int): Bool { return true }
+          }
+
+          let s = S()
+          let a = s.foo(a: 1)
+          let b = s.foo(b: 2)
+          let c = s.foo(1, 2)
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "a"))
+		assert.Equal(t, sema.StringType, RequireGlobalValue(t, checker.Elaboration, "b"))
+		assert.Equal(t, sema.BoolType, RequireGlobalValue(t, checker.Elaboration, "c"))
+	})
+
+	t.Run("duplicate argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun foo(a: Int) {}
+              fun foo(a: String) {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("no matching overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun foo(a: Int) {}
+              fun foo(b: Int) {}
+          }
+
+          fun test() {
+              S().foo(c: 1)
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var noMatchingOverloadErr *sema.NoMatchingOverloadError
+		require.ErrorAs(t, errs[0], &noMatchingOverloadErr)
+		assert.Equal(t, "foo(c:)", noMatchingOverloadErr.Name)
+		assert.Equal(t,
+			[]string{"foo(a:)", "foo(b:)"},
+			noMatchingOverloadErr.Candidates,
+		)
+	})
+
+	t.Run("not invoked", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun foo(a: Int) {}
+              fun foo(b: Int) {}
+          }
+
+          let s = S()
+          let f = s.foo
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var ambiguousErr *sema.AmbiguousOverloadReferenceError
+		require.ErrorAs(t, errs[0], &ambiguousErr)
+		assert.Equal(t, "foo", ambiguousErr.Name)
+		assert.Equal(t,
+			[]string{"foo(a:)", "foo(b:)"},
+			ambiguousErr.Candidates,
+		)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun foo(a: Int)
+              fun foo(b: Int)
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("conformance", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun foo(b: Int): Int
+          }
+
+          struct S: I {
+              fun foo(a: Int): Int { return a }
+              fun foo(b: Int): Int { return b }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("conformance, missing overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun foo(c: Int): Int
+          }
+
+          struct S: I {
+              fun foo(a: Int): Int { return a }
+              fun foo(b: Int): Int { return b }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+}
+
+func TestCheckCompositeInitializerOverloadInvocation(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+
+              init() {
+                  self.x = 0
+              }
+          }
+
+          fun test() {
+              let r1 <- create R(x: 1)
+              let r2 <- create R()
+              destroy r1
+              destroy r2
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("selection", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+
+              init(_ a: Int, _ b: Int) {
+                  self.x = a + b
+              }
+          }
+
+          let s1 = S(x: 1)
+          let s2 = S(1, 2)
+        `)
+		require.NoError(t, err)
+
+		sType := RequireGlobalType(t, checker.Elaboration, "S")
+		assert.Equal(t, sType, RequireGlobalValue(t, checker.Elaboration, "s1"))
+		assert.Equal(t, sType, RequireGlobalValue(t, checker.Elaboration, "s2"))
+	})
+
+	t.Run("duplicate argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              init(a: Int) {}
+              init(a: String) {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("no matching overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              init(a: Int) {}
+              init(b: Int) {}
+          }
+
+          let s = S(c: 1)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var noMatchingOverloadErr *sema.NoMatchingOverloadError
+		require.ErrorAs(t, errs[0], &noMatchingOverloadErr)
+		assert.Equal(t, "init(c:)", noMatchingOverloadErr.Name)
+		assert.Equal(t,
+			[]string{"init(a:)", "init(b:)"},
+			noMatchingOverloadErr.Candidates,
+		)
+	})
+
+	t.Run("each overload must initialize all fields", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+
+              init() {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.FieldUninitializedError{}, errs[0])
+	})
+
+	t.Run("conformance", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              init(b: Int)
+          }
+
+          struct S: I {
+              init(a: Int) {}
+              init(b: Int) {}
+          }
+        `)
+		require.NoError(t, err)
+	})
+}
@@ -129,6 +129,18 @@ func (ri *ReturnInfo) AddJumpOffset(offset int) {
 	ri.JumpOffsets.Add(offset)
 }
 
+// AddJumpOffsetToTarget adds the given jump offset to the current jump target,
+// and to all enclosing jump targets, up to and including the given target.
+// It is used for labeled jumps, which may leave several loops at once
+func (ri *ReturnInfo) AddJumpOffsetToTarget(offset int, target *persistent.OrderedSet[int]) {
+	for jumpOffsets := ri.JumpOffsets; jumpOffsets != nil; jumpOffsets = jumpOffsets.Parent {
+		jumpOffsets.Add(offset)
+		if jumpOffsets == target {
+			return
+		}
+	}
+}
+
 func (ri *ReturnInfo) WithNewJumpTarget(f func()) {
 	ri.JumpOffsets = ri.JumpOffsets.Clone()
 	f()
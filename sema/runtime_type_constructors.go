@@ -197,6 +197,20 @@ var InclusiveRangeTypeFunctionType = NewSimpleFunctionType(
 	OptionalMetaTypeAnnotation,
 )
 
+const SetTypeFunctionName = "SetType"
+
+var SetTypeFunctionType = NewSimpleFunctionType(
+	FunctionPurityView,
+	[]Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "type",
+			TypeAnnotation: MetaTypeAnnotation,
+		},
+	},
+	OptionalMetaTypeAnnotation,
+)
+
 var runtimeTypeConstructors = []*RuntimeTypeConstructor{
 	{
 		Name:      OptionalTypeFunctionName,
@@ -268,4 +282,11 @@ var runtimeTypeConstructors = []*RuntimeTypeConstructor{
 		DocString: `Creates a run-time type representing an inclusive range type of the given run-time member type. 
 		Returns nil if the member type is not a valid inclusive range member type.`,
 	},
+
+	{
+		Name:  SetTypeFunctionName,
+		Value: SetTypeFunctionType,
+		DocString: `Creates a run-time type representing a set type of the given run-time element type.
+		Returns nil if the element type is not a valid set element type.`,
+	},
 }
@@ -0,0 +1,307 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/stdlib"
+	. "github.com/onflow/cadence/test_utils/sema_utils"
+)
+
+func parseAndCheckWithSet(t *testing.T, code string) (*sema.Checker, error) {
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.SetConstructorFunction)
+
+	return ParseAndCheckWithOptions(t,
+		code,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				BaseValueActivationHandler: func(common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+		},
+	)
+}
+
+func TestCheckSet(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("construction", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckWithSet(t, `
+          let s1 = Set<Int>()
+          let s2 = Set([1, 2, 3])
+          let s3: Set<String> = Set(["a", "b"])
+        `)
+		require.NoError(t, err)
+
+		intSetType := &sema.SetType{
+			ElementType: sema.IntType,
+		}
+
+		assert.Equal(t, intSetType, RequireGlobalValue(t, checker.Elaboration, "s1"))
+		assert.Equal(t, intSetType, RequireGlobalValue(t, checker.Elaboration, "s2"))
+		assert.Equal(t,
+			&sema.SetType{
+				ElementType: sema.StringType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "s3"),
+		)
+	})
+
+	t.Run("non-hashable element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          let s = Set([[1], [2]])
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("non-hashable element type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          let s: Set<[Int]>? = nil
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          let s: Set = Set([1])
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingTypeArgumentError{}, errs[0])
+	})
+
+	t.Run("members", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckWithSet(t, `
+          fun test(): [AnyStruct] {
+              let s = Set([1, 2, 3])
+              let other = Set([3, 4])
+              let inserted = s.insert(4)
+              let removed = s.remove(1)
+              s.forEach(fun (element: Int): Bool {
+                  return true
+              })
+              return [
+                  inserted,
+                  removed,
+                  s.contains(2),
+                  s.length,
+                  s.union(other),
+                  s.intersection(other),
+                  s.difference(other)
+              ]
+          }
+
+          let s = Set([1])
+          let contains = s.contains(1)
+          let length = s.length
+          let union = s.union(Set([2]))
+          let intersection = s.intersection(Set([2]))
+          let difference = s.difference(Set([2]))
+        `)
+		require.NoError(t, err)
+
+		intSetType := &sema.SetType{
+			ElementType: sema.IntType,
+		}
+
+		assert.Equal(t, sema.BoolType, RequireGlobalValue(t, checker.Elaboration, "contains"))
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "length"))
+		assert.Equal(t, intSetType, RequireGlobalValue(t, checker.Elaboration, "union"))
+		assert.Equal(t, intSetType, RequireGlobalValue(t, checker.Elaboration, "intersection"))
+		assert.Equal(t, intSetType, RequireGlobalValue(t, checker.Elaboration, "difference"))
+	})
+
+	t.Run("insert, invalid element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          fun test() {
+              let s = Set([1])
+              s.insert("a")
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("union, invalid element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          let s = Set([1]).union(Set(["a"]))
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("subtyping", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          let s1: Set<Int> = Set([1])
+          let s2: Set<Int>? = s1
+          let s3: AnyStruct = s1
+          let s4: {HashableStruct: Bool} = {}
+        `)
+		require.NoError(t, err)
+
+		_, err = parseAndCheckWithSet(t, `
+          let s1: Set<Int> = Set([1])
+          let s2: Set<Int8> = s1
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckSetReferenceEntitlements(t *testing.T) {
+
+	t.Parallel()
+
+	for _, member := range []string{"insert", "remove"} {
+
+		t.Run(member, func(t *testing.T) {
+
+			t.Parallel()
+
+			t.Run("unauthorized", func(t *testing.T) {
+
+				t.Parallel()
+
+				_, err := parseAndCheckWithSet(t, `
+                  fun test() {
+                      let s = Set([1])
+                      let ref = &s as &Set<Int>
+                      ref.`+member+`(2)
+                  }
+                `)
+
+				errs := RequireCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.InvalidAccessError{}, errs[0])
+			})
+
+			t.Run("authorized", func(t *testing.T) {
+
+				t.Parallel()
+
+				_, err := parseAndCheckWithSet(t, `
+                  fun test() {
+                      let s = Set([1])
+                      let ref = &s as auth(Mutate) &Set<Int>
+                      ref.`+member+`(2)
+                  }
+                `)
+				require.NoError(t, err)
+			})
+		})
+	}
+
+	t.Run("read-only members", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          fun test() {
+              let s = Set([1])
+              let ref = &s as &Set<Int>
+              ref.contains(1)
+              ref.length
+              ref.union(Set([2]))
+          }
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckSetShadowing(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckWithSet(t, `
+          struct Set {}
+
+          let s = Set()
+        `)
+		require.NoError(t, err)
+
+		sType := RequireGlobalValue(t, checker.Elaboration, "s")
+		require.IsType(t, &sema.CompositeType{}, sType)
+	})
+
+	t.Run("nested resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          contract C {
+              resource Set {}
+
+              fun createSet(): @Set {
+                  return <- create Set()
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+}
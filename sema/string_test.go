@@ -699,6 +699,199 @@ func TestCheckStringCount(t *testing.T) {
 	})
 }
 
+func TestCheckStringToUpper(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let x = "Abc".toUpper()
+	`)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.StringType,
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+}
+
+func TestCheckStringTrim(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+		  let a = "  abcdef  "
+		  let x: String = a.trim()
+		  let y: String = a.trimPrefix("  ab")
+		  let z: String = a.trimSuffix("ef  ")
+		`)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong argument type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+		  let a = "abcdef"
+		  let x = a.trimPrefix(1)
+		  let y = a.trimSuffix(1)
+		`)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("excessive arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+		  let a = "abcdef"
+		  let x = a.trim(" ")
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ExcessiveArgumentsError{}, errs[0])
+	})
+}
+
+func TestCheckStringStartsWithEndsWith(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+		  let a = "abcdef"
+		  let x: Bool = a.startsWith("ab")
+		  let y: Bool = a.endsWith("ef")
+		`)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong argument type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+		  let a = "abcdef"
+		  let x = a.startsWith(1)
+		  let y = a.endsWith(1)
+		`)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+}
+
+func TestCheckStringPad(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+		  let a = "42"
+		  let x: String = a.padLeft(toLength: 5, with: "0")
+		  let y: String = a.padRight(toLength: 5, with: " ")
+		`)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("string padding", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+		  let a = "42"
+		  let b = "00"
+		  let x = a.padLeft(toLength: 5, with: b)
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing argument label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+		  let a = "42"
+		  let x = a.padRight(5, with: "0")
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+}
+
+func TestCheckStringLastIndex(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+		  let a = "abcabc"
+		  let x: Int = a.lastIndex(of: "bc")
+		`)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing argument label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+		  let a = "abcabc"
+		  let x: Int = a.lastIndex("bc")
+		`)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+}
+
+func TestCheckStringCharactersField(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let x = "abc".characters
+	`)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		sema.CharacterArrayType,
+		RequireGlobalValue(t, checker.Elaboration, "x"),
+	)
+}
+
 func TestCheckStringTemplate(t *testing.T) {
 
 	t.Parallel()
@@ -219,6 +219,66 @@ func init() {
 				StringTypeCountFunctionType,
 				stringTypeCountFunctionDocString,
 			),
+			NewUnmeteredPublicFunctionMember(
+				t,
+				StringTypeLastIndexFunctionName,
+				StringTypeLastIndexFunctionType,
+				stringTypeLastIndexFunctionDocString,
+			),
+			NewUnmeteredPublicFunctionMember(
+				t,
+				StringTypeToUpperFunctionName,
+				StringTypeToUpperFunctionType,
+				stringTypeToUpperFunctionDocString,
+			),
+			NewUnmeteredPublicFunctionMember(
+				t,
+				StringTypeTrimFunctionName,
+				StringTypeTrimFunctionType,
+				stringTypeTrimFunctionDocString,
+			),
+			NewUnmeteredPublicFunctionMember(
+				t,
+				StringTypeTrimPrefixFunctionName,
+				StringTypeTrimPrefixFunctionType,
+				stringTypeTrimPrefixFunctionDocString,
+			),
+			NewUnmeteredPublicFunctionMember(
+				t,
+				StringTypeTrimSuffixFunctionName,
+				StringTypeTrimSuffixFunctionType,
+				stringTypeTrimSuffixFunctionDocString,
+			),
+			NewUnmeteredPublicFunctionMember(
+				t,
+				StringTypeStartsWithFunctionName,
+				StringTypeStartsWithFunctionType,
+				stringTypeStartsWithFunctionDocString,
+			),
+			NewUnmeteredPublicFunctionMember(
+				t,
+				StringTypeEndsWithFunctionName,
+				StringTypeEndsWithFunctionType,
+				stringTypeEndsWithFunctionDocString,
+			),
+			NewUnmeteredPublicFunctionMember(
+				t,
+				StringTypePadLeftFunctionName,
+				StringTypePadFunctionType,
+				stringTypePadLeftFunctionDocString,
+			),
+			NewUnmeteredPublicFunctionMember(
+				t,
+				StringTypePadRightFunctionName,
+				StringTypePadFunctionType,
+				stringTypePadRightFunctionDocString,
+			),
+			NewUnmeteredPublicConstantFieldMember(
+				t,
+				StringTypeCharactersFieldName,
+				CharacterArrayType,
+				stringTypeCharactersFieldDocString,
+			),
 		})
 	}
 }
@@ -324,6 +384,164 @@ Returns the number of non-overlapping instances of the given substring in this s
 If the given substring is an empty string, the function returns 1 + the number of characters in this string.
 `
 
+var StringTypeLastIndexFunctionType = NewSimpleFunctionType(
+	FunctionPurityView,
+	[]Parameter{
+		{
+			Label:          "of",
+			Identifier:     "other",
+			TypeAnnotation: StringTypeAnnotation,
+		},
+	},
+	IntTypeAnnotation,
+)
+
+const StringTypeLastIndexFunctionName = "lastIndex"
+
+const stringTypeLastIndexFunctionDocString = `
+Returns the index within this string of the last occurrence of the given substring.
+
+If the substring is not found, the function returns -1.
+If the given substring is an empty string, the function returns the length of this string.
+`
+
+var StringTypeToUpperFunctionType = NewSimpleFunctionType(
+	FunctionPurityView,
+	nil,
+	StringTypeAnnotation,
+)
+
+const StringTypeToUpperFunctionName = "toUpper"
+
+const stringTypeToUpperFunctionDocString = `
+Returns the string with lower case letters replaced with uppercase
+`
+
+var StringTypeTrimFunctionType = NewSimpleFunctionType(
+	FunctionPurityView,
+	nil,
+	StringTypeAnnotation,
+)
+
+const StringTypeTrimFunctionName = "trim"
+
+const stringTypeTrimFunctionDocString = `
+Returns a new string with all leading and trailing whitespace characters removed.
+`
+
+var StringTypeTrimPrefixFunctionType = NewSimpleFunctionType(
+	FunctionPurityView,
+	[]Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "prefix",
+			TypeAnnotation: StringTypeAnnotation,
+		},
+	},
+	StringTypeAnnotation,
+)
+
+const StringTypeTrimPrefixFunctionName = "trimPrefix"
+
+const stringTypeTrimPrefixFunctionDocString = `
+Returns a new string with the given prefix removed.
+
+If the string does not start with the prefix, the string is returned unchanged.
+`
+
+var StringTypeTrimSuffixFunctionType = NewSimpleFunctionType(
+	FunctionPurityView,
+	[]Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "suffix",
+			TypeAnnotation: StringTypeAnnotation,
+		},
+	},
+	StringTypeAnnotation,
+)
+
+const StringTypeTrimSuffixFunctionName = "trimSuffix"
+
+const stringTypeTrimSuffixFunctionDocString = `
+Returns a new string with the given suffix removed.
+
+If the string does not end with the suffix, the string is returned unchanged.
+`
+
+var StringTypeStartsWithFunctionType = NewSimpleFunctionType(
+	FunctionPurityView,
+	[]Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "prefix",
+			TypeAnnotation: StringTypeAnnotation,
+		},
+	},
+	BoolTypeAnnotation,
+)
+
+const StringTypeStartsWithFunctionName = "startsWith"
+
+const stringTypeStartsWithFunctionDocString = `
+Returns true if this string starts with the given prefix.
+
+The prefix must end at a character boundary of this string.
+`
+
+var StringTypeEndsWithFunctionType = NewSimpleFunctionType(
+	FunctionPurityView,
+	[]Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "suffix",
+			TypeAnnotation: StringTypeAnnotation,
+		},
+	},
+	BoolTypeAnnotation,
+)
+
+const StringTypeEndsWithFunctionName = "endsWith"
+
+const stringTypeEndsWithFunctionDocString = `
+Returns true if this string ends with the given suffix.
+
+The suffix must start at a character boundary of this string.
+`
+
+var StringTypePadFunctionType = NewSimpleFunctionType(
+	FunctionPurityView,
+	[]Parameter{
+		{
+			Label:          "toLength",
+			Identifier:     "length",
+			TypeAnnotation: IntTypeAnnotation,
+		},
+		{
+			Label:          "with",
+			Identifier:     "character",
+			TypeAnnotation: CharacterTypeAnnotation,
+		},
+	},
+	StringTypeAnnotation,
+)
+
+const StringTypePadLeftFunctionName = "padLeft"
+
+const stringTypePadLeftFunctionDocString = `
+Returns a new string of the given length, with the given character repeated at the start of the string.
+
+If the string is already at least as long as the given length, the string is returned unchanged.
+`
+
+const StringTypePadRightFunctionName = "padRight"
+
+const stringTypePadRightFunctionDocString = `
+Returns a new string of the given length, with the given character repeated at the end of the string.
+
+If the string is already at least as long as the given length, the string is returned unchanged.
+`
+
 var StringTypeReplaceAllFunctionType = NewSimpleFunctionType(
 	FunctionPurityView,
 	[]Parameter{
@@ -389,6 +607,17 @@ const stringTypeUtf8FieldDocString = `
 The byte array of the UTF-8 encoding
 `
 
+// CharacterArrayType represents the type [Character]
+var CharacterArrayType = &VariableSizedType{
+	Type: CharacterType,
+}
+
+const StringTypeCharactersFieldName = "characters"
+
+const stringTypeCharactersFieldDocString = `
+The array of characters of the string
+`
+
 var StringTypeToLowerFunctionType = NewSimpleFunctionType(
 	FunctionPurityView,
 	nil,
@@ -610,3 +610,278 @@ func TestCheckSwitchResourceInvalidation(t *testing.T) {
 		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
 	})
 }
+
+func TestCheckSwitchStatementEnumExhaustiveness(t *testing.T) {
+
+	t.Parallel()
+
+	parseAndCheck := func(t *testing.T, code string) (*sema.Checker, error) {
+		return ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					EnumSwitchExhaustivenessCheckEnabled: true,
+				},
+			},
+		)
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum Status: UInt8 {
+              case pending
+              case active
+          }
+
+          fun test(_ status: Status): Int {
+              switch status {
+              case Status.active:
+                  return 2
+              }
+              return 0
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("all cases", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          enum Status: UInt8 {
+              case pending
+              case active
+              case closed
+          }
+
+          fun test(_ status: Status): Int {
+              switch status {
+              case Status.pending:
+                  return 1
+              case Status.active:
+                  return 2
+              case Status.closed:
+                  return 3
+              }
+              return 0
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("default case", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          enum Status: UInt8 {
+              case pending
+              case active
+              case closed
+          }
+
+          fun test(_ status: Status): Int {
+              switch status {
+              case Status.pending:
+                  return 1
+              default:
+                  return 2
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing cases", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          enum Status: UInt8 {
+              case pending
+              case active
+              case closed
+          }
+
+          fun test(_ status: Status): Int {
+              switch status {
+              case Status.active:
+                  return 2
+              }
+              return 0
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var missingCasesErr *sema.MissingSwitchCasesError
+		require.ErrorAs(t, errs[0], &missingCasesErr)
+
+		assert.Equal(t, []string{"pending", "closed"}, missingCasesErr.MissingCases)
+		assert.Equal(t,
+			"missing cases: `Status.pending`, `Status.closed`; add the missing cases or a `default` case",
+			missingCasesErr.SecondaryError(),
+		)
+	})
+
+	t.Run("duplicate case", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          enum Status: UInt8 {
+              case pending
+              case active
+          }
+
+          fun test(_ status: Status) {
+              switch status {
+              case Status.active:
+                  return
+              case Status.active:
+                  return
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var missingCasesErr *sema.MissingSwitchCasesError
+		require.ErrorAs(t, errs[0], &missingCasesErr)
+
+		assert.Equal(t, []string{"pending"}, missingCasesErr.MissingCases)
+	})
+
+	t.Run("case via variable", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          enum Status: UInt8 {
+              case pending
+              case active
+          }
+
+          let active = Status.active
+
+          fun test(_ status: Status) {
+              switch status {
+              case Status.pending:
+                  return
+              case active:
+                  return
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var missingCasesErr *sema.MissingSwitchCasesError
+		require.ErrorAs(t, errs[0], &missingCasesErr)
+
+		assert.Equal(t, []string{"active"}, missingCasesErr.MissingCases)
+	})
+
+	t.Run("nested enum", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          contract C {
+              enum E: UInt8 {
+                  case a
+                  case b
+              }
+          }
+
+          fun test(_ e: C.E) {
+              switch e {
+              case C.E.a:
+                  return
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var missingCasesErr *sema.MissingSwitchCasesError
+		require.ErrorAs(t, errs[0], &missingCasesErr)
+
+		assert.Equal(t,
+			"missing cases: `C.E.b`; add the missing cases or a `default` case",
+			missingCasesErr.SecondaryError(),
+		)
+	})
+
+	t.Run("optional enum", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheck(t, `
+          enum Status: UInt8 {
+              case pending
+              case active
+          }
+
+          fun test(_ status: Status?) {
+              switch status {
+              case Status.pending:
+                  return
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("suggested fix", func(t *testing.T) {
+		t.Parallel()
+
+		const code = `
+          enum Status: UInt8 {
+              case pending
+              case active
+              case closed
+          }
+
+          fun test(_ status: Status) {
+              switch status {
+              case Status.active:
+                  return
+              }
+          }
+        `
+
+		_, err := parseAndCheck(t, code)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var missingCasesErr *sema.MissingSwitchCasesError
+		require.ErrorAs(t, errs[0], &missingCasesErr)
+
+		fixes := missingCasesErr.SuggestFixes(code)
+		require.Len(t, fixes, 1)
+		require.Len(t, fixes[0].TextEdits, 1)
+
+		edit := fixes[0].TextEdits[0]
+		offset := edit.StartPos.Offset
+		fixedCode := code[:offset] + edit.Insertion + code[offset:]
+
+		assert.Equal(t,
+			`
+          enum Status: UInt8 {
+              case pending
+              case active
+              case closed
+          }
+
+          fun test(_ status: Status) {
+              switch status {
+              case Status.active:
+                  return
+              case Status.pending:
+                  break
+              case Status.closed:
+                  break
+              }
+          }
+        `,
+			fixedCode,
+		)
+	})
+}
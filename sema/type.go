@@ -953,8 +953,12 @@ func (t *GenericType) Equal(other Type) bool {
 	return t.TypeParameter == otherType.TypeParameter
 }
 
-func (*GenericType) IsResourceType() bool {
-	return false
+// The properties of a generic type are the properties of its type bound, if any:
+// The generic type may only be instantiated with subtypes of the type bound.
+
+func (t *GenericType) IsResourceType() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsResourceType()
 }
 
 func (*GenericType) IsPrimitiveType() bool {
@@ -969,28 +973,34 @@ func (*GenericType) IsOrContainsReferenceType() bool {
 	return false
 }
 
-func (*GenericType) IsStorable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsStorable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsStorable(results)
 }
 
-func (*GenericType) IsExportable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsExportable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsExportable(results)
 }
 
-func (t *GenericType) IsImportable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsImportable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsImportable(results)
 }
 
-func (*GenericType) IsEquatable() bool {
-	return false
+func (t *GenericType) IsEquatable() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsEquatable()
 }
 
-func (*GenericType) IsComparable() bool {
-	return false
+func (t *GenericType) IsComparable() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.IsComparable()
 }
 
 func (t *GenericType) ContainFieldsOrElements() bool {
-	return false
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil && typeBound.ContainFieldsOrElements()
 }
 
 func (*GenericType) TypeAnnotationState() TypeAnnotationState {
@@ -1061,6 +1071,11 @@ func (t *GenericType) Map(_ common.MemoryGauge, typeParamMap map[*TypeParameter]
 }
 
 func (t *GenericType) GetMembers() map[string]MemberResolver {
+	// The members of a generic type are the members of its type bound, if any
+	typeBound := t.TypeParameter.TypeBound
+	if typeBound != nil {
+		return typeBound.GetMembers()
+	}
 	return withBuiltinMembers(t, nil)
 }
 
@@ -1902,6 +1917,35 @@ var UFix64Type = NewFixedPointNumericType(UFix64TypeName).
 	})
 var UFix64TypeAnnotation = NewTypeAnnotation(UFix64Type)
 
+// Fix128Type represents the 128-bit signed decimal fixed-point type `Fix128`
+// which has a scale of Fix128Scale, and checks for overflow and underflow
+var Fix128Type = NewFixedPointNumericType(Fix128TypeName).
+	WithTag(Fix128TypeTag).
+	WithIntRange(Fix128TypeMinIntBig, Fix128TypeMaxIntBig).
+	WithFractionalRange(Fix128TypeMinFractionalBig, Fix128TypeMaxFractionalBig).
+	WithScale(Fix128Scale).
+	WithSaturatingFunctions(SaturatingArithmeticSupport{
+		Add:      true,
+		Subtract: true,
+		Multiply: true,
+		Divide:   true,
+	})
+var Fix128TypeAnnotation = NewTypeAnnotation(Fix128Type)
+
+// UFix128Type represents the 128-bit unsigned decimal fixed-point type `UFix128`
+// which has a scale of Fix128Scale, and checks for overflow and underflow
+var UFix128Type = NewFixedPointNumericType(UFix128TypeName).
+	WithTag(UFix128TypeTag).
+	WithIntRange(UFix128TypeMinIntBig, UFix128TypeMaxIntBig).
+	WithFractionalRange(UFix128TypeMinFractionalBig, UFix128TypeMaxFractionalBig).
+	WithScale(Fix128Scale).
+	WithSaturatingFunctions(SaturatingArithmeticSupport{
+		Add:      true,
+		Subtract: true,
+		Multiply: true,
+	})
+var UFix128TypeAnnotation = NewTypeAnnotation(UFix128Type)
+
 // Numeric type ranges
 var (
 	Int8TypeMinInt = new(big.Int).SetInt64(math.MinInt8)
@@ -2026,6 +2070,26 @@ var (
 
 	UFix64TypeMinFractionalBig = fixedpoint.UFix64TypeMinFractionalBig
 	UFix64TypeMaxFractionalBig = fixedpoint.UFix64TypeMaxFractionalBig
+
+	Fix128FactorBig = fixedpoint.Fix128FactorBig
+
+	Fix128TypeMinBig = fixedpoint.Fix128TypeMinBig
+	Fix128TypeMaxBig = fixedpoint.Fix128TypeMaxBig
+
+	Fix128TypeMinIntBig = fixedpoint.Fix128TypeMinIntBig
+	Fix128TypeMaxIntBig = fixedpoint.Fix128TypeMaxIntBig
+
+	Fix128TypeMinFractionalBig = fixedpoint.Fix128TypeMinFractionalBig
+	Fix128TypeMaxFractionalBig = fixedpoint.Fix128TypeMaxFractionalBig
+
+	UFix128TypeMinBig = fixedpoint.UFix128TypeMinBig
+	UFix128TypeMaxBig = fixedpoint.UFix128TypeMaxBig
+
+	UFix128TypeMinIntBig = fixedpoint.UFix128TypeMinIntBig
+	UFix128TypeMaxIntBig = fixedpoint.UFix128TypeMaxIntBig
+
+	UFix128TypeMinFractionalBig = fixedpoint.UFix128TypeMinFractionalBig
+	UFix128TypeMaxFractionalBig = fixedpoint.UFix128TypeMaxFractionalBig
 )
 
 // size constants (in bytes) for fixed-width numeric types
@@ -2046,6 +2110,8 @@ const (
 	UFix64TypeSize  uint = 8
 	Int128TypeSize  uint = 16
 	UInt128TypeSize uint = 16
+	Fix128TypeSize  uint = 16
+	UFix128TypeSize uint = 16
 	Int256TypeSize  uint = 32
 	UInt256TypeSize uint = 32
 )
@@ -2065,6 +2131,8 @@ const UFix64TypeMaxInt = fixedpoint.UFix64TypeMaxInt
 const UFix64TypeMinFractional = fixedpoint.UFix64TypeMinFractional
 const UFix64TypeMaxFractional = fixedpoint.UFix64TypeMaxFractional
 
+const Fix128Scale = fixedpoint.Fix128Scale
+
 // ArrayType
 
 type ArrayType interface {
@@ -2186,6 +2254,60 @@ const arrayTypeMapFunctionDocString = `
 Returns a new array whose elements are produced by applying the mapper function on each element of the original array.
 `
 
+const ArrayTypeFirstIndexFunctionName = "firstIndex"
+
+// ArrayTypeFirstIndexWhereFunctionOverloadName is the overload name
+// of the overload of the firstIndex function which finds the first element matching a predicate
+var ArrayTypeFirstIndexWhereFunctionOverloadName = OverloadName(
+	ArrayTypeFirstIndexFunctionName,
+	[]string{"where"},
+)
+
+const arrayTypeFirstIndexWhereFunctionDocString = `
+Returns the index of the first element of the array for which the given predicate function returns true, nil if no element matches.
+Available if the array element type is not resource-kinded.
+`
+
+const ArrayTypeSortFunctionName = "sort"
+
+const arrayTypeSortFunctionDocString = `
+Returns a new array with the elements of the original array, sorted using the given comparator function.
+The comparator function must return true if its first argument should be ordered before its second argument.
+
+The sort is stable, i.e. the order of elements which are not ordered before each other is preserved.
+It does not modify the original array.
+Available if the array element type is not resource-kinded.
+`
+
+const ArrayTypeReduceFunctionName = "reduce"
+
+const arrayTypeReduceFunctionDocString = `
+Returns the result of combining the elements of the array using the given function,
+starting with the given initial value.
+Available if the array element type is not resource-kinded.
+`
+
+const ArrayTypeAnyFunctionName = "any"
+
+const arrayTypeAnyFunctionDocString = `
+Returns true if the given predicate function returns true for at least one element of the array.
+Available if the array element type is not resource-kinded.
+`
+
+const ArrayTypeAllFunctionName = "all"
+
+const arrayTypeAllFunctionDocString = `
+Returns true if the given predicate function returns true for all elements of the array.
+Available if the array element type is not resource-kinded.
+`
+
+const ArrayTypeForEachFunctionName = "forEach"
+
+const arrayTypeForEachFunctionDocString = `
+Iterate over each element of the array, in order, exiting early if the passed function returns false.
+Available if the array element type is not resource-kinded.
+`
+
 func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 
 	members := map[string]MemberResolver{
@@ -2245,7 +2367,7 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 				)
 			},
 		},
-		"firstIndex": {
+		ArrayTypeFirstIndexFunctionName: {
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(
 				memoryGauge common.MemoryGauge,
@@ -2280,13 +2402,31 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 					)
 				}
 
-				return NewPublicFunctionMember(
+				member := NewPublicFunctionMember(
 					memoryGauge,
 					arrayType,
 					identifier,
 					ArrayFirstIndexFunctionType(elementType),
 					arrayTypeFirstIndexFunctionDocString,
 				)
+
+				// The function is overloaded with a function which finds
+				// the first element matching a predicate, i.e. `firstIndex(where:)`.
+				// NOTE: errors reported above only apply to the first overload,
+				// see Checker.visitMember
+
+				whereOverload := NewPublicFunctionMember(
+					memoryGauge,
+					arrayType,
+					identifier,
+					ArrayFirstIndexWhereFunctionType(elementType),
+					arrayTypeFirstIndexWhereFunctionDocString,
+				)
+				whereOverload.OverloadName = ArrayTypeFirstIndexWhereFunctionOverloadName
+
+				member.Overloads = []*Member{whereOverload}
+
+				return member
 			},
 		},
 		ArrayTypeReverseFunctionName: {
@@ -2379,6 +2519,39 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 				)
 			},
 		},
+		ArrayTypeSortFunctionName: newNonResourceArrayFunctionMemberResolver(
+			arrayType,
+			ArraySortFunctionType,
+			arrayTypeSortFunctionDocString,
+		),
+		ArrayTypeReduceFunctionName: newNonResourceArrayFunctionMemberResolver(
+			arrayType,
+			func(arrayType ArrayType) *FunctionType {
+				return ArrayReduceFunctionType(arrayType.ElementType(false))
+			},
+			arrayTypeReduceFunctionDocString,
+		),
+		ArrayTypeAnyFunctionName: newNonResourceArrayFunctionMemberResolver(
+			arrayType,
+			func(arrayType ArrayType) *FunctionType {
+				return ArrayAnyFunctionType(arrayType.ElementType(false))
+			},
+			arrayTypeAnyFunctionDocString,
+		),
+		ArrayTypeAllFunctionName: newNonResourceArrayFunctionMemberResolver(
+			arrayType,
+			func(arrayType ArrayType) *FunctionType {
+				return ArrayAllFunctionType(arrayType.ElementType(false))
+			},
+			arrayTypeAllFunctionDocString,
+		),
+		ArrayTypeForEachFunctionName: newNonResourceArrayFunctionMemberResolver(
+			arrayType,
+			func(arrayType ArrayType) *FunctionType {
+				return ArrayForEachFunctionType(arrayType.ElementType(false))
+			},
+			arrayTypeForEachFunctionDocString,
+		),
 	}
 
 	// TODO: maybe still return members but report a helpful error?
@@ -2945,6 +3118,218 @@ func ArrayMapFunctionType(memoryGauge common.MemoryGauge, arrayType ArrayType) *
 	}
 }
 
+// newNonResourceArrayFunctionMemberResolver returns a member resolver
+// for a public function of the given array type, which is only available
+// if the element type of the array is not resource-kinded
+func newNonResourceArrayFunctionMemberResolver(
+	arrayType ArrayType,
+	functionType func(arrayType ArrayType) *FunctionType,
+	docString string,
+) MemberResolver {
+	return MemberResolver{
+		Kind: common.DeclarationKindFunction,
+		Resolve: func(
+			memoryGauge common.MemoryGauge,
+			identifier string,
+			targetRange ast.HasPosition,
+			report func(error),
+		) *Member {
+
+			elementType := arrayType.ElementType(false)
+
+			if elementType.IsResourceType() {
+				report(
+					&InvalidResourceArrayMemberError{
+						Name:            identifier,
+						DeclarationKind: common.DeclarationKindFunction,
+						Range:           ast.NewRangeFromPositioned(memoryGauge, targetRange),
+					},
+				)
+			}
+
+			return NewPublicFunctionMember(
+				memoryGauge,
+				arrayType,
+				identifier,
+				functionType(arrayType),
+				docString,
+			)
+		},
+	}
+}
+
+// arrayPredicateFunctionType returns the type of a predicate function
+// for elements of the given type, i.e. `view fun(T): Bool`
+func arrayPredicateFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []Parameter{
+			{
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: BoolTypeAnnotation,
+		Purity:               FunctionPurityView,
+	}
+}
+
+func ArrayFirstIndexWhereFunctionType(elementType Type) *FunctionType {
+	// view fun firstIndex(where predicate: view fun(T): Bool): Int?
+	return &FunctionType{
+		Parameters: []Parameter{
+			{
+				Label:          "where",
+				Identifier:     "predicate",
+				TypeAnnotation: NewTypeAnnotation(arrayPredicateFunctionType(elementType)),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{Type: IntType},
+		),
+		Purity: FunctionPurityView,
+	}
+}
+
+func ArraySortFunctionType(arrayType ArrayType) *FunctionType {
+	// For [T] or [T; N]
+	// view fun sort(by comparator: view fun(T, T): Bool): [T]
+	//               or
+	// view fun sort(by comparator: view fun(T, T): Bool): [T; N]
+
+	elementType := arrayType.ElementType(false)
+
+	comparatorFunctionType := &FunctionType{
+		Parameters: []Parameter{
+			{
+				Identifier:     "a",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+			{
+				Identifier:     "b",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: BoolTypeAnnotation,
+		Purity:               FunctionPurityView,
+	}
+
+	return &FunctionType{
+		Parameters: []Parameter{
+			{
+				Label:          "by",
+				Identifier:     "comparator",
+				TypeAnnotation: NewTypeAnnotation(comparatorFunctionType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(arrayType),
+		Purity:               FunctionPurityView,
+	}
+}
+
+func ArrayReduceFunctionType(elementType Type) *FunctionType {
+	// fun reduce<U: AnyStruct>(initial: U, _ f: fun(U, T): U): U
+
+	typeParameter := &TypeParameter{
+		Name:      "U",
+		TypeBound: AnyStructType,
+	}
+
+	typeU := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	combineFunctionType := &FunctionType{
+		Parameters: []Parameter{
+			{
+				Identifier:     "accumulator",
+				TypeAnnotation: NewTypeAnnotation(typeU),
+			},
+			{
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(typeU),
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []Parameter{
+			{
+				Identifier:     "initial",
+				TypeAnnotation: NewTypeAnnotation(typeU),
+			},
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "f",
+				TypeAnnotation: NewTypeAnnotation(combineFunctionType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(typeU),
+	}
+}
+
+func ArrayAnyFunctionType(elementType Type) *FunctionType {
+	// view fun any(_ predicate: view fun(T): Bool): Bool
+	return &FunctionType{
+		Parameters: []Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "predicate",
+				TypeAnnotation: NewTypeAnnotation(arrayPredicateFunctionType(elementType)),
+			},
+		},
+		ReturnTypeAnnotation: BoolTypeAnnotation,
+		Purity:               FunctionPurityView,
+	}
+}
+
+func ArrayAllFunctionType(elementType Type) *FunctionType {
+	// view fun all(_ predicate: view fun(T): Bool): Bool
+	return &FunctionType{
+		Parameters: []Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "predicate",
+				TypeAnnotation: NewTypeAnnotation(arrayPredicateFunctionType(elementType)),
+			},
+		},
+		ReturnTypeAnnotation: BoolTypeAnnotation,
+		Purity:               FunctionPurityView,
+	}
+}
+
+func ArrayForEachFunctionType(elementType Type) *FunctionType {
+	const functionPurity = FunctionPurityImpure
+
+	// fun(T): Bool
+	funcType := NewSimpleFunctionType(
+		functionPurity,
+		[]Parameter{
+			{
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(elementType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+
+	// fun forEach(_ function: fun(T): Bool): Void
+	return NewSimpleFunctionType(
+		functionPurity,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "function",
+				TypeAnnotation: NewTypeAnnotation(funcType),
+			},
+		},
+		VoidTypeAnnotation,
+	)
+}
+
 // VariableSizedType is a variable sized array type
 type VariableSizedType struct {
 	Type                Type
@@ -3346,7 +3731,9 @@ func formatParameter(spaces bool, label, identifier, typeAnnotation string) stri
 }
 
 type Parameter struct {
-	TypeAnnotation  TypeAnnotation
+	TypeAnnotation TypeAnnotation
+	// DefaultArgument is the type of the parameter's default argument, if any.
+	// Arguments for trailing parameters with default arguments may be omitted
 	DefaultArgument Type
 	Label           string
 	Identifier      string
@@ -3516,6 +3903,18 @@ func (arity *Arity) MinCount(parameterCount int) int {
 	return minCount
 }
 
+// RequiredArgumentCount returns the number of arguments that must be provided
+// for the given parameters, i.e. the number of parameters
+// before the first parameter with a default argument
+func RequiredArgumentCount(parameters []Parameter) int {
+	for i, parameter := range parameters {
+		if parameter.DefaultArgument != nil {
+			return i
+		}
+	}
+	return len(parameters)
+}
+
 func (arity *Arity) MaxCount(parameterCount int) *int {
 	maxCount := parameterCount
 	if arity != nil {
@@ -3554,8 +3953,11 @@ type FunctionType struct {
 	TypeParameters           []*TypeParameter
 	Parameters               []Parameter
 	memberResolvers          map[string]MemberResolver
-	memberResolversOnce      sync.Once
-	IsConstructor            bool
+	// Overloads are the function types of the other overloads of an overloaded constructor.
+	// The overload of an invocation is selected based on the argument labels
+	Overloads           []*FunctionType
+	memberResolversOnce sync.Once
+	IsConstructor       bool
 }
 
 func NewSimpleFunctionType(
@@ -3936,6 +4338,26 @@ func (t *FunctionType) RewriteWithIntersectionTypes() (Type, bool) {
 	}
 }
 
+// EffectiveArity returns the arity of the function.
+// If no arity is declared explicitly, but the function has parameters with default arguments,
+// then the arguments for these parameters may be omitted
+func (t *FunctionType) EffectiveArity() *Arity {
+	if t.Arity != nil {
+		return t.Arity
+	}
+
+	parameterCount := len(t.Parameters)
+	requiredArgumentCount := RequiredArgumentCount(t.Parameters)
+	if requiredArgumentCount == parameterCount {
+		return nil
+	}
+
+	return &Arity{
+		Min: requiredArgumentCount,
+		Max: parameterCount,
+	}
+}
+
 func (t *FunctionType) ArgumentLabels() (argumentLabels []string) {
 
 	for _, parameter := range t.Parameters {
@@ -4011,7 +4433,25 @@ func (t *FunctionType) Unify(
 
 func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
 
-	// TODO: type parameters ?
+	// type parameters
+
+	// The function's own type parameters, which are resolved to themselves,
+	// remain type parameters of the resolved function type,
+	// e.g. for functions of instantiated generic composite types
+
+	var newTypeParameters []*TypeParameter
+
+	for _, typeParameter := range t.TypeParameters {
+		typeArgument, ok := typeArguments.Get(typeParameter)
+		if !ok {
+			continue
+		}
+
+		genericTypeArgument, ok := typeArgument.(*GenericType)
+		if ok && genericTypeArgument.TypeParameter == typeParameter {
+			newTypeParameters = append(newTypeParameters, typeParameter)
+		}
+	}
 
 	// parameters
 
@@ -4026,12 +4466,21 @@ func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type
 				return nil
 			}
 
+			var newDefaultArgument Type
+			if parameter.DefaultArgument != nil {
+				newDefaultArgument = parameter.DefaultArgument.Resolve(typeArguments)
+				if newDefaultArgument == nil {
+					newDefaultArgument = newParameterType
+				}
+			}
+
 			newParameters = append(
 				newParameters,
 				Parameter{
-					Label:          parameter.Label,
-					Identifier:     parameter.Identifier,
-					TypeAnnotation: NewTypeAnnotation(newParameterType),
+					Label:           parameter.Label,
+					Identifier:      parameter.Identifier,
+					TypeAnnotation:  NewTypeAnnotation(newParameterType),
+					DefaultArgument: newDefaultArgument,
 				},
 			)
 		}
@@ -4046,6 +4495,7 @@ func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type
 
 	return &FunctionType{
 		Purity:               t.Purity,
+		TypeParameters:       newTypeParameters,
 		Parameters:           newParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(newReturnType),
 		Arity:                t.Arity,
@@ -4197,6 +4647,7 @@ func init() {
 			DeploymentResultType,
 			HashableStructType,
 			&InclusiveRangeType{},
+			&SetType{},
 			StructStringerType,
 		},
 	)
@@ -4207,6 +4658,11 @@ func init() {
 
 	addToBaseActivation(IdentityType)
 
+	// Programs could already declare types named `Set` before the built-in type was added,
+	// so allow user declarations to shadow it
+
+	BaseTypeActivation.Find(SetTypeName).AllowShadowing = true
+
 	// The AST contains empty type annotations, resolve them to Void
 
 	BaseTypeActivation.Set(
@@ -4259,10 +4715,12 @@ var BaseValueActivation = NewVariableActivation(nil)
 
 var AllSignedFixedPointTypes = []Type{
 	Fix64Type,
+	Fix128Type,
 }
 
 var AllUnsignedFixedPointTypes = []Type{
 	UFix64Type,
+	UFix128Type,
 }
 
 var AllFixedPointTypes = common.Concat(
@@ -4738,10 +5196,15 @@ type Conformance struct {
 }
 
 type CompositeType struct {
-	Location      common.Location
-	EnumRawType   Type
+	Location    common.Location
+	EnumRawType Type
+	// EnumCases are the names of the cases of an enum type, in declaration order.
+	// Only set for enums declared in programs, not for native enums
+	EnumCases     []string
 	containerType Type
 	NestedTypes   *StringTypeOrderedMap
+	// TypeAliases are the type aliases nested in the composite type
+	TypeAliases *StringTypeAliasOrderedMap
 
 	// in a language with support for algebraic data types,
 	// we would implement this as an argument to the CompositeKind type constructor.
@@ -4770,19 +5233,32 @@ type CompositeType struct {
 	effectiveInterfaceConformancesOnce   sync.Once
 	memberResolversOnce                  sync.Once
 	ConstructorPurity                    FunctionPurity
-	HasComputedMembers                   bool
+	// InitializerOverloads are the function types of all initializers, in declaration order,
+	// if the composite declares overloaded initializers
+	InitializerOverloads []*FunctionType
+	HasComputedMembers   bool
 	// Only applicable for native composite types
 	ImportableBuiltin         bool
 	supportedEntitlementsOnce sync.Once
 	supportedEntitlements     *EntitlementSet
+	// typeParameters are the type parameters of a generic composite type
+	typeParameters []*TypeParameter
+	// genericType is the generic composite type
+	// this composite type is an instantiation of, if any
+	genericType *CompositeType
+	// typeArguments are the type arguments of an instantiated generic composite type
+	typeArguments           []Type
+	instantiatedMembersOnce sync.Once
 }
 
 var _ Type = &CompositeType{}
 var _ ContainerType = &CompositeType{}
+var _ TypeAliasContainerType = &CompositeType{}
 var _ ContainedType = &CompositeType{}
 var _ LocatedType = &CompositeType{}
 var _ CompositeKindedType = &CompositeType{}
 var _ TypeIndexableType = &CompositeType{}
+var _ ParameterizedType = &CompositeType{}
 
 func (t *CompositeType) Tag() TypeTag {
 	return CompositeTypeTag
@@ -4818,11 +5294,50 @@ func (t *CompositeType) EffectiveInterfaceConformances() []Conformance {
 func (*CompositeType) IsType() {}
 
 func (t *CompositeType) String() string {
-	return t.Identifier
+	return t.string(t.Identifier, func(ty Type) string {
+		return ty.String()
+	})
 }
 
 func (t *CompositeType) QualifiedString() string {
-	return t.QualifiedIdentifier()
+	return t.string(t.QualifiedIdentifier(), func(ty Type) string {
+		return ty.QualifiedString()
+	})
+}
+
+func (t *CompositeType) string(identifier string, typeFormatter func(Type) string) string {
+	if len(t.typeArguments) == 0 {
+		return identifier
+	}
+
+	typeArgumentStrings := make([]string, 0, len(t.typeArguments))
+	for _, typeArgument := range t.typeArguments {
+		typeArgumentStrings = append(typeArgumentStrings, typeFormatter(typeArgument))
+	}
+	return formatInstantiatedType(identifier, ", ", typeArgumentStrings)
+}
+
+func formatInstantiatedType[T ~string](baseType T, separator string, typeArguments []T) string {
+	var builder strings.Builder
+	builder.WriteString(string(baseType))
+	builder.WriteByte('<')
+	for i, typeArgument := range typeArguments {
+		if i > 0 {
+			builder.WriteString(separator)
+		}
+		builder.WriteString(string(typeArgument))
+	}
+	builder.WriteByte('>')
+	return builder.String()
+}
+
+// FormatInstantiatedTypeID returns the type ID of an instantiation
+// of the generic type with the given type ID, e.g. `S.test.Box<Int>`
+func FormatInstantiatedTypeID[T ~string](baseTypeID T, typeArgumentIDs []T) T {
+	if len(typeArgumentIDs) == 0 {
+		return baseTypeID
+	}
+	return T(formatInstantiatedType(baseTypeID, ",", typeArgumentIDs))
 }
 
 func (t *CompositeType) GetContainerType() Type {
@@ -4934,6 +5449,22 @@ func (t *CompositeType) initializeIdentifiers() {
 		return
 	}
 
+	if t.genericType != nil {
+		typeArgumentIDs := make([]TypeID, 0, len(t.typeArguments))
+		for _, typeArgument := range t.typeArguments {
+			typeArgumentIDs = append(typeArgumentIDs, typeArgument.ID())
+		}
+
+		t.cachedIdentifiers = &struct {
+			TypeID              TypeID
+			QualifiedIdentifier string
+		}{
+			TypeID:              FormatInstantiatedTypeID(t.genericType.ID(), typeArgumentIDs),
+			QualifiedIdentifier: t.genericType.QualifiedIdentifier(),
+		}
+		return
+	}
+
 	identifier := qualifiedIdentifier(t.Identifier, t.containerType)
 
 	typeID := common.NewTypeIDFromQualifiedName(nil, t.Location, identifier)
@@ -4958,9 +5489,39 @@ func (t *CompositeType) Equal(other Type) bool {
 }
 
 func (t *CompositeType) MemberMap() *StringMemberOrderedMap {
+	if t.genericType != nil {
+		t.instantiatedMembersOnce.Do(t.instantiateMembers)
+	}
 	return t.Members
 }
 
+// instantiateMembers declares the members of an instantiated generic composite type:
+// The members of the generic type, with the type parameters replaced by the type arguments.
+//
+// The members are instantiated lazily, as the generic composite type may be instantiated
+// before the members of the generic type are declared
+func (t *CompositeType) instantiateMembers() {
+	typeArguments := t.typeArgumentsMap()
+
+	members := &StringMemberOrderedMap{}
+	t.genericType.MemberMap().Foreach(func(name string, member *Member) {
+		members.Set(name, member.instantiate(typeArguments))
+	})
+
+	t.Members = members
+	t.Fields = t.genericType.Fields
+}
+
+// typeArgumentsMap returns the type arguments of the composite type,
+// keyed by the type parameters of the generic composite type
+func (t *CompositeType) typeArgumentsMap() *TypeParameterTypeOrderedMap {
+	typeArguments := &TypeParameterTypeOrderedMap{}
+	for i, typeParameter := range t.typeParameters {
+		typeArguments.Set(typeParameter, t.typeArguments[i])
+	}
+	return typeArguments
+}
+
 func newCompositeOrInterfaceSupportedEntitlementSet(
 	members *StringMemberOrderedMap,
 	effectiveInterfaceConformanceSet *InterfaceSet,
@@ -5017,7 +5578,7 @@ func (t *CompositeType) SupportedEntitlements() *EntitlementSet {
 	t.supportedEntitlementsOnce.Do(func() {
 
 		set := newCompositeOrInterfaceSupportedEntitlementSet(
-			t.Members,
+			t.MemberMap(),
 			t.EffectiveInterfaceConformanceSet(),
 		)
 
@@ -5081,7 +5642,7 @@ func (t *CompositeType) IsStorable(results map[*Member]bool) bool {
 	// If this composite type has a member which is non-storable,
 	// then the composite type is not storable.
 
-	for pair := t.Members.Oldest(); pair != nil; pair = pair.Next() {
+	for pair := t.MemberMap().Oldest(); pair != nil; pair = pair.Next() {
 		if !pair.Value.IsStorable(results) {
 			return false
 		}
@@ -5112,7 +5673,7 @@ func (t *CompositeType) IsImportable(results map[*Member]bool) bool {
 	// If this composite type has a member which is not importable,
 	// then the composite type is not importable.
 
-	for pair := t.Members.Oldest(); pair != nil; pair = pair.Next() {
+	for pair := t.MemberMap().Oldest(); pair != nil; pair = pair.Next() {
 		if !pair.Value.IsImportable(results) {
 			return false
 		}
@@ -5137,7 +5698,7 @@ func (t *CompositeType) IsExportable(results map[*Member]bool) bool {
 	// If this composite type has a member which is not exportable,
 	// then the composite type is not exportable.
 
-	for p := t.Members.Oldest(); p != nil; p = p.Next() {
+	for p := t.MemberMap().Oldest(); p != nil; p = p.Next() {
 		if !p.Value.IsExportable(results) {
 			return false
 		}
@@ -5170,19 +5731,157 @@ func (t *CompositeType) RewriteWithIntersectionTypes() (result Type, rewritten b
 	return t, false
 }
 
-func (*CompositeType) Unify(
-	_ Type,
-	_ *TypeParameterTypeOrderedMap,
-	_ func(err error),
-	_ common.MemoryGauge,
-	_ ast.HasPosition,
+func (t *CompositeType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	memoryGauge common.MemoryGauge,
+	outerRange ast.HasPosition,
 ) bool {
-	// TODO:
-	return false
+	otherComposite, ok := other.(*CompositeType)
+	if !ok {
+		return false
+	}
+
+	genericType, typeArguments := t.genericTypeAndTypeArguments()
+	otherGenericType, otherTypeArguments := otherComposite.genericTypeAndTypeArguments()
+	if len(typeArguments) == 0 || genericType != otherGenericType {
+		return false
+	}
+
+	result := false
+	for i, typeArgument := range typeArguments {
+		if typeArgument.Unify(
+			otherTypeArguments[i],
+			typeParameters,
+			report,
+			memoryGauge,
+			outerRange,
+		) {
+			result = true
+		}
+	}
+	return result
 }
 
-func (t *CompositeType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
-	return t
+func (t *CompositeType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	genericType, ownTypeArguments := t.genericTypeAndTypeArguments()
+	if len(ownTypeArguments) == 0 {
+		return t
+	}
+
+	resolvedTypeArguments := make([]Type, 0, len(ownTypeArguments))
+	for _, typeArgument := range ownTypeArguments {
+		resolvedTypeArgument := typeArgument.Resolve(typeArguments)
+		if resolvedTypeArgument == nil {
+			// The generic composite type itself is used inside of its declaration,
+			// where its own type parameters are not necessarily resolved
+			genericTypeArgument, ok := typeArgument.(*GenericType)
+			if !ok || t.genericType != nil {
+				return nil
+			}
+			resolvedTypeArgument = genericTypeArgument
+		}
+		resolvedTypeArguments = append(resolvedTypeArguments, resolvedTypeArgument)
+	}
+
+	return genericType.instantiate(resolvedTypeArguments)
+}
+
+// genericTypeAndTypeArguments returns the generic composite type and the type arguments of the composite type.
+//
+// A generic composite type is considered to be instantiated with its own type parameters,
+// e.g. `S` is `S<T>` in the declaration of `S<T>`.
+//
+// If the composite type is not generic, no type arguments are returned
+func (t *CompositeType) genericTypeAndTypeArguments() (*CompositeType, []Type) {
+	if t.genericType != nil {
+		return t.genericType, t.typeArguments
+	}
+
+	if len(t.typeParameters) == 0 {
+		return t, nil
+	}
+
+	typeArguments := make([]Type, 0, len(t.typeParameters))
+	for _, typeParameter := range t.typeParameters {
+		typeArguments = append(
+			typeArguments,
+			&GenericType{
+				TypeParameter: typeParameter,
+			},
+		)
+	}
+	return t, typeArguments
+}
+
+func (t *CompositeType) TypeParameters() []*TypeParameter {
+	return t.typeParameters
+}
+
+func (t *CompositeType) TypeArguments() []Type {
+	return t.typeArguments
+}
+
+// BaseType returns the generic composite type,
+// if the composite type is an instantiated generic composite type
+func (t *CompositeType) BaseType() Type {
+	if t.genericType == nil {
+		return nil
+	}
+	return t.genericType
+}
+
+func (t *CompositeType) Instantiate(
+	_ common.MemoryGauge,
+	typeArguments []Type,
+	_ []*ast.TypeAnnotation,
+	_ func(err error),
+) Type {
+	genericType := t
+	if t.genericType != nil {
+		genericType = t.genericType
+	}
+
+	// The type arguments are already checked against the type parameters by the checker
+
+	if len(typeArguments) != len(genericType.typeParameters) {
+		return InvalidType
+	}
+
+	return genericType.instantiate(typeArguments)
+}
+
+func (t *CompositeType) instantiate(typeArguments []Type) *CompositeType {
+
+	// Instantiating a generic composite type with its own type parameters
+	// results in the generic composite type itself
+
+	isIdentity := true
+	for i, typeArgument := range typeArguments {
+		genericTypeArgument, ok := typeArgument.(*GenericType)
+		if !ok || genericTypeArgument.TypeParameter != t.typeParameters[i] {
+			isIdentity = false
+			break
+		}
+	}
+	if isIdentity {
+		return t
+	}
+
+	return &CompositeType{
+		Location:                      t.Location,
+		Identifier:                    t.Identifier,
+		Kind:                          t.Kind,
+		containerType:                 t.containerType,
+		NestedTypes:                   t.NestedTypes,
+		TypeAliases:                   t.TypeAliases,
+		ExplicitInterfaceConformances: t.ExplicitInterfaceConformances,
+		HasComputedMembers:            t.HasComputedMembers,
+		typeParameters:                t.typeParameters,
+		genericType:                   t,
+		typeArguments:                 typeArguments,
+	}
 }
 
 func (t *CompositeType) IsContainerType() bool {
@@ -5193,6 +5892,24 @@ func (t *CompositeType) GetNestedTypes() *StringTypeOrderedMap {
 	return t.NestedTypes
 }
 
+func (t *CompositeType) GetTypeAliases() *StringTypeAliasOrderedMap {
+	return t.TypeAliases
+}
+
+func (t *CompositeType) GetTypeAlias(name string) (*TypeAlias, bool) {
+	if t.TypeAliases == nil {
+		return nil, false
+	}
+	return t.TypeAliases.Get(name)
+}
+
+func (t *CompositeType) SetTypeAlias(alias *TypeAlias) {
+	if t.TypeAliases == nil {
+		t.TypeAliases = &StringTypeAliasOrderedMap{}
+	}
+	t.TypeAliases.Set(alias.Identifier.Identifier, alias)
+}
+
 func (t *CompositeType) isTypeIndexableType() bool {
 	// resources and structs only can be indexed for attachments
 	return t.Kind.SupportsAttachments()
@@ -5276,7 +5993,7 @@ func (t *CompositeType) initializeMemberResolvers() {
 
 func (t *CompositeType) initializerMemberResolversFunc() func() {
 	return func() {
-		memberResolvers := MembersMapAsResolvers(t.Members)
+		memberResolvers := MembersMapAsResolvers(t.MemberMap())
 
 		// Check conformances.
 		// If this composite type results from a normal composite declaration,
@@ -5352,7 +6069,31 @@ func (t *CompositeType) ConstructorFunctionType() *FunctionType {
 		Purity:               t.ConstructorPurity,
 		Parameters:           t.ConstructorParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(t),
+		Overloads:            t.ConstructorOverloadFunctionTypes(),
+	}
+}
+
+// ConstructorOverloadFunctionTypes returns the constructor function types
+// for all overloaded initializers but the first
+func (t *CompositeType) ConstructorOverloadFunctionTypes() []*FunctionType {
+	if len(t.InitializerOverloads) < 2 {
+		return nil
+	}
+
+	overloads := make([]*FunctionType, 0, len(t.InitializerOverloads)-1)
+	for _, initializerType := range t.InitializerOverloads[1:] {
+		overloads = append(
+			overloads,
+			&FunctionType{
+				IsConstructor:        true,
+				Purity:               initializerType.Purity,
+				TypeParameters:       t.typeParameters,
+				Parameters:           initializerType.Parameters,
+				ReturnTypeAnnotation: NewTypeAnnotation(t),
+			},
+		)
 	}
+	return overloads
 }
 
 func (t *CompositeType) InitializerFunctionType() *FunctionType {
@@ -5392,6 +6133,10 @@ func (t *CompositeType) CheckInstantiated(pos ast.HasPosition, memoryGauge commo
 	for _, typ := range t.ExplicitInterfaceConformances {
 		typ.CheckInstantiated(pos, memoryGauge, report)
 	}
+
+	for _, typeArgument := range t.typeArguments {
+		typeArgument.CheckInstantiated(pos, memoryGauge, report)
+	}
 }
 
 // Member
@@ -5413,6 +6158,78 @@ type Member struct {
 	HasConditions     bool
 	// IgnoreInSerialization determines if the field is ignored in serialization
 	IgnoreInSerialization bool
+	// Overloads are the other overloads of an overloaded function.
+	// Only set for the first function of an overload set
+	Overloads []*Member
+	// OverloadName is the name under which an overload is declared,
+	// if it is not the first function of its overload set
+	OverloadName string
+}
+
+// DeclaredName returns the name under which the member is declared in its container
+func (m *Member) DeclaredName() string {
+	if m.OverloadName != "" {
+		return m.OverloadName
+	}
+	return m.Identifier.Identifier
+}
+
+// Overload returns the member of the member's overload set
+// which has the given argument labels, if any
+func (m *Member) Overload(argumentLabels []string) *Member {
+	if argumentLabelsEqual(m.ArgumentLabels, argumentLabels) {
+		return m
+	}
+	for _, overload := range m.Overloads {
+		if argumentLabelsEqual(overload.ArgumentLabels, argumentLabels) {
+			return overload
+		}
+	}
+	return nil
+}
+
+// instantiate returns a copy of the member of a generic composite type,
+// with the type parameters replaced by the given type arguments
+func (m *Member) instantiate(typeArguments *TypeParameterTypeOrderedMap) *Member {
+	instantiatedMember := *m
+
+	// The type parameters of a generic function remain type parameters
+
+	if functionType, ok := m.TypeAnnotation.Type.(*FunctionType); ok &&
+		len(functionType.TypeParameters) > 0 {
+
+		functionTypeArguments := &TypeParameterTypeOrderedMap{}
+		functionTypeArguments.SetAll(typeArguments)
+		for _, typeParameter := range functionType.TypeParameters {
+			functionTypeArguments.Set(
+				typeParameter,
+				&GenericType{
+					TypeParameter: typeParameter,
+				},
+			)
+		}
+		typeArguments = functionTypeArguments
+	}
+
+	instantiatedType := m.TypeAnnotation.Type.Resolve(typeArguments)
+	if instantiatedType != nil {
+		instantiatedMember.TypeAnnotation = TypeAnnotation{
+			IsResource: m.TypeAnnotation.IsResource,
+			Type:       instantiatedType,
+		}
+	}
+
+	if len(m.Overloads) > 0 {
+		instantiatedMember.Overloads = make([]*Member, 0, len(m.Overloads))
+		for _, overload := range m.Overloads {
+			instantiatedMember.Overloads = append(
+				instantiatedMember.Overloads,
+				overload.instantiate(typeArguments),
+			)
+		}
+	}
+
+	return &instantiatedMember
 }
 
 func NewUnmeteredPublicFunctionMember(
@@ -5688,6 +6505,7 @@ type InterfaceType struct {
 	Members           *StringMemberOrderedMap
 	memberResolvers   map[string]MemberResolver
 	NestedTypes       *StringTypeOrderedMap
+	TypeAliases       *StringTypeAliasOrderedMap
 	cachedIdentifiers *struct {
 		TypeID              TypeID
 		QualifiedIdentifier string
@@ -5714,6 +6532,7 @@ type InterfaceType struct {
 
 var _ Type = &InterfaceType{}
 var _ ContainerType = &InterfaceType{}
+var _ TypeAliasContainerType = &InterfaceType{}
 var _ ContainedType = &InterfaceType{}
 var _ LocatedType = &InterfaceType{}
 var _ CompositeKindedType = &InterfaceType{}
@@ -5964,6 +6783,24 @@ func (t *InterfaceType) GetNestedTypes() *StringTypeOrderedMap {
 	return t.NestedTypes
 }
 
+func (t *InterfaceType) GetTypeAliases() *StringTypeAliasOrderedMap {
+	return t.TypeAliases
+}
+
+func (t *InterfaceType) GetTypeAlias(name string) (*TypeAlias, bool) {
+	if t.TypeAliases == nil {
+		return nil, false
+	}
+	return t.TypeAliases.Get(name)
+}
+
+func (t *InterfaceType) SetTypeAlias(alias *TypeAlias) {
+	if t.TypeAliases == nil {
+		t.TypeAliases = &StringTypeAliasOrderedMap{}
+	}
+	t.TypeAliases.Set(alias.Identifier.Identifier, alias)
+}
+
 func (t *InterfaceType) FieldPosition(name string, declaration *ast.InterfaceDeclaration) ast.Position {
 	return declaration.Members.FieldPosition(name, declaration.CompositeKind)
 }
@@ -6221,6 +7058,41 @@ since no intermediate storage is allocated.
 The order of iteration is undefined
 `
 
+const DictionaryTypeFilterFunctionName = "filter"
+
+const dictionaryTypeFilterFunctionDocString = `
+Returns a new dictionary with all entries of this dictionary for which the given function returns true.
+
+The given function must be a view function.
+This function does not modify the original dictionary.
+`
+
+const DictionaryTypeMapFunctionName = "map"
+
+const dictionaryTypeMapFunctionDocString = `
+Returns a new dictionary with the same keys as this dictionary,
+and the values returned by the given transform function, which is called for each key and value.
+
+This function does not modify the original dictionary.
+`
+
+const DictionaryTypeMapValuesFunctionName = "mapValues"
+
+const dictionaryTypeMapValuesFunctionDocString = `
+Returns a new dictionary with the same keys as this dictionary,
+and the values returned by the given transform function, which is called for each value.
+
+This function does not modify the original dictionary.
+`
+
+const DictionaryTypeForEachFunctionName = "forEach"
+
+const dictionaryTypeForEachFunctionDocString = `
+Iterate over each key and value of this dictionary, exiting early if the passed function returns false.
+
+The order of iteration is undefined
+`
+
 const dictionaryTypeValuesFieldDocString = `
 An array containing all values of the dictionary
 `
@@ -6412,11 +7284,71 @@ func (t *DictionaryType) initializeMemberResolvers() {
 						)
 					},
 				},
+				DictionaryTypeFilterFunctionName: newNonResourceDictionaryFunctionMemberResolver(
+					t,
+					DictionaryFilterFunctionType,
+					dictionaryTypeFilterFunctionDocString,
+				),
+				DictionaryTypeMapFunctionName: newNonResourceDictionaryFunctionMemberResolver(
+					t,
+					DictionaryMapFunctionType,
+					dictionaryTypeMapFunctionDocString,
+				),
+				DictionaryTypeMapValuesFunctionName: newNonResourceDictionaryFunctionMemberResolver(
+					t,
+					DictionaryMapValuesFunctionType,
+					dictionaryTypeMapValuesFunctionDocString,
+				),
+				DictionaryTypeForEachFunctionName: newNonResourceDictionaryFunctionMemberResolver(
+					t,
+					DictionaryForEachFunctionType,
+					dictionaryTypeForEachFunctionDocString,
+				),
 			},
 		)
 	})
 }
 
+// newNonResourceDictionaryFunctionMemberResolver returns a member resolver
+// for a public function of the given dictionary type, which is only available
+// if neither the key type nor the value type of the dictionary are resource-kinded
+func newNonResourceDictionaryFunctionMemberResolver(
+	dictionaryType *DictionaryType,
+	functionType func(dictionaryType *DictionaryType) *FunctionType,
+	docString string,
+) MemberResolver {
+	return MemberResolver{
+		Kind: common.DeclarationKindFunction,
+		Resolve: func(
+			memoryGauge common.MemoryGauge,
+			identifier string,
+			targetRange ast.HasPosition,
+			report func(error),
+		) *Member {
+
+			if dictionaryType.KeyType.IsResourceType() ||
+				dictionaryType.ValueType.IsResourceType() {
+
+				report(
+					&InvalidResourceDictionaryMemberError{
+						Name:            identifier,
+						DeclarationKind: common.DeclarationKindFunction,
+						Range:           ast.NewRangeFromPositioned(memoryGauge, targetRange),
+					},
+				)
+			}
+
+			return NewPublicFunctionMember(
+				memoryGauge,
+				dictionaryType,
+				identifier,
+				functionType(dictionaryType),
+				docString,
+			)
+		},
+	}
+}
+
 func DictionaryContainsKeyFunctionType(t *DictionaryType) *FunctionType {
 	return NewSimpleFunctionType(
 		FunctionPurityView,
@@ -6499,20 +7431,150 @@ func DictionaryForEachKeyFunctionType(t *DictionaryType) *FunctionType {
 	)
 }
 
-func (*DictionaryType) isValueIndexableType() bool {
-	return true
-}
+func DictionaryFilterFunctionType(t *DictionaryType) *FunctionType {
+	// fun filter(_ f: view fun(K, V): Bool): {K: V}
 
-func (t *DictionaryType) ElementType(_ bool) Type {
-	return &OptionalType{Type: t.ValueType}
-}
+	funcType := NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Identifier:     "key",
+				TypeAnnotation: NewTypeAnnotation(t.KeyType),
+			},
+			{
+				Identifier:     "value",
+				TypeAnnotation: NewTypeAnnotation(t.ValueType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
 
-func (*DictionaryType) AllowsValueIndexingAssignment() bool {
-	return true
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "f",
+				TypeAnnotation: NewTypeAnnotation(funcType),
+			},
+		},
+		NewTypeAnnotation(t),
+	)
 }
 
-func (t *DictionaryType) IndexingType() Type {
-	return t.KeyType
+// dictionaryMapFunctionType returns the type of a function
+// which maps the values of the given dictionary type to new values of a type U.
+// If withKey is true, the transform function is also passed the key
+func dictionaryMapFunctionType(t *DictionaryType, withKey bool) *FunctionType {
+
+	typeParameter := &TypeParameter{
+		Name: "U",
+	}
+
+	typeU := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	var transformParameters []Parameter
+	if withKey {
+		transformParameters = append(
+			transformParameters,
+			Parameter{
+				Identifier:     "key",
+				TypeAnnotation: NewTypeAnnotation(t.KeyType),
+			},
+		)
+	}
+	transformParameters = append(
+		transformParameters,
+		Parameter{
+			Identifier:     "value",
+			TypeAnnotation: NewTypeAnnotation(t.ValueType),
+		},
+	)
+
+	transformFuncType := &FunctionType{
+		Parameters:           transformParameters,
+		ReturnTypeAnnotation: NewTypeAnnotation(typeU),
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "transform",
+				TypeAnnotation: NewTypeAnnotation(transformFuncType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&DictionaryType{
+				KeyType:   t.KeyType,
+				ValueType: typeU,
+			},
+		),
+	}
+}
+
+func DictionaryMapFunctionType(t *DictionaryType) *FunctionType {
+	// fun map<U>(_ transform: fun(K, V): U): {K: U}
+	return dictionaryMapFunctionType(t, true)
+}
+
+func DictionaryMapValuesFunctionType(t *DictionaryType) *FunctionType {
+	// fun mapValues<U>(_ transform: fun(V): U): {K: U}
+	return dictionaryMapFunctionType(t, false)
+}
+
+func DictionaryForEachFunctionType(t *DictionaryType) *FunctionType {
+	const functionPurity = FunctionPurityImpure
+
+	// fun(K, V): Bool
+	funcType := NewSimpleFunctionType(
+		functionPurity,
+		[]Parameter{
+			{
+				Identifier:     "key",
+				TypeAnnotation: NewTypeAnnotation(t.KeyType),
+			},
+			{
+				Identifier:     "value",
+				TypeAnnotation: NewTypeAnnotation(t.ValueType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+
+	// fun forEach(_ function: fun(K, V): Bool): Void
+	return NewSimpleFunctionType(
+		functionPurity,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "function",
+				TypeAnnotation: NewTypeAnnotation(funcType),
+			},
+		},
+		VoidTypeAnnotation,
+	)
+}
+
+func (*DictionaryType) isValueIndexableType() bool {
+	return true
+}
+
+func (t *DictionaryType) ElementType(_ bool) Type {
+	return &OptionalType{Type: t.ValueType}
+}
+
+func (*DictionaryType) AllowsValueIndexingAssignment() bool {
+	return true
+}
+
+func (t *DictionaryType) IndexingType() Type {
+	return t.KeyType
 }
 
 type DictionaryEntryType struct {
@@ -6954,6 +8016,531 @@ func (t *InclusiveRangeType) Map(
 	return f(NewInclusiveRangeType(gauge, mappedMemberType))
 }
 
+// SetType
+
+const SetTypeName = "Set"
+
+type SetType struct {
+	ElementType         Type
+	memberResolvers     map[string]MemberResolver
+	memberResolversOnce sync.Once
+}
+
+var _ Type = &SetType{}
+var _ ParameterizedType = &SetType{}
+var _ EntitlementSupportingType = &SetType{}
+
+func NewSetType(memoryGauge common.MemoryGauge, elementType Type) *SetType {
+	common.UseMemory(memoryGauge, common.SetSemaTypeMemoryUsage)
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
+func (*SetType) IsType() {}
+
+func (*SetType) Tag() TypeTag {
+	return SetTypeTag
+}
+
+func (t *SetType) String() string {
+	elementString := ""
+	if t.ElementType != nil {
+		elementString = fmt.Sprintf("<%s>", t.ElementType.String())
+	}
+	return fmt.Sprintf(
+		"%s%s",
+		SetTypeName,
+		elementString,
+	)
+}
+
+func (t *SetType) QualifiedString() string {
+	elementString := ""
+	if t.ElementType != nil {
+		elementString = fmt.Sprintf("<%s>", t.ElementType.QualifiedString())
+	}
+	return fmt.Sprintf(
+		"%s%s",
+		SetTypeName,
+		elementString,
+	)
+}
+
+func SetTypeID(elementTypeID string) TypeID {
+	if elementTypeID != "" {
+		elementTypeID = fmt.Sprintf("<%s>", elementTypeID)
+	}
+	return TypeID(fmt.Sprintf(
+		"%s%s",
+		SetTypeName,
+		elementTypeID,
+	))
+}
+
+func (t *SetType) ID() TypeID {
+	var elementTypeID string
+	if t.ElementType != nil {
+		elementTypeID = string(t.ElementType.ID())
+	}
+	return SetTypeID(elementTypeID)
+}
+
+func (t *SetType) Equal(other Type) bool {
+	otherSet, ok := other.(*SetType)
+	if !ok {
+		return false
+	}
+	if otherSet.ElementType == nil {
+		return t.ElementType == nil
+	}
+
+	return otherSet.ElementType.Equal(t.ElementType)
+}
+
+func (*SetType) IsResourceType() bool {
+	return false
+}
+
+func (t *SetType) IsInvalidType() bool {
+	return t.ElementType != nil && t.ElementType.IsInvalidType()
+}
+
+func (t *SetType) IsOrContainsReferenceType() bool {
+	return t.ElementType != nil && t.ElementType.IsOrContainsReferenceType()
+}
+
+func (t *SetType) IsStorable(results map[*Member]bool) bool {
+	return t.ElementType != nil && t.ElementType.IsStorable(results)
+}
+
+func (t *SetType) IsExportable(results map[*Member]bool) bool {
+	return t.ElementType != nil && t.ElementType.IsExportable(results)
+}
+
+func (t *SetType) IsImportable(results map[*Member]bool) bool {
+	return t.ElementType != nil && t.ElementType.IsImportable(results)
+}
+
+func (t *SetType) IsEquatable() bool {
+	return t.ElementType != nil && t.ElementType.IsEquatable()
+}
+
+func (*SetType) IsComparable() bool {
+	return false
+}
+
+func (*SetType) IsPrimitiveType() bool {
+	return false
+}
+
+func (*SetType) ContainFieldsOrElements() bool {
+	return true
+}
+
+func (t *SetType) TypeAnnotationState() TypeAnnotationState {
+	if t.ElementType == nil {
+		return TypeAnnotationStateValid
+	}
+
+	return t.ElementType.TypeAnnotationState()
+}
+
+func (t *SetType) RewriteWithIntersectionTypes() (Type, bool) {
+	if t.ElementType == nil {
+		return t, false
+	}
+	rewrittenElementType, rewritten := t.ElementType.RewriteWithIntersectionTypes()
+	if rewritten {
+		return &SetType{
+			ElementType: rewrittenElementType,
+		}, true
+	}
+	return t, false
+}
+
+func (t *SetType) BaseType() Type {
+	if t.ElementType == nil {
+		return nil
+	}
+	return &SetType{}
+}
+
+func (t *SetType) Instantiate(
+	memoryGauge common.MemoryGauge,
+	typeArguments []Type,
+	_ []*ast.TypeAnnotation,
+	_ func(err error),
+) Type {
+	elementType := typeArguments[0]
+	return NewSetType(memoryGauge, elementType)
+}
+
+func (t *SetType) TypeArguments() []Type {
+	return []Type{
+		t.ElementType,
+	}
+}
+
+func (t *SetType) CheckInstantiated(pos ast.HasPosition, memoryGauge common.MemoryGauge, report func(err error)) {
+	CheckParameterizedTypeInstantiated(t, pos, memoryGauge, report)
+}
+
+var setTypeParameter = &TypeParameter{
+	Name:      "T",
+	TypeBound: HashableStructType,
+}
+
+func (*SetType) TypeParameters() []*TypeParameter {
+	return []*TypeParameter{
+		setTypeParameter,
+	}
+}
+
+func (*SetType) SupportedEntitlements() *EntitlementSet {
+	return arrayDictionaryEntitlements
+}
+
+const SetTypeLengthFieldName = "length"
+
+const setTypeLengthFieldDocString = `
+The number of elements in the set
+`
+
+const SetTypeContainsFunctionName = "contains"
+
+const setTypeContainsFunctionDocString = `
+Returns true if the given element is in the set
+`
+
+const SetTypeInsertFunctionName = "insert"
+
+const setTypeInsertFunctionDocString = `
+Inserts the given element into the set.
+
+Returns true if the element was inserted, or false if the set already contained the element
+`
+
+const SetTypeRemoveFunctionName = "remove"
+
+const setTypeRemoveFunctionDocString = `
+Removes the given element from the set.
+
+Returns true if the element was removed, or false if the set did not contain the element
+`
+
+const SetTypeUnionFunctionName = "union"
+
+const setTypeUnionFunctionDocString = `
+Returns a new set containing the elements of this set and the elements of the given set
+`
+
+const SetTypeIntersectionFunctionName = "intersection"
+
+const setTypeIntersectionFunctionDocString = `
+Returns a new set containing the elements of this set which are also in the given set
+`
+
+const SetTypeDifferenceFunctionName = "difference"
+
+const setTypeDifferenceFunctionDocString = `
+Returns a new set containing the elements of this set which are not in the given set
+`
+
+const SetTypeForEachFunctionName = "forEach"
+
+const setTypeForEachFunctionDocString = `
+Iterate over each element in this set, exiting early if the passed function returns false.
+
+The order of iteration is undefined
+`
+
+func (t *SetType) GetMembers() map[string]MemberResolver {
+	t.initializeMemberResolvers()
+	return t.memberResolvers
+}
+
+func (t *SetType) initializeMemberResolvers() {
+	t.memberResolversOnce.Do(func() {
+		t.memberResolvers = withBuiltinMembers(
+			t,
+			map[string]MemberResolver{
+				SetTypeLengthFieldName: {
+					Kind: common.DeclarationKindField,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewPublicConstantFieldMember(
+							memoryGauge,
+							t,
+							identifier,
+							IntType,
+							setTypeLengthFieldDocString,
+						)
+					},
+				},
+				SetTypeContainsFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewPublicFunctionMember(
+							memoryGauge,
+							t,
+							identifier,
+							SetContainsFunctionType(t),
+							setTypeContainsFunctionDocString,
+						)
+					},
+				},
+				SetTypeInsertFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewFunctionMember(
+							memoryGauge,
+							t,
+							insertMutateEntitledAccess,
+							identifier,
+							SetInsertFunctionType(t),
+							setTypeInsertFunctionDocString,
+						)
+					},
+				},
+				SetTypeRemoveFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewFunctionMember(
+							memoryGauge,
+							t,
+							removeMutateEntitledAccess,
+							identifier,
+							SetRemoveFunctionType(t),
+							setTypeRemoveFunctionDocString,
+						)
+					},
+				},
+				SetTypeUnionFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewPublicFunctionMember(
+							memoryGauge,
+							t,
+							identifier,
+							SetOperationFunctionType(t),
+							setTypeUnionFunctionDocString,
+						)
+					},
+				},
+				SetTypeIntersectionFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewPublicFunctionMember(
+							memoryGauge,
+							t,
+							identifier,
+							SetOperationFunctionType(t),
+							setTypeIntersectionFunctionDocString,
+						)
+					},
+				},
+				SetTypeDifferenceFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewPublicFunctionMember(
+							memoryGauge,
+							t,
+							identifier,
+							SetOperationFunctionType(t),
+							setTypeDifferenceFunctionDocString,
+						)
+					},
+				},
+				SetTypeForEachFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewPublicFunctionMember(
+							memoryGauge,
+							t,
+							identifier,
+							SetForEachFunctionType(t),
+							setTypeForEachFunctionDocString,
+						)
+					},
+				},
+			},
+		)
+	})
+}
+
+func SetContainsFunctionType(t *SetType) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+}
+
+func SetInsertFunctionType(t *SetType) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityImpure,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+}
+
+func SetRemoveFunctionType(t *SetType) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityImpure,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+}
+
+// SetOperationFunctionType returns the type of the functions
+// which combine the set with another set into a new set,
+// i.e. `union`, `intersection`, and `difference`
+func SetOperationFunctionType(t *SetType) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: NewTypeAnnotation(t),
+			},
+		},
+		NewTypeAnnotation(t),
+	)
+}
+
+func SetForEachFunctionType(t *SetType) *FunctionType {
+	const functionPurity = FunctionPurityImpure
+
+	// fun(T): Bool
+	funcType := NewSimpleFunctionType(
+		functionPurity,
+		[]Parameter{
+			{
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+
+	// fun forEach(_ function: fun(T): Bool): Void
+	return NewSimpleFunctionType(
+		functionPurity,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "function",
+				TypeAnnotation: NewTypeAnnotation(funcType),
+			},
+		},
+		VoidTypeAnnotation,
+	)
+}
+
+func (*SetType) AllowsValueIndexingAssignment() bool {
+	return false
+}
+
+func (t *SetType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	memoryGauge common.MemoryGauge,
+	outerRange ast.HasPosition,
+) bool {
+	otherSet, ok := other.(*SetType)
+	if !ok {
+		return false
+	}
+
+	return t.ElementType.Unify(
+		otherSet.ElementType,
+		typeParameters,
+		report,
+		memoryGauge,
+		outerRange,
+	)
+}
+
+func (t *SetType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	elementType := t.ElementType.Resolve(typeArguments)
+	if elementType == nil {
+		return nil
+	}
+
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
+func (t *SetType) Map(
+	gauge common.MemoryGauge,
+	typeParamMap map[*TypeParameter]*TypeParameter,
+	f func(Type) Type,
+) Type {
+	mappedElementType := t.ElementType.Map(gauge, typeParamMap, f)
+	return f(NewSetType(gauge, mappedElementType))
+}
+
 // ReferenceType represents the reference to a value
 type ReferenceType struct {
 	Type          Type
@@ -7498,6 +9085,15 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 		return true
 	}
 
+	// A generic type is a subtype of all supertypes of its type bound
+
+	if genericSubType, ok := subType.(*GenericType); ok {
+		typeBound := genericSubType.TypeParameter.TypeBound
+		if typeBound != nil && IsSubType(typeBound, superType) {
+			return true
+		}
+	}
+
 	switch superType {
 	case AnyType:
 		return true
@@ -7586,7 +9182,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 	case FixedPointType:
 		switch subType {
 		case FixedPointType, SignedFixedPointType,
-			UFix64Type:
+			UFix64Type, UFix128Type:
 
 			return true
 
@@ -7596,7 +9192,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 
 	case SignedFixedPointType:
 		switch subType {
-		case SignedFixedPointType, Fix64Type:
+		case SignedFixedPointType, Fix64Type, Fix128Type:
 			return true
 
 		default:
@@ -7923,8 +9519,9 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 
 			// A composite type `T` is a subtype of an interface type `V`:
 			// if `T` conforms to `V`, and `V` and `T` are of the same kind
+			// (or `T` is an enum and `V` is a struct interface)
 
-			if typedSubType.Kind != typedSuperType.CompositeKind {
+			if !typedSubType.Kind.CanConformTo(typedSuperType.CompositeKind) {
 				return false
 			}
 
@@ -0,0 +1,51 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+)
+
+// TypeAlias is a type alias, i.e. an alternative name for a type.
+//
+// Type aliases are transparent: the type of a type alias is the aliased type itself,
+// so values of the aliased type and of the type alias are interchangeable.
+type TypeAlias struct {
+	Location      common.Location
+	ContainerType Type
+	Type          Type
+	Identifier    ast.Identifier
+	DocString     string
+	Access        Access
+	// Range is the range of the type alias declaration
+	Range ast.Range
+}
+
+func (a *TypeAlias) QualifiedIdentifier() string {
+	return qualifiedIdentifier(a.Identifier.Identifier, a.ContainerType)
+}
+
+// TypeAliasContainerType is a type which might have nested type aliases
+type TypeAliasContainerType interface {
+	ContainerType
+	GetTypeAliases() *StringTypeAliasOrderedMap
+	GetTypeAlias(name string) (*TypeAlias, bool)
+	SetTypeAlias(alias *TypeAlias)
+}
@@ -0,0 +1,451 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/sema"
+	. "github.com/onflow/cadence/test_utils/common_utils"
+	. "github.com/onflow/cadence/test_utils/sema_utils"
+)
+
+func TestCheckTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("top-level", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          access(all) typealias Balances = {Address: UFix64}
+
+          access(all) fun total(balances: Balances): UFix64 {
+              var total = 0.0
+              for balance in balances.values {
+                  total = total + balance
+              }
+              return total
+          }
+
+          access(all) let balances: {Address: UFix64} = {0x1: 1.0}
+          access(all) let x = total(balances: balances)
+        `)
+		require.NoError(t, err)
+
+		aliasedType := RequireGlobalType(t, checker.Elaboration, "Balances")
+		assert.True(t,
+			aliasedType.Equal(&sema.DictionaryType{
+				KeyType:   sema.TheAddressType,
+				ValueType: sema.UFix64Type,
+			}),
+		)
+
+		assert.Equal(t,
+			sema.UFix64Type,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          access(all) entitlement Withdraw
+
+          access(all) resource interface Vault {
+              access(Withdraw) fun withdraw(): @{Vault}
+          }
+
+          access(all) typealias WithdrawableVault = auth(Withdraw) &{Vault}
+
+          access(all) resource R: Vault {
+              access(Withdraw) fun withdraw(): @{Vault} {
+                  return <- create R()
+              }
+          }
+
+          access(all) fun withdraw(vault: WithdrawableVault): @{Vault} {
+              return <- vault.withdraw()
+          }
+
+          access(all) fun test(): @{Vault} {
+              let r <- create R()
+              let vault <- withdraw(vault: &r as auth(Withdraw) &R)
+              destroy r
+              return <- vault
+          }
+        `)
+		require.NoError(t, err)
+
+		aliasedType := RequireGlobalType(t, checker.Elaboration, "WithdrawableVault")
+		require.IsType(t, &sema.ReferenceType{}, aliasedType)
+		assert.Equal(t,
+			"auth(Withdraw) &{Vault}",
+			aliasedType.QualifiedString(),
+		)
+	})
+
+	t.Run("alias of alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          access(all) typealias IDs = [UInt64]
+          access(all) typealias OptionalIDs = IDs?
+
+          access(all) let ids: OptionalIDs = [1, 2, 3]
+        `)
+		require.NoError(t, err)
+
+		assert.True(t,
+			RequireGlobalValue(t, checker.Elaboration, "ids").Equal(
+				&sema.OptionalType{
+					Type: &sema.VariableSizedType{
+						Type: sema.UInt64Type,
+					},
+				},
+			),
+		)
+	})
+
+	t.Run("forward reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) typealias OptionalIDs = IDs?
+          access(all) typealias IDs = [UInt64]
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("cyclic", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) typealias T = [T]
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var cyclicErr *sema.CyclicTypeAliasError
+		require.ErrorAs(t, errs[0], &cyclicErr)
+		assert.Equal(t, "T", cyclicErr.Name)
+	})
+
+	t.Run("cyclic, indirect", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) typealias A = B
+          access(all) typealias B = {String: A}
+          access(all) typealias C = A?
+        `)
+
+		// C refers to a cyclic type alias, but is not part of the cycle itself
+		errs := RequireCheckerErrors(t, err, 2)
+
+		var cyclicErr *sema.CyclicTypeAliasError
+
+		require.ErrorAs(t, errs[0], &cyclicErr)
+		assert.Equal(t, "A", cyclicErr.Name)
+
+		require.ErrorAs(t, errs[1], &cyclicErr)
+		assert.Equal(t, "B", cyclicErr.Name)
+	})
+
+	t.Run("redeclaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) struct S {}
+          access(all) typealias S = Int
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("built-in type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) typealias Int = String
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("local", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              typealias IDs = [UInt64]
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDeclarationError{}, errs[0])
+	})
+
+	t.Run("invalid access modifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(self) typealias IDs = [UInt64]
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessModifierError{}, errs[0])
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) typealias Balances = {Address: UFix64}
+
+          access(all) let names: {Address: String} = {}
+          access(all) let balances: Balances = names
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var typeMismatchErr *sema.TypeMismatchError
+		require.ErrorAs(t, errs[0], &typeMismatchErr)
+
+		notes := typeMismatchErr.ErrorNotes()
+		require.Len(t, notes, 1)
+
+		note := notes[0]
+		assert.Equal(t,
+			"`Balances` is an alias for `{Address: UFix64}`",
+			note.Message(),
+		)
+		assert.Equal(t,
+			ast.Position{Offset: 11, Line: 2, Column: 10},
+			note.(ast.HasPosition).StartPosition(),
+		)
+	})
+
+	t.Run("mismatch, argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) typealias IDs = [UInt64]
+
+          access(all) fun count(ids: IDs): Int {
+              return ids.length
+          }
+
+          access(all) let ids: [String] = []
+          access(all) let x = count(ids: ids)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var typeMismatchErr *sema.TypeMismatchError
+		require.ErrorAs(t, errs[0], &typeMismatchErr)
+
+		notes := typeMismatchErr.ErrorNotes()
+		require.Len(t, notes, 1)
+		assert.Equal(t,
+			"`IDs` is an alias for `[UInt64]`",
+			notes[0].Message(),
+		)
+	})
+}
+
+func TestCheckNestedTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) contract C {
+
+              access(all) resource NFT {}
+
+              access(all) typealias Collection = {UInt64: NFT}
+
+              access(all) struct S {
+                  access(all) let ids: IDs
+
+                  init(ids: IDs) {
+                      self.ids = ids
+                  }
+              }
+
+              access(all) typealias IDs = [UInt64]
+
+              access(all) fun makeCollection(): @Collection {
+                  return <- {}
+              }
+          }
+
+          access(all) fun test(): C.IDs {
+              let collection: @C.Collection <- C.makeCollection()
+              let ids = collection.keys
+              destroy collection
+              return ids
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("contract interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) contract interface CI {
+
+              access(all) typealias IDs = [UInt64]
+
+              access(all) fun getIDs(): IDs
+          }
+
+          access(all) contract C: CI {
+
+              access(all) fun getIDs(): CI.IDs {
+                  return []
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown nested type alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) contract C {}
+
+          access(all) let ids: C.IDs = []
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("conflict with nested type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) contract C {
+              access(all) struct S {}
+              access(all) typealias S = Int
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+		assert.IsType(t, &sema.RedeclarationError{}, errs[1])
+	})
+
+	for _, kind := range []common.CompositeKind{
+		common.CompositeKindStructure,
+		common.CompositeKindResource,
+	} {
+		t.Run(kind.Keyword(), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t, `
+              access(all) `+kind.Keyword()+` T {
+                  access(all) typealias IDs = [UInt64]
+              }
+            `)
+
+			errs := RequireCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidNestedDeclarationError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckImportTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          access(all) typealias IDs = [UInt64]
+
+          access(all) contract C {
+              access(all) typealias Balances = {Address: UFix64}
+          }
+        `,
+		ParseAndCheckOptions{
+			Location: ImportedLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = ParseAndCheckWithOptions(t,
+		`
+          import IDs, C from "imported"
+
+          access(all) let ids: IDs = [1, 2, 3]
+          access(all) let balances: C.Balances = {0x1: 1.0}
+        `,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				ImportHandler: func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+					return sema.ElaborationImport{
+						Elaboration: importedChecker.Elaboration,
+					}, nil
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+}
@@ -172,14 +172,14 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	fix64TypeMask
-	_ // future: Fix128
+	fix128TypeMask
 	_ // future: Fix256
 
 	_ // future: UFix8
 	_ // future: UFix16
 	_ // future: UFix32
 	ufix64TypeMask
-	_ // future: UFix128
+	ufix128TypeMask
 	_ // future: UFix256
 
 	stringTypeMask
@@ -228,6 +228,7 @@ const (
 	functionTypeMask
 	hashableStructMask
 	inclusiveRangeTypeMask
+	setTypeMask
 
 	invalidTypeMask
 )
@@ -268,10 +269,12 @@ var (
 			Or(UnsignedIntegerTypeTag)
 
 	SignedFixedPointTypeTag = newTypeTagFromLowerMask(signedFixedPointTypeMask).
-				Or(Fix64TypeTag)
+				Or(Fix64TypeTag).
+				Or(Fix128TypeTag)
 
 	UnsignedFixedPointTypeTag = newTypeTagFromLowerMask(unsignedFixedPointTypeMask).
-					Or(UFix64TypeTag)
+					Or(UFix64TypeTag).
+					Or(UFix128TypeTag)
 
 	FixedPointTypeTag = newTypeTagFromLowerMask(fixedPointTypeMask).
 				Or(SignedFixedPointTypeTag).
@@ -309,8 +312,10 @@ var (
 	Word128TypeTag = newTypeTagFromLowerMask(word128TypeMask)
 	Word256TypeTag = newTypeTagFromLowerMask(word256TypeMask)
 
-	Fix64TypeTag  = newTypeTagFromLowerMask(fix64TypeMask)
-	UFix64TypeTag = newTypeTagFromLowerMask(ufix64TypeMask)
+	Fix64TypeTag   = newTypeTagFromLowerMask(fix64TypeMask)
+	Fix128TypeTag  = newTypeTagFromLowerMask(fix128TypeMask)
+	UFix64TypeTag  = newTypeTagFromLowerMask(ufix64TypeMask)
+	UFix128TypeTag = newTypeTagFromLowerMask(ufix128TypeMask)
 
 	StringTypeTag           = newTypeTagFromLowerMask(stringTypeMask)
 	CharacterTypeTag        = newTypeTagFromLowerMask(characterTypeMask)
@@ -347,6 +352,7 @@ var (
 	IntersectionTypeTag                = newTypeTagFromUpperMask(intersectionTypeMask)
 	CapabilityTypeTag                  = newTypeTagFromUpperMask(capabilityTypeMask)
 	InclusiveRangeTypeTag              = newTypeTagFromUpperMask(inclusiveRangeTypeMask)
+	SetTypeTag                         = newTypeTagFromUpperMask(setTypeMask)
 	InvalidTypeTag                     = newTypeTagFromUpperMask(invalidTypeMask)
 	TransactionTypeTag                 = newTypeTagFromUpperMask(transactionTypeMask)
 	AnyResourceAttachmentTypeTag       = newTypeTagFromUpperMask(anyResourceAttachmentMask)
@@ -389,7 +395,8 @@ var (
 				Or(StorageCapabilityControllerTypeTag).
 				Or(AccountCapabilityControllerTypeTag).
 				Or(HashableStructTypeTag).
-				Or(InclusiveRangeTypeTag)
+				Or(InclusiveRangeTypeTag).
+				Or(SetTypeTag)
 
 	AnyResourceTypeTag = newTypeTagFromLowerMask(anyResourceTypeMask).
 				Or(AnyResourceAttachmentTypeTag)
@@ -598,8 +605,12 @@ func findSuperTypeFromLowerMask(joinedTypeTag TypeTag, types []Type) Type {
 
 	case fix64TypeMask:
 		return Fix64Type
+	case fix128TypeMask:
+		return Fix128Type
 	case ufix64TypeMask:
 		return UFix64Type
+	case ufix128TypeMask:
+		return UFix128Type
 
 	case stringTypeMask:
 		return StringType
@@ -694,7 +705,8 @@ func findSuperTypeFromUpperMask(joinedTypeTag TypeTag, types []Type) Type {
 		transactionTypeMask,
 		interfaceTypeMask,
 		functionTypeMask,
-		inclusiveRangeTypeMask:
+		inclusiveRangeTypeMask,
+		setTypeMask:
 		return getSuperTypeOfDerivedTypes(types)
 
 	case hashableStructMask:
@@ -45,4 +45,7 @@ type Variable struct {
 	ActivationDepth int
 	// IsConstant indicates if the variable is read-only
 	IsConstant bool
+	// AllowShadowing indicates if the built-in variable may be shadowed by user declarations.
+	// This is the case for built-ins which were added after programs could already use the name
+	AllowShadowing bool
 }
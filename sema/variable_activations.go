@@ -135,6 +135,7 @@ func (a *VariableActivation) DeclareValue(declaration ValueDeclaration) {
 		Pos:             declaration.ValueDeclarationPosition(),
 		DocString:       declaration.ValueDeclarationDocString(),
 		ActivationDepth: 0,
+		AllowShadowing:  declaration.ValueDeclarationAllowShadowing(),
 	})
 }
 
@@ -277,15 +278,17 @@ func (a *VariableActivations) declare(declaration variableDeclaration) (*Variabl
 	depth := a.Depth()
 
 	// Check if a variable with this name is already declared.
-	// Report an error if shadowing variables of outer scopes is not allowed,
-	// or the existing variable is declared in the current scope,
-	// or the existing variable is a built-in.
+	// Report an error if the existing variable is declared in the current scope,
+	// or shadowing variables of outer scopes is not allowed,
+	// or the existing variable is a built-in,
+	// unless the existing variable explicitly allows shadowing.
 
 	existingVariable := a.Find(declaration.identifier)
 	if existingVariable != nil &&
-		(!declaration.allowOuterScopeShadowing ||
-			existingVariable.ActivationDepth == depth ||
-			existingVariable.ActivationDepth == 0) {
+		(existingVariable.ActivationDepth == depth ||
+			(!existingVariable.AllowShadowing &&
+				(!declaration.allowOuterScopeShadowing ||
+					existingVariable.ActivationDepth == 0))) {
 
 		return nil, &RedeclarationError{
 			Kind:        declaration.kind,
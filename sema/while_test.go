@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/sema"
 	. "github.com/onflow/cadence/test_utils/sema_utils"
@@ -165,3 +166,171 @@ func TestCheckInvalidContinueStatement(t *testing.T) {
 	errs := RequireCheckerErrors(t, err, 1)
 	assert.IsType(t, &sema.ControlStatementError{}, errs[0])
 }
+
+func TestCheckLabeledLoops(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("break and continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  inner: for x in [1, 2, 3] {
+                      if x == 1 {
+                          continue outer
+                      }
+                      if x == 2 {
+                          continue inner
+                      }
+                      break outer
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("break in switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: for x in [1, 2, 3] {
+                  switch x {
+                      case 1:
+                          break outer
+                      default:
+                          break
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("same label in sibling loops", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              loop: while true {
+                  break loop
+              }
+              loop: while true {
+                  break loop
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("undeclared label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  break inner
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var notDeclaredErr *sema.NotDeclaredLabelError
+		require.ErrorAs(t, errs[0], &notDeclaredErr)
+		assert.Equal(t, "inner", notDeclaredErr.Name)
+	})
+
+	t.Run("label of non-enclosing loop", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              first: while true {
+                  break
+              }
+              while true {
+                  continue first
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredLabelError{}, errs[0])
+	})
+
+	t.Run("label in nested function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              outer: while true {
+                  fun () {
+                      while true {
+                          break outer
+                      }
+                  }
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredLabelError{}, errs[0])
+	})
+
+	t.Run("redeclared label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              loop: while true {
+                  loop: while true {
+                      break loop
+                  }
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.LabelRedeclarationError{}, errs[0])
+	})
+
+	t.Run("resource loss", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              outer: while true {
+                  let r <- create R()
+                  while true {
+                      break outer
+                  }
+                  destroy r
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+}
@@ -2023,10 +2023,14 @@ func instantiateContract(
 	argumentCount := len(argumentTypes)
 	parameterCount := len(parameterTypes)
 
-	if argumentCount < parameterCount {
+	// Arguments for trailing parameters with default arguments may be omitted
+
+	requiredArgumentCount := sema.RequiredArgumentCount(contractType.ConstructorParameters)
+
+	if argumentCount < requiredArgumentCount {
 		return nil, errors.NewDefaultUserError(
 			"invalid argument count, too few arguments: expected %d, got %d, next missing argument: `%s`",
-			parameterCount, argumentCount,
+			requiredArgumentCount, argumentCount,
 			parameterTypes[argumentCount],
 		)
 	} else if argumentCount > parameterCount {
@@ -2037,7 +2041,7 @@ func instantiateContract(
 		)
 	}
 
-	// argumentCount now equals to parameterCount
+	// argumentCount is now at most parameterCount
 
 	// Check arguments match parameter
 
@@ -773,7 +773,8 @@ func (validator *CadenceV042ToV1ContractUpdateValidator) checkConformanceV1(
 
 	oldConformances := oldDecl.Conformances
 
-	// NOTE 1: Here it is assumed enums will always have one and only one conformance.
+	// NOTE 1: Here it is assumed the first conformance of an enum is its raw type,
+	// and any further conformances are interfaces.
 	// This is enforced by the checker.
 	//
 	// NOTE 2: If one declaration is an enum, then other is also an enum at this stage.
@@ -789,14 +790,15 @@ func (validator *CadenceV042ToV1ContractUpdateValidator) checkConformanceV1(
 				MissingConformance: oldConformance.String(),
 				Range:              ast.NewUnmeteredRangeFromPositioned(newDecl.Identifier),
 			})
+
+			return
 		}
 
-		return
+		// The remaining conformances are interface conformances,
+		// which are checked the same way as for other composite declarations.
+		oldConformances = oldConformances[1:]
 	}
 
-	// Below check for multiple conformances is only applicable
-	// for non-enum type composite declarations. i.e: structs, resources, etc.
-
 	location := validator.underlyingUpdateValidator.location
 
 	elaboration := validator.newElaborations[location]
@@ -353,8 +353,14 @@ func checkFields(
 	newDeclaration ast.Declaration,
 ) {
 
-	oldFields := oldDeclaration.DeclarationMembers().FieldsByIdentifier()
-	newFields := newDeclaration.DeclarationMembers().Fields()
+	// Computed fields are not stored, so they can be freely added, removed, or changed.
+
+	oldFields := map[string]*ast.FieldDeclaration{}
+	for _, oldField := range oldDeclaration.DeclarationMembers().StoredFields() {
+		oldFields[oldField.Identifier.Identifier] = oldField
+	}
+
+	newFields := newDeclaration.DeclarationMembers().StoredFields()
 
 	// Updated contract has to have at-most the same number of field as the old contract.
 	// Any additional field may cause crashes/garbage-values when deserializing the already-stored data.
@@ -660,10 +666,11 @@ func (validator *ContractUpdateValidator) checkConformance(
 	newDecl *ast.CompositeDeclaration,
 ) {
 
-	// Here it is assumed enums will always have one and only one conformance.
+	// The first conformance of an enum is its raw type,
+	// and any further conformances are struct interfaces.
 	// This is enforced by the checker.
-	// Therefore, below check for multiple conformances is only applicable
-	// for non-enum type composite declarations. i.e: structs, resources, etc.
+	// Therefore, the below check also covers enums:
+	// The raw type cannot be changed, and conformances cannot be removed.
 
 	oldConformances := oldDecl.Conformances
 	newConformances := newDecl.Conformances
@@ -0,0 +1,126 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/sema"
+)
+
+// SetConstructorFunction
+
+const setConstructorFunctionDocString = `
+ Constructs a set containing the given elements.
+
+ If no elements are given, an empty set is constructed.
+ `
+
+var setConstructorFunctionType = func() *sema.FunctionType {
+	typeParameter := &sema.TypeParameter{
+		Name:      "T",
+		TypeBound: sema.HashableStructType,
+	}
+
+	elementType := &sema.GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		TypeParameters: []*sema.TypeParameter{
+			typeParameter,
+		},
+		Parameters: []sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "elements",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					&sema.VariableSizedType{
+						Type: elementType,
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(
+			&sema.SetType{
+				ElementType: elementType,
+			},
+		),
+		// `elements` parameter is optional
+		Arity: &sema.Arity{Min: 0, Max: 1},
+	}
+}()
+
+var SetConstructorFunction = func() StandardLibraryValue {
+	value := NewStandardLibraryStaticFunction(
+		sema.SetTypeName,
+		setConstructorFunctionType,
+		setConstructorFunctionDocString,
+		setConstructorFunction,
+	)
+
+	// Programs could already declare values named `Set`,
+	// e.g. the constructors of composites named `Set`,
+	// before the built-in constructor was added,
+	// so allow user declarations to shadow it
+	value.AllowShadowing = true
+
+	return value
+}()
+
+func setConstructorFunction(invocation interpreter.Invocation) interpreter.Value {
+	inter := invocation.Interpreter
+	locationRange := invocation.LocationRange
+
+	elementType := invocation.TypeParameterTypes.Oldest().Value
+
+	setStaticType := interpreter.NewSetStaticType(
+		inter,
+		interpreter.ConvertSemaToStaticType(inter, elementType),
+	)
+
+	var elements []interpreter.Value
+
+	if len(invocation.Arguments) > 0 {
+		array, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		elements = make([]interpreter.Value, 0, array.Count())
+
+		array.Iterate(
+			inter,
+			func(element interpreter.Value) (resume bool) {
+				elements = append(elements, element)
+				return true
+			},
+			false,
+			locationRange,
+		)
+	}
+
+	return interpreter.NewSetValue(
+		inter,
+		locationRange,
+		setStaticType,
+		elements...,
+	)
+}
@@ -33,6 +33,8 @@ type StandardLibraryValue struct {
 	DocString      string
 	ArgumentLabels []string
 	Kind           common.DeclarationKind
+	// AllowShadowing indicates if user declarations may shadow the value
+	AllowShadowing bool
 }
 
 func (v StandardLibraryValue) ValueDeclarationName() string {
@@ -66,3 +68,7 @@ func (v StandardLibraryValue) ValueDeclarationIsConstant() bool {
 func (v StandardLibraryValue) ValueDeclarationArgumentLabels() []string {
 	return v.ArgumentLabels
 }
+
+func (v StandardLibraryValue) ValueDeclarationAllowShadowing() bool {
+	return v.AllowShadowing
+}
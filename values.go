@@ -1384,6 +1384,169 @@ func (v UFix64) String() string {
 	return format.UFix64(uint64(v))
 }
 
+// Fix128
+
+type Fix128 struct {
+	Value *big.Int
+}
+
+var _ Value = Fix128{}
+
+var Fix128MemoryUsage = common.NewCadenceBigIntMemoryUsage(16)
+
+var fix128MinExceededError = errors.NewDefaultUserError("value exceeds min of Fix128")
+var fix128MaxExceededError = errors.NewDefaultUserError("value exceeds max of Fix128")
+
+func NewFix128(s string) (Fix128, error) {
+	v, err := fixedpoint.ParseFix128(s)
+	if err != nil {
+		return Fix128{}, err
+	}
+	return Fix128{Value: v}, nil
+}
+
+func NewFix128FromParts(negative bool, integer int, fraction uint) (Fix128, error) {
+	v, err := fixedpoint.NewFix128(
+		negative,
+		new(big.Int).SetInt64(int64(integer)),
+		new(big.Int).SetUint64(uint64(fraction)),
+		fixedpoint.Fix128Scale,
+	)
+	if err != nil {
+		return Fix128{}, err
+	}
+	return Fix128{Value: v}, nil
+}
+
+// NewFix128FromBig returns a Fix128 for the given raw fixed-point number,
+// i.e. the value scaled by 10^Fix128Scale
+func NewFix128FromBig(i *big.Int) (Fix128, error) {
+	if i.Cmp(sema.Fix128TypeMinBig) < 0 {
+		return Fix128{}, fix128MinExceededError
+	}
+	if i.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		return Fix128{}, fix128MaxExceededError
+	}
+	return Fix128{Value: i}, nil
+}
+
+func NewMeteredFix128(gauge common.MemoryGauge, constructor func() (string, error)) (Fix128, error) {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	value, err := constructor()
+	if err != nil {
+		return Fix128{}, err
+	}
+	return NewFix128(value)
+}
+
+func NewMeteredFix128FromRawFixedPointNumber(gauge common.MemoryGauge, n *big.Int) (Fix128, error) {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	return NewFix128FromBig(n)
+}
+
+func (Fix128) isValue() {}
+
+func (Fix128) Type() Type {
+	return Fix128Type
+}
+
+func (v Fix128) MeteredType(common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v Fix128) Big() *big.Int {
+	return v.Value
+}
+
+func (v Fix128) ToBigEndianBytes() []byte {
+	return values.SignedBigIntToSizedBigEndianBytes(v.Value, sema.Fix128TypeSize)
+}
+
+func (v Fix128) String() string {
+	return format.Fix128(v.Value)
+}
+
+// UFix128
+
+type UFix128 struct {
+	Value *big.Int
+}
+
+var _ Value = UFix128{}
+
+var UFix128MemoryUsage = common.NewCadenceBigIntMemoryUsage(16)
+
+var ufix128NegativeError = errors.NewDefaultUserError("invalid negative value for UFix128")
+var ufix128MaxExceededError = errors.NewDefaultUserError("value exceeds max of UFix128")
+
+func NewUFix128(s string) (UFix128, error) {
+	v, err := fixedpoint.ParseUFix128(s)
+	if err != nil {
+		return UFix128{}, err
+	}
+	return UFix128{Value: v}, nil
+}
+
+func NewUFix128FromParts(integer int, fraction uint) (UFix128, error) {
+	v, err := fixedpoint.NewUFix128(
+		new(big.Int).SetInt64(int64(integer)),
+		new(big.Int).SetUint64(uint64(fraction)),
+		fixedpoint.Fix128Scale,
+	)
+	if err != nil {
+		return UFix128{}, err
+	}
+	return UFix128{Value: v}, nil
+}
+
+// NewUFix128FromBig returns a UFix128 for the given raw fixed-point number,
+// i.e. the value scaled by 10^Fix128Scale
+func NewUFix128FromBig(i *big.Int) (UFix128, error) {
+	if i.Sign() < 0 {
+		return UFix128{}, ufix128NegativeError
+	}
+	if i.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		return UFix128{}, ufix128MaxExceededError
+	}
+	return UFix128{Value: i}, nil
+}
+
+func NewMeteredUFix128(gauge common.MemoryGauge, constructor func() (string, error)) (UFix128, error) {
+	common.UseMemory(gauge, UFix128MemoryUsage)
+	value, err := constructor()
+	if err != nil {
+		return UFix128{}, err
+	}
+	return NewUFix128(value)
+}
+
+func NewMeteredUFix128FromRawFixedPointNumber(gauge common.MemoryGauge, n *big.Int) (UFix128, error) {
+	common.UseMemory(gauge, UFix128MemoryUsage)
+	return NewUFix128FromBig(n)
+}
+
+func (UFix128) isValue() {}
+
+func (UFix128) Type() Type {
+	return UFix128Type
+}
+
+func (v UFix128) MeteredType(common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v UFix128) Big() *big.Int {
+	return v.Value
+}
+
+func (v UFix128) ToBigEndianBytes() []byte {
+	return values.UnsignedBigIntToSizedBigEndianBytes(v.Value, sema.UFix128TypeSize)
+}
+
+func (v UFix128) String() string {
+	return format.UFix128(v.Value)
+}
+
 // Array
 
 type Array struct {
@@ -1518,6 +1681,61 @@ func NewMeteredKeyValuePair(gauge common.MemoryGauge, key, value Value) KeyValue
 	}
 }
 
+// Set
+
+type Set struct {
+	SetType *SetType
+	Values  []Value
+}
+
+var _ Value = Set{}
+
+func NewSet(values []Value) Set {
+	return Set{Values: values}
+}
+
+func NewMeteredSet(
+	gauge common.MemoryGauge,
+	size int,
+	constructor func() ([]Value, error),
+) (Set, error) {
+	common.UseMemory(gauge, common.CadenceSetValueMemoryUsage)
+
+	values, err := constructor()
+	if err != nil {
+		return Set{}, err
+	}
+	return NewSet(values), err
+}
+
+func (Set) isValue() {}
+
+func (v Set) Type() Type {
+	if v.SetType == nil {
+		// Return nil Type instead of Type referencing nil *SetType,
+		// so caller can check if v's type is nil and also prevent nil pointer dereference.
+		return nil
+	}
+	return v.SetType
+}
+
+func (v Set) MeteredType(common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v Set) WithType(setType *SetType) Set {
+	v.SetType = setType
+	return v
+}
+
+func (v Set) String() string {
+	values := make([]string, len(v.Values))
+	for i, value := range v.Values {
+		values[i] = value.String()
+	}
+	return format.Set(values)
+}
+
 // Composite
 
 type Composite interface {
@@ -0,0 +1,311 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package values
+
+import (
+	"math/big"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/format"
+	"github.com/onflow/cadence/sema"
+)
+
+type Fix128Value struct {
+	BigInt *big.Int
+}
+
+var Fix128MemoryUsage = common.NewBigIntMemoryUsage(16)
+
+func NewFix128ValueWithInteger(gauge common.MemoryGauge, constructor func() (*big.Int, error)) (Fix128Value, error) {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	v, err := constructor()
+	if err != nil {
+		return Fix128Value{}, err
+	}
+	return NewUnmeteredFix128ValueWithInteger(v)
+}
+
+func NewUnmeteredFix128ValueWithInteger(integer *big.Int) (Fix128Value, error) {
+	if integer.Cmp(sema.Fix128TypeMinIntBig) < 0 {
+		return Fix128Value{}, UnderflowError{}
+	}
+
+	if integer.Cmp(sema.Fix128TypeMaxIntBig) > 0 {
+		return Fix128Value{}, OverflowError{}
+	}
+
+	return NewUnmeteredFix128Value(
+		new(big.Int).Mul(integer, sema.Fix128FactorBig),
+	), nil
+}
+
+func NewFix128Value(gauge common.MemoryGauge, constructor func() (*big.Int, error)) (Fix128Value, error) {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	v, err := constructor()
+	if err != nil {
+		return Fix128Value{}, err
+	}
+	return NewUnmeteredFix128Value(v), nil
+}
+
+func NewUnmeteredFix128Value(value *big.Int) Fix128Value {
+	return Fix128Value{
+		BigInt: value,
+	}
+}
+
+var _ Value = Fix128Value{}
+var _ EquatableValue = Fix128Value{}
+var _ ComparableValue[Fix128Value] = Fix128Value{}
+var _ NumberValue[Fix128Value] = Fix128Value{}
+var _ FixedPointValue[Fix128Value, *big.Int] = Fix128Value{}
+var _ atree.Storable = Fix128Value{}
+
+func (Fix128Value) isValue() {}
+
+func (v Fix128Value) String() string {
+	return format.Fix128(v.BigInt)
+}
+
+// checkFix128Range returns an error if the given value
+// is outside the range of Fix128
+func checkFix128Range(value *big.Int) error {
+	if value.Cmp(sema.Fix128TypeMinBig) < 0 {
+		return UnderflowError{}
+	} else if value.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		return OverflowError{}
+	}
+	return nil
+}
+
+// saturateFix128 returns the given value,
+// clamped to the range of Fix128
+func saturateFix128(value *big.Int) *big.Int {
+	if value.Cmp(sema.Fix128TypeMinBig) < 0 {
+		return sema.Fix128TypeMinBig
+	} else if value.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		return sema.Fix128TypeMaxBig
+	}
+	return value
+}
+
+func (v Fix128Value) Negate(gauge common.MemoryGauge) Fix128Value {
+	result, err := NewFix128Value(gauge, func() (*big.Int, error) {
+		// INT32-C
+		if v.BigInt.Cmp(sema.Fix128TypeMinBig) == 0 {
+			return nil, OverflowError{}
+		}
+		return new(big.Int).Neg(v.BigInt), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func (v Fix128Value) Plus(gauge common.MemoryGauge, other Fix128Value) (Fix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Add(v.BigInt, other.BigInt)
+		return result, checkFix128Range(result)
+	}
+
+	return NewFix128Value(gauge, valueGetter)
+}
+
+func (v Fix128Value) SaturatingPlus(gauge common.MemoryGauge, other Fix128Value) (Fix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Add(v.BigInt, other.BigInt)
+		return saturateFix128(result), nil
+	}
+
+	return NewFix128Value(gauge, valueGetter)
+}
+
+func (v Fix128Value) Minus(gauge common.MemoryGauge, other Fix128Value) (Fix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Sub(v.BigInt, other.BigInt)
+		return result, checkFix128Range(result)
+	}
+
+	return NewFix128Value(gauge, valueGetter)
+}
+
+func (v Fix128Value) SaturatingMinus(gauge common.MemoryGauge, other Fix128Value) (Fix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Sub(v.BigInt, other.BigInt)
+		return saturateFix128(result), nil
+	}
+
+	return NewFix128Value(gauge, valueGetter)
+}
+
+func (v Fix128Value) Mul(gauge common.MemoryGauge, other Fix128Value) (Fix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Mul(v.BigInt, other.BigInt)
+		result.Div(result, sema.Fix128FactorBig)
+		return result, checkFix128Range(result)
+	}
+
+	return NewFix128Value(gauge, valueGetter)
+}
+
+func (v Fix128Value) SaturatingMul(gauge common.MemoryGauge, other Fix128Value) (Fix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Mul(v.BigInt, other.BigInt)
+		result.Div(result, sema.Fix128FactorBig)
+		return saturateFix128(result), nil
+	}
+
+	return NewFix128Value(gauge, valueGetter)
+}
+
+func (v Fix128Value) Div(gauge common.MemoryGauge, other Fix128Value) (Fix128Value, error) {
+	// INT33-C
+	if other.BigInt.Sign() == 0 {
+		return Fix128Value{}, DivisionByZeroError{}
+	}
+
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		result.Div(result, other.BigInt)
+		return result, checkFix128Range(result)
+	}
+
+	return NewFix128Value(gauge, valueGetter)
+}
+
+func (v Fix128Value) SaturatingDiv(gauge common.MemoryGauge, other Fix128Value) (Fix128Value, error) {
+	// INT33-C
+	if other.BigInt.Sign() == 0 {
+		return Fix128Value{}, DivisionByZeroError{}
+	}
+
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		result.Div(result, other.BigInt)
+		return saturateFix128(result), nil
+	}
+
+	return NewFix128Value(gauge, valueGetter)
+}
+
+func (v Fix128Value) Mod(gauge common.MemoryGauge, other Fix128Value) (Fix128Value, error) {
+	// v - int(v/o) * o
+	quotient, err := v.Div(gauge, other)
+	if err != nil {
+		return Fix128Value{}, err
+	}
+
+	truncatedQuotient, err := NewFix128Value(
+		gauge,
+		func() (*big.Int, error) {
+			result := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+			return result.Mul(result, sema.Fix128FactorBig), nil
+		},
+	)
+	if err != nil {
+		return Fix128Value{}, err
+	}
+
+	subtrahend, err := truncatedQuotient.Mul(gauge, other)
+	if err != nil {
+		return Fix128Value{}, err
+	}
+
+	return v.Minus(gauge, subtrahend)
+}
+
+func (v Fix128Value) Less(other Fix128Value) bool {
+	return v.BigInt.Cmp(other.BigInt) < 0
+}
+
+func (v Fix128Value) LessEqual(other Fix128Value) bool {
+	return v.BigInt.Cmp(other.BigInt) <= 0
+}
+
+func (v Fix128Value) Greater(other Fix128Value) bool {
+	return v.BigInt.Cmp(other.BigInt) > 0
+}
+
+func (v Fix128Value) GreaterEqual(other Fix128Value) bool {
+	return v.BigInt.Cmp(other.BigInt) >= 0
+}
+
+func (v Fix128Value) Equal(other Value) bool {
+	otherFix128, ok := other.(Fix128Value)
+	if !ok {
+		return false
+	}
+	return v.BigInt.Cmp(otherFix128.BigInt) == 0
+}
+
+func (v Fix128Value) IntegerPart() *big.Int {
+	return new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig)
+}
+
+func (Fix128Value) Scale() int {
+	return sema.Fix128Scale
+}
+
+func (v Fix128Value) ToInt() (int, error) {
+	integer := v.IntegerPart()
+	if !integer.IsInt64() {
+		return 0, OverflowError{}
+	}
+	return int(integer.Int64()), nil
+}
+
+func (v Fix128Value) ToBigEndianBytes() []byte {
+	return SignedBigIntToSizedBigEndianBytes(v.BigInt, sema.Fix128TypeSize)
+}
+
+func (v Fix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (v Fix128Value) ByteSize() uint32 {
+	return CBORTagSize + GetBigIntCBORSize(v.BigInt)
+}
+
+func (v Fix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (Fix128Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// Encode encodes Fix128Value as
+//
+//	cbor.Tag{
+//			Number:  CBORTagFix128Value,
+//			Content: *big.Int(v.BigInt),
+//	}
+func (v Fix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
@@ -18,8 +18,10 @@
 
 package values
 
+import "math/big"
+
 // FixedPointValue is a fixed-point number value
-type FixedPointValue[T Value, U uint64 | int64] interface {
+type FixedPointValue[T Value, U uint64 | int64 | *big.Int] interface {
 	NumberValue[T]
 	IntegerPart() U
 	Scale() int
@@ -0,0 +1,300 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package values
+
+import (
+	"math/big"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/format"
+	"github.com/onflow/cadence/sema"
+)
+
+type UFix128Value struct {
+	BigInt *big.Int
+}
+
+func NewUFix128ValueWithInteger(gauge common.MemoryGauge, constructor func() (*big.Int, error)) (UFix128Value, error) {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	v, err := constructor()
+	if err != nil {
+		return UFix128Value{}, err
+	}
+	return NewUnmeteredUFix128ValueWithInteger(v)
+}
+
+func NewUnmeteredUFix128ValueWithInteger(integer *big.Int) (UFix128Value, error) {
+	if integer.Sign() < 0 {
+		return UFix128Value{}, UnderflowError{}
+	}
+
+	if integer.Cmp(sema.UFix128TypeMaxIntBig) > 0 {
+		return UFix128Value{}, OverflowError{}
+	}
+
+	return NewUnmeteredUFix128Value(
+		new(big.Int).Mul(integer, sema.Fix128FactorBig),
+	), nil
+}
+
+func NewUFix128Value(gauge common.MemoryGauge, constructor func() (*big.Int, error)) (UFix128Value, error) {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	v, err := constructor()
+	if err != nil {
+		return UFix128Value{}, err
+	}
+	return NewUnmeteredUFix128Value(v), nil
+}
+
+func NewUnmeteredUFix128Value(value *big.Int) UFix128Value {
+	return UFix128Value{
+		BigInt: value,
+	}
+}
+
+var _ Value = UFix128Value{}
+var _ EquatableValue = UFix128Value{}
+var _ ComparableValue[UFix128Value] = UFix128Value{}
+var _ NumberValue[UFix128Value] = UFix128Value{}
+var _ FixedPointValue[UFix128Value, *big.Int] = UFix128Value{}
+var _ atree.Storable = UFix128Value{}
+
+func (UFix128Value) isValue() {}
+
+func (v UFix128Value) String() string {
+	return format.UFix128(v.BigInt)
+}
+
+// checkUFix128Range returns an error if the given value
+// is outside the range of UFix128
+func checkUFix128Range(value *big.Int) error {
+	if value.Cmp(sema.UFix128TypeMinBig) < 0 {
+		return UnderflowError{}
+	} else if value.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		return OverflowError{}
+	}
+	return nil
+}
+
+// saturateUFix128 returns the given value,
+// clamped to the range of UFix128
+func saturateUFix128(value *big.Int) *big.Int {
+	if value.Cmp(sema.UFix128TypeMinBig) < 0 {
+		return sema.UFix128TypeMinBig
+	} else if value.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		return sema.UFix128TypeMaxBig
+	}
+	return value
+}
+
+func (v UFix128Value) Negate(_ common.MemoryGauge) UFix128Value {
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix128Value) Plus(gauge common.MemoryGauge, other UFix128Value) (UFix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Add(v.BigInt, other.BigInt)
+		return result, checkUFix128Range(result)
+	}
+
+	return NewUFix128Value(gauge, valueGetter)
+}
+
+func (v UFix128Value) SaturatingPlus(gauge common.MemoryGauge, other UFix128Value) (UFix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Add(v.BigInt, other.BigInt)
+		return saturateUFix128(result), nil
+	}
+
+	return NewUFix128Value(gauge, valueGetter)
+}
+
+func (v UFix128Value) Minus(gauge common.MemoryGauge, other UFix128Value) (UFix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Sub(v.BigInt, other.BigInt)
+		return result, checkUFix128Range(result)
+	}
+
+	return NewUFix128Value(gauge, valueGetter)
+}
+
+func (v UFix128Value) SaturatingMinus(gauge common.MemoryGauge, other UFix128Value) (UFix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Sub(v.BigInt, other.BigInt)
+		return saturateUFix128(result), nil
+	}
+
+	return NewUFix128Value(gauge, valueGetter)
+}
+
+func (v UFix128Value) Mul(gauge common.MemoryGauge, other UFix128Value) (UFix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Mul(v.BigInt, other.BigInt)
+		result.Div(result, sema.Fix128FactorBig)
+		return result, checkUFix128Range(result)
+	}
+
+	return NewUFix128Value(gauge, valueGetter)
+}
+
+func (v UFix128Value) SaturatingMul(gauge common.MemoryGauge, other UFix128Value) (UFix128Value, error) {
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Mul(v.BigInt, other.BigInt)
+		result.Div(result, sema.Fix128FactorBig)
+		return saturateUFix128(result), nil
+	}
+
+	return NewUFix128Value(gauge, valueGetter)
+}
+
+func (v UFix128Value) Div(gauge common.MemoryGauge, other UFix128Value) (UFix128Value, error) {
+	// INT33-C
+	if other.BigInt.Sign() == 0 {
+		return UFix128Value{}, DivisionByZeroError{}
+	}
+
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		result.Div(result, other.BigInt)
+		return result, checkUFix128Range(result)
+	}
+
+	return NewUFix128Value(gauge, valueGetter)
+}
+
+func (v UFix128Value) SaturatingDiv(gauge common.MemoryGauge, other UFix128Value) (UFix128Value, error) {
+	// INT33-C
+	if other.BigInt.Sign() == 0 {
+		return UFix128Value{}, DivisionByZeroError{}
+	}
+
+	valueGetter := func() (*big.Int, error) {
+		result := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		result.Div(result, other.BigInt)
+		return saturateUFix128(result), nil
+	}
+
+	return NewUFix128Value(gauge, valueGetter)
+}
+
+func (v UFix128Value) Mod(gauge common.MemoryGauge, other UFix128Value) (UFix128Value, error) {
+	// v - int(v/o) * o
+	quotient, err := v.Div(gauge, other)
+	if err != nil {
+		return UFix128Value{}, err
+	}
+
+	truncatedQuotient, err := NewUFix128Value(
+		gauge,
+		func() (*big.Int, error) {
+			result := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+			return result.Mul(result, sema.Fix128FactorBig), nil
+		},
+	)
+	if err != nil {
+		return UFix128Value{}, err
+	}
+
+	subtrahend, err := truncatedQuotient.Mul(gauge, other)
+	if err != nil {
+		return UFix128Value{}, err
+	}
+
+	return v.Minus(gauge, subtrahend)
+}
+
+func (v UFix128Value) Less(other UFix128Value) bool {
+	return v.BigInt.Cmp(other.BigInt) < 0
+}
+
+func (v UFix128Value) LessEqual(other UFix128Value) bool {
+	return v.BigInt.Cmp(other.BigInt) <= 0
+}
+
+func (v UFix128Value) Greater(other UFix128Value) bool {
+	return v.BigInt.Cmp(other.BigInt) > 0
+}
+
+func (v UFix128Value) GreaterEqual(other UFix128Value) bool {
+	return v.BigInt.Cmp(other.BigInt) >= 0
+}
+
+func (v UFix128Value) Equal(other Value) bool {
+	otherUFix128, ok := other.(UFix128Value)
+	if !ok {
+		return false
+	}
+	return v.BigInt.Cmp(otherUFix128.BigInt) == 0
+}
+
+func (v UFix128Value) IntegerPart() *big.Int {
+	return new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig)
+}
+
+func (UFix128Value) Scale() int {
+	return sema.Fix128Scale
+}
+
+func (v UFix128Value) ToInt() (int, error) {
+	integer := v.IntegerPart()
+	if !integer.IsInt64() {
+		return 0, OverflowError{}
+	}
+	return int(integer.Int64()), nil
+}
+
+func (v UFix128Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToSizedBigEndianBytes(v.BigInt, sema.UFix128TypeSize)
+}
+
+func (v UFix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (v UFix128Value) ByteSize() uint32 {
+	return CBORTagSize + GetBigIntCBORSize(v.BigInt)
+}
+
+func (v UFix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (UFix128Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// Encode encodes UFix128Value as
+//
+//	cbor.Tag{
+//			Number:  CBORTagUFix128Value,
+//			Content: *big.Int(v.BigInt),
+//	}
+func (v UFix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagUFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
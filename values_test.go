@@ -45,6 +45,8 @@ type valueTestCase struct {
 func newValueTestCases() map[string]valueTestCase {
 	ufix64, _ := NewUFix64("64.01")
 	fix64, _ := NewFix64("-32.11")
+	ufix128, _ := NewUFix128("64.01")
+	fix128, _ := NewFix128("-32.11")
 
 	testFunctionType := NewFunctionType(
 		FunctionPurityUnspecified,
@@ -168,6 +170,16 @@ func newValueTestCases() map[string]valueTestCase {
 			string:       "-32.11000000",
 			expectedType: Fix64Type,
 		},
+		"UFix128": {
+			value:        ufix128,
+			string:       "64.010000000000000000000000",
+			expectedType: UFix128Type,
+		},
+		"Fix128": {
+			value:        fix128,
+			string:       "-32.110000000000000000000000",
+			expectedType: Fix128Type,
+		},
 		"Void": {
 			value:        NewVoid(),
 			string:       "()",
@@ -474,6 +486,12 @@ func TestNumberValue_ToBigEndianBytes(t *testing.T) {
 	word256LargeValueTestCase, _ := NewWord256FromBig(new(big.Int).SetBytes([]byte{127, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255}))
 	word256MaxValue, _ := NewWord256FromBig(sema.Word256TypeMaxIntBig)
 
+	fix128Zero, _ := NewFix128("0.0")
+	fix128Value, _ := NewFix128("42.24")
+	fix128Negative, _ := NewFix128("-1.0")
+	ufix128Zero, _ := NewUFix128("0.0")
+	ufix128Value, _ := NewUFix128("42.24")
+
 	typeTests := map[string]map[NumberValue][]byte{
 		// Int*
 		"Int": {
@@ -662,12 +680,21 @@ func TestNumberValue_ToBigEndianBytes(t *testing.T) {
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 			Fix64(-1_00000000): {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			fix128Zero:     {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			fix128Value:    {0, 0, 0, 0, 0, 34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+			fix128Negative: {255, 255, 255, 255, 255, 255, 44, 61, 228, 49, 51, 18, 95, 0, 0, 0},
+		},
 		// UFix*
 		"UFix64": {
 			Fix64(0):           {0, 0, 0, 0, 0, 0, 0, 0},
 			Fix64(42_00000000): {0, 0, 0, 0, 250, 86, 234, 0},
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			ufix128Zero:  {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			ufix128Value: {0, 0, 0, 0, 0, 34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+		},
 	}
 
 	// Ensure the test cases are complete